@@ -0,0 +1,98 @@
+package airgap
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/assets"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog"
+	"github.com/project-ai-services/ai-services/internal/pkg/image"
+	"github.com/project-ai-services/ai-services/internal/pkg/modelstore"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// AirgapCmd returns the cobra command for moving templates to hosts without network access.
+func AirgapCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "airgap",
+		Short: "Move application templates to hosts without network access",
+		Long: `Export the container images, models and catalog assets of an application template to a
+bundle on a connected host, and import the bundle on a host without network access.
+
+Note:
+  - Supports only podman runtime`,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			// The catalog selects the components of the podman runtime
+			vars.RuntimeFactory = runtime.NewRuntimeFactory(types.RuntimeTypePodman)
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(NewExportCmd())
+	cmd.AddCommand(NewImportCmd())
+
+	return cmd
+}
+
+// templateContent returns the images and models the deployments of a template need.
+func templateContent(ctx context.Context, template string) ([]string, []string, error) {
+	provider, err := catalog.NewCatalogProvider()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create catalog provider: %w", err)
+	}
+
+	images, err := provider.GetCatalogImages(ctx, template)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Models of watsonx components are served remotely
+	models, err := provider.GetCatalogModels(ctx, template, "watsonx")
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Strings(images)
+	sort.Strings(models)
+
+	return images, models, nil
+}
+
+// catalogAssets returns the catalog assets embedded in the CLI.
+func catalogAssets() fs.FS {
+	return &assets.CatalogFS
+}
+
+// missingContent returns the images of a template that are not in the local image storage and its
+// models that are not in the models directory or fail verification.
+func missingContent(ctx context.Context, client *podman.PodmanClient, template, modelsDir string) ([]string, []string, error) {
+	images, models, err := templateContent(ctx, template)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	missingImages, err := image.FetchImagesNotFound(client, images)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	store := modelstore.NewStore(modelsDir)
+	missingModels := []string{}
+	for _, model := range models {
+		if result, err := store.Verify(model); err != nil || !result.Valid {
+			missingModels = append(missingModels, model)
+		}
+	}
+
+	return missingImages, missingModels, nil
+}
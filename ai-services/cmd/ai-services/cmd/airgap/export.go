@@ -0,0 +1,145 @@
+package airgap
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/version"
+	"github.com/project-ai-services/ai-services/internal/pkg/airgap"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog"
+	"github.com/project-ai-services/ai-services/internal/pkg/image"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/modelsource"
+	"github.com/project-ai-services/ai-services/internal/pkg/modelstore"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+// NewExportCmd creates the command exporting a template to a bundle.
+func NewExportCmd() *cobra.Command {
+	var (
+		template  string
+		output    string
+		modelsDir string
+	)
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the images, models and catalog assets of a template to a bundle",
+		Long: `Export everything the deployments of an application template need to a bundle: a tar
+archive holding an OCI archive per container image, the model files and the catalog assets, listed
+with their checksums in a manifest.
+
+Images missing from the local image storage are pulled and models missing from the models
+directory are downloaded first, from the model source set in the values of their component. Models are verified against the checksums
+recorded when they were downloaded.`,
+		Example: `  # Export the Digital Assistant template
+  ai-services airgap export --template rag -o bundle.tar
+
+  # Export with models read from a custom directory
+  ai-services airgap export --template rag -o bundle.tar --dir /path/to/models`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return export(cmd.Context(), template, output, modelsDir)
+		},
+	}
+
+	cmd.Flags().StringVarP(&template, "template", "t", "", "Application template name (Required)")
+	_ = cmd.MarkFlagRequired("template")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Path of the bundle to write (Required)")
+	_ = cmd.MarkFlagRequired("output")
+	cmd.Flags().StringVar(&modelsDir, "dir", utils.GetModelsPath(), "Directory of the models")
+
+	return cmd
+}
+
+func export(ctx context.Context, template, output, modelsDir string) error {
+	images, models, err := templateContent(ctx, template)
+	if err != nil {
+		return err
+	}
+
+	client, err := podman.NewPodmanClient()
+	if err != nil {
+		return fmt.Errorf("failed to connect to podman: %w", err)
+	}
+	if err := fetchMissing(ctx, client, template, images, models, modelsDir); err != nil {
+		return err
+	}
+
+	// Write next to the bundle and rename, so that a failed export leaves no bundle behind
+	partial := output + ".partial"
+	f, err := os.Create(partial)
+	if err != nil {
+		return fmt.Errorf("failed to create bundle: %w", err)
+	}
+	defer os.Remove(partial)
+	defer f.Close()
+
+	manifest, err := airgap.Export(ctx, client, airgap.ExportOptions{
+		Template:   template,
+		CLIVersion: version.GetVersion(),
+		Images:     images,
+		Models:     models,
+		ModelsDir:  modelsDir,
+		Catalog:    catalogAssets(),
+	}, f)
+	if err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write bundle: %w", err)
+	}
+	if err := os.Rename(partial, output); err != nil {
+		return fmt.Errorf("failed to write bundle: %w", err)
+	}
+
+	logger.Infof("Exported %d images, %d models and %d catalog assets of template '%s' to %s\n",
+		len(manifest.Images), len(manifest.Models), len(manifest.Catalog), template, output)
+
+	return nil
+}
+
+// fetchMissing pulls the images missing from the local image storage and downloads the models
+// missing from the models directory from the model source of their component.
+func fetchMissing(ctx context.Context, client *podman.PodmanClient, template string, images, models []string, modelsDir string) error {
+	missingImages, err := image.FetchImagesNotFound(client, images)
+	if err != nil {
+		return err
+	}
+	if err := image.PullImageFromRegistry(ctx, client, missingImages); err != nil {
+		return err
+	}
+
+	store := modelstore.NewStore(modelsDir)
+	var sources map[string]modelsource.Config
+	for _, model := range models {
+		if _, err := store.Get(model); err == nil {
+			continue
+		}
+		if sources == nil {
+			if sources, err = modelSources(ctx, template); err != nil {
+				return err
+			}
+		}
+		if err := modelsource.Download(ctx, sources[model], nil, model, modelsDir); err != nil {
+			return fmt.Errorf("failed to download model %s from %s: %w", model, sources[model], err)
+		}
+	}
+
+	return nil
+}
+
+// modelSources returns the model source of every model of a template, from the values of its
+// component.
+func modelSources(ctx context.Context, template string) (map[string]modelsource.Config, error) {
+	provider, err := catalog.NewCatalogProvider()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create catalog provider: %w", err)
+	}
+
+	// Models of watsonx components are served remotely
+	return provider.GetCatalogModelSources(ctx, template, "watsonx")
+}
@@ -0,0 +1,107 @@
+package airgap
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/version"
+	"github.com/project-ai-services/ai-services/internal/pkg/airgap"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+// NewImportCmd creates the command importing a bundle.
+func NewImportCmd() *cobra.Command {
+	var (
+		template  string
+		modelsDir string
+	)
+	cmd := &cobra.Command{
+		Use:   "import <bundle>",
+		Short: "Import a bundle into the local image storage and models directory",
+		Long: `Import a bundle written by 'ai-services airgap export': load its container images into the
+local podman image storage and its models into the models directory.
+
+Every image archive and model file is verified against the checksum of the manifest before it is
+used, and every loaded image must have the ID it was exported with. The command then reports the
+images and models of the template that are still missing on the host.`,
+		Example: `  # Import a bundle
+  ai-services airgap import bundle.tar
+
+  # Import a bundle and report what the chat template still misses
+  ai-services airgap import bundle.tar --template chat`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return importBundle(cmd.Context(), args[0], template, modelsDir)
+		},
+	}
+
+	cmd.Flags().StringVarP(&template, "template", "t", "", "Application template to report missing images and models for (default: the template of the bundle)")
+	cmd.Flags().StringVar(&modelsDir, "dir", utils.GetModelsPath(), "Directory to import the models to")
+
+	return cmd
+}
+
+func importBundle(ctx context.Context, bundle, template, modelsDir string) error {
+	f, err := os.Open(bundle)
+	if err != nil {
+		return fmt.Errorf("failed to open bundle: %w", err)
+	}
+	defer f.Close()
+
+	client, err := podman.NewPodmanClient()
+	if err != nil {
+		return fmt.Errorf("failed to connect to podman: %w", err)
+	}
+
+	result, err := airgap.Import(ctx, client, f, airgap.ImportOptions{ModelsDir: modelsDir, Catalog: catalogAssets()})
+	if err != nil {
+		return fmt.Errorf("failed to import bundle: %w", err)
+	}
+	manifest := result.Manifest
+	logger.Infof("Imported %d images and %d models of template '%s'\n", len(manifest.Images), len(manifest.Models), manifest.Template)
+
+	if len(result.CatalogChanges) > 0 {
+		logger.Warningf("The bundle was exported by ai-services %s, whose catalog differs from the one of this CLI (%s) in %d assets; "+
+			"templates may need images or models the bundle does not have\n",
+			manifest.CLIVersion, version.GetVersion(), len(result.CatalogChanges))
+	}
+
+	if template == "" {
+		template = manifest.Template
+	}
+
+	return reportMissing(ctx, client, template, modelsDir)
+}
+
+// reportMissing prints the images and models of a template that the host does not have.
+func reportMissing(ctx context.Context, client *podman.PodmanClient, template, modelsDir string) error {
+	images, models, err := missingContent(ctx, client, template, modelsDir)
+	if err != nil {
+		return fmt.Errorf("failed to check template '%s': %w", template, err)
+	}
+
+	if len(images) == 0 && len(models) == 0 {
+		logger.Infof("All images and models of template '%s' are available\n", template)
+
+		return nil
+	}
+
+	logger.Warningf("Template '%s' is missing %d images and %d models:\n", template, len(images), len(models))
+	printer := utils.NewTableWriter()
+	defer printer.CloseTableWriter()
+
+	printer.SetHeaders("TYPE", "NAME")
+	for _, image := range images {
+		printer.AppendRow("image", image)
+	}
+	for _, model := range models {
+		printer.AppendRow("model", model)
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/application/image"
 	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/application/model"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/output"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
@@ -26,6 +27,9 @@ var ApplicationCmd = &cobra.Command{
 	Long:  `The application command helps you deploy and monitor the applications`,
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 		cmd.SilenceUsage = true
+		if err := output.Check(cmd); err != nil {
+			return err
+		}
 		// Initialize runtime factory based on flag
 		rt := types.RuntimeType(runtimeType)
 		if !rt.Valid() {
@@ -47,11 +51,14 @@ func init() {
 	ApplicationCmd.AddCommand(image.ImageCmd)
 	ApplicationCmd.AddCommand(stopCmd)
 	ApplicationCmd.AddCommand(startCmd)
+	ApplicationCmd.AddCommand(restartCmd)
 	ApplicationCmd.AddCommand(infoCmd)
 	ApplicationCmd.AddCommand(logsCmd)
 	ApplicationCmd.AddCommand(model.ModelCmd)
 	ApplicationCmd.AddCommand(restoreCmd)
 	ApplicationCmd.AddCommand(backupCmd)
+	ApplicationCmd.AddCommand(exportCmd)
+	ApplicationCmd.AddCommand(applyCmd)
 
 	// Add runtime flag as required
 	ApplicationCmd.PersistentFlags().StringVarP(&runtimeType, "runtime", "r", "", fmt.Sprintf("runtime to use (options: %s, %s) (required)", types.RuntimeTypePodman, types.RuntimeTypeOpenShift))
@@ -61,4 +68,5 @@ func init() {
 	ApplicationCmd.PersistentFlags().BoolVar(&hiddenTemplates, "hidden", false, "Show hidden templates")
 	_ = ApplicationCmd.PersistentFlags().MarkHidden("tool-image")
 	_ = ApplicationCmd.PersistentFlags().MarkHidden("hidden")
+	output.BindFlags(ApplicationCmd.PersistentFlags())
 }
@@ -0,0 +1,177 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	catalogClient "github.com/project-ai-services/ai-services/internal/pkg/catalog/client"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	pkgUtils "github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+var (
+	applyFile   string
+	applyName   string
+	applyNoWait bool
+	applyPrune  bool
+	applyForce  bool
+)
+
+const applyManifestHelp = `Manifests are YAML or JSON documents (several per file separated by '---'). Either the
+short form, resolved like 'ai-services application create -t <template> --params ...':
+
+  apiVersion: ai-services.io/v1
+  kind: Application
+  metadata:
+    name: rag
+  spec:
+    template: rag
+    params:
+      chat.llm.vllm.model: ibm-granite/granite-3.3-8b-instruct
+
+or a full definition as produced by 'ai-services application export'.
+
+References of the form ${VAR} are replaced with the value of the environment variable VAR,
+so secrets can be supplied without storing them in the manifests. Every referenced variable
+must be set.
+
+Existing applications whose catalog template, versions, providers or non-secret component
+parameters differ from their manifest are replaced: they are deleted with their data kept
+and created again. Secret and service-level parameters are not compared.
+
+Replacing an application keeps its backup policies but deletes its API keys, and deleting
+it deletes both. Backups are always kept and can be restored into the application created
+again. Apply refuses changes that delete policies or API keys unless --force is given.
+`
+
+var applyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "Converge applications to a set of manifests",
+	Long: `Creates, replaces and optionally deletes applications so that the catalog server matches the
+manifests in a file or directory.
+
+` + applyManifestHelp + `
+With --prune, applications that exist on the server but have no manifest are deleted.
+`,
+	Example: `  # Recreate an application from an exported definition
+  ai-services application apply -f rag.yaml --runtime podman
+
+  # Deploy the same definition under a different name
+  ai-services application apply -f rag.yaml --name rag-staging --runtime openshift
+
+  # Converge to every manifest in a directory and delete unmanaged applications
+  ai-services application apply -f environments/prod/ --prune --runtime podman
+
+  # Show what apply would change
+  ai-services application apply diff -f environments/prod/ --prune --runtime podman`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		return applyManifests()
+	},
+}
+
+var applyDiffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Show the changes apply would make",
+	Long: `Compares the manifests in a file or directory with the applications on the catalog server
+and prints the planned changes without applying them.
+
+` + applyManifestHelp,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		_, plan, err := planManifests()
+		if err != nil {
+			return err
+		}
+
+		printPlan(plan)
+
+		return nil
+	},
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{applyCmd, applyDiffCmd} {
+		cmd.Flags().StringVarP(&applyFile, "file", "f", "", "Manifest file or directory of manifests (required)")
+		cmd.Flags().StringVar(&applyName, "name", "", "Override the application name (only for a single manifest)")
+		cmd.Flags().BoolVar(&applyPrune, "prune", false, "Delete applications that have no manifest")
+		_ = cmd.MarkFlagRequired("file")
+	}
+
+	applyCmd.Flags().BoolVar(&applyForce, "force", false, "Replace or delete applications even if their API keys or backup policies are deleted")
+	applyCmd.Flags().BoolVar(&applyNoWait, "no-wait", false, "Return once applications have been accepted instead of waiting for them to be ready")
+	applyCmd.Flags().BoolVarP(&autoYes, "yes", "y", false, "Automatically accept all confirmation prompts (default=false)")
+
+	applyCmd.AddCommand(applyDiffCmd)
+}
+
+// planManifests loads the manifests and computes the reconciliation plan.
+func planManifests() (*catalogClient.ApplicationClient, *reconcilePlan, error) {
+	manifests, err := loadManifests(applyFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if applyName != "" {
+		if len(manifests) != 1 {
+			return nil, nil, fmt.Errorf("--name can only be used with a single manifest, found %d", len(manifests))
+		}
+		manifests[0].Metadata.Name = applyName
+		if err := pkgUtils.VerifyAppName(applyName); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	appClient, err := catalogClient.NewApplicationClient()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create application client: %w", err)
+	}
+
+	plan, err := buildReconcilePlan(appClient, manifests, applyPrune)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return appClient, plan, nil
+}
+
+func applyManifests() error {
+	appClient, plan, err := planManifests()
+	if err != nil {
+		return err
+	}
+
+	printPlan(plan)
+
+	if !plan.hasChanges() {
+		logger.Infoln("Applications are up to date")
+
+		return nil
+	}
+
+	if n := plan.lossy(); n > 0 && !applyForce {
+		return fmt.Errorf("%d application(s) would lose API keys or backup policies, use --force to continue", n)
+	}
+
+	destructive := plan.count(changeDelete) + plan.count(changeReplace)
+	if destructive > 0 && !autoYes {
+		confirmed, err := pkgUtils.ConfirmAction(fmt.Sprintf("%d application(s) will be deleted or replaced. Continue? ", destructive))
+		if err != nil {
+			return fmt.Errorf("failed to take user input: %w", err)
+		}
+		if !confirmed {
+			logger.Infoln("Apply cancelled")
+
+			return nil
+		}
+	}
+
+	return executePlan(appClient, plan, !applyNoWait)
+}
+
+// Made with Bob
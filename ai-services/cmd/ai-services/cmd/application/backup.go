@@ -10,6 +10,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/application"
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common/opensearch"
 	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
@@ -18,6 +19,7 @@ import (
 var (
 	backupTarget   string
 	backupFilename string
+	backupIndices  []string
 )
 
 var backupCmd = &cobra.Command{
@@ -30,10 +32,16 @@ Arguments:
 
 Supported targets:
   - opensearch: Backup OpenSearch indices and data (Podman and OpenShift)
-  - digitize:   Backup digitize metadata (jobs and documents) (Podman and OpenShift)`,
+  - digitize:   Backup digitize metadata (jobs and documents) (Podman and OpenShift)
+
+OpenSearch backups contain the indices matching --index (all indices starting with "rag" by
+default) and a checksum manifest that is verified on restore.`,
 	Example: `  # Backup OpenSearch data with Podman (auto-generated filename)
   ai-services application backup myapp --target opensearch --runtime podman
 
+  # Backup the OpenSearch indices matching several patterns
+  ai-services application backup myapp --target opensearch --index 'rag*' --index 'docs-*' --runtime podman
+
   # Backup digitize data with OpenShift
   ai-services application backup myapp --target digitize --runtime openshift
 
@@ -57,6 +65,13 @@ Supported targets:
 			return fmt.Errorf("invalid target '%s'. Valid targets are: %s", target, strings.Join(validTargets, ", "))
 		}
 
+		if len(backupIndices) > 0 && target != "opensearch" {
+			return fmt.Errorf("--index is only supported with the opensearch target")
+		}
+		if err := opensearch.ValidatePatterns(backupIndices); err != nil {
+			return err
+		}
+
 		// Validate filename extension if provided
 		if backupFilename != "" && !strings.HasSuffix(backupFilename, ".tar.gz") {
 			return fmt.Errorf("backup file must have .tar.gz extension, got: %s", backupFilename)
@@ -107,6 +122,8 @@ Supported targets:
 			Name:       applicationName,
 			Target:     backupTarget,
 			BackupFile: absFilename, // Can be empty for auto-generation
+
+			IndexPatterns: backupIndices,
 		}
 
 		// Execute backup using the application interface
@@ -116,6 +133,7 @@ Supported targets:
 
 func init() {
 	backupCmd.Flags().StringVar(&backupTarget, "target", "", "Target to backup (opensearch, digitize) (required)")
+	backupCmd.Flags().StringSliceVar(&backupIndices, "index", nil, "Glob pattern of the OpenSearch indices to backup, repeatable (default \"rag*\")")
 	backupCmd.Flags().StringVar(&backupFilename, "filename", "", "Path to save the backup tar.gz file (optional, auto-generated if not specified)")
 
 	_ = backupCmd.MarkFlagRequired("target")
@@ -20,6 +20,7 @@ import (
 	apiModels "github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/models"
 	catalogClient "github.com/project-ai-services/ai-services/internal/pkg/catalog/client"
 	catalogTypes "github.com/project-ai-services/ai-services/internal/pkg/catalog/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/validators"
 	appFlags "github.com/project-ai-services/ai-services/internal/pkg/cli/constants/application"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/flagvalidator"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
@@ -47,6 +48,10 @@ var (
 	argParams    map[string]string
 	legacyCreate bool
 
+	// interactive flags.
+	interactiveCreate bool
+	saveValuesPath    string
+
 	// podman flags.
 	skipModelDownload     bool
 	skipImageDownload     bool
@@ -63,6 +68,12 @@ var createCmd = &cobra.Command{
 	Short: "Deploys an application",
 	Long: `Deploys an application with the provided application name based on the template
 
+With --interactive, the template, the services of an architecture, the component providers and
+their parameters are chosen in prompts that show the defaults and descriptions of the template
+schemas, followed by the CPU, memory, storage and Spyre cards the selection needs. The choices
+can be saved with --save-values as a values file for later non-interactive runs with -f. The
+file holds the selected services under "services" and the provider of every component type.
+
 Arguments:
   [name] : Application name (required)
 `,
@@ -74,6 +85,10 @@ Arguments:
 			return err
 		}
 
+		if templateName == "" && !interactiveCreate {
+			return fmt.Errorf("required flag(s) \"%s\" not set", appFlags.Create.Template)
+		}
+
 		// Build and run flag validator
 		flagValidator := buildFlagValidator()
 		if err := flagValidator.Validate(cmd); err != nil {
@@ -135,6 +150,15 @@ func createExample() string {
   # Deploy with default mode (CPU mode)
   ai-services application create rag --template rag --runtime podman --params reranker.vllm-cpu=true,llm.vllm-cpu=true
 
+  # Choose the template, providers and parameters in prompts
+  ai-services application create rag --runtime podman --interactive
+
+  # Choose providers and parameters in prompts and save them for later runs
+  ai-services application create rag --template rag --runtime podman --interactive --save-values rag-values.yaml
+
+  # Deploy with a saved values file
+  ai-services application create rag --template rag --runtime podman -f rag-values.yaml
+
   # Deploy with legacy mode
   ai-services application create rag --template rag --runtime podman --legacy
 
@@ -169,8 +193,7 @@ func initCreateCommonFlags() {
 	skipCheckDesc := appBootstrap.BuildSkipFlagDescription()
 	createCmd.Flags().StringSliceVar(&skipChecks, appFlags.Create.SkipValidation, []string{}, skipCheckDesc)
 
-	createCmd.Flags().StringVarP(&templateName, appFlags.Create.Template, "t", "", "Application template to use (required)")
-	_ = createCmd.MarkFlagRequired(appFlags.Create.Template)
+	createCmd.Flags().StringVarP(&templateName, appFlags.Create.Template, "t", "", "Application template to use (required unless --interactive)")
 
 	createCmd.Flags().StringSliceVar(
 		&rawArgParams,
@@ -192,10 +215,26 @@ func initCreateCommonFlags() {
 		[]string{},
 		"Specify values files to override default template values.\n\n"+
 			"Usage:\n"+
-			"- Can be provided multiple times; files are applied in order and later files override earlier ones\n",
+			"- Can be provided multiple times; files are applied in order and later files override earlier ones\n"+
+			"- A \"services\" list deploys only those services of an architecture\n",
 	)
 
 	createCmd.Flags().BoolVar(&legacyCreate, appFlags.Create.Legacy, false, "Use legacy application create implementation")
+
+	createCmd.Flags().BoolVar(
+		&interactiveCreate,
+		appFlags.Create.Interactive,
+		false,
+		"Choose the template, services, component providers and parameters in prompts\n\n"+
+			"Values given with --template, --values and --params are preselected\n",
+	)
+	createCmd.Flags().StringVar(
+		&saveValuesPath,
+		appFlags.Create.SaveValues,
+		"",
+		"Save the chosen services and parameters to a values file that can be passed to later runs with --values\n\n"+
+			"Secret parameters are not saved\n",
+	)
 }
 
 func initCreatePodmanFlags() {
@@ -273,7 +312,9 @@ func buildFlagValidator() *flagvalidator.FlagValidator {
 		AddCommonFlag(appFlags.Create.Template, validateTemplateFlag).
 		AddCommonFlag(appFlags.Create.Params, validateParamsFlag).
 		AddCommonFlag(appFlags.Create.Values, validateValuesFlag).
-		AddCommonFlag(appFlags.Create.Legacy, nil)
+		AddCommonFlag(appFlags.Create.Legacy, nil).
+		AddCommonFlag(appFlags.Create.Interactive, validateNotLegacyFlag(appFlags.Create.Interactive)).
+		AddCommonFlag(appFlags.Create.SaveValues, validateNotLegacyFlag(appFlags.Create.SaveValues))
 
 	// Register Podman-specific flags
 	builder.
@@ -340,16 +381,35 @@ func validateValuesFlag(cmd *cobra.Command) error {
 		}
 	}
 
-	// Validate parameters in values files
-	tp := templates.NewEmbedTemplateProvider(&assets.ApplicationFS)
-	_, err := tp.LoadValues(templateName, valuesFiles, nil)
-	if err != nil {
-		return fmt.Errorf("failed to validate values files: %w", err)
+	// Validate parameters in values files against the template values in legacy mode
+	// In default mode, values are validated against catalog API schemas
+	if legacyCreate {
+		tp := templates.NewEmbedTemplateProvider(&assets.ApplicationFS)
+		if _, err := tp.LoadValues(templateName, valuesFiles, nil); err != nil {
+			return fmt.Errorf("failed to validate values files: %w", err)
+		}
+
+		return nil
+	}
+
+	if _, _, err := loadValuesFiles(valuesFiles, nil); err != nil {
+		return err
 	}
 
 	return nil
 }
 
+// validateNotLegacyFlag returns a validator rejecting the flag in legacy mode.
+func validateNotLegacyFlag(flag string) func(cmd *cobra.Command) error {
+	return func(cmd *cobra.Command) error {
+		if legacyCreate {
+			return fmt.Errorf("--%s cannot be used with --%s", flag, appFlags.Create.Legacy)
+		}
+
+		return nil
+	}
+}
+
 // validateImagePullPolicyFlag validates the image-pull-policy flag.
 func validateImagePullPolicyFlag(cmd *cobra.Command) error {
 	if ok := image.ImagePullPolicy(rawArgImagePullPolicy).Valid(); !ok {
@@ -396,13 +456,45 @@ func createApp(appName string) error {
 		return err
 	}
 
-	// 3. Build the catalog API payload
-	payload, err := buildCatalogPayload(appName)
+	// 3. Collect the services and params from values files and --params
+	params, services, err := loadValuesFiles(valuesFiles, argParams)
 	if err != nil {
 		return err
 	}
 
-	// 4. Create application via catalog API
+	// 4. Let the user choose the template, services, providers and params
+	var sel *interactiveSelection
+	if interactiveCreate {
+		if sel, err = runInteractiveCreate(appClient, templateName, services, params); err != nil {
+			return err
+		}
+		templateName, services, params = sel.template, sel.services, sel.params
+	}
+
+	if saveValuesPath != "" {
+		if err := saveCreateValues(sel, services, params); err != nil {
+			return err
+		}
+	}
+
+	if sel != nil && !sel.confirmed {
+		logger.Infoln("Application creation cancelled")
+
+		return nil
+	}
+
+	// 5. Build the catalog API payload
+	payload, err := buildCatalogPayload(newParamSchemas(appClient), templateName, appName, params)
+	if err != nil {
+		return err
+	}
+	if services != nil {
+		if payload.Services, err = filterPayloadServices(payload.Services, services); err != nil {
+			return err
+		}
+	}
+
+	// 6. Create application via catalog API
 	logger.Infof("Creating application '%s' using template '%s'...\n", appName, templateName)
 	var resp *apiModels.CreateApplicationResponse
 	err = utils.Retry(context.Background(), vars.RetryCount, vars.RetryInterval, nil, func() error {
@@ -417,10 +509,20 @@ func createApp(appName string) error {
 
 	logger.Infof("Application creation initiated (ID: %s)\n", resp.ID)
 
-	// 5. Poll for application status
+	// 7. Poll for application status
 	return pollApplicationStatus(appClient, appName, resp.ID)
 }
 
+// saveCreateValues saves the services and params of the create to the --save-values file.
+func saveCreateValues(sel *interactiveSelection, services []string, params map[string]string) error {
+	secrets := map[string]bool{}
+	if sel != nil {
+		secrets = sel.secrets
+	}
+
+	return saveValuesFile(saveValuesPath, templateName, services, params, secrets)
+}
+
 // checkApplicationExists checks if an application with the given name already exists.
 func checkApplicationExists(appClient *catalogClient.ApplicationClient, appName string) error {
 	existingApp, err := cliutils.GetAppByName(appClient, appName)
@@ -435,8 +537,10 @@ func checkApplicationExists(appClient *catalogClient.ApplicationClient, appName
 	return nil
 }
 
-// buildCatalogPayload builds the catalog API payload for the given template.
-func buildCatalogPayload(appName string) (*apiModels.CreateApplicationRequest, error) {
+// buildCatalogPayload builds the catalog API payload for the given template and --params style key/value pairs.
+// The params are validated against the schemas when schemas is not nil; otherwise the catalog API
+// server reports invalid params when the payload is sent.
+func buildCatalogPayload(schemas *paramSchemas, templateName, appName string, params map[string]string) (*apiModels.CreateApplicationRequest, error) {
 	// Initialize catalog provider
 	provider, err := catalog.NewCatalogProvider()
 	if err != nil {
@@ -452,11 +556,136 @@ func buildCatalogPayload(appName string) (*apiModels.CreateApplicationRequest, e
 	}
 
 	// Build the payload
+	var payload *apiModels.CreateApplicationRequest
 	if isArchitecture {
-		return buildArchitecturePayload(provider, templateName, appName)
+		payload, err = buildArchitecturePayload(provider, templateName, appName, params)
+	} else {
+		payload, err = buildServicePayload(templateName, appName, params)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate the params against the schemas before sending them, as the catalog API server does
+	if schemas != nil {
+		if err := validatePayloadParams(schemas, payload); err != nil {
+			return nil, err
+		}
+	}
+
+	return payload, nil
+}
+
+// paramSchemas fetches the params schemas of services and component providers from the catalog API,
+// each once per command.
+type paramSchemas struct {
+	appClient *catalogClient.ApplicationClient
+	services  map[string]map[string]any
+	providers map[string]map[string]any
+}
+
+func newParamSchemas(appClient *catalogClient.ApplicationClient) *paramSchemas {
+	return &paramSchemas{
+		appClient: appClient,
+		services:  map[string]map[string]any{},
+		providers: map[string]map[string]any{},
+	}
+}
+
+// service returns the params schema of a service.
+func (s *paramSchemas) service(serviceID string) (map[string]any, error) {
+	if schema, ok := s.services[serviceID]; ok {
+		return schema, nil
+	}
+
+	schema, err := s.appClient.GetServiceParams(serviceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch schema of service '%s': %w", serviceID, err)
+	}
+	s.services[serviceID] = schema
+
+	return schema, nil
+}
+
+// provider returns the params schema of a component provider.
+func (s *paramSchemas) provider(componentType, providerID string) (map[string]any, error) {
+	key := componentType + "/" + providerID
+	if schema, ok := s.providers[key]; ok {
+		return schema, nil
+	}
+
+	schema, err := s.appClient.GetComponentProviderParams(componentType, providerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch schema of %s: %w", key, err)
+	}
+	s.providers[key] = schema
+
+	return schema, nil
+}
+
+// validatePayloadParams converts the string params of the payload to the types of their schemas and
+// validates them with the validator of the catalog API server. All violations are reported at once,
+// addressed by their --params keys.
+func validatePayloadParams(schemas *paramSchemas, payload *apiModels.CreateApplicationRequest) error {
+	var violations []string
+	collect := func(params, schema map[string]any, keyPrefix, contextName string) error {
+		validators.CoerceParams(params, schema)
+		found, err := validators.SchemaViolations(params, schema, "", contextName)
+		if err != nil {
+			return err
+		}
+		for _, v := range found {
+			key := strings.TrimSuffix(keyPrefix, ".")
+			if v.Pointer != "" {
+				key = keyPrefix + pointerToParamKey(v.Pointer)
+			}
+			violations = append(violations, fmt.Sprintf("%s: %s", key, v.Message))
+		}
+
+		return nil
+	}
+
+	for i := range payload.Services {
+		svc := &payload.Services[i]
+
+		schema, err := schemas.service(svc.CatalogID)
+		if err != nil {
+			return err
+		}
+		if svc.Params == nil {
+			svc.Params = map[string]any{}
+		}
+		if err := collect(svc.Params, schema, svc.CatalogID+".", fmt.Sprintf("service '%s'", svc.CatalogID)); err != nil {
+			return err
+		}
+
+		for _, comp := range svc.Components {
+			schema, err := schemas.provider(comp.ComponentType, comp.ProviderID)
+			if err != nil {
+				return err
+			}
+			keyPrefix := comp.ComponentType + "." + comp.ProviderID + "."
+			if err := collect(comp.Params, schema, keyPrefix, fmt.Sprintf("component '%s/%s'", comp.ComponentType, comp.ProviderID)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("invalid params for template '%s':\n  - %s", payload.CatalogID, strings.Join(violations, "\n  - "))
+}
+
+// pointerToParamKey converts a JSON pointer below a params object to a dotted --params key.
+func pointerToParamKey(pointer string) string {
+	tokens := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
 	}
 
-	return buildServicePayload(templateName, appName)
+	return strings.Join(tokens, ".")
 }
 
 // pollApplicationStatus polls the application status until it's ready or fails.
@@ -603,7 +832,7 @@ func printNextStepsMD(tmpls map[string]*template.Template, params map[string]str
 }
 
 // buildArchitecturePayload builds the payload for an architecture deployment.
-func buildArchitecturePayload(provider *catalog.CatalogProvider, archID, appName string) (*apiModels.CreateApplicationRequest, error) {
+func buildArchitecturePayload(provider *catalog.CatalogProvider, archID, appName string, params map[string]string) (*apiModels.CreateApplicationRequest, error) {
 	// Load architecture metadata
 	arch, err := provider.LoadArchitecture(archID)
 	if err != nil {
@@ -639,7 +868,7 @@ func buildArchitecturePayload(provider *catalog.CatalogProvider, archID, appName
 			return nil, fmt.Errorf("deploy options not found for service '%s'", svcRef.ID)
 		}
 
-		svc, err := buildServiceEntryWithDeployOptions(appClient, svcRef.ID, svcDeployOpts)
+		svc, err := buildServiceEntryWithDeployOptions(appClient, svcRef.ID, svcDeployOpts, params)
 		if err != nil {
 			return nil, fmt.Errorf("failed to build service '%s': %w", svcRef.ID, err)
 		}
@@ -655,7 +884,7 @@ func buildArchitecturePayload(provider *catalog.CatalogProvider, archID, appName
 }
 
 // buildServicePayload builds the payload for a standalone service deployment.
-func buildServicePayload(serviceID, appName string) (*apiModels.CreateApplicationRequest, error) {
+func buildServicePayload(serviceID, appName string, params map[string]string) (*apiModels.CreateApplicationRequest, error) {
 	// Create application client for API calls
 	appClient, err := catalogClient.NewApplicationClient()
 	if err != nil {
@@ -668,7 +897,7 @@ func buildServicePayload(serviceID, appName string) (*apiModels.CreateApplicatio
 		return nil, fmt.Errorf("failed to get deploy options: %w", err)
 	}
 
-	svc, err := buildServiceEntryWithDeployOptions(appClient, serviceID, deployOptions)
+	svc, err := buildServiceEntryWithDeployOptions(appClient, serviceID, deployOptions, params)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build service: %w", err)
 	}
@@ -682,12 +911,12 @@ func buildServicePayload(serviceID, appName string) (*apiModels.CreateApplicatio
 }
 
 // buildServiceEntryWithDeployOptions builds a single service entry with its components using deploy options.
-func buildServiceEntryWithDeployOptions(appClient *catalogClient.ApplicationClient, serviceID string, deployOptions *catalogTypes.DeployOptionsService) (apiModels.Service, error) {
+func buildServiceEntryWithDeployOptions(appClient *catalogClient.ApplicationClient, serviceID string, deployOptions *catalogTypes.DeployOptionsService, params map[string]string) (apiModels.Service, error) {
 	// Build components list from deploy options
 	components := make([]apiModels.Component, 0, len(deployOptions.Components))
 	for _, compDeployOpt := range deployOptions.Components {
-		// Get component configuration from argParams (provider-specific params)
-		providerParams := extractComponentParamsForService(serviceID, compDeployOpt.Type, argParams)
+		// Get component configuration from params (provider-specific params)
+		providerParams := extractComponentParamsForService(serviceID, compDeployOpt.Type, params)
 
 		// Determine provider ID and get its params
 		providerID, userParams, err := selectProviderFromDeployOptions(compDeployOpt, providerParams)
@@ -736,7 +965,7 @@ func buildServiceEntryWithDeployOptions(appClient *catalogClient.ApplicationClie
 	}
 
 	// Extract service-level parameters (excluding component params)
-	serviceParams := extractServiceParams(serviceID, deployOptions.Components, argParams)
+	serviceParams := extractServiceParams(serviceID, deployOptions.Components, params)
 
 	return apiModels.Service{
 		CatalogID:  serviceID,
@@ -0,0 +1,186 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/charmbracelet/huh"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog"
+	catalogClient "github.com/project-ai-services/ai-services/internal/pkg/catalog/client"
+	catalogTypes "github.com/project-ai-services/ai-services/internal/pkg/catalog/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+// interactiveSelection holds the choices made in an interactive create.
+type interactiveSelection struct {
+	template string
+	// params holds the choices as --params style key/value pairs.
+	params map[string]string
+	// services holds the selected services of an architecture, or nil for a service template.
+	services []string
+	// secrets holds the keys of params with secret values, which are not saved to values files.
+	secrets map[string]bool
+	// confirmed reports whether the user chose to deploy the application.
+	confirmed bool
+}
+
+// runInteractiveCreate prompts for the template, services, component providers and parameters of
+// a new application, starting from the template, services and params given on the command line.
+func runInteractiveCreate(appClient *catalogClient.ApplicationClient, template string, services []string, params map[string]string) (*interactiveSelection, error) {
+	sel := &interactiveSelection{
+		template: template,
+		params:   make(map[string]string, len(params)),
+		services: services,
+		secrets:  make(map[string]bool),
+	}
+	for k, v := range params {
+		sel.params[k] = v
+	}
+
+	provider, err := catalog.NewCatalogProvider()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create catalog provider: %w", err)
+	}
+
+	if sel.template == "" {
+		if sel.template, err = promptTemplate(provider); err != nil {
+			return nil, err
+		}
+	}
+
+	deployServices, err := promptServices(appClient, provider, sel)
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := promptProviders(appClient, deployServices, sel)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := promptServiceParams(appClient, deployServices, sel); err != nil {
+		return nil, err
+	}
+
+	printResourceSummary(resources)
+
+	if sel.confirmed, err = utils.ConfirmAction(fmt.Sprintf("Deploy the application with template '%s'?", sel.template)); err != nil {
+		return nil, err
+	}
+
+	return sel, nil
+}
+
+// promptTemplate lets the user pick an architecture or service of the catalog.
+func promptTemplate(provider *catalog.CatalogProvider) (string, error) {
+	architectures, err := provider.ListArchitectures()
+	if err != nil {
+		return "", fmt.Errorf("failed to list architectures: %w", err)
+	}
+
+	services, err := provider.ListServices()
+	if err != nil {
+		return "", fmt.Errorf("failed to list services: %w", err)
+	}
+
+	options := make([]huh.Option[string], 0, len(architectures)+len(services))
+	for _, arch := range architectures {
+		options = append(options, huh.NewOption(fmt.Sprintf("%s (architecture) - %s", arch.ID, arch.Name), arch.ID))
+	}
+	for _, svc := range services {
+		options = append(options, huh.NewOption(fmt.Sprintf("%s (service) - %s", svc.ID, svc.Name), svc.ID))
+	}
+
+	if len(options) == 0 {
+		return "", errors.New("the catalog has no templates")
+	}
+
+	var template string
+	err = runPrompt(huh.NewSelect[string]().
+		Title("Application template").
+		Description("Architectures deploy several services together; services deploy on their own").
+		Options(options...).
+		Value(&template))
+
+	return template, err
+}
+
+// promptServices fetches the deploy options of the template and, for an architecture, lets the user
+// pick the services to deploy, preselecting those given on the command line or else all of them.
+// It returns the deploy options of the selected services.
+func promptServices(appClient *catalogClient.ApplicationClient, provider *catalog.CatalogProvider, sel *interactiveSelection) ([]catalogTypes.DeployOptionsService, error) {
+	if !provider.ArchitectureExists(sel.template) {
+		if !provider.ServiceExists(sel.template) {
+			return nil, fmt.Errorf("template '%s' not found as architecture or service", sel.template)
+		}
+
+		deployOptions, err := appClient.GetServiceDeployOptions(sel.template)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get deploy options: %w", err)
+		}
+		sel.services = nil
+
+		return []catalogTypes.DeployOptionsService{*deployOptions}, nil
+	}
+
+	deployOptions, err := appClient.GetArchitectureDeployOptions(sel.template)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deploy options: %w", err)
+	}
+
+	preselected := make(map[string]bool, len(sel.services))
+	for _, id := range sel.services {
+		preselected[id] = true
+	}
+
+	options := make([]huh.Option[string], 0, len(deployOptions.Services))
+	for _, svc := range deployOptions.Services {
+		label := fmt.Sprintf("%s - %s", svc.ID, svc.Name)
+		if svc.Resources != nil {
+			label += " (" + resourceLabel(*svc.Resources) + ")"
+		}
+		options = append(options, huh.NewOption(label, svc.ID).Selected(sel.services == nil || preselected[svc.ID]))
+	}
+
+	err = runPrompt(huh.NewMultiSelect[string]().
+		Title("Services").
+		Description("Services of the architecture to deploy").
+		Options(options...).
+		Validate(func(selected []string) error {
+			if len(selected) == 0 {
+				return errors.New("select at least one service")
+			}
+
+			return nil
+		}).
+		Value(&sel.services))
+	if err != nil {
+		return nil, err
+	}
+
+	selected := make(map[string]bool, len(sel.services))
+	for _, id := range sel.services {
+		selected[id] = true
+	}
+
+	services := make([]catalogTypes.DeployOptionsService, 0, len(sel.services))
+	for _, svc := range deployOptions.Services {
+		if selected[svc.ID] {
+			services = append(services, svc)
+		}
+	}
+
+	return services, nil
+}
+
+// runPrompt runs a single prompt field.
+func runPrompt(field huh.Field) error {
+	if err := huh.NewForm(huh.NewGroup(field)).Run(); err != nil {
+		return fmt.Errorf("failed to run prompt: %w", err)
+	}
+
+	return nil
+}
+
+// Made with Bob
@@ -0,0 +1,248 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+
+	catalogClient "github.com/project-ai-services/ai-services/internal/pkg/catalog/client"
+	catalogTypes "github.com/project-ai-services/ai-services/internal/pkg/catalog/types"
+)
+
+// schemaFormatPassword marks schema parameters holding secrets.
+const schemaFormatPassword = "password"
+
+// schemaParam is a scalar parameter of a values.schema.json, addressed by its dotted path.
+type schemaParam struct {
+	path        string
+	title       string
+	description string
+	kind        string
+	defaultVal  string
+	hasDefault  bool
+	choices     []schemaChoice
+	secret      bool
+	required    bool
+	pattern     string
+}
+
+// schemaChoice is an allowed value of a parameter, from its oneOf or enum keywords.
+type schemaChoice struct {
+	value string
+	label string
+}
+
+// promptServiceParams prompts for the service-level parameters of the services.
+func promptServiceParams(appClient *catalogClient.ApplicationClient, services []catalogTypes.DeployOptionsService, sel *interactiveSelection) error {
+	for _, svc := range services {
+		if svc.Schema == "" {
+			continue
+		}
+
+		schema, err := appClient.GetServiceParams(svc.ID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch schema of service '%s': %w", svc.ID, err)
+		}
+
+		if err := promptSchemaParams(schemaParams(schema, ""), svc.ID+".", sel); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// promptSchemaParams prompts for every parameter, showing the value given on the command line or
+// else the schema default. Values that differ from the default are stored under prefix+path.
+func promptSchemaParams(params []schemaParam, prefix string, sel *interactiveSelection) error {
+	for _, p := range params {
+		key := prefix + p.path
+
+		current, ok := sel.params[key]
+		if !ok {
+			current = p.defaultVal
+		}
+
+		value, err := promptSchemaParam(p, current)
+		if err != nil {
+			return err
+		}
+
+		if value == p.defaultVal || (value == "" && !p.hasDefault) {
+			delete(sel.params, key)
+
+			continue
+		}
+
+		sel.params[key] = value
+		if p.secret {
+			sel.secrets[key] = true
+		}
+	}
+
+	return nil
+}
+
+// promptSchemaParam prompts for a single parameter with the field matching its type.
+func promptSchemaParam(p schemaParam, current string) (string, error) {
+	title := p.path
+	if p.title != "" {
+		title = fmt.Sprintf("%s [%s]", p.title, p.path)
+	}
+
+	description := p.description
+	if p.hasDefault && !p.secret {
+		description = strings.TrimSpace(description + "\nDefault: " + p.defaultVal)
+	}
+
+	switch {
+	case p.kind == "boolean":
+		value, _ := strconv.ParseBool(current)
+		err := runPrompt(huh.NewConfirm().Title(title).Description(description).Value(&value))
+
+		return strconv.FormatBool(value), err
+
+	case len(p.choices) > 0:
+		options := make([]huh.Option[string], 0, len(p.choices))
+		for _, c := range p.choices {
+			options = append(options, huh.NewOption(c.label, c.value))
+		}
+		err := runPrompt(huh.NewSelect[string]().Title(title).Description(description).Options(options...).Value(&current))
+
+		return current, err
+
+	default:
+		input := huh.NewInput().Title(title).Description(description).Validate(p.validate).Value(&current)
+		if p.secret {
+			input = input.EchoMode(huh.EchoModePassword)
+		}
+		err := runPrompt(input)
+
+		return current, err
+	}
+}
+
+// validate checks a value entered for the parameter against its type, pattern and required keywords.
+func (p schemaParam) validate(value string) error {
+	if value == "" {
+		if p.required {
+			return errors.New("a value is required")
+		}
+
+		return nil
+	}
+
+	switch p.kind {
+	case "integer":
+		if _, err := strconv.Atoi(value); err != nil {
+			return errors.New("must be an integer")
+		}
+	case "number":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return errors.New("must be a number")
+		}
+	}
+
+	if p.pattern != "" {
+		re, err := regexp.Compile(p.pattern)
+		if err == nil && !re.MatchString(value) {
+			return fmt.Errorf("must match the pattern %s", p.pattern)
+		}
+	}
+
+	return nil
+}
+
+// schemaParams returns the scalar parameters of a JSON schema in order of their dotted paths,
+// descending into nested objects. Array parameters and parameters only used by the UI are skipped.
+func schemaParams(schema map[string]any, prefix string) []schemaParam {
+	properties, ok := schema["properties"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	required := make(map[string]bool)
+	if names, ok := schema["required"].([]any); ok {
+		for _, name := range names {
+			if s, ok := name.(string); ok {
+				required[s] = true
+			}
+		}
+	}
+
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var params []schemaParam
+	for _, name := range names {
+		prop, ok := properties[name].(map[string]any)
+		if !ok || prop["x-ui-only"] == true {
+			continue
+		}
+
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+
+		kind, _ := prop["type"].(string)
+		switch kind {
+		case "object":
+			params = append(params, schemaParams(prop, path)...)
+		case "string", "integer", "number", "boolean":
+			params = append(params, newSchemaParam(path, kind, required[name], prop))
+		}
+	}
+
+	return params
+}
+
+func newSchemaParam(path, kind string, required bool, prop map[string]any) schemaParam {
+	p := schemaParam{path: path, kind: kind, required: required}
+	p.title, _ = prop["title"].(string)
+	p.description, _ = prop["description"].(string)
+	p.pattern, _ = prop["pattern"].(string)
+
+	if format, _ := prop["format"].(string); format == schemaFormatPassword {
+		p.secret = true
+	}
+
+	if def, ok := prop["default"]; ok {
+		p.defaultVal = fmt.Sprint(def)
+		p.hasDefault = true
+	}
+
+	if oneOf, ok := prop["oneOf"].([]any); ok {
+		for _, entry := range oneOf {
+			m, ok := entry.(map[string]any)
+			if !ok {
+				continue
+			}
+			if value, ok := m["const"]; ok {
+				label, _ := m["title"].(string)
+				if label == "" {
+					label = fmt.Sprint(value)
+				}
+				p.choices = append(p.choices, schemaChoice{value: fmt.Sprint(value), label: label})
+			}
+		}
+	}
+
+	if enum, ok := prop["enum"].([]any); ok && len(p.choices) == 0 {
+		for _, value := range enum {
+			p.choices = append(p.choices, schemaChoice{value: fmt.Sprint(value), label: fmt.Sprint(value)})
+		}
+	}
+
+	return p
+}
+
+// Made with Bob
@@ -0,0 +1,62 @@
+package application
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSchemaParams(t *testing.T) {
+	schema := map[string]any{
+		"required": []any{"apiKey"},
+		"properties": map[string]any{
+			"apiKey": map[string]any{"type": "string", "format": "password", "pattern": "^[a-z]{4}$"},
+			"model": map[string]any{
+				"type":    "string",
+				"default": "granite",
+				"oneOf":   []any{map[string]any{"const": "granite", "title": "Granite"}},
+			},
+			"backend": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"editPrompt": map[string]any{"type": "boolean", "x-ui-only": true},
+					"port":       map[string]any{"type": "integer", "default": float64(8000)},
+				},
+			},
+			"tags": map[string]any{"type": "array"},
+		},
+	}
+
+	params := schemaParams(schema, "")
+
+	paths := make([]string, 0, len(params))
+	for _, p := range params {
+		paths = append(paths, p.path)
+	}
+	if got := strings.Join(paths, ","); got != "apiKey,backend.port,model" {
+		t.Fatalf("expected params apiKey,backend.port,model, got %s", got)
+	}
+
+	apiKey, port, model := params[0], params[1], params[2]
+	if !apiKey.secret || !apiKey.required {
+		t.Errorf("expected apiKey to be a required secret, got %+v", apiKey)
+	}
+	if port.defaultVal != "8000" || !port.hasDefault {
+		t.Errorf("expected port default 8000, got %+v", port)
+	}
+	if len(model.choices) != 1 || model.choices[0].label != "Granite" {
+		t.Errorf("expected model choice Granite, got %+v", model.choices)
+	}
+
+	if err := apiKey.validate(""); err == nil {
+		t.Error("expected an error for an empty required value")
+	}
+	if err := apiKey.validate("ABCD"); err == nil {
+		t.Error("expected an error for a value not matching the pattern")
+	}
+	if err := port.validate("80a"); err == nil {
+		t.Error("expected an error for a non-integer value")
+	}
+	if err := port.validate("8080"); err != nil {
+		t.Errorf("expected a valid port, got %v", err)
+	}
+}
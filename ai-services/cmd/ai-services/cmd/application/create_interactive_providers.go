@@ -0,0 +1,196 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+
+	catalogClient "github.com/project-ai-services/ai-services/internal/pkg/catalog/client"
+	catalogTypes "github.com/project-ai-services/ai-services/internal/pkg/catalog/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// resourceRow is a line of the resource summary.
+type resourceRow struct {
+	service   string
+	component string
+	provider  string
+	resources catalogTypes.Resources
+}
+
+// promptProviders lets the user pick a provider and its parameters for every component type of the
+// services. A component type shared by several services is asked for once. It returns the resource
+// rows of the services and their selected providers.
+func promptProviders(appClient *catalogClient.ApplicationClient, services []catalogTypes.DeployOptionsService, sel *interactiveSelection) ([]resourceRow, error) {
+	chosen := make(map[string]catalogTypes.DeployOptionsProvider)
+	var rows []resourceRow
+
+	for _, svc := range services {
+		row := resourceRow{service: svc.ID}
+		if svc.Resources != nil {
+			row.resources = *svc.Resources
+		}
+		rows = append(rows, row)
+
+		for _, comp := range svc.Components {
+			p, ok := chosen[comp.Type]
+			if !ok {
+				var err error
+				if p, err = promptProvider(appClient, svc.ID, comp, sel); err != nil {
+					return nil, err
+				}
+				chosen[comp.Type] = p
+			}
+
+			row := resourceRow{service: svc.ID, component: comp.Type, provider: p.ID}
+			if p.Resources != nil {
+				row.resources = *p.Resources
+			}
+			rows = append(rows, row)
+		}
+	}
+
+	return rows, nil
+}
+
+// promptProvider lets the user pick the provider of a component type and its parameters. The
+// provider selected by the params given on the command line, else the default one, is preselected.
+func promptProvider(appClient *catalogClient.ApplicationClient, serviceID string, comp catalogTypes.DeployOptionsComponent, sel *interactiveSelection) (catalogTypes.DeployOptionsProvider, error) {
+	if len(comp.Providers) == 0 {
+		return catalogTypes.DeployOptionsProvider{}, fmt.Errorf("no provider found for component type '%s'", comp.Type)
+	}
+
+	providerID, _, err := selectProviderFromDeployOptions(comp, extractComponentParamsForService(serviceID, comp.Type, sel.params))
+	if err != nil {
+		return catalogTypes.DeployOptionsProvider{}, err
+	}
+
+	options := make([]huh.Option[string], 0, len(comp.Providers))
+	for _, p := range comp.Providers {
+		label := p.Name
+		if p.Resources != nil {
+			label += " (" + resourceLabel(*p.Resources) + ")"
+		}
+		options = append(options, huh.NewOption(label, p.ID))
+	}
+
+	title := comp.Name
+	if title == "" {
+		title = comp.Type
+	}
+
+	err = runPrompt(huh.NewSelect[string]().
+		Title(fmt.Sprintf("%s provider [%s]", title, comp.Type)).
+		Options(options...).
+		Value(&providerID))
+	if err != nil {
+		return catalogTypes.DeployOptionsProvider{}, err
+	}
+
+	var provider catalogTypes.DeployOptionsProvider
+	for _, p := range comp.Providers {
+		if p.ID == providerID {
+			provider = p
+		}
+	}
+
+	// Drop the selections of other providers, which would conflict with the chosen one
+	for key := range sel.params {
+		for _, prefix := range []string{comp.Type + ".", serviceID + "." + comp.Type + "."} {
+			after, ok := strings.CutPrefix(key, prefix)
+			if ok && strings.SplitN(after, ".", paramSplitParts)[0] != providerID {
+				delete(sel.params, key)
+			}
+		}
+	}
+	sel.params[comp.Type+"."+providerID] = "true"
+
+	if provider.Schema == "" {
+		return provider, nil
+	}
+
+	schema, err := appClient.GetComponentProviderParams(comp.Type, providerID)
+	if err != nil {
+		return catalogTypes.DeployOptionsProvider{}, fmt.Errorf("failed to fetch schema of %s/%s: %w", comp.Type, providerID, err)
+	}
+
+	return provider, promptSchemaParams(schemaParams(schema, ""), comp.Type+"."+providerID+".", sel)
+}
+
+// resourceLabel renders the resources of a service or provider for prompt options.
+func resourceLabel(r catalogTypes.Resources) string {
+	parts := []string{fmt.Sprintf("%d CPU", r.CPU), utils.FormatBytes(int64(r.Memory)) + " memory"}
+	if cards := r.Accelerators[constants.SpyreResourceName]; cards > 0 {
+		parts = append(parts, fmt.Sprintf("%d Spyre", cards))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// printResourceSummary prints the resources of the selection and, on Podman, compares the Spyre
+// cards it needs with the free cards of the host.
+func printResourceSummary(rows []resourceRow) {
+	var total catalogTypes.Resources
+
+	logger.Infoln("\nResource impact:")
+	p := utils.NewTableWriter()
+	p.SetHeaders("SERVICE", "COMPONENT", "PROVIDER", "CPU", "MEMORY", "STORAGE", "SPYRE CARDS")
+	for _, row := range rows {
+		cards := row.resources.Accelerators[constants.SpyreResourceName]
+		p.AppendRow(row.service, row.component, row.provider, strconv.Itoa(row.resources.CPU),
+			utils.FormatBytes(int64(row.resources.Memory)), utils.FormatBytes(int64(row.resources.Storage)), strconv.Itoa(cards))
+
+		total.CPU += row.resources.CPU
+		total.Memory += row.resources.Memory
+		total.Storage += row.resources.Storage
+		total.Accelerators = addAccelerators(total.Accelerators, row.resources.Accelerators)
+	}
+	spyreCards := total.Accelerators[constants.SpyreResourceName]
+	p.AppendRow("TOTAL", "", "", strconv.Itoa(total.CPU), utils.FormatBytes(int64(total.Memory)),
+		utils.FormatBytes(int64(total.Storage)), strconv.Itoa(spyreCards))
+	p.CloseTableWriter()
+
+	if spyreCards == 0 || vars.RuntimeFactory.GetRuntimeType() != types.RuntimeTypePodman {
+		return
+	}
+
+	free, err := helpers.FindFreeSpyreCards(context.Background())
+	if err != nil {
+		logger.Warningf("Failed to look up free Spyre cards: %v\n", err)
+
+		return
+	}
+
+	if len(free) < spyreCards {
+		logger.Warningf("The selection needs %d Spyre cards but only %d are free on this host\n", spyreCards, len(free))
+
+		return
+	}
+
+	logger.Infof("Spyre cards: %d needed, %d free\n", spyreCards, len(free))
+}
+
+func addAccelerators(total, add map[string]int) map[string]int {
+	if len(add) == 0 {
+		return total
+	}
+
+	if total == nil {
+		total = make(map[string]int, len(add))
+	}
+	for name, count := range add {
+		total[name] += count
+	}
+
+	return total
+}
+
+// Made with Bob
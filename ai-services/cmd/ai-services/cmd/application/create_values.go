@@ -0,0 +1,147 @@
+package application
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	k8syaml "sigs.k8s.io/yaml"
+
+	apiModels "github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+const (
+	valuesFilePerm = 0o600
+	// valuesServicesKey holds the services of an architecture to deploy in a values file.
+	valuesServicesKey = "services"
+)
+
+// filterPayloadServices keeps the services of the payload that were selected. Selected services
+// the template does not deploy are reported as an error.
+func filterPayloadServices(services []apiModels.Service, selected []string) ([]apiModels.Service, error) {
+	keep := make(map[string]bool, len(selected))
+	for _, id := range selected {
+		keep[id] = true
+	}
+
+	filtered := make([]apiModels.Service, 0, len(selected))
+	for _, svc := range services {
+		if keep[svc.CatalogID] {
+			filtered = append(filtered, svc)
+			delete(keep, svc.CatalogID)
+		}
+	}
+
+	if len(keep) > 0 {
+		unknown := make([]string, 0, len(keep))
+		for id := range keep {
+			unknown = append(unknown, id)
+		}
+		sort.Strings(unknown)
+
+		return nil, fmt.Errorf("services not part of the template: %s", strings.Join(unknown, ", "))
+	}
+
+	return filtered, nil
+}
+
+// loadValuesFiles reads values files for the catalog create path and returns their values as
+// --params style key/value pairs, along with the services to deploy, nil when no file selects
+// them. Nested keys are flattened to dotted keys, later files override earlier ones, and params
+// override the files.
+func loadValuesFiles(files []string, params map[string]string) (map[string]string, []string, error) {
+	result := make(map[string]string)
+	var services []string
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read values file %s: %w", file, err)
+		}
+
+		values := map[string]any{}
+		if err := k8syaml.Unmarshal(data, &values); err != nil {
+			return nil, nil, fmt.Errorf("failed to parse values file %s: %w", file, err)
+		}
+
+		if raw, ok := values[valuesServicesKey]; ok {
+			if services, err = parseValuesServices(raw); err != nil {
+				return nil, nil, fmt.Errorf("invalid values file %s: %w", file, err)
+			}
+			delete(values, valuesServicesKey)
+		}
+
+		for k, v := range utils.FlattenMapWithValues(values, "") {
+			result[k] = v
+		}
+	}
+
+	for k, v := range params {
+		result[k] = v
+	}
+
+	return result, services, nil
+}
+
+// parseValuesServices reads the list of services of a values file.
+func parseValuesServices(raw any) ([]string, error) {
+	list, ok := raw.([]any)
+	if !ok || len(list) == 0 {
+		return nil, fmt.Errorf("%s must be a non-empty list of service IDs", valuesServicesKey)
+	}
+
+	services := make([]string, 0, len(list))
+	for _, item := range list {
+		id, ok := item.(string)
+		if !ok || id == "" {
+			return nil, fmt.Errorf("%s must be a non-empty list of service IDs", valuesServicesKey)
+		}
+		services = append(services, id)
+	}
+
+	return services, nil
+}
+
+// saveValuesFile writes the selected services and params as a values file that can be passed to
+// create with -f. The params include the selected provider of every component type. Services
+// are left out when nil, so that all services of the template are deployed. Secret values are
+// left out so that they are not stored on disk.
+func saveValuesFile(path, template string, services []string, params map[string]string, secrets map[string]bool) error {
+	values := make(map[string]any, len(params)+1)
+	if services != nil {
+		values[valuesServicesKey] = services
+	}
+	var omitted []string
+	for k, v := range params {
+		if secrets[k] {
+			omitted = append(omitted, k)
+
+			continue
+		}
+		values[k] = v
+	}
+
+	data, err := k8syaml.Marshal(values)
+	if err != nil {
+		return fmt.Errorf("failed to encode values: %w", err)
+	}
+
+	header := fmt.Sprintf("# Values for template '%s'. Deploy with:\n#   ai-services application create <name> --template %s -f %s\n",
+		template, template, path)
+	if len(omitted) > 0 {
+		sort.Strings(omitted)
+		header += "# Secret values are not stored; pass them with --params: " + strings.Join(omitted, ", ") + "\n"
+	}
+
+	if err := os.WriteFile(path, append([]byte(header), data...), valuesFilePerm); err != nil {
+		return fmt.Errorf("failed to write values file: %w", err)
+	}
+
+	logger.Infof("Values saved to %s\n", path)
+
+	return nil
+}
+
+// Made with Bob
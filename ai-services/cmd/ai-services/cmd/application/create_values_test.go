@@ -0,0 +1,80 @@
+package application
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	apiModels "github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/models"
+)
+
+func TestValuesFileRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "values.yaml")
+
+	params := map[string]string{
+		"llm.vllm-cpu":       "true",
+		"llm.vllm-cpu.model": "granite",
+		"llm.watsonx.apiKey": "secret",
+	}
+	if err := saveValuesFile(path, "rag", []string{"chat", "digitize"}, params, map[string]bool{"llm.watsonx.apiKey": true}); err != nil {
+		t.Fatalf("saveValuesFile failed: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "secret\n") {
+		t.Errorf("expected the secret value to be left out, got:\n%s", data)
+	}
+
+	values, services, err := loadValuesFiles([]string{path}, map[string]string{"llm.vllm-cpu.model": "override"})
+	if err != nil {
+		t.Fatalf("loadValuesFiles failed: %v", err)
+	}
+	if strings.Join(services, ",") != "chat,digitize" {
+		t.Errorf("expected services chat,digitize, got %v", services)
+	}
+	if values["llm.vllm-cpu"] != "true" || values["llm.vllm-cpu.model"] != "override" {
+		t.Errorf("unexpected values %v", values)
+	}
+	if _, ok := values["llm.watsonx.apiKey"]; ok {
+		t.Error("expected no secret in the loaded values")
+	}
+}
+
+func TestLoadValuesFilesNested(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "values.yaml")
+	if err := os.WriteFile(path, []byte("chat:\n  backend:\n    systemPrompt: hello\nllm:\n  vllm-cpu: true\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	values, services, err := loadValuesFiles([]string{path}, nil)
+	if err != nil {
+		t.Fatalf("loadValuesFiles failed: %v", err)
+	}
+	if values["chat.backend.systemPrompt"] != "hello" || values["llm.vllm-cpu"] != "true" {
+		t.Errorf("unexpected values %v", values)
+	}
+	if services != nil {
+		t.Errorf("expected no service selection, got %v", services)
+	}
+}
+
+func TestFilterPayloadServices(t *testing.T) {
+	services := []apiModels.Service{{CatalogID: "chat"}, {CatalogID: "digitize"}, {CatalogID: "summarize"}}
+
+	filtered, err := filterPayloadServices(services, []string{"summarize", "chat"})
+	if err != nil {
+		t.Fatalf("filterPayloadServices failed: %v", err)
+	}
+	if len(filtered) != 2 || filtered[0].CatalogID != "chat" || filtered[1].CatalogID != "summarize" {
+		t.Errorf("expected chat and summarize, got %+v", filtered)
+	}
+
+	if _, err := filterPayloadServices(services, []string{"chat", "translate"}); err == nil {
+		t.Error("expected an error for a service not part of the template")
+	}
+}
@@ -0,0 +1,78 @@
+package application
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	catalogClient "github.com/project-ai-services/ai-services/internal/pkg/catalog/client"
+	cliUtils "github.com/project-ai-services/ai-services/internal/pkg/cli/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+var exportOutputFile string
+
+var exportCmd = &cobra.Command{
+	Use:   "export [name]",
+	Short: "Export an application definition",
+	Long: `Exports the definition of a deployed application as YAML.
+
+The definition contains the catalog template, versions, providers and non-sensitive
+parameters of every service and component. Sensitive parameters are never exported; their
+paths are listed under secret_refs and are regenerated on apply unless supplied in params.
+Connectors are referenced by name and must exist on the target system.
+
+Arguments:
+  [name] : Application name (required)
+`,
+	Example: `  # Print the definition of an application
+  ai-services application export rag --runtime podman
+
+  # Save the definition to a file
+  ai-services application export rag -o rag.yaml --runtime podman`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		return exportApplication(args[0])
+	},
+}
+
+func init() {
+	exportCmd.Flags().StringVarP(&exportOutputFile, "output", "o", "", "Write the definition to this file instead of stdout")
+}
+
+func exportApplication(appName string) error {
+	appClient, err := catalogClient.NewApplicationClient()
+	if err != nil {
+		return fmt.Errorf("failed to create application client: %w", err)
+	}
+
+	app, err := cliUtils.GetAppByName(appClient, appName)
+	if err != nil {
+		return err
+	}
+
+	data, err := appClient.ExportApplication(app.ID)
+	if err != nil {
+		return fmt.Errorf("failed to export application: %w", err)
+	}
+
+	if exportOutputFile == "" {
+		fmt.Print(string(data))
+
+		return nil
+	}
+
+	if err := os.WriteFile(exportOutputFile, data, constants.FilePerm); err != nil {
+		return fmt.Errorf("failed to write %s: %w", exportOutputFile, err)
+	}
+
+	logger.Infof("Application %s exported to %s\n", appName, exportOutputFile)
+
+	return nil
+}
+
+// Made with Bob
@@ -7,6 +7,8 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog"
+	"github.com/project-ai-services/ai-services/internal/pkg/image"
+	"github.com/project-ai-services/ai-services/internal/pkg/imagemirror"
 )
 
 var (
@@ -39,9 +41,36 @@ func getCatalogImages(templateID string) ([]string, error) {
 	return images, nil
 }
 
+// sourceImages returns the images of the template as referenced by its templates, before mirroring.
+func sourceImages(template string) ([]string, error) {
+	if !legacyImage {
+		return getCatalogImages(template)
+	}
+
+	img := &image.Images{AppTemplate: template}
+	images, err := img.ListImages()
+	if err != nil {
+		return nil, fmt.Errorf("error listing images: %w", err)
+	}
+
+	return images, nil
+}
+
+// mirrored returns the references of the images on their mirrors, the images deployments pull.
+func mirrored(images []string) ([]string, error) {
+	mirrors, err := imagemirror.Current()
+	if err != nil {
+		return nil, err
+	}
+
+	return mirrors.RewriteImages(images), nil
+}
+
 func init() {
 	ImageCmd.AddCommand(listCmd)
 	ImageCmd.AddCommand(pullCmd)
+	ImageCmd.AddCommand(mirrorCmd)
+	ImageCmd.AddCommand(verifyCmd)
 	ImageCmd.PersistentFlags().StringVarP(&templateName, "template", "t", "", "Application template name (Required)")
 	_ = ImageCmd.MarkPersistentFlagRequired("template")
 	ImageCmd.PersistentFlags().BoolVar(&legacyImage, "legacy", false, "Use legacy application image implementation")
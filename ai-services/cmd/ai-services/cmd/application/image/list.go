@@ -2,15 +2,40 @@ package image
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/spf13/cobra"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/output"
 	"github.com/project-ai-services/ai-services/internal/pkg/image"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
+var listOutput = output.Selected()
+
+// templateImages is the structured output of the list command.
+type templateImages struct {
+	Template string   `json:"template"`
+	Images   []string `json:"images"`
+}
+
+// newTemplateImages returns the structured output of the images of the template, listing no images as an empty
+// list rather than null.
+func newTemplateImages(template string, images []string) templateImages {
+	if images == nil {
+		images = []string{}
+	}
+
+	return templateImages{Template: template, Images: images}
+}
+
+// Names returns the image references.
+func (t templateImages) Names() []string {
+	return t.Images
+}
+
 var listCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List container images for a given application template",
@@ -22,22 +47,36 @@ var listCmd = &cobra.Command{
   ai-services application image list --template chat --runtime podman
 
   # List images using legacy implementation
-  ai-services application image list --template rag --legacy --runtime podman`,
+  ai-services application image list --template rag --legacy --runtime podman
+
+  # List the image references only, one per line
+  ai-services application image list --template rag -o name --runtime podman`,
 	Args: cobra.MaximumNArgs(0),
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := listOutput.Validate(); err != nil {
+			return err
+		}
+
 		// Once precheck passes, silence usage for any *later* internal errors.
 		cmd.SilenceUsage = true
 
-		return list(templateName)
+		return list(cmd.OutOrStdout(), templateName)
 	},
 }
 
-func list(templateName string) error {
+func init() {
+	output.Supported(listCmd)
+}
+
+func list(w io.Writer, templateName string) error {
 	if !legacyImage && vars.RuntimeFactory.GetRuntimeType() == types.RuntimeTypePodman {
-		return listCatalogImages(templateName)
+		return listCatalogImages(w, templateName)
 	}
 
 	if vars.RuntimeFactory.GetRuntimeType() == types.RuntimeTypeOpenShift {
+		if err := listOutput.RequireTable("the openshift runtime"); err != nil {
+			return err
+		}
 		logger.Warningln("Not supported for openshift runtime")
 
 		return nil
@@ -50,6 +89,13 @@ func list(templateName string) error {
 	if err != nil {
 		return fmt.Errorf("error listing images: %w", err)
 	}
+	if images, err = mirrored(images); err != nil {
+		return err
+	}
+
+	if listOutput.Structured() {
+		return listOutput.Print(w, newTemplateImages(templateName, images))
+	}
 
 	logger.Infof("Container images for application template '%s' are:\n", templateName)
 	for _, image := range images {
@@ -60,11 +106,18 @@ func list(templateName string) error {
 }
 
 // listCatalogImages lists container images for services or architectures from the catalog.
-func listCatalogImages(templateID string) error {
+func listCatalogImages(w io.Writer, templateID string) error {
 	images, err := getCatalogImages(templateID)
 	if err != nil {
 		return err
 	}
+	if images, err = mirrored(images); err != nil {
+		return err
+	}
+
+	if listOutput.Structured() {
+		return listOutput.Print(w, newTemplateImages(templateID, images))
+	}
 
 	if len(images) == 0 {
 		logger.Infoln("No images found")
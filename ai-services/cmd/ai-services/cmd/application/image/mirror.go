@@ -0,0 +1,134 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/imagemirror"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+var (
+	mirrorTo       string
+	mirrorPin      bool
+	mirrorAuthFile string
+	mirrorInsecure bool
+)
+
+var mirrorCmd = &cobra.Command{
+	Use:   "mirror",
+	Short: "Copies the container images of an application template into an internal registry",
+	Long: `Copy the container images of an application template into an internal registry, and write
+the mirror rules rewriting them to that registry to the registries file of the base directory.
+
+Images keep their path without the registry, e.g. icr.io/ai-services-cicd/chatbot-ui:v0.0.50 is
+copied to <registry>/ai-services-cicd/chatbot-ui:v0.0.50. Once mirrored, the images are listed,
+pulled and deployed from the internal registry for both runtimes.
+
+Note:
+  - Supports only podman runtime
+  - With --pin-digests, deployments reference the mirrored images by the digests pushed`,
+	Example: `  # Mirror the images of Digital Assistant to an internal registry
+  ai-services application image mirror --template rag --to registry.local --runtime podman
+
+  # Mirror to a registry with a self-signed certificate, authenticating with an auth file
+  ai-services application image mirror --template rag --to registry.local:5000/ai-services --authfile auth.json --insecure --runtime podman
+
+  # Mirror and pin the deployments to the digests of the mirrored images
+  ai-services application image mirror --template rag --to registry.local --pin-digests --runtime podman`,
+	Args: cobra.MaximumNArgs(0),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// Once precheck passes, silence usage for any *later* internal errors.
+		cmd.SilenceUsage = true
+
+		if vars.RuntimeFactory.GetRuntimeType() != types.RuntimeTypePodman {
+			return fmt.Errorf("mirroring images is only supported for the podman runtime")
+		}
+
+		return mirror(cmd.Context(), templateName)
+	},
+}
+
+func init() {
+	mirrorCmd.Flags().StringVar(&mirrorTo, "to", "", "Registry, or registry and namespace, to copy the images to (Required)")
+	_ = mirrorCmd.MarkFlagRequired("to")
+	mirrorCmd.Flags().BoolVar(&mirrorPin, "pin-digests", false, "Reference the mirrored images by their digests in deployments")
+	mirrorCmd.Flags().StringVar(&mirrorAuthFile, "authfile", "", "Auth file with the credentials of the internal registry")
+	mirrorCmd.Flags().BoolVar(&mirrorInsecure, "insecure", false, "Skip the verification of the certificate of the internal registry")
+}
+
+func mirror(ctx context.Context, template string) error {
+	images, err := sourceImages(template)
+	if err != nil {
+		return err
+	}
+
+	mirrors, err := imagemirror.Current()
+	if err != nil {
+		return err
+	}
+	if mirrorAuthFile != "" || mirrorInsecure {
+		if mirrors.Auth == nil {
+			mirrors.Auth = map[string]imagemirror.Auth{}
+		}
+		mirrors.Auth[imagemirror.Registry(mirrorTo+"/")] = imagemirror.Auth{AuthFile: mirrorAuthFile, Insecure: mirrorInsecure}
+	}
+
+	// Images of several registries must not land on the same path of the mirror
+	destinations := map[string]string{}
+	registries := map[string]bool{}
+	for _, img := range images {
+		dest := imagemirror.MirrorOf(img, mirrorTo)
+		if other, ok := destinations[dest]; ok {
+			return fmt.Errorf("images %s and %s would both be mirrored to %s", other, img, dest)
+		}
+		destinations[dest] = img
+		registries[imagemirror.Registry(img)] = true
+	}
+
+	client, err := podman.NewPodmanClient()
+	if err != nil {
+		return fmt.Errorf("failed to connect to podman: %w", err)
+	}
+
+	logger.Infof("Mirroring %d images for template '%s' to %s...\n", len(images), template, mirrorTo)
+	for _, img := range images {
+		dest := imagemirror.MirrorOf(img, mirrorTo)
+		digest, err := client.MirrorImage(ctx, img, dest, mirrors)
+		if err != nil {
+			return err
+		}
+		if digest != "" {
+			if mirrors.Digests == nil {
+				mirrors.Digests = map[string]string{}
+			}
+			mirrors.Digests[dest] = digest
+		}
+		logger.Infof("Mirrored %s to %s\n", img, dest)
+	}
+
+	sources := make([]string, 0, len(registries))
+	for registry := range registries {
+		sources = append(sources, registry)
+	}
+	sort.Strings(sources)
+	for _, source := range sources {
+		mirrors.SetRule(imagemirror.Rule{Source: source, Mirror: mirrorTo, PinDigests: mirrorPin})
+	}
+	if err := mirrors.Validate(); err != nil {
+		return err
+	}
+	if err := mirrors.Save(imagemirror.ConfigPath()); err != nil {
+		return err
+	}
+
+	logger.Infof("Successfully mirrored all images for template '%s'; mirror rules written to %s\n", template, imagemirror.ConfigPath())
+
+	return nil
+}
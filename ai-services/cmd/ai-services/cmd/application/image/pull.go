@@ -59,8 +59,17 @@ func pull(template string) error {
 		return fmt.Errorf("failed to connect to podman: %w", err)
 	}
 
+	verified, err := image.Verify(context.Background(), images)
+	if err != nil {
+		return err
+	}
+
 	// Use shared helper function with retry logic
-	return image.PullImageFromRegistry(context.Background(), runtimeClient, images)
+	if err := image.PullImageFromRegistry(context.Background(), runtimeClient, images); err != nil {
+		return err
+	}
+
+	return image.CheckDigests(runtimeClient, verified)
 }
 
 // pullCatalogImages pulls container images for services or architectures from the catalog.
@@ -83,10 +92,18 @@ func pullCatalogImages(templateID string) error {
 		return fmt.Errorf("failed to connect to podman: %w", err)
 	}
 
+	verified, err := image.Verify(context.Background(), images)
+	if err != nil {
+		return err
+	}
+
 	// Use shared helper function with retry logic
 	if err := image.PullImageFromRegistry(context.Background(), runtimeClient, images); err != nil {
 		return err
 	}
+	if err := image.CheckDigests(runtimeClient, verified); err != nil {
+		return err
+	}
 
 	logger.Infof("Successfully pulled all images for template '%s'\n", templateID)
 
@@ -3,6 +3,7 @@ package application
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"strings"
 	"text/template"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog"
 	catalogClient "github.com/project-ai-services/ai-services/internal/pkg/catalog/client"
 	catalogTypes "github.com/project-ai-services/ai-services/internal/pkg/catalog/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/output"
 	cliUtils "github.com/project-ai-services/ai-services/internal/pkg/cli/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
@@ -21,8 +23,19 @@ import (
 
 var (
 	legacyInfo bool
+	infoOutput = output.Selected()
 )
 
+// applicationInfo is the structured output of the info command.
+type applicationInfo struct {
+	catalogTypes.Application
+}
+
+// Names returns the name of the application.
+func (i applicationInfo) Names() []string {
+	return []string{i.Name}
+}
+
 var infoCmd = &cobra.Command{
 	Use:   "info [name]",
 	Short: "Application info",
@@ -36,12 +49,19 @@ Arguments:
   
   # Display application information from openshift runtime
   ai-services application info rag --runtime openshift
+
+  # Display the application with its services and endpoints as JSON
+  ai-services application info rag -o json --runtime podman
   `,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// fetch application name
 		applicationName := args[0]
 
+		if err := infoOutput.Validate(); err != nil {
+			return err
+		}
+
 		// Once precheck passes, silence usage for any *later* internal errors.
 		cmd.SilenceUsage = true
 
@@ -50,6 +70,10 @@ Arguments:
 		// When legacyInfo is true and runtime is podman, use the older/stable code path
 		// For openshift runtime, always use the older/stable code path regardless of legacy flag
 		if legacyInfo && rt == types.RuntimeTypePodman {
+			if err := infoOutput.RequireTable("--legacy"); err != nil {
+				return err
+			}
+
 			// Create application instance using factory
 			factory := application.NewFactory(rt)
 			app, err := factory.Create(applicationName)
@@ -67,10 +91,13 @@ Arguments:
 		// Default: use new implementation using catalog
 		// For openshift runtime, always use the older/stable code path
 		if rt == types.RuntimeTypePodman {
-			return renderApplicationInfo(applicationName)
+			return renderApplicationInfo(cmd.OutOrStdout(), applicationName)
 		}
 
 		// OpenShift runtime uses the older implementation
+		if err := infoOutput.RequireTable("the openshift runtime"); err != nil {
+			return err
+		}
 		factory := application.NewFactory(rt)
 		app, err := factory.Create(applicationName)
 		if err != nil {
@@ -87,9 +114,10 @@ Arguments:
 
 func init() {
 	infoCmd.Flags().BoolVar(&legacyInfo, "legacy", false, "Use legacy application info implementation")
+	output.Supported(infoCmd)
 }
 
-func renderApplicationInfo(appName string) error {
+func renderApplicationInfo(w io.Writer, appName string) error {
 	appClient, err := catalogClient.NewApplicationClient()
 	if err != nil {
 		return fmt.Errorf("failed to create application client: %w", err)
@@ -97,7 +125,7 @@ func renderApplicationInfo(appName string) error {
 
 	app, err := cliUtils.GetAppByName(appClient, appName)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
+		if strings.Contains(err.Error(), "not found") && !infoOutput.Structured() {
 			logger.Warningf("Application: '%s' does not exist", appName)
 
 			return nil
@@ -111,6 +139,10 @@ func renderApplicationInfo(appName string) error {
 		return fmt.Errorf("failed to get application: %w", err)
 	}
 
+	if infoOutput.Structured() {
+		return infoOutput.Print(w, applicationInfo{Application: *application})
+	}
+
 	appPS, err := appClient.GetApplicationPS(app.ID)
 	if err != nil {
 		return fmt.Errorf("failed to get application pods: %w", err)
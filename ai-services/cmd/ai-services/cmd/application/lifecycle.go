@@ -0,0 +1,187 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	catalogClient "github.com/project-ai-services/ai-services/internal/pkg/catalog/client"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/types"
+	cliUtils "github.com/project-ai-services/ai-services/internal/pkg/cli/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+const (
+	lifecycleActionStart   = "start"
+	lifecycleActionStop    = "stop"
+	lifecycleActionRestart = "restart"
+
+	statusRunning  = "Running"
+	statusStopped  = "Stopped"
+	statusStarting = "Starting"
+	statusStopping = "Stopping"
+	statusError    = "Error"
+)
+
+// changeApplicationState starts, stops or restarts an application (or one of its services)
+// through the catalog API. serviceName may be a service ID or catalog ID.
+func changeApplicationState(appName, serviceName, action string) error {
+	appClient, err := catalogClient.NewApplicationClient()
+	if err != nil {
+		return fmt.Errorf("failed to create application client: %w", err)
+	}
+
+	app, err := cliUtils.GetAppByName(appClient, appName)
+	if err != nil {
+		return err
+	}
+
+	params := &catalogClient.LifecycleParams{}
+	if serviceName != "" {
+		details, err := appClient.GetApplication(app.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get application details: %w", err)
+		}
+
+		svc, err := findApplicationService(details, serviceName)
+		if err != nil {
+			return err
+		}
+		params.ServiceID = svc.ID
+	}
+
+	logger.Infof("Requesting %s of application %s...\n", action, appName)
+	if err := requestStateChange(appClient, app.ID, action, params); err != nil {
+		return err
+	}
+
+	if action == lifecycleActionRestart {
+		logger.Infof("Restart of application %s initiated. Use 'ai-services application ps %s' to follow progress.\n", appName, appName)
+
+		return nil
+	}
+
+	want := statusRunning
+	if action == lifecycleActionStop {
+		want = statusStopped
+	}
+
+	if err := waitForApplicationState(appClient, app.ID, params.ServiceID, want); err != nil {
+		return err
+	}
+
+	logger.Infof("Application %s is %s.\n", appName, want)
+
+	return nil
+}
+
+// requestStateChange posts the lifecycle action, retrying only when the request did not get a
+// response. An action accepted by the server moves the application to a transitional status,
+// so a conflict after a lost response is taken as accepted when the application is in the
+// status of the action.
+func requestStateChange(appClient *catalogClient.ApplicationClient, appID, action string, params *catalogClient.LifecycleParams) error {
+	var (
+		rejected error
+		attempts int
+	)
+	err := utils.Retry(context.Background(), vars.RetryCount, vars.RetryInterval, nil, func() error {
+		attempts++
+
+		var err error
+		switch action {
+		case lifecycleActionStart:
+			_, err = appClient.StartApplication(appID, params)
+		case lifecycleActionStop:
+			_, err = appClient.StopApplication(appID, params)
+		default:
+			_, err = appClient.RestartApplication(appID, params)
+		}
+
+		// The server answered, retrying does not change its answer
+		var httpErr *catalogClient.HTTPError
+		if errors.As(err, &httpErr) {
+			rejected = httpErr
+			if httpErr.StatusCode == http.StatusConflict && attempts > 1 && inTransition(appClient, appID, action) {
+				rejected = nil
+			}
+
+			return nil
+		}
+
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to %s application after %d retries: %w", action, vars.RetryCount, err)
+	}
+	if rejected != nil {
+		return fmt.Errorf("failed to %s application: %w", action, rejected)
+	}
+
+	return nil
+}
+
+// inTransition reports whether the application is in the transitional status of action.
+func inTransition(appClient *catalogClient.ApplicationClient, appID, action string) bool {
+	app, err := appClient.GetApplication(appID)
+	if err != nil {
+		return false
+	}
+
+	want := statusStarting
+	if action == lifecycleActionStop {
+		want = statusStopping
+	}
+
+	return app.Status == want
+}
+
+// findApplicationService matches a service by ID or catalog ID.
+func findApplicationService(app *types.Application, name string) (*types.ApplicationService, error) {
+	for i := range app.Services {
+		if app.Services[i].ID == name || app.Services[i].CatalogID == name {
+			return &app.Services[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("service '%s' not found in application '%s'", name, app.Name)
+}
+
+// waitForApplicationState polls until the application, or the given service, reaches the wanted status.
+func waitForApplicationState(appClient *catalogClient.ApplicationClient, appID, serviceID, want string) error {
+	const (
+		pollInterval = 5 * time.Second
+		maxAttempts  = 36
+	)
+
+	for range maxAttempts {
+		app, err := appClient.GetApplication(appID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch application: %w", err)
+		}
+
+		status, message := app.Status, app.Message
+		if serviceID != "" {
+			if svc, err := findApplicationService(app, serviceID); err == nil {
+				status, message = svc.Status, svc.Message
+			}
+		}
+
+		if status == want {
+			return nil
+		}
+		if status == statusError {
+			return fmt.Errorf("application entered Error state: %s", message)
+		}
+
+		logger.Infof("Status: %s, message: %s\n", status, message)
+		time.Sleep(pollInterval)
+	}
+
+	return fmt.Errorf("timeout waiting for application to reach %s state after %v", want, maxAttempts*pollInterval)
+}
+
+// Made with Bob
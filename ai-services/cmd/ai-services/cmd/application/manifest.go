@@ -0,0 +1,237 @@
+package application
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	k8syaml "sigs.k8s.io/yaml"
+
+	apiModels "github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+var (
+	// envReference matches ${VAR} references used to inject secrets into a manifest.
+	envReference = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+	// documentSeparator splits multi-document YAML files.
+	documentSeparator = regexp.MustCompile(`(?m)^---\s*$`)
+)
+
+// manifestExtensions lists the file types read from a manifest directory.
+var manifestExtensions = map[string]bool{".yaml": true, ".yml": true, ".json": true}
+
+// applicationManifest is a declarative description of one application. It accepts either the
+// short form used by `application create` (a template and --params style keys) or a full
+// definition as produced by `application export`.
+type applicationManifest struct {
+	APIVersion string                                  `json:"apiVersion"`
+	Kind       string                                  `json:"kind"`
+	Metadata   apiModels.ApplicationDefinitionMetadata `json:"metadata"`
+	Spec       applicationManifestSpec                 `json:"spec"`
+
+	// source is the file the manifest was read from, used in messages.
+	source string
+}
+
+// applicationManifestSpec extends the exported definition spec with the template short form.
+type applicationManifestSpec struct {
+	// Template is an architecture or service ID, resolved like `application create -t`.
+	Template string `json:"template,omitempty"`
+	// Params holds --params style keys (e.g. chat.llm.vllm.model) for the template form.
+	Params map[string]any `json:"params,omitempty"`
+
+	apiModels.ApplicationDefinitionSpec
+}
+
+// isTemplate reports whether the manifest uses the template short form.
+func (m *applicationManifest) isTemplate() bool {
+	return m.Spec.Template != ""
+}
+
+// definition returns the manifest as an exported application definition.
+func (m *applicationManifest) definition() apiModels.ApplicationDefinition {
+	return apiModels.ApplicationDefinition{
+		APIVersion: m.APIVersion,
+		Kind:       m.Kind,
+		Metadata:   m.Metadata,
+		Spec:       m.Spec.ApplicationDefinitionSpec,
+	}
+}
+
+// validate checks the document header and that exactly one spec form is used.
+func (m *applicationManifest) validate() error {
+	if m.isTemplate() {
+		if len(m.Spec.Services) > 0 || m.Spec.CatalogID != "" {
+			return fmt.Errorf("%s: spec.template cannot be combined with spec.catalog_id or spec.services", m.source)
+		}
+
+		def := m.definition()
+		// Reuse the header checks; catalog_id is implied by the template.
+		def.Spec.CatalogID = m.Spec.Template
+		if err := def.Validate(); err != nil {
+			return fmt.Errorf("%s: %w", m.source, err)
+		}
+
+		return utils.VerifyAppName(m.Metadata.Name)
+	}
+
+	if len(m.Spec.Params) > 0 {
+		return fmt.Errorf("%s: spec.params is only supported together with spec.template", m.source)
+	}
+
+	def := m.definition()
+	if err := def.Validate(); err != nil {
+		return fmt.Errorf("%s: %w", m.source, err)
+	}
+
+	return nil
+}
+
+// templateParams converts the manifest params into the key/value form used by --params.
+func (m *applicationManifest) templateParams() map[string]string {
+	params := make(map[string]string, len(m.Spec.Params))
+	for k, v := range m.Spec.Params {
+		params[k] = fmt.Sprint(v)
+	}
+
+	return params
+}
+
+// loadManifests reads every manifest from a file or, for a directory, from all YAML and JSON
+// files directly inside it. ${VAR} references are expanded from the environment.
+func loadManifests(path string) ([]applicationManifest, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	files := []string{path}
+	if info.IsDir() {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read directory %s: %w", path, err)
+		}
+
+		files = files[:0]
+		for _, entry := range entries {
+			if !entry.IsDir() && manifestExtensions[strings.ToLower(filepath.Ext(entry.Name()))] {
+				files = append(files, filepath.Join(path, entry.Name()))
+			}
+		}
+		sort.Strings(files)
+	}
+
+	var manifests []applicationManifest
+	seen := map[string]string{}
+
+	for _, file := range files {
+		fileManifests, err := loadManifestFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, m := range fileManifests {
+			if prev, ok := seen[m.Metadata.Name]; ok {
+				return nil, fmt.Errorf("application '%s' is defined in both %s and %s", m.Metadata.Name, prev, m.source)
+			}
+			seen[m.Metadata.Name] = m.source
+			manifests = append(manifests, m)
+		}
+	}
+
+	if len(manifests) == 0 {
+		return nil, fmt.Errorf("no application manifests found in %s", path)
+	}
+
+	return manifests, nil
+}
+
+func loadManifestFile(file string) ([]applicationManifest, error) {
+	raw, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", file, err)
+	}
+
+	data, err := expandEnvReferences(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+
+	var manifests []applicationManifest
+	for _, doc := range documentSeparator.Split(string(data), -1) {
+		if len(bytes.TrimSpace([]byte(doc))) == 0 {
+			continue
+		}
+
+		var m applicationManifest
+		if err := k8syaml.UnmarshalStrict([]byte(doc), &m); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", file, err)
+		}
+		m.source = file
+
+		if err := m.validate(); err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, m)
+	}
+
+	return manifests, nil
+}
+
+// expandEnvReferences substitutes ${VAR} references with environment values and reports
+// all unset variables at once.
+func expandEnvReferences(data []byte) ([]byte, error) {
+	missing := map[string]bool{}
+
+	expanded := envReference.ReplaceAllFunc(data, func(match []byte) []byte {
+		name := string(envReference.FindSubmatch(match)[1])
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			missing[name] = true
+
+			return match
+		}
+
+		return []byte(value)
+	})
+
+	if len(missing) > 0 {
+		names := make([]string, 0, len(missing))
+		for name := range missing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		return nil, fmt.Errorf("referenced environment variables are not set: %s", strings.Join(names, ", "))
+	}
+
+	return expanded, nil
+}
+
+// normalizeParams round-trips params through JSON so values decoded from different sources
+// (YAML, JSON, Go literals) compare equal.
+func normalizeParams(params map[string]any) map[string]any {
+	if len(params) == 0 {
+		return map[string]any{}
+	}
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		return params
+	}
+
+	var normalized map[string]any
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return params
+	}
+
+	return normalized
+}
+
+// Made with Bob
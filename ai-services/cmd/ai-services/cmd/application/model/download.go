@@ -6,13 +6,18 @@ import (
 
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/modelsource"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 	"github.com/spf13/cobra"
 )
 
-var modelDirectory string
+var (
+	modelDirectory string
+	modelSource    modelsource.Config
+	sourceType     string
+)
 
 var downloadCmd = &cobra.Command{
 	Use:   "download",
@@ -22,6 +27,10 @@ var downloadCmd = &cobra.Command{
 Note:
   - Supports only podman runtime
   - Models are downloaded to the default models directory unless --dir is specified
+  - Models are downloaded from Hugging Face unless --source is specified; tokens and S3
+    credentials are read from the AI_SERVICES_MODEL_SOURCE_TOKEN, AWS_ACCESS_KEY_ID and
+    AWS_SECRET_ACCESS_KEY environment variables
+  - Interrupted downloads are resumed and downloaded files are verified against their checksums
   - Use 'ai-services application model list' to see available models for a template`,
 	Example: `  # Download models for Digital Assistant
 	 ai-services application model download --template rag --runtime podman
@@ -32,6 +41,18 @@ Note:
 	 # Download models to a custom directory
 	 ai-services application model download --template chat --dir /path/to/models --runtime podman
 
+	 # Download models from a Hugging Face mirror
+	 ai-services application model download --template rag --source-endpoint https://hf-mirror.example.com --runtime podman
+
+	 # Download models from OCI artifacts of an internal registry
+	 ai-services application model download --template rag --source oci --source-endpoint registry.example.com/models --runtime podman
+
+	 # Download models from an S3-compatible bucket
+	 ai-services application model download --template rag --source s3 --source-endpoint https://s3.example.com --source-bucket models --runtime podman
+
+	 # Copy models from an NFS mount
+	 ai-services application model download --template rag --source local --source-path /mnt/models --runtime podman
+
 	 # Download models using legacy implementation
 	 ai-services application model download --template rag --legacy --runtime podman`,
 	Args: cobra.MaximumNArgs(0),
@@ -39,6 +60,10 @@ Note:
 		// Once precheck passes, silence usage for any *later* internal errors.
 		cmd.SilenceUsage = true
 		hiddenTemplates, _ = cmd.Flags().GetBool("hidden")
+		modelSource.Type = modelsource.Type(sourceType)
+		if err := modelSource.Validate(); err != nil {
+			return err
+		}
 
 		return download(cmd)
 	},
@@ -50,6 +75,14 @@ func init() {
 	downloadCmd.Flags().StringVar(&vars.ToolImage, "tool-image", vars.ToolImage, "Tool container image used for downloading the model (for development purposes only)")
 	_ = downloadCmd.Flags().MarkHidden("tool-image")
 	downloadCmd.Flags().StringVar(&modelDirectory, "dir", utils.GetModelsPath(), "Directory to download the model files")
+	downloadCmd.Flags().StringVar(&sourceType, "source", string(modelsource.TypeHuggingFace), "Source of the models: huggingface, s3, oci or local")
+	downloadCmd.Flags().StringVar(&modelSource.Endpoint, "source-endpoint", "", "Hugging Face mirror, S3 endpoint, or registry and namespace of the OCI artifacts")
+	downloadCmd.Flags().StringVar(&modelSource.Revision, "source-revision", "", "Hugging Face revision or OCI artifact tag")
+	downloadCmd.Flags().StringVar(&modelSource.Username, "source-username", "", "Username of the registry")
+	downloadCmd.Flags().StringVar(&modelSource.Bucket, "source-bucket", "", "Bucket holding the models")
+	downloadCmd.Flags().StringVar(&modelSource.Prefix, "source-prefix", "", "Key prefix of the models in the bucket")
+	downloadCmd.Flags().StringVar(&modelSource.Path, "source-path", "", "Directory holding the models of a local source")
+	downloadCmd.Flags().BoolVar(&modelSource.Insecure, "source-insecure", false, "Skip the verification of the certificate of the registry")
 }
 
 func download(cmd *cobra.Command) error {
@@ -96,9 +129,7 @@ func downloadCatalogModels(templateID string) error {
 	logger.Infof("Downloading %d models for template '%s'...\n", len(models), templateID)
 
 	for _, model := range models {
-		logger.Infof("Downloading model: %s\n", model)
-
-		if err := helpers.DownloadModelContainer(context.Background(), model, modelDirectory); err != nil {
+		if err := modelsource.Download(context.Background(), modelSource, nil, model, modelDirectory); err != nil {
 			return fmt.Errorf("failed to download model %s: %w", model, err)
 		}
 	}
@@ -2,19 +2,47 @@ package model
 
 import (
 	"fmt"
+	"io"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/output"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 	"github.com/spf13/cobra"
 )
 
-var templateName string
+var (
+	templateName string
+	outputOpts   = output.Selected()
+)
+
+// templateModels is the structured output of the list command.
+type templateModels struct {
+	Template string   `json:"template"`
+	Models   []string `json:"models"`
+}
+
+// newTemplateModels returns the structured output of the models of the template, listing no models as an empty
+// list rather than null.
+func newTemplateModels(template string, models []string) templateModels {
+	if models == nil {
+		models = []string{}
+	}
+
+	return templateModels{Template: template, Models: models}
+}
+
+// Names returns the model names.
+func (t templateModels) Names() []string {
+	return t.Models
+}
 
 var listCmd = &cobra.Command{
 	Use:   "list",
-	Short: "List models for a given application template",
-	Long: `List all available models for a specific application template.
+	Short: "List models for a given application template or downloaded to the host",
+	Long: `List all available models for a specific application template, or with --local the models
+downloaded to the models directory with their size, source revision and the applications using them.
 Note:
   - Supports only podman runtime.
   - Use 'ai-services application templates' to see available template names`,
@@ -25,28 +53,53 @@ Note:
 	 ai-services application model list --template chat --runtime podman
 
 	 # List models using legacy implementation
-	 ai-services application model list --template rag --legacy --runtime podman`,
+	 ai-services application model list --template rag --legacy --runtime podman
+
+	 # List the models as JSON
+	 ai-services application model list --template rag -o json --runtime podman
+
+	 # List the models downloaded to the host
+	 ai-services application model list --local --runtime podman`,
 	Args: cobra.MaximumNArgs(0),
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := outputOpts.Validate(); err != nil {
+			return err
+		}
+
 		// Once precheck passes, silence usage for any *later* internal errors.
 		cmd.SilenceUsage = true
 		hiddenTemplates, _ = cmd.Flags().GetBool("hidden")
 
+		if localModels {
+			if err := requirePodman(); err != nil {
+				return err
+			}
+
+			return listLocal(cmd.OutOrStdout())
+		}
+
 		return list(cmd)
 	},
 }
 
 func init() {
-	listCmd.Flags().StringVarP(&templateName, "template", "t", "", "Application template name (Required)")
-	_ = listCmd.MarkFlagRequired("template")
+	listCmd.Flags().StringVarP(&templateName, "template", "t", "", "Application template name (Required unless --local)")
+	listCmd.Flags().BoolVar(&localModels, "local", false, "List the models downloaded to the models directory")
+	listCmd.Flags().StringVar(&localDirectory, "dir", utils.GetModelsPath(), "Directory of the downloaded models, with --local")
+	listCmd.MarkFlagsOneRequired("template", "local")
+	listCmd.MarkFlagsMutuallyExclusive("template", "local")
+	output.Supported(listCmd)
 }
 
 func list(cmd *cobra.Command) error {
 	if !legacyModel && vars.RuntimeFactory.GetRuntimeType() == types.RuntimeTypePodman {
-		return listCatalogModels(templateName)
+		return listCatalogModels(cmd.OutOrStdout(), templateName)
 	}
 
 	if vars.RuntimeFactory.GetRuntimeType() == types.RuntimeTypeOpenShift {
+		if err := outputOpts.RequireTable("the openshift runtime"); err != nil {
+			return err
+		}
 		// Since we do not have tmpl files in OpenShift marking it as unsupported for now
 		logger.Warningln("Not supported for openshift runtime")
 
@@ -57,6 +110,10 @@ func list(cmd *cobra.Command) error {
 	if err != nil {
 		return fmt.Errorf("failed to list the models, err: %w", err)
 	}
+
+	if outputOpts.Structured() {
+		return outputOpts.Print(cmd.OutOrStdout(), newTemplateModels(templateName, models))
+	}
 	logger.Infoln("Models in application template " + templateName + ":")
 	for _, model := range models {
 		logger.Infoln("- " + model)
@@ -66,12 +123,16 @@ func list(cmd *cobra.Command) error {
 }
 
 // listCatalogModels lists models for services or architectures from the catalog.
-func listCatalogModels(templateID string) error {
+func listCatalogModels(w io.Writer, templateID string) error {
 	models, err := getCatalogModels(templateID)
 	if err != nil {
 		return err
 	}
 
+	if outputOpts.Structured() {
+		return outputOpts.Print(w, newTemplateModels(templateID, models))
+	}
+
 	if len(models) == 0 {
 		logger.Infoln("No models found")
 
@@ -0,0 +1,360 @@
+package model
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	catalogClient "github.com/project-ai-services/ai-services/internal/pkg/catalog/client"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/output"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/modelstore"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+var (
+	localModels     bool
+	localDirectory  string
+	inspectChecksum bool
+	deleteYes       bool
+)
+
+// localModelList is the structured output of the list command with --local.
+type localModelList struct {
+	Models []modelstore.Model `json:"models"`
+}
+
+// Names returns the model names.
+func (l localModelList) Names() []string {
+	names := make([]string, 0, len(l.Models))
+	for _, model := range l.Models {
+		names = append(names, model.Name)
+	}
+
+	return names
+}
+
+var inspectCmd = &cobra.Command{
+	Use:   "inspect <model>",
+	Short: "Show a downloaded model",
+	Long: `Show the size, source revision, files and checksums of a model downloaded to the models
+directory, and the applications using it.
+
+Checksums are the ones recorded when the files were downloaded; use --checksums to compute them
+from the files instead, which reads every file.
+
+Note:
+  - Supports only podman runtime`,
+	Example: `  # Inspect a model
+  ai-services application model inspect ibm-granite/granite-3.3-8b-instruct --runtime podman
+
+  # Inspect a model with the checksums computed from its files, as JSON
+  ai-services application model inspect ibm-granite/granite-3.3-8b-instruct --checksums -o json --runtime podman`,
+	Args: cobra.ExactArgs(1),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := outputOpts.Validate(); err != nil {
+			return err
+		}
+
+		return requirePodman()
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		return inspect(cmd.OutOrStdout(), args[0])
+	},
+}
+
+var verifyCmd = &cobra.Command{
+	Use:   "verify <model>",
+	Short: "Verify the files of a downloaded model",
+	Long: `Verify the files of a model downloaded to the models directory against the checksums recorded
+when they were downloaded. Reports files that were modified or deleted since, and exits with an
+error if any.
+
+Note:
+  - Supports only podman runtime`,
+	Example: `  # Verify a model
+  ai-services application model verify ibm-granite/granite-3.3-8b-instruct --runtime podman`,
+	Args: cobra.ExactArgs(1),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := outputOpts.Validate(); err != nil {
+			return err
+		}
+
+		return requirePodman()
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		return verify(cmd.OutOrStdout(), args[0])
+	},
+}
+
+var deleteCmd = &cobra.Command{
+	Use:   "delete <model>",
+	Short: "Delete a downloaded model",
+	Long: `Delete a model downloaded to the models directory to reclaim disk space.
+
+Deletion is refused while an application uses the model, running or not; delete or update those
+applications first. The catalog records which applications use a model, so deletion is also refused
+when the catalog cannot be reached.
+
+Note:
+  - Supports only podman runtime`,
+	Example: `  # Delete a model
+  ai-services application model delete ibm-granite/granite-3.3-8b-instruct --runtime podman`,
+	Args: cobra.ExactArgs(1),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return requirePodman()
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		return deleteModel(args[0])
+	},
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{inspectCmd, verifyCmd, deleteCmd} {
+		cmd.Flags().StringVar(&localDirectory, "dir", utils.GetModelsPath(), "Directory of the downloaded models")
+	}
+	inspectCmd.Flags().BoolVar(&inspectChecksum, "checksums", false, "Compute the checksums of the model files")
+	output.Supported(inspectCmd)
+	output.Supported(verifyCmd)
+	deleteCmd.Flags().BoolVarP(&deleteYes, "yes", "y", false, "Automatically accept all confirmation prompts (default=false)")
+}
+
+func requirePodman() error {
+	if vars.RuntimeFactory.GetRuntimeType() != types.RuntimeTypePodman {
+		return fmt.Errorf("downloaded models are only supported for the podman runtime")
+	}
+
+	return nil
+}
+
+// modelUsage returns the applications using each model, from the catalog.
+func modelUsage() (modelstore.Usage, error) {
+	appClient, err := catalogClient.NewApplicationClient()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := appClient.ListModels()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the models of the catalog: %w", err)
+	}
+
+	usage := modelstore.Usage{}
+	for _, model := range resp.Models {
+		usage.Add(model.Name, model.UsedBy...)
+	}
+
+	return usage, nil
+}
+
+// applyUsage sets the applications using the models. When the catalog cannot tell, it warns and
+// leaves the usage of the models unknown.
+func applyUsage(models []modelstore.Model) {
+	usage, err := modelUsage()
+	if err != nil {
+		logger.Warningf("Could not determine the applications using the models: %v\n", err)
+		for i := range models {
+			models[i].UsedBy = nil
+		}
+
+		return
+	}
+	usage.Apply(models)
+}
+
+// listLocal lists the models downloaded to the models directory.
+func listLocal(w io.Writer) error {
+	models, err := modelstore.NewStore(localDirectory).List()
+	if err != nil {
+		return err
+	}
+
+	applyUsage(models)
+
+	if outputOpts.Structured() {
+		return outputOpts.Print(w, localModelList{Models: models})
+	}
+
+	if len(models) == 0 {
+		logger.Infof("No models found in %s\n", localDirectory)
+
+		return nil
+	}
+
+	printer := utils.NewTableWriter()
+	defer printer.CloseTableWriter()
+
+	if outputOpts.Wide() {
+		printer.SetHeaders("MODEL", "SIZE", "REVISION", "MODIFIED", "USED BY", "PATH")
+	} else {
+		printer.SetHeaders("MODEL", "SIZE", "REVISION", "USED BY")
+	}
+	for _, model := range models {
+		row := []string{model.Name, formatSize(model.SizeBytes), shortRevision(model.Revision)}
+		if outputOpts.Wide() {
+			row = append(row, model.ModifiedAt.Format("2006-01-02 15:04"), usedBy(model.UsedBy), model.Path)
+		} else {
+			row = append(row, usedBy(model.UsedBy))
+		}
+		printer.AppendRow(row...)
+	}
+
+	return nil
+}
+
+func inspect(w io.Writer, name string) error {
+	model, err := modelstore.NewStore(localDirectory).Get(name)
+	if err != nil {
+		return err
+	}
+	if inspectChecksum {
+		logger.Infof("Computing the checksums of %d files of %s...\n", len(model.Files), name)
+		if err := modelstore.ComputeChecksums(model); err != nil {
+			return err
+		}
+	}
+
+	models := []modelstore.Model{*model}
+	applyUsage(models)
+	model = &models[0]
+
+	if outputOpts.Structured() {
+		return outputOpts.Print(w, model)
+	}
+
+	fmt.Fprintf(w, "Name:     %s\n", model.Name)
+	fmt.Fprintf(w, "Path:     %s\n", model.Path)
+	fmt.Fprintf(w, "Size:     %s\n", formatSize(model.SizeBytes))
+	fmt.Fprintf(w, "Revision: %s\n", valueOrNone(model.Revision))
+	fmt.Fprintf(w, "Modified: %s\n", model.ModifiedAt.Format("2006-01-02 15:04:05"))
+	fmt.Fprintf(w, "Used by:  %s\n", usedBy(model.UsedBy))
+	fmt.Fprintln(w, "Files:")
+
+	printer := utils.NewTableWriter()
+	defer printer.CloseTableWriter()
+
+	printer.SetHeaders("FILE", "SIZE", "SHA256")
+	for _, file := range model.Files {
+		printer.AppendRow(file.Path, formatSize(file.SizeBytes), valueOrNone(file.SHA256))
+	}
+
+	return nil
+}
+
+func verify(w io.Writer, name string) error {
+	result, err := modelstore.NewStore(localDirectory).Verify(name)
+	if err != nil {
+		return err
+	}
+
+	if outputOpts.Structured() {
+		if err := outputOpts.Print(w, result); err != nil {
+			return err
+		}
+	} else {
+		printer := utils.NewTableWriter()
+		printer.SetHeaders("FILE", "STATUS")
+		for _, file := range result.Files {
+			printer.AppendRow(file.Path, string(file.Status))
+		}
+		printer.CloseTableWriter()
+	}
+
+	if !result.Valid {
+		return fmt.Errorf("model %s has missing or modified files", name)
+	}
+	if !outputOpts.Structured() {
+		logger.Infof("Model %s verified\n", name)
+	}
+
+	return nil
+}
+
+func deleteModel(name string) error {
+	store := modelstore.NewStore(localDirectory)
+	model, err := store.Get(name)
+	if err != nil {
+		return err
+	}
+
+	usage, err := modelUsage()
+	if err != nil {
+		return fmt.Errorf("refusing to delete model %s: cannot determine whether it is in use: %w", name, err)
+	}
+	if users := usage[name]; len(users) > 0 {
+		return fmt.Errorf("model %s is in use by %s; delete or update them first", name, strings.Join(users, ", "))
+	}
+
+	if !deleteYes {
+		confirmed, err := utils.ConfirmAction(fmt.Sprintf("Delete model %s (%s) from %s?", name, formatSize(model.SizeBytes), localDirectory))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			logger.Infoln("Deletion cancelled")
+
+			return nil
+		}
+	}
+
+	if err := store.Delete(name); err != nil {
+		return err
+	}
+	logger.Infof("Model %s deleted, %s reclaimed\n", name, formatSize(model.SizeBytes))
+
+	return nil
+}
+
+// formatSize formats a size in bytes with binary units, e.g. 15.2 GiB.
+func formatSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
+
+// shortRevision shortens a commit to the length git shows.
+func shortRevision(revision string) string {
+	const shortLen = 12
+	if len(revision) > shortLen {
+		return revision[:shortLen]
+	}
+
+	return valueOrNone(revision)
+}
+
+func usedBy(users []string) string {
+	if users == nil {
+		return "unknown"
+	}
+	if len(users) == 0 {
+		return "-"
+	}
+
+	return strings.Join(users, ",")
+}
+
+func valueOrNone(value string) string {
+	if value == "" {
+		return "-"
+	}
+
+	return value
+}
@@ -2,13 +2,15 @@ package application
 
 import (
 	"fmt"
-	"strings"
+	"io"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/application"
 	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
 	catalogClient "github.com/project-ai-services/ai-services/internal/pkg/catalog/client"
+	catalogTypes "github.com/project-ai-services/ai-services/internal/pkg/catalog/types"
 	appFlags "github.com/project-ai-services/ai-services/internal/pkg/cli/constants/application"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/flagvalidator"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/output"
 	cliUtils "github.com/project-ai-services/ai-services/internal/pkg/cli/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
@@ -17,12 +19,28 @@ import (
 )
 
 var (
-	output   string
+	psOutput = output.Selected()
 	legacyPs bool
 )
 
-func isOutputWide() bool {
-	return strings.ToLower(output) == "wide"
+// applicationPSList is the structured output of the ps command.
+type applicationPSList struct {
+	Items []catalogTypes.ApplicationPSResponse `json:"items"`
+}
+
+// Names returns the names of the pods of the applications.
+func (l applicationPSList) Names() []string {
+	var names []string
+	for _, app := range l.Items {
+		for _, pod := range app.Services {
+			names = append(names, pod.PodName)
+		}
+		for _, pod := range app.Components {
+			names = append(names, pod.PodName)
+		}
+	}
+
+	return names
 }
 
 var psCmd = &cobra.Command{
@@ -47,6 +65,13 @@ Arguments:
   # List a specific application with wide output
   ai-services application ps myapp -o wide --runtime podman
 
+  # List applications as JSON, or only the names of their pods
+  ai-services application ps -o json --runtime podman
+  ai-services application ps -o name --runtime podman
+
+  # Print the status of every pod of an application
+  ai-services application ps myapp --jsonpath '{range .items[*].services[*]}{.pod_name}={.status}{"\n"}{end}' --runtime podman
+
   # Use legacy implementation (Podman only)
   ai-services application ps --legacy --runtime podman
 
@@ -78,11 +103,15 @@ Arguments:
 		rt := vars.RuntimeFactory.GetRuntimeType()
 		opts := appTypes.ListOptions{
 			ApplicationName: applicationName,
-			OutputWide:      isOutputWide(),
+			OutputWide:      psOutput.Wide(),
 		}
 
 		// When legacyPs is true and runtime is podman, use the older/stable code path
 		if legacyPs {
+			if err := psOutput.RequireTable("--legacy"); err != nil {
+				return err
+			}
+
 			// Create application instance using factory
 			factory := application.NewFactory(rt)
 			app, err := factory.Create(applicationName)
@@ -99,7 +128,7 @@ Arguments:
 		}
 
 		// Default: use new implementation via catalog
-		return renderApplicationPS(opts)
+		return renderApplicationPS(cmd.OutOrStdout(), opts)
 	},
 }
 
@@ -115,13 +144,7 @@ func initPsCommonFlags() {
 		"Use legacy application ps implementation",
 	)
 
-	psCmd.Flags().StringVarP(
-		&output,
-		appFlags.Ps.Output,
-		"o",
-		"",
-		"Output format (e.g., wide)",
-	)
+	output.Supported(psCmd)
 }
 
 // buildPsFlagValidator creates and configures the flag validator for the ps command.
@@ -132,15 +155,22 @@ func buildPsFlagValidator() *flagvalidator.FlagValidator {
 
 	// Register common flags
 	builder.
-		AddCommonFlag(appFlags.Ps.Output, nil).
+		AddCommonFlag(appFlags.Ps.Output, validatePsOutput).
+		AddCommonFlag(appFlags.Ps.JSONPath, validatePsOutput).
 		AddCommonFlag(appFlags.Ps.Legacy, nil)
 
 	return builder.Build()
 }
 
+// validatePsOutput validates the output format of the ps command.
+func validatePsOutput(_ *cobra.Command) error {
+	return psOutput.Validate()
+}
+
 // renderApplicationPS retrieves and processes the PS information for multiple application IDs.
-// It fetches the process status for each application using the catalog API and prints the results in tabular format.
-func renderApplicationPS(opts appTypes.ListOptions) error {
+// It fetches the process status for each application using the catalog API and prints the results
+// in tabular format, or in the structured output format selected.
+func renderApplicationPS(w io.Writer, opts appTypes.ListOptions) error {
 	appClient, err := catalogClient.NewApplicationClient()
 	if err != nil {
 		return fmt.Errorf("failed to create application client: %w", err)
@@ -151,7 +181,21 @@ func renderApplicationPS(opts appTypes.ListOptions) error {
 		return err
 	}
 
-	if len(applicationList) == 0 {
+	// Get PS information for each application
+	list := applicationPSList{Items: []catalogTypes.ApplicationPSResponse{}}
+	for _, app := range applicationList {
+		psResp, err := appClient.GetApplicationPS(app.ID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch application: %w", err)
+		}
+		list.Items = append(list.Items, *psResp)
+	}
+
+	if psOutput.Structured() {
+		return psOutput.Print(w, list)
+	}
+
+	if len(list.Items) == 0 {
 		logger.Warningln("No Application found")
 
 		return nil
@@ -164,14 +208,7 @@ func renderApplicationPS(opts appTypes.ListOptions) error {
 	// Set table headers based on output format
 	setApplicationPSTableHeaders(printer, opts.OutputWide)
 
-	// Process each application ID
-	for _, app := range applicationList {
-		// Get PS information for the application
-		psResp, err := appClient.GetApplicationPS(app.ID)
-		if err != nil {
-			return fmt.Errorf("failed to fetch application: %w", err)
-		}
-
+	for _, psResp := range list.Items {
 		// Process services pods
 		for _, pod := range psResp.Services {
 			rows := cliUtils.BuildPodRowFromAPI(psResp.Name, pod, opts.OutputWide)
@@ -0,0 +1,532 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	k8syaml "sigs.k8s.io/yaml"
+
+	apiModels "github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/models"
+	catalogClient "github.com/project-ai-services/ai-services/internal/pkg/catalog/client"
+	dbModels "github.com/project-ai-services/ai-services/internal/pkg/catalog/db/models"
+	catalogTypes "github.com/project-ai-services/ai-services/internal/pkg/catalog/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// listPageSize is the largest page the catalog server returns.
+const listPageSize = 100
+
+// changeAction describes what apply does with one application.
+type changeAction string
+
+const (
+	changeCreate    changeAction = "create"
+	changeReplace   changeAction = "replace"
+	changeDelete    changeAction = "delete"
+	changeUnchanged changeAction = "unchanged"
+)
+
+// plannedChange is one entry of a reconciliation plan.
+type plannedChange struct {
+	Action  changeAction
+	Name    string
+	Current *catalogTypes.Application
+	// Manifest and Desired are unset for deletions.
+	Manifest *applicationManifest
+	Desired  *apiModels.CreateApplicationRequest
+	// Details lists the differences that caused a replacement.
+	Details []string
+	// Dependents are the records of Current that are tied to it on the server; unset for
+	// creations and unchanged applications.
+	Dependents *dependentRecords
+}
+
+// dependentRecords are the records tied to an application on the server. Policies and API keys
+// are deleted along with the application; backups are kept and stay listed under an application
+// of the same name.
+type dependentRecords struct {
+	Policies []dbModels.BackupPolicy
+	APIKeys  []dbModels.APIKey
+	Backups  []dbModels.Backup
+}
+
+// lost describes the dependent records a change deletes. Backup policies survive a replacement,
+// as they are created again on the new application; API keys cannot be, as their secrets are
+// only known to their clients.
+func (c *plannedChange) lost() []string {
+	if c.Dependents == nil {
+		return nil
+	}
+
+	var lost []string
+	if n := len(c.Dependents.Policies); n > 0 && c.Action == changeDelete {
+		lost = append(lost, fmt.Sprintf("%d backup policy(ies)", n))
+	}
+	if n := len(c.Dependents.APIKeys); n > 0 {
+		lost = append(lost, fmt.Sprintf("%d API key(s)", n))
+	}
+
+	return lost
+}
+
+// reconcilePlan is the ordered set of changes that converges the server to the manifests.
+type reconcilePlan struct {
+	Changes []plannedChange
+}
+
+// count returns the number of changes with the given action.
+func (p *reconcilePlan) count(action changeAction) int {
+	n := 0
+	for _, c := range p.Changes {
+		if c.Action == action {
+			n++
+		}
+	}
+
+	return n
+}
+
+// lossy returns the number of changes that delete dependent records.
+func (p *reconcilePlan) lossy() int {
+	n := 0
+	for i := range p.Changes {
+		if len(p.Changes[i].lost()) > 0 {
+			n++
+		}
+	}
+
+	return n
+}
+
+// hasChanges reports whether applying the plan would modify anything.
+func (p *reconcilePlan) hasChanges() bool {
+	return p.count(changeUnchanged) != len(p.Changes)
+}
+
+// buildReconcilePlan compares the manifests with the applications on the catalog server.
+// Applications that exist on the server but not in the manifests are deleted only when prune is set.
+func buildReconcilePlan(appClient *catalogClient.ApplicationClient, manifests []applicationManifest, prune bool) (*reconcilePlan, error) {
+	existing, err := listAllApplications(appClient)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]*catalogTypes.Application, len(existing))
+	for i := range existing {
+		byName[existing[i].Name] = &existing[i]
+	}
+
+	plan := &reconcilePlan{}
+	declared := make(map[string]bool, len(manifests))
+	schemas := newParamSchemas(appClient)
+
+	for i := range manifests {
+		m := &manifests[i]
+		declared[m.Metadata.Name] = true
+
+		desired, err := desiredRequest(schemas, m)
+		if err != nil {
+			return nil, err
+		}
+
+		change := plannedChange{Name: m.Metadata.Name, Manifest: m, Desired: desired}
+
+		current, ok := byName[m.Metadata.Name]
+		if !ok {
+			change.Action = changeCreate
+			plan.Changes = append(plan.Changes, change)
+
+			continue
+		}
+
+		change.Current = current
+		currentDef, err := exportDefinition(appClient, current.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read current state of '%s': %w", current.Name, err)
+		}
+
+		change.Details = diffApplication(desired, currentDef)
+		change.Action = changeUnchanged
+		if len(change.Details) > 0 {
+			change.Action = changeReplace
+			if change.Dependents, err = fetchDependents(appClient, current.ID); err != nil {
+				return nil, fmt.Errorf("failed to read records of '%s': %w", current.Name, err)
+			}
+		}
+		plan.Changes = append(plan.Changes, change)
+	}
+
+	if prune {
+		for i := range existing {
+			if !declared[existing[i].Name] {
+				dependents, err := fetchDependents(appClient, existing[i].ID)
+				if err != nil {
+					return nil, fmt.Errorf("failed to read records of '%s': %w", existing[i].Name, err)
+				}
+				plan.Changes = append(plan.Changes, plannedChange{
+					Action:     changeDelete,
+					Name:       existing[i].Name,
+					Current:    &existing[i],
+					Dependents: dependents,
+				})
+			}
+		}
+	}
+
+	return plan, nil
+}
+
+// fetchDependents reads the backup policies, API keys and backups of an application.
+func fetchDependents(appClient *catalogClient.ApplicationClient, id string) (*dependentRecords, error) {
+	policies, err := appClient.ListBackupPolicies(id)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := appClient.ListAPIKeys(id)
+	if err != nil {
+		return nil, err
+	}
+	backups, err := appClient.ListBackups(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dependentRecords{Policies: policies, APIKeys: keys, Backups: backups}, nil
+}
+
+// desiredRequest builds the create request a manifest stands for. The template form reuses the
+// payload builder of `application create`, including provider selection, schema defaults and the
+// validation of params against schemas, which is skipped when schemas is nil.
+func desiredRequest(schemas *paramSchemas, m *applicationManifest) (*apiModels.CreateApplicationRequest, error) {
+	if m.isTemplate() {
+		req, err := buildCatalogPayload(schemas, m.Spec.Template, m.Metadata.Name, m.templateParams())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", m.source, err)
+		}
+
+		return req, nil
+	}
+
+	def := m.definition()
+	req := def.ToCreateRequest()
+
+	return &req, nil
+}
+
+// listAllApplications pages through every application visible to the user.
+func listAllApplications(appClient *catalogClient.ApplicationClient) ([]catalogTypes.Application, error) {
+	var apps []catalogTypes.Application
+
+	for page := 1; ; page++ {
+		resp, err := appClient.ListApplications(&catalogClient.ListApplicationsParams{Page: page, PageSize: listPageSize})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch applications: %w", err)
+		}
+
+		apps = append(apps, resp.Data...)
+		if !resp.Pagination.HasNext {
+			return apps, nil
+		}
+	}
+}
+
+func exportDefinition(appClient *catalogClient.ApplicationClient, id string) (*apiModels.ApplicationDefinition, error) {
+	data, err := appClient.ExportApplication(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var def apiModels.ApplicationDefinition
+	if err := k8syaml.Unmarshal(data, &def); err != nil {
+		return nil, fmt.Errorf("failed to decode application definition: %w", err)
+	}
+
+	return &def, nil
+}
+
+// diffApplication lists the differences between a desired create request and the exported
+// state of an existing application. Secret parameters are not stored by the server and, like
+// service-level parameters, are not compared.
+func diffApplication(desired *apiModels.CreateApplicationRequest, current *apiModels.ApplicationDefinition) []string {
+	var diffs []string
+
+	if desired.CatalogID != current.Spec.CatalogID {
+		diffs = append(diffs, fmt.Sprintf("catalog_id: %s -> %s", current.Spec.CatalogID, desired.CatalogID))
+	}
+	if desired.Version != "" && desired.Version != current.Spec.Version {
+		diffs = append(diffs, fmt.Sprintf("version: %s -> %s", current.Spec.Version, desired.Version))
+	}
+
+	currentServices := make(map[string]apiModels.ServiceDefinition, len(current.Spec.Services))
+	for _, svc := range current.Spec.Services {
+		currentServices[svc.CatalogID] = svc
+	}
+
+	desiredServices := make(map[string]bool, len(desired.Services))
+	for _, svc := range desired.Services {
+		desiredServices[svc.CatalogID] = true
+
+		cur, ok := currentServices[svc.CatalogID]
+		if !ok {
+			diffs = append(diffs, fmt.Sprintf("services.%s: added", svc.CatalogID))
+
+			continue
+		}
+
+		diffs = append(diffs, diffService(svc, cur)...)
+	}
+
+	for _, svc := range current.Spec.Services {
+		if !desiredServices[svc.CatalogID] {
+			diffs = append(diffs, fmt.Sprintf("services.%s: removed", svc.CatalogID))
+		}
+	}
+
+	return diffs
+}
+
+func diffService(desired apiModels.Service, current apiModels.ServiceDefinition) []string {
+	var diffs []string
+	prefix := "services." + desired.CatalogID
+
+	if desired.Version != "" && desired.Version != current.Version {
+		diffs = append(diffs, fmt.Sprintf("%s.version: %s -> %s", prefix, current.Version, desired.Version))
+	}
+
+	currentComponents := make(map[string]apiModels.ComponentDefinition, len(current.Components))
+	for _, comp := range current.Components {
+		currentComponents[comp.ComponentType] = comp
+	}
+
+	for _, comp := range desired.Components {
+		compPrefix := prefix + ".components." + comp.ComponentType
+
+		cur, ok := currentComponents[comp.ComponentType]
+		if !ok {
+			diffs = append(diffs, compPrefix+": added")
+
+			continue
+		}
+
+		if comp.ProviderID != cur.ProviderID {
+			diffs = append(diffs, fmt.Sprintf("%s.provider: %s -> %s", compPrefix, cur.ProviderID, comp.ProviderID))
+
+			continue
+		}
+		if comp.Version != "" && comp.Version != cur.Version {
+			diffs = append(diffs, fmt.Sprintf("%s.version: %s -> %s", compPrefix, cur.Version, comp.Version))
+		}
+
+		for _, key := range diffParams(withoutPaths(comp.Params, cur.SecretRefs), cur.Params) {
+			diffs = append(diffs, fmt.Sprintf("%s.params.%s: changed", compPrefix, key))
+		}
+	}
+
+	return diffs
+}
+
+// diffParams returns the sorted top-level keys whose values differ between two param maps.
+func diffParams(desired, current map[string]any) []string {
+	desired = normalizeParams(desired)
+	current = normalizeParams(current)
+
+	keys := map[string]bool{}
+	for k := range desired {
+		keys[k] = true
+	}
+	for k := range current {
+		keys[k] = true
+	}
+
+	var changed []string
+	for k := range keys {
+		if !reflect.DeepEqual(desired[k], current[k]) {
+			changed = append(changed, k)
+		}
+	}
+	sort.Strings(changed)
+
+	return changed
+}
+
+// withoutPaths returns a copy of params with the given dotted paths removed.
+func withoutPaths(params map[string]any, paths []string) map[string]any {
+	if len(paths) == 0 {
+		return params
+	}
+
+	out := normalizeParams(params)
+	for _, path := range paths {
+		parts := strings.Split(path, ".")
+		m := out
+		for _, part := range parts[:len(parts)-1] {
+			next, ok := m[part].(map[string]any)
+			if !ok {
+				m = nil
+
+				break
+			}
+			m = next
+		}
+		if m != nil {
+			delete(m, parts[len(parts)-1])
+		}
+	}
+
+	return out
+}
+
+// printPlan logs the plan in a diff-like format.
+func printPlan(plan *reconcilePlan) {
+	symbols := map[changeAction]string{
+		changeCreate:    "+",
+		changeReplace:   "~",
+		changeDelete:    "-",
+		changeUnchanged: "=",
+	}
+
+	for _, c := range plan.Changes {
+		logger.Infof("%s %s %s\n", symbols[c.Action], c.Action, c.Name)
+		for _, d := range c.Details {
+			logger.Infof("    %s\n", d)
+		}
+		if c.Action == changeReplace && c.Dependents != nil && len(c.Dependents.Policies) > 0 {
+			logger.Infof("    %d backup policy(ies) will be created again\n", len(c.Dependents.Policies))
+		}
+		if c.Dependents != nil && len(c.Dependents.Backups) > 0 {
+			logger.Infof("    %d backup(s) will be kept\n", len(c.Dependents.Backups))
+		}
+		if lost := c.lost(); len(lost) > 0 {
+			logger.Infof("    ! will delete %s\n", strings.Join(lost, ", "))
+		}
+	}
+
+	logger.Infof("Plan: %d to create, %d to replace, %d to delete, %d unchanged.\n",
+		plan.count(changeCreate), plan.count(changeReplace), plan.count(changeDelete), plan.count(changeUnchanged))
+}
+
+// executePlan applies the plan: deletions first, then replacements, then creations.
+// Replacements keep the data of the previous deployment.
+func executePlan(appClient *catalogClient.ApplicationClient, plan *reconcilePlan, wait bool) error {
+	for _, action := range []changeAction{changeDelete, changeReplace, changeCreate} {
+		for i := range plan.Changes {
+			c := &plan.Changes[i]
+			if c.Action != action {
+				continue
+			}
+
+			if err := executeChange(appClient, c, wait); err != nil {
+				return fmt.Errorf("failed to %s application '%s': %w", c.Action, c.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func executeChange(appClient *catalogClient.ApplicationClient, c *plannedChange, wait bool) error {
+	switch c.Action {
+	case changeDelete:
+		logger.Infof("Deleting application '%s'...\n", c.Name)
+
+		return deleteAndWait(appClient, c.Current.ID, false)
+	case changeReplace:
+		logger.Infof("Replacing application '%s'...\n", c.Name)
+		if err := deleteAndWait(appClient, c.Current.ID, true); err != nil {
+			return err
+		}
+
+		// The policies are created again even if the new application does not become ready
+		id, err := createFromManifest(appClient, c, wait)
+		if id == "" {
+			return err
+		}
+
+		return errors.Join(err, restorePolicies(appClient, id, c.Dependents))
+	case changeCreate:
+		logger.Infof("Creating application '%s'...\n", c.Name)
+
+		_, err := createFromManifest(appClient, c, wait)
+
+		return err
+	case changeUnchanged:
+	}
+
+	return nil
+}
+
+func deleteAndWait(appClient *catalogClient.ApplicationClient, id string, keepData bool) error {
+	if err := appClient.DeleteApplication(id, &catalogClient.DeleteApplicationParams{KeepData: keepData}); err != nil {
+		return err
+	}
+
+	return waitForApplicationDeletion(appClient, id)
+}
+
+// createFromManifest creates the application of a planned change. Template manifests are
+// created from the built payload; full definitions go through the import endpoint so their
+// connector references are resolved by the server.
+func createFromManifest(appClient *catalogClient.ApplicationClient, c *plannedChange, wait bool) (string, error) {
+	var resp *apiModels.CreateApplicationResponse
+
+	err := utils.Retry(context.Background(), vars.RetryCount, vars.RetryInterval, nil, func() error {
+		var createErr error
+		if c.Manifest.isTemplate() {
+			resp, createErr = appClient.CreateApplication(c.Desired)
+
+			return createErr
+		}
+
+		data, err := json.Marshal(c.Manifest.definition())
+		if err != nil {
+			return err
+		}
+		resp, createErr = appClient.ImportApplication(data, "")
+
+		return createErr
+	})
+	if err != nil {
+		return "", err
+	}
+
+	logger.Infof("Application creation initiated (ID: %s)\n", resp.ID)
+
+	if !wait {
+		return resp.ID, nil
+	}
+
+	return resp.ID, pollApplicationStatus(appClient, c.Name, resp.ID)
+}
+
+// restorePolicies creates the backup policies of a replaced application on its replacement.
+func restorePolicies(appClient *catalogClient.ApplicationClient, id string, dependents *dependentRecords) error {
+	if dependents == nil {
+		return nil
+	}
+
+	for _, policy := range dependents.Policies {
+		req := &apiModels.CreateBackupPolicyRequest{
+			Target:    policy.Target,
+			Schedule:  policy.Schedule,
+			Retention: policy.Retention,
+		}
+		if policy.ConnectorID != nil {
+			req.ConnectorID = policy.ConnectorID.String()
+		}
+
+		if _, err := appClient.CreateBackupPolicy(id, req); err != nil {
+			return fmt.Errorf("failed to create backup policy of %s again: %w", policy.Target, err)
+		}
+	}
+
+	return nil
+}
+
+// Made with Bob
@@ -0,0 +1,217 @@
+package application
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	apiModels "github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/models"
+	dbModels "github.com/project-ai-services/ai-services/internal/pkg/catalog/db/models"
+)
+
+func testDefinition() *apiModels.ApplicationDefinition {
+	return &apiModels.ApplicationDefinition{
+		Spec: apiModels.ApplicationDefinitionSpec{
+			CatalogID: "rag",
+			Version:   "1.0.0",
+			Services: []apiModels.ServiceDefinition{{
+				CatalogID: "chat",
+				Version:   "1.0.0",
+				Components: []apiModels.ComponentDefinition{{
+					ComponentType: "llm",
+					ProviderID:    "watsonx",
+					Version:       "1.0.0",
+					Params:        map[string]any{"model": "granite", "port": float64(8000)},
+					SecretRefs:    []string{"apiKey"},
+				}},
+			}},
+		},
+	}
+}
+
+func testRequest() *apiModels.CreateApplicationRequest {
+	return &apiModels.CreateApplicationRequest{
+		CatalogID: "rag",
+		Version:   "1.0.0",
+		Services: []apiModels.Service{{
+			CatalogID: "chat",
+			Version:   "1.0.0",
+			Components: []apiModels.Component{{
+				ComponentType: "llm",
+				ProviderID:    "watsonx",
+				Version:       "1.0.0",
+				Params:        map[string]any{"model": "granite", "port": 8000, "apiKey": "secret"},
+			}},
+		}},
+	}
+}
+
+func TestDiffApplication_Unchanged(t *testing.T) {
+	if diffs := diffApplication(testRequest(), testDefinition()); len(diffs) != 0 {
+		t.Errorf("expected no differences, got %v", diffs)
+	}
+}
+
+func TestDiffApplication_ProviderChanged(t *testing.T) {
+	req := testRequest()
+	req.Services[0].Components[0].ProviderID = "vllm"
+
+	want := []string{"services.chat.components.llm.provider: watsonx -> vllm"}
+	if diffs := diffApplication(req, testDefinition()); !reflect.DeepEqual(diffs, want) {
+		t.Errorf("diffApplication() = %v, want %v", diffs, want)
+	}
+}
+
+func TestDiffApplication_ParamChanged(t *testing.T) {
+	req := testRequest()
+	req.Services[0].Components[0].Params["model"] = "llama"
+
+	want := []string{"services.chat.components.llm.params.model: changed"}
+	if diffs := diffApplication(req, testDefinition()); !reflect.DeepEqual(diffs, want) {
+		t.Errorf("diffApplication() = %v, want %v", diffs, want)
+	}
+}
+
+func TestDiffApplication_ServicesAddedAndRemoved(t *testing.T) {
+	req := testRequest()
+	req.Services[0].CatalogID = "summarize"
+
+	want := []string{"services.summarize: added", "services.chat: removed"}
+	if diffs := diffApplication(req, testDefinition()); !reflect.DeepEqual(diffs, want) {
+		t.Errorf("diffApplication() = %v, want %v", diffs, want)
+	}
+}
+
+func TestPlannedChangeLost(t *testing.T) {
+	dependents := &dependentRecords{
+		Policies: []dbModels.BackupPolicy{{Target: "opensearch"}},
+		APIKeys:  []dbModels.APIKey{{Name: "client"}, {Name: "ci"}},
+	}
+
+	replace := plannedChange{Action: changeReplace, Dependents: dependents}
+	if got, want := replace.lost(), []string{"2 API key(s)"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("lost() of a replacement = %v, want %v", got, want)
+	}
+
+	remove := plannedChange{Action: changeDelete, Dependents: dependents}
+	if got, want := remove.lost(), []string{"1 backup policy(ies)", "2 API key(s)"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("lost() of a deletion = %v, want %v", got, want)
+	}
+
+	plan := &reconcilePlan{Changes: []plannedChange{replace, remove, {Action: changeReplace, Dependents: &dependentRecords{Policies: dependents.Policies}}}}
+	if n := plan.lossy(); n != 2 {
+		t.Errorf("lossy() = %d, want 2", n)
+	}
+}
+
+func TestWithoutPaths_RemovesNestedSecret(t *testing.T) {
+	params := map[string]any{"auth": map[string]any{"user": "admin", "password": "x"}}
+
+	got := withoutPaths(params, []string{"auth.password", "missing.path"})
+	want := map[string]any{"auth": map[string]any{"user": "admin"}}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("withoutPaths() = %v, want %v", got, want)
+	}
+	if _, ok := params["auth"].(map[string]any)["password"]; !ok {
+		t.Error("expected the input params to be left untouched")
+	}
+}
+
+func TestLoadManifests_Directory(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("TEST_API_KEY", "secret")
+
+	files := map[string]string{
+		"a.yaml": `apiVersion: ai-services.io/v1
+kind: Application
+metadata:
+  name: chat-a
+spec:
+  template: chat
+  params:
+    chat.llm.watsonx.apiKey: ${TEST_API_KEY}
+    chat.port: 8000
+---
+apiVersion: ai-services.io/v1
+kind: Application
+metadata:
+  name: chat-b
+spec:
+  template: chat
+`,
+		"b.json":    `{"apiVersion": "ai-services.io/v1", "kind": "Application", "metadata": {"name": "rag"}, "spec": {"catalog_id": "rag", "version": "1.0.0", "services": []}}`,
+		"notes.txt": "ignored",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	manifests, err := loadManifests(dir)
+	if err != nil {
+		t.Fatalf("loadManifests() error = %v", err)
+	}
+
+	var names []string
+	for _, m := range manifests {
+		names = append(names, m.Metadata.Name)
+	}
+	if want := []string{"chat-a", "chat-b", "rag"}; !reflect.DeepEqual(names, want) {
+		t.Fatalf("manifest names = %v, want %v", names, want)
+	}
+
+	params := manifests[0].templateParams()
+	if params["chat.llm.watsonx.apiKey"] != "secret" || params["chat.port"] != "8000" {
+		t.Errorf("unexpected template params %v", params)
+	}
+	if manifests[2].isTemplate() {
+		t.Error("expected the exported definition not to use the template form")
+	}
+}
+
+func TestLoadManifests_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "unset variable",
+			content: "apiVersion: ai-services.io/v1\nkind: Application\nmetadata:\n  name: chat\nspec:\n  template: chat\n  params:\n    key: ${TEST_UNSET_VARIABLE}\n",
+			wantErr: "TEST_UNSET_VARIABLE",
+		},
+		{
+			name:    "mixed forms",
+			content: "apiVersion: ai-services.io/v1\nkind: Application\nmetadata:\n  name: chat\nspec:\n  template: chat\n  catalog_id: chat\n",
+			wantErr: "cannot be combined",
+		},
+		{
+			name:    "wrong kind",
+			content: "apiVersion: ai-services.io/v1\nkind: Service\nmetadata:\n  name: chat\nspec:\n  template: chat\n",
+			wantErr: "unsupported kind",
+		},
+		{
+			name:    "duplicate names",
+			content: "apiVersion: ai-services.io/v1\nkind: Application\nmetadata:\n  name: chat\nspec:\n  template: chat\n---\napiVersion: ai-services.io/v1\nkind: Application\nmetadata:\n  name: chat\nspec:\n  template: chat\n",
+			wantErr: "defined in both",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "app.yaml")
+			if err := os.WriteFile(file, []byte(tt.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err := loadManifests(file)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("loadManifests() error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package application
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var restartService string
+
+var restartCmd = &cobra.Command{
+	Use:   "restart [name]",
+	Short: "Restart an application",
+	Long: `Restarts an application by name through the catalog API.
+
+Every service (or only the one given with --service) and the components no other service
+depends on are stopped and started again.
+
+Arguments:
+  [name] : Application name (required)
+`,
+	Example: `  # Restart an application
+  ai-services application restart rag --runtime podman
+
+  # Restart a single service of an application
+  ai-services application restart rag --service chat --runtime openshift`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+
+		return changeApplicationState(args[0], restartService, lifecycleActionRestart)
+	},
+}
+
+func init() {
+	restartCmd.Flags().StringVar(&restartService, "service", "", "Restart only this service of the application (service ID or catalog ID)")
+}
+
+// Made with Bob
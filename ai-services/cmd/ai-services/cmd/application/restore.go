@@ -10,6 +10,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/application"
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common/opensearch"
 	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
@@ -20,6 +21,7 @@ var (
 	restoreTarget   string
 	restoreFilename string
 	restoreAutoYes  bool
+	restoreIndices  []string
 )
 
 var restoreCmd = &cobra.Command{
@@ -35,11 +37,16 @@ Supported targets:
   - digitize:   Restore digitize metadata (jobs and documents) (Podman and OpenShift)
 
 Note:
-  - WARNING: Restore will overwrite existing data`,
+  - WARNING: Restore will overwrite existing data
+  - Backup checksums are verified before any data is restored
+  - An interrupted OpenSearch restore resumes where it stopped when run again with the same backup file`,
 	Example: `  For Podman:
   # Restore OpenSearch data with Podman
   ai-services application restore myapp --target opensearch --filename backup.tar.gz --runtime podman
 
+  # Restore only the OpenSearch indices matching a pattern
+  ai-services application restore myapp --target opensearch --filename backup.tar.gz --index 'rag_docs*' --runtime podman
+
   # Restore with automatic confirmation
   ai-services application restore myapp --target digitize --filename backup.tar.gz --runtime podman --yes
 
@@ -71,6 +78,13 @@ Note:
 			return fmt.Errorf("invalid target '%s'. Valid targets are: %s", target, strings.Join(validTargets, ", "))
 		}
 
+		if len(restoreIndices) > 0 && target != "opensearch" {
+			return fmt.Errorf("--index is only supported with the opensearch target")
+		}
+		if err := opensearch.ValidatePatterns(restoreIndices); err != nil {
+			return err
+		}
+
 		// Validate filename extension
 		if !strings.HasSuffix(filename, ".tar.gz") {
 			return fmt.Errorf("backup file must have .tar.gz extension, got: %s", filename)
@@ -124,6 +138,8 @@ Note:
 			Target:     restoreTarget,
 			BackupFile: absFilename,
 			AutoYes:    restoreAutoYes,
+
+			IndexPatterns: restoreIndices,
 		}
 
 		// Execute restore using the application interface
@@ -140,6 +156,7 @@ Note:
 func init() {
 	restoreCmd.Flags().StringVar(&restoreTarget, "target", "", "Target to restore (opensearch, digitize) (required)")
 	restoreCmd.Flags().StringVar(&restoreFilename, "filename", "", "Path to the backup tar.gz file (required)")
+	restoreCmd.Flags().StringSliceVar(&restoreIndices, "index", nil, "Glob pattern of the OpenSearch indices to restore, repeatable (default \"rag*\")")
 	restoreCmd.Flags().BoolVarP(&restoreAutoYes, "yes", "y", false, "Automatically accept all confirmation prompts (default=false)")
 
 	_ = restoreCmd.MarkFlagRequired("target")
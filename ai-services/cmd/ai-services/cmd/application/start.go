@@ -17,6 +17,7 @@ var (
 	startPodNames []string
 	autoYes       bool
 	legacyStart   bool
+	startService  string
 )
 
 var startCmd = &cobra.Command{
@@ -27,13 +28,20 @@ var startCmd = &cobra.Command{
 Arguments:
   [name] : Application name (required)
 
+By default the application is started through the catalog API, which brings back every
+service (or only the one given with --service) and its components. Use --pod or --legacy to
+operate on individual pods directly.
+
 Note:
   - Logs are streamed only when a single pod is specified, and only after the pod has started.
-  - Supported for podman runtime only.
+  - --pod and --legacy are supported for podman runtime only.
 `,
 	Example: `  # Start an application
   ai-services application start rag --runtime podman
 
+  # Start a single service of an application
+  ai-services application start rag --service chat --runtime openshift
+
   # Start an application and skip logs
   ai-services application start rag --skip-logs --runtime podman
 
@@ -67,6 +75,10 @@ Note:
 		// Once precheck passes, silence usage for any *later* internal errors.
 		cmd.SilenceUsage = true
 
+		if !legacyStart && len(startPodNames) == 0 {
+			return changeApplicationState(applicationName, startService, lifecycleActionStart)
+		}
+
 		rt := vars.RuntimeFactory.GetRuntimeType()
 
 		// For podman runtime with default mode, validate application name using catalog API
@@ -103,6 +115,7 @@ Note:
 func init() {
 	startCmd.Flags().BoolVar(&legacyStart, "legacy", false, "Use legacy application start implementation")
 	startCmd.Flags().StringSlice("pod", []string{}, "Specific pod name(s) to start (optional)\nCan be specified multiple times: --pod pod1 --pod pod2\nOr comma-separated: --pod pod1,pod2")
+	startCmd.Flags().StringVar(&startService, "service", "", "Start only this service of the application (service ID or catalog ID)")
 	startCmd.Flags().BoolVar(&skipLogs, "skip-logs", false, "Skip displaying logs after starting the pod")
 	startCmd.Flags().BoolVarP(&autoYes, "yes", "y", false, "Automatically accept all confirmation prompts (default=false)")
 }
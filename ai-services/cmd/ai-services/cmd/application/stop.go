@@ -7,7 +7,9 @@ import (
 	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
 	catalogClient "github.com/project-ai-services/ai-services/internal/pkg/catalog/client"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	pkgUtils "github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 	"github.com/spf13/cobra"
 )
@@ -15,6 +17,7 @@ import (
 var (
 	stopPodNames []string
 	legacyStop   bool
+	stopService  string
 )
 
 var stopCmd = &cobra.Command{
@@ -25,12 +28,20 @@ var stopCmd = &cobra.Command{
 Arguments:
   [name] : Application name (required)
 
+By default the application is stopped through the catalog API: every service (or only the
+one given with --service) and the components no other running service depends on are scaled
+down, and the application is reported as Stopped. Data and routes are kept. Use --pod or
+--legacy to operate on individual pods directly.
+
 Note:
-  - Supported for podman runtime only.
+  - --pod and --legacy are supported for podman runtime only.
 `,
 	Example: `  # Stop an application
   ai-services application stop rag --runtime podman
 
+  # Stop a single service of an application
+  ai-services application stop rag --service chat --runtime openshift
+
   # Stop specific pods in an application
   ai-services application stop rag --pod pod1 --pod pod2 --runtime podman
 
@@ -58,6 +69,10 @@ Note:
 		// Once precheck passes, silence usage for any *later* internal errors.
 		cmd.SilenceUsage = true
 
+		if !legacyStop && len(stopPodNames) == 0 {
+			return stopApplication(applicationName)
+		}
+
 		rt := vars.RuntimeFactory.GetRuntimeType()
 
 		// For podman runtime with default mode, validate application name using catalog API
@@ -91,6 +106,24 @@ Note:
 
 func init() {
 	stopCmd.Flags().StringSlice("pod", []string{}, "Specific pod name(s) to stop (optional)\nCan be specified multiple times: --pod pod1 --pod pod2\nOr comma-separated: --pod pod1,pod2")
+	stopCmd.Flags().StringVar(&stopService, "service", "", "Stop only this service of the application (service ID or catalog ID)")
 	stopCmd.Flags().BoolVarP(&autoYes, "yes", "y", false, "Automatically accept all confirmation prompts (default=false)")
 	stopCmd.Flags().BoolVar(&legacyStop, "legacy", false, "Use legacy application stop implementation")
 }
+
+// stopApplication stops the application through the catalog API after confirmation.
+func stopApplication(appName string) error {
+	if !autoYes {
+		confirmStop, err := pkgUtils.ConfirmAction("Are you sure you want to stop the application? ")
+		if err != nil {
+			return fmt.Errorf("failed to take user input: %w", err)
+		}
+		if !confirmStop {
+			logger.Infoln("Stop cancelled")
+
+			return nil
+		}
+	}
+
+	return changeApplicationState(appName, stopService, lifecycleActionStop)
+}
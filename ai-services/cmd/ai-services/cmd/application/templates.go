@@ -3,6 +3,7 @@ package application
 import (
 	"errors"
 	"fmt"
+	"io"
 	"sort"
 	"strings"
 
@@ -12,14 +13,37 @@ import (
 	appTemplates "github.com/project-ai-services/ai-services/cmd/ai-services/cmd/application/templates"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog"
 	catalogTypes "github.com/project-ai-services/ai-services/internal/pkg/catalog/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/output"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 )
 
 var (
 	legacyTemplates bool
+	templatesOutput = output.Selected()
 )
 
+// catalogTemplates is the structured output of the templates command.
+type catalogTemplates struct {
+	Architectures []catalogTypes.Architecture `json:"architectures"`
+	Services      []catalogTypes.Service      `json:"services"`
+	Components    []catalogTypes.Component    `json:"components"`
+}
+
+// Names returns the IDs of the architectures and services, which are the templates accepted by
+// --template.
+func (t catalogTemplates) Names() []string {
+	names := make([]string, 0, len(t.Architectures)+len(t.Services))
+	for _, arch := range t.Architectures {
+		names = append(names, arch.ID)
+	}
+	for _, svc := range t.Services {
+		names = append(names, svc.ID)
+	}
+
+	return names
+}
+
 var templatesCmd = &cobra.Command{
 	Use:   "templates",
 	Short: "Lists the offered application templates and their supported parameters",
@@ -34,6 +58,9 @@ var templatesCmd = &cobra.Command{
 	 # List templates using legacy implementation
 	 ai-services application templates --legacy --runtime podman
 
+	 # List the template IDs only
+	 ai-services application templates -o name --runtime podman
+
 	 For OpenShift:
 	 # List all available application templates (OpenShift)
 	 ai-services application templates --runtime openshift
@@ -41,13 +68,21 @@ var templatesCmd = &cobra.Command{
 	 # List parameters for a specific template (see subcommand)
 	 ai-services application templates parameters --template digitize --runtime openshift `,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := templatesOutput.Validate(); err != nil {
+			return err
+		}
+
 		// Once precheck passes, silence usage for any *later* internal errors.
 		cmd.SilenceUsage = true
 
 		// When legacyTemplates is true, use the older/stable code path
 		if !legacyTemplates {
 			// Use catalog templates listing (architectures and services)
-			return listCatalogTemplates(cmd)
+			return listCatalogTemplates(cmd.OutOrStdout())
+		}
+
+		if err := templatesOutput.RequireTable("--legacy"); err != nil {
+			return err
 		}
 
 		tp := templates.NewEmbedTemplateProvider(&assets.ApplicationFS)
@@ -107,13 +142,14 @@ var templatesCmd = &cobra.Command{
 
 func init() {
 	templatesCmd.Flags().BoolVar(&legacyTemplates, "legacy", false, "Use legacy application templates implementation")
+	output.Supported(templatesCmd)
 
 	// Add parameters subcommand
 	templatesCmd.AddCommand(appTemplates.NewParametersCmd())
 }
 
 // listCatalogTemplates lists architectures, services, and components from the catalog.
-func listCatalogTemplates(cmd *cobra.Command) error {
+func listCatalogTemplates(w io.Writer) error {
 	// Create catalog provider
 	provider, err := catalog.NewCatalogProvider()
 	if err != nil {
@@ -136,6 +172,14 @@ func listCatalogTemplates(cmd *cobra.Command) error {
 		return fmt.Errorf("failed to list components: %w", err)
 	}
 
+	if templatesOutput.Structured() {
+		return templatesOutput.Print(w, catalogTemplates{
+			Architectures: architectures,
+			Services:      services,
+			Components:    components,
+		})
+	}
+
 	// Section 1: Deployment Architectures with list of services
 	logger.Infoln("Available Deployment Architectures:")
 	for _, arch := range architectures {
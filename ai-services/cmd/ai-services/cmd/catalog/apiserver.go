@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"strconv"
 	"syscall"
 	"time"
@@ -13,14 +14,19 @@ import (
 	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/catalog/common"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver"
+	apimodels "github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/models"
 	apirepository "github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/repository"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/services/auth"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/services/backup"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/services/sync"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/db"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/db/repository"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/miq"
+	catalogutils "github.com/project-ai-services/ai-services/internal/pkg/catalog/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/metrics"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 	workerregistry "github.com/project-ai-services/ai-services/internal/pkg/worker/registry"
@@ -81,6 +87,10 @@ func buildAPIServerOptions(ctx context.Context, pool *pgxpool.Pool, secretKey, a
 	svcRepo := repository.NewServiceRepository(pool)
 	compRepo := repository.NewComponentRepository(pool)
 	svcDepRepo := repository.NewServiceDependencyRepository(pool)
+	connectorRepo := repository.NewConnectorRepository(pool)
+	backupRepo := repository.NewBackupRepository(pool)
+	apiKeyRepo := repository.NewAPIKeyRepository(pool)
+	allocationRepo := repository.NewAcceleratorAllocationRepository(pool)
 
 	// Initialize sync service for background DB-Pod synchronization
 	// TODO: implement sync service on remote machines
@@ -111,25 +121,75 @@ func buildAPIServerOptions(ctx context.Context, pool *pgxpool.Pool, secretKey, a
 		authSvc = auth.NewAuthService(userRepo, tokenMgr, blacklist)
 	}
 
+	backupDir := utils.GetEnv(constants.BackupDirEnv, filepath.Join(utils.GetBaseDir(), constants.DefaultBackupDirName))
+	appService := apirepository.NewApplicationService(appRepo, svcRepo, compRepo, svcDepRepo, connectorRepo, backupRepo, apiKeyRepo, allocationRepo, backupDir, catalogProvider, vars.RuntimeFactory.GetRuntimeType())
+
+	if err := registerMetrics(pool, appService); err != nil {
+		syncService.Stop(ctx)
+
+		return apiserver.APIServerOptions{}, nil, err
+	}
+
+	// Initialize the scheduler running backup policies
+	backupScheduler := backup.NewScheduler(backupRepo, appService.RunBackupPolicy)
+	backupScheduler.Start(ctx)
+
 	opts := apiserver.APIServerOptions{
 		Port:               0, // set by caller
 		AuthService:        authSvc,
 		TokenManager:       tokenMgr,
 		Blacklist:          blacklist,
-		ApplicationService: apirepository.NewApplicationService(appRepo, svcRepo, compRepo, svcDepRepo, catalogProvider, vars.RuntimeFactory.GetRuntimeType()),
+		ApplicationService: appService,
 		WorkerGatewayPort:  workerGatewayPort,
 		WorkerRegistry:     workerReg,
 	}
 	cleanup := func() {
 		blacklist.Stop()
 		syncService.Stop(ctx)
+		backupScheduler.Stop(ctx)
 	}
 
 	return opts, cleanup, nil
 }
 
+// registerMetrics registers the metrics read at scrape time: the statistics of the database pool
+// and, on Podman, the allocation of the Spyre cards.
+func registerMetrics(pool *pgxpool.Pool, appService apirepository.ApplicationServiceInterface) error {
+	if err := metrics.RegisterDBPool(pool); err != nil {
+		return fmt.Errorf("failed to register database pool metrics: %w", err)
+	}
+
+	// Cards are scheduled by the cluster on OpenShift and never reserved by the catalog
+	if vars.RuntimeFactory.GetRuntimeType() != types.RuntimeTypePodman {
+		return nil
+	}
+
+	countCards := func(ctx context.Context) (map[string]int, error) {
+		resp, err := appService.ListAccelerators(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		counts := map[string]int{
+			apimodels.AcceleratorStatusFree:     0,
+			apimodels.AcceleratorStatusReserved: 0,
+			apimodels.AcceleratorStatusInUse:    0,
+		}
+		for _, acc := range resp.Accelerators {
+			counts[acc.Status]++
+		}
+
+		return counts, nil
+	}
+	if err := metrics.RegisterSpyreCards(countCards); err != nil {
+		return fmt.Errorf("failed to register Spyre card metrics: %w", err)
+	}
+
+	return nil
+}
+
 // runAPIServer initializes and starts the API server with the provided configuration.
-func runAPIServer(port int, accessTTL, refreshTTL time.Duration, adminUser, adminPassHash string, workerGatewayPort int, manageiqURL string, manageiqInsecure bool) error {
+func runAPIServer(port int, accessTTL, refreshTTL time.Duration, adminUser, adminPassHash string, workerGatewayPort, metricsPort int, manageiqURL string, manageiqInsecure bool) error {
 	secretKey, err := getOrGenerateSecretKey()
 	if err != nil {
 		return err
@@ -140,6 +200,12 @@ func runAPIServer(port int, accessTTL, refreshTTL time.Duration, adminUser, admi
 		return err
 	}
 
+	serviceAuthMode, err := catalogutils.ServiceAuthMode()
+	if err != nil {
+		return err
+	}
+	logger.Infof("Service endpoint authentication mode: %s\n", serviceAuthMode)
+
 	// Use a signal-aware context so that SIGINT/SIGTERM cancel the context,
 	// which stops the gateway sweeper and triggers gRPC GracefulStop.
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
@@ -159,6 +225,7 @@ func runAPIServer(port int, accessTTL, refreshTTL time.Duration, adminUser, admi
 	defer cleanup()
 
 	opts.Port = port
+	opts.MetricsPort = metricsPort
 
 	return apiserver.NewAPIserver(opts).Start(ctx)
 }
@@ -175,6 +242,7 @@ func NewAPIServerCmd() *cobra.Command {
 		manageiqInsecure       bool
 		runtimeType            string
 		workerGatewayPort      int
+		metricsPort            int
 	)
 
 	apiserverCmd := &cobra.Command{
@@ -198,12 +266,14 @@ func NewAPIServerCmd() *cobra.Command {
 
 Note:
   - Requires database connection via environment variables (DB_HOST, DB_PORT, DB_USER, DB_PASSWORD, DB_NAME)
-  - AUTH_JWT_SECRET environment variable is recommended for production use`,
+  - AUTH_JWT_SECRET environment variable is recommended for production use
+  - Local backup archives are written to BACKUP_DIR (default <base dir>/backups)
+  - SERVICE_AUTH_MODE selects how deployed service APIs authenticate requests: forward_auth (default), api_key or none`,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return common.InitAndValidateRuntimeFlag(runtimeType)
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runAPIServer(port, defaultAccessTokenTTL, defaultRefreshTokenTTL, adminUserName, adminPasswordHash, workerGatewayPort, manageiqURL, manageiqInsecure)
+			return runAPIServer(port, defaultAccessTokenTTL, defaultRefreshTokenTTL, adminUserName, adminPasswordHash, workerGatewayPort, metricsPort, manageiqURL, manageiqInsecure)
 		},
 	}
 
@@ -213,6 +283,7 @@ Note:
 	apiserverCmd.Flags().StringVar(&adminUserName, "admin-username", "admin", "Username for the default admin user")
 	apiserverCmd.Flags().StringVar(&adminPasswordHash, "admin-password-hash", "", "Precomputed hash of the password for the default admin user")
 	apiserverCmd.Flags().IntVar(&workerGatewayPort, "workergateway-port", defaultWorkerGatewayPort, "Port for the gRPC worker gateway (always active, default 9090)")
+	apiserverCmd.Flags().IntVar(&metricsPort, "metrics-port", 0, "Port to serve Prometheus metrics on, apart from the API (disabled when 0)")
 	apiserverCmd.Flags().StringVar(&manageiqURL, "manageiq-url", "", "ManageIQ base URL for AuthN/AuthZ, e.g. https://9.20.202.144:8443")
 	apiserverCmd.Flags().BoolVar(&manageiqInsecure, "manageiq-insecure-tls", false, "Skip TLS verification for ManageIQ (self-signed certs)")
 	// Hide the ManageIQ flags
@@ -1,6 +1,10 @@
 package catalog
 
-import "github.com/spf13/cobra"
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/output"
+)
 
 // CatalogCmd returns the cobra command for managing the AI Services catalog service, including subcommands for the API server.
 func CatalogCmd() *cobra.Command {
@@ -9,6 +13,11 @@ func CatalogCmd() *cobra.Command {
 		Short: "Manage the AI Services catalog",
 		Long: `The catalog service offers APIs for managing the AI Services catalog, enabling you to list available services,
 deploy them, and handle service metadata`,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			return output.Check(cmd)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return cmd.Help()
 		},
@@ -21,9 +30,12 @@ deploy them, and handle service metadata`,
 	catalogCMD.AddCommand(NewLoginCmd())
 	catalogCMD.AddCommand(NewLogoutCmd())
 	catalogCMD.AddCommand(NewWhoamiCmd())
+	catalogCMD.AddCommand(NewContextCmd())
 	catalogCMD.AddCommand(NewMigrateCmd())
 	catalogCMD.AddCommand(NewInfoCmd())
 
+	output.BindFlags(catalogCMD.PersistentFlags())
+
 	return catalogCMD
 }
 
@@ -3,6 +3,8 @@ package catalog
 import (
 	"context"
 	"fmt"
+	"net/mail"
+	"net/url"
 	"os"
 	"path/filepath"
 	"time"
@@ -17,6 +19,7 @@ import (
 	catalogUtils "github.com/project-ai-services/ai-services/internal/pkg/catalog/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/flagvalidator"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/proxy"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
@@ -41,10 +44,21 @@ var (
 	httpsPort int
 	// WorkerGateway port — always active, defaults to 9090.
 	workerGatewayPort int
+	// Routing mode flag telling the routes of the catalog and services apart by hostname or path.
+	routingMode string
+	// Caddy admin mode flag telling how the catalog backend reaches the Caddy admin API.
+	caddyAdminMode string
 	// Reset podman auth secret for catalog configure command.
 	resetPodmanAuthFlag bool
 	// Reset certificate flag for catalog configure command.
 	resetCertificateFlag bool
+	// ACME flags for certificate issuance by an ACME CA.
+	acmeEmail       string
+	acmeCAURL       string
+	acmeCARootPath  string
+	acmeChallenge   string
+	acmeDNSProvider string
+	acmeDNSConfig   map[string]string
 
 	// openShift flags.
 	timeout time.Duration
@@ -68,6 +82,9 @@ This command performs the following operations:
 Use --workergateway-port to set the gRPC port that workers connect to (default 9090).
 The worker gateway is always started; only the port number is configurable.
 
+Certificates are self-signed by Caddy unless a wildcard certificate is provided with --ssl-cert
+and --ssl-key, or --acme-email is set to obtain them from an ACME CA such as Let's Encrypt.
+
 Additional configuration options include base directory customization, domain name setup,
 SSL/TLS certificate management, HTTPS port configuration, and credential/certificate reset capabilities.`,
 	Example: `  # Configure catalog service for podman (worker gateway on default port 9090)
@@ -77,7 +94,20 @@ SSL/TLS certificate management, HTTPS port configuration, and credential/certifi
 	 ai-services catalog configure --runtime podman --workergateway-port 9191
 
 	 # Configure with custom HTTPS port
-	 ai-services catalog configure --runtime podman --https-port 8443`,
+	 ai-services catalog configure --runtime podman --https-port 8443
+
+	 # Configure for a host with a single DNS name, serving services under paths of that name
+	 ai-services catalog configure --runtime podman --domain-name ai.example.com --routing-mode path
+
+	 # Configure with the catalog backend authenticating to the Caddy admin API with a client certificate
+	 ai-services catalog configure --runtime podman --caddy-admin-mode mtls
+
+	 # Configure with Let's Encrypt certificates validated over HTTP (port 80 must be reachable)
+	 ai-services catalog configure --runtime podman --domain-name example.com --acme-email admin@example.com
+
+	 # Configure with a Let's Encrypt wildcard certificate validated over DNS
+	 ai-services catalog configure --runtime podman --domain-name example.com --acme-email admin@example.com \
+	   --acme-challenge dns-01 --acme-dns-provider cloudflare --acme-dns-config api_token=<token>`,
 	Args: cobra.NoArgs,
 	PreRunE: func(cmd *cobra.Command, args []string) error {
 		cmd.SilenceUsage = true
@@ -151,6 +181,9 @@ func runConfigure() error {
 			SSLKeyPath:        catalogUtils.SanitizeFilePath(sslKeyPath),
 			HttpsPort:         httpsPort,
 			WorkerGatewayPort: workerGatewayPort,
+			RoutingMode:       routingMode,
+			AdminMode:         caddyAdminMode,
+			ACME:              acmeOptions(),
 		}
 
 		return catalogPodman.DeployCatalog(ctx, opts)
@@ -206,6 +239,18 @@ func validateConfigureFlags() error {
 			return err
 		}
 
+		if err := validateACMEFlags(); err != nil {
+			return err
+		}
+
+		if err := proxy.ValidateRoutingMode(routingMode); err != nil {
+			return fmt.Errorf("invalid --routing-mode: %w", err)
+		}
+
+		if err := proxy.ValidateAdminMode(caddyAdminMode); err != nil {
+			return fmt.Errorf("invalid --caddy-admin-mode: %w", err)
+		}
+
 		// Validate HTTPS port range
 		if httpsPort < 1 || httpsPort > 65535 {
 			return fmt.Errorf("invalid HTTPS port %d: must be between 1 and 65535", httpsPort)
@@ -273,6 +318,69 @@ func validateSSLCertificates() error {
 	return nil
 }
 
+// validateACMEFlags validates the flags of ACME certificate issuance.
+func validateACMEFlags() error {
+	if acmeEmail == "" {
+		if acmeCAURL != "" || acmeCARootPath != "" || acmeDNSProvider != "" || len(acmeDNSConfig) > 0 ||
+			acmeChallenge != catalogUtils.ACMEChallengeHTTP01 {
+			return fmt.Errorf("--acme-email is required to enable ACME certificate issuance")
+		}
+
+		return nil
+	}
+
+	if sslCertPath != "" || sslKeyPath != "" {
+		return fmt.Errorf("--acme-email cannot be used together with --ssl-cert and --ssl-key")
+	}
+
+	if _, err := mail.ParseAddress(acmeEmail); err != nil {
+		return fmt.Errorf("invalid --acme-email '%s': %w", acmeEmail, err)
+	}
+
+	if acmeCAURL != "" {
+		if u, err := url.Parse(acmeCAURL); err != nil || u.Scheme != "https" || u.Host == "" {
+			return fmt.Errorf("invalid --acme-ca-url '%s': must be an https URL of an ACME directory", acmeCAURL)
+		}
+	}
+
+	if acmeCARootPath != "" {
+		if _, err := utils.LoadCertificate(acmeCARootPath); err != nil {
+			return fmt.Errorf("invalid --acme-ca-root: %w", err)
+		}
+	}
+
+	switch acmeChallenge {
+	case catalogUtils.ACMEChallengeHTTP01:
+		if acmeDNSProvider != "" || len(acmeDNSConfig) > 0 {
+			return fmt.Errorf("--acme-dns-provider and --acme-dns-config require --acme-challenge %s", catalogUtils.ACMEChallengeDNS01)
+		}
+	case catalogUtils.ACMEChallengeDNS01:
+		if acmeDNSProvider == "" {
+			return fmt.Errorf("--acme-dns-provider is required with --acme-challenge %s", catalogUtils.ACMEChallengeDNS01)
+		}
+	default:
+		return fmt.Errorf("invalid --acme-challenge '%s': must be %s or %s", acmeChallenge, catalogUtils.ACMEChallengeHTTP01, catalogUtils.ACMEChallengeDNS01)
+	}
+
+	return nil
+}
+
+// acmeOptions returns the ACME options from the flags, or nil when ACME issuance is not enabled.
+func acmeOptions() *catalogUtils.ACMEOptions {
+	if acmeEmail == "" {
+		return nil
+	}
+
+	return &catalogUtils.ACMEOptions{
+		Email:       acmeEmail,
+		CAURL:       acmeCAURL,
+		CARootPath:  catalogUtils.SanitizeFilePath(acmeCARootPath),
+		Challenge:   acmeChallenge,
+		DNSProvider: acmeDNSProvider,
+		DNSConfig:   acmeDNSConfig,
+	}
+}
+
 func validateResetCertificateFlags(cmd *cobra.Command, flagName string) error {
 	// Require SSL certificate flags with reset-certificate
 	if sslCertPath == "" || sslKeyPath == "" {
@@ -320,6 +428,7 @@ func initConfigureCommonFlags() {
 
 func initConfigurePodmanFlags() {
 	initConfigurePodmanDeployFlags()
+	initConfigurePodmanACMEFlags()
 	initConfigurePodmanResetFlags()
 }
 
@@ -351,6 +460,32 @@ func initConfigurePodmanDeployFlags() {
 			"Example: --workergateway-port 9090\n",
 	)
 
+	configureCmd.Flags().StringVar(
+		&routingMode,
+		"routing-mode",
+		proxy.RoutingModeHost,
+		"How the routes of the catalog and deployed services are told apart: host or path.\n"+
+			"host serves every route under its own hostname below the domain, which requires wildcard DNS.\n"+
+			"path serves every route from the domain itself under its own path, e.g. https://<domain>/apps/<app>/<service>/,\n"+
+			"for hosts with a single DNS name. The catalog UI is served at the root and its API under /catalog-api.\n"+
+			"Service UIs cannot be served under a path and keep their own hostnames below the domain.\n"+
+			"Note: Supported for podman runtime only.\n"+
+			"Example: --routing-mode path\n",
+	)
+
+	configureCmd.Flags().StringVar(
+		&caddyAdminMode,
+		"caddy-admin-mode",
+		proxy.AdminModeUnix,
+		"How the catalog backend reaches the Caddy admin API: unix or mtls.\n"+
+			"unix serves the admin API on a unix socket in <basedir>/common/caddy-admin only.\n"+
+			"mtls additionally serves Caddy's remote admin API, which only accepts the client certificate\n"+
+			"generated for the catalog backend.\n"+
+			"Existing deployments that serve the admin API on a TCP port are migrated when configure is re-run.\n"+
+			"Note: Supported for podman runtime only.\n"+
+			"Example: --caddy-admin-mode mtls\n",
+	)
+
 	configureCmd.Flags().StringVar(
 		&domainName,
 		"domain-name",
@@ -358,6 +493,7 @@ func initConfigurePodmanDeployFlags() {
 		"Custom domain name for self-signed certificates.\n"+
 			"If not provided, uses wildcard DNS format: <service>.<ip>.nip.io\n"+
 			"If a custom SSL certificate/key pair is provided, the domain is extracted from the certificate and the --domain flag is ignored.\n"+
+			"With --routing-mode path, the domain is the hostname every route is served from.\n"+
 			"Note: Supported for podman runtime only.\n"+
 			"Example: --domain-name example.com generates certs for *.example.com\n",
 	)
@@ -384,6 +520,66 @@ func initConfigurePodmanDeployFlags() {
 	)
 }
 
+func initConfigurePodmanACMEFlags() {
+	configureCmd.Flags().StringVar(
+		&acmeEmail,
+		"acme-email",
+		"",
+		"Email of the ACME account; enables certificate issuance by an ACME CA (optional).\n"+
+			"Caddy obtains and renews the certificates of the catalog and service domains.\n"+
+			"Cannot be used together with --ssl-cert and --ssl-key.\n"+
+			"Note: Supported for podman runtime only.\n"+
+			"Example: --acme-email admin@example.com\n",
+	)
+
+	configureCmd.Flags().StringVar(
+		&acmeCAURL,
+		"acme-ca-url",
+		"",
+		"ACME directory URL of the CA. Defaults to Let's Encrypt.\n"+
+			"Note: Supported for podman runtime only.\n"+
+			"Example: --acme-ca-url https://acme-staging-v02.api.letsencrypt.org/directory\n",
+	)
+
+	configureCmd.Flags().StringVar(
+		&acmeCARootPath,
+		"acme-ca-root",
+		"",
+		"Path to the PEM root certificate to trust for the ACME directory, e.g. of a private CA.\n"+
+			"Note: Supported for podman runtime only.\n"+
+			"Example: --acme-ca-root /path/to/ca-root.pem\n",
+	)
+
+	configureCmd.Flags().StringVar(
+		&acmeChallenge,
+		"acme-challenge",
+		catalogUtils.ACMEChallengeHTTP01,
+		"ACME challenge type: http-01 or dns-01.\n"+
+			"http-01 publishes port 80 of the Caddy pod, which must be reachable by the CA, and obtains a certificate per domain.\n"+
+			"dns-01 obtains a single wildcard certificate and requires --acme-dns-provider.\n"+
+			"Note: Supported for podman runtime only.\n"+
+			"Example: --acme-challenge dns-01\n",
+	)
+
+	configureCmd.Flags().StringVar(
+		&acmeDNSProvider,
+		"acme-dns-provider",
+		"",
+		"Caddy DNS provider module solving dns-01 challenges. The Caddy image must include the module.\n"+
+			"Note: Supported for podman runtime only.\n"+
+			"Example: --acme-dns-provider cloudflare\n",
+	)
+
+	configureCmd.Flags().StringToStringVar(
+		&acmeDNSConfig,
+		"acme-dns-config",
+		nil,
+		"Settings of the DNS provider as key=value pairs.\n"+
+			"Note: Supported for podman runtime only.\n"+
+			"Example: --acme-dns-config api_token=<token>\n",
+	)
+}
+
 func initConfigurePodmanResetFlags() {
 	configureCmd.Flags().BoolVar(
 		&resetPodmanAuthFlag,
@@ -418,9 +614,17 @@ func buildFlagValidator() *flagvalidator.FlagValidator {
 		AddPodmanFlag("basedir", nil).
 		AddPodmanFlag("https-port", nil).
 		AddPodmanFlag("workergateway-port", nil).
+		AddPodmanFlag("routing-mode", nil).
+		AddPodmanFlag("caddy-admin-mode", nil).
 		AddPodmanFlag("domain-name", nil).
 		AddPodmanFlag("ssl-cert", nil).
 		AddPodmanFlag("ssl-key", nil).
+		AddPodmanFlag("acme-email", nil).
+		AddPodmanFlag("acme-ca-url", nil).
+		AddPodmanFlag("acme-ca-root", nil).
+		AddPodmanFlag("acme-challenge", nil).
+		AddPodmanFlag("acme-dns-provider", nil).
+		AddPodmanFlag("acme-dns-config", nil).
 		AddPodmanFlag("reset-podman-auth", nil).
 		AddPodmanFlag("reset-certificate", nil)
 
@@ -0,0 +1,166 @@
+package catalog
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/config"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/output"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+// NewContextCmd returns the cobra command for managing the catalog contexts stored by login.
+func NewContextCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "context",
+		Short: "Manage catalog contexts",
+		Long: `A context holds the endpoint, TLS settings and credentials of one catalog API server.
+Contexts are created by 'ai-services catalog login --context <name>'.
+
+Catalog commands act on the context selected with the global --context flag, else
+with the AI_SERVICES_CONTEXT environment variable, else on the current context.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(newContextListCmd())
+	cmd.AddCommand(newContextUseCmd())
+	cmd.AddCommand(newContextDeleteCmd())
+
+	return cmd
+}
+
+// catalogContext is a context in the structured output of the context list command.
+type catalogContext struct {
+	Name     string `json:"name"`
+	Current  bool   `json:"current"`
+	Server   string `json:"server"`
+	LoggedIn bool   `json:"logged_in"`
+}
+
+// catalogContexts is the structured output of the context list command.
+type catalogContexts struct {
+	Items []catalogContext `json:"items"`
+}
+
+// Names returns the context names.
+func (c catalogContexts) Names() []string {
+	names := make([]string, 0, len(c.Items))
+	for _, item := range c.Items {
+		names = append(names, item.Name)
+	}
+
+	return names
+}
+
+func newContextListCmd() *cobra.Command {
+	outputOpts := output.Selected()
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List the catalog contexts",
+		Example: `  # List the catalog contexts, the current one is marked with '*'
+  ai-services catalog context list
+
+  # Print the name of the current context
+  ai-services catalog context list --jsonpath '{.items[?(@.current==true)].name}'`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := outputOpts.Validate(); err != nil {
+				return err
+			}
+
+			f, err := config.LoadFile()
+			if err != nil {
+				return err
+			}
+
+			contexts := catalogContexts{Items: []catalogContext{}}
+			for _, name := range f.ContextNames() {
+				creds := f.Contexts[name]
+				contexts.Items = append(contexts.Items, catalogContext{
+					Name:     name,
+					Current:  name == f.CurrentContext,
+					Server:   creds.ServerURL,
+					LoggedIn: creds.LoggedIn(),
+				})
+			}
+
+			if outputOpts.Structured() {
+				return outputOpts.Print(cmd.OutOrStdout(), contexts)
+			}
+
+			if len(contexts.Items) == 0 {
+				logger.Warningln("No catalog context found: run 'ai-services catalog login' first")
+
+				return nil
+			}
+
+			printer := utils.NewTableWriter()
+			defer printer.CloseTableWriter()
+
+			printer.SetHeaders("NAME", "CURRENT", "SERVER", "LOGGED IN")
+			for _, item := range contexts.Items {
+				current := ""
+				if item.Current {
+					current = "*"
+				}
+				loggedIn := "no"
+				if item.LoggedIn {
+					loggedIn = "yes"
+				}
+
+				printer.AppendRow(item.Name, current, item.Server, loggedIn)
+			}
+
+			return nil
+		},
+	}
+	output.Supported(cmd)
+
+	return cmd
+}
+
+func newContextUseCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "use <name>",
+		Short: "Set the current catalog context",
+		Example: `  # Make catalog commands act on the context "prod"
+  ai-services catalog context use prod`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := config.UseContext(args[0]); err != nil {
+				return err
+			}
+
+			logger.Infof("Switched to catalog context %q.\n", args[0])
+
+			return nil
+		},
+	}
+}
+
+func newContextDeleteCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Delete a catalog context and its stored credentials",
+		Long: `Delete a catalog context with its stored credentials. The session is not
+invalidated on the server; use 'ai-services catalog logout --context <name>' for that.`,
+		Example: `  # Delete the context "staging"
+  ai-services catalog context delete staging`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := config.DeleteContext(args[0]); err != nil {
+				return err
+			}
+
+			logger.Infof("Deleted catalog context %q.\n", args[0])
+
+			return nil
+		},
+	}
+}
+
+// Made with Bob
@@ -5,6 +5,7 @@ import (
 
 	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/catalog/common"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/cli/info"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/output"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
@@ -12,6 +13,7 @@ import (
 func NewInfoCmd() *cobra.Command {
 	var (
 		runtimeType string
+		outputOpts  = output.Selected()
 	)
 	cmd := &cobra.Command{
 		Use:   "info",
@@ -24,18 +26,34 @@ func NewInfoCmd() *cobra.Command {
   ai-services catalog info --runtime podman
 
   # Display catalog service info for openshift
-  ai-services catalog info --runtime openshift`,
+  ai-services catalog info --runtime openshift
+
+  # Print the domain of the backend API for scripts
+  ai-services catalog info --runtime podman --jsonpath '{.routes.CATALOG_API_DOMAIN}'`,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := outputOpts.Validate(); err != nil {
+				return err
+			}
 			cmd.SilenceUsage = true
 
 			return common.InitAndValidateRuntimeFlag(runtimeType)
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return info.Run(cmd.Context(), vars.RuntimeFactory.GetRuntimeType())
+			if !outputOpts.Structured() {
+				return info.Run(cmd.Context(), vars.RuntimeFactory.GetRuntimeType())
+			}
+
+			catalogInfo, err := info.Get(vars.RuntimeFactory.GetRuntimeType())
+			if err != nil {
+				return err
+			}
+
+			return outputOpts.Print(cmd.OutOrStdout(), catalogInfo)
 		},
 	}
 
 	common.ConfigureRuntimeFlag(cmd, &runtimeType)
+	output.Supported(cmd)
 
 	return cmd
 }
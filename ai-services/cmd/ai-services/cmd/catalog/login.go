@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"path/filepath"
 	"strings"
 	"syscall"
 
@@ -13,6 +14,7 @@ import (
 
 	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/catalog/common"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/client"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/config"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 )
 
@@ -24,7 +26,9 @@ func NewLoginCmd() *cobra.Command {
 		passwordStdin bool
 		miqToken      string
 		insecure      bool
+		caFile        string
 		runtimeType   string
+		target        config.Credentials
 	)
 
 	cmd := &cobra.Command{
@@ -36,6 +40,13 @@ The generated access and refresh tokens are stored in the OS user config directo
 and are used automatically by subsequent catalog commands. The exact path is
 printed after a successful login.
 
+Credentials are stored per context, so that several catalog servers can be used
+without logging in again. The login is stored in the context selected with the
+global --context flag or the AI_SERVICES_CONTEXT environment variable, else in the
+current context, and that context becomes the current context unless it was
+named with --context or AI_SERVICES_CONTEXT. When logging in again to an existing context, --server
+and its TLS settings may be omitted.
+
 The stored access token is reused for subsequent commands as long as it is still
 valid. It is refreshed automatically only when it is about to expire, avoiding
 unnecessary round-trips to the server.
@@ -48,42 +59,96 @@ To get the Catalog backend endpoint, use: ai-services catalog info`,
   echo "$MY_PASSWORD" | ai-services catalog login --server <catalog_backend_endpoint> --username admin --password-stdin --runtime podman
 
    # Login with insecure TLS (skip certificate verification)
-  ai-services catalog login --server <catalog_backend_endpoint> --username admin --insecure --runtime podman`,
+  ai-services catalog login --server <catalog_backend_endpoint> --username admin --insecure --runtime podman
+
+  # Login to a server whose certificate is issued by a custom CA, stored as context "prod"
+  ai-services catalog login --context prod --server <catalog_backend_endpoint> --ca-file ca.pem --username admin --runtime podman
+
+  # Login again to the existing context "prod"
+  ai-services catalog login --context prod --username admin --runtime podman`,
 
 		PreRunE: func(cmd *cobra.Command, args []string) error {
-			return validateLoginFlags(runtimeType, serverURL, username, miqToken, passwordStdin)
+			var err error
+			target, err = resolveLoginTarget(serverURL, caFile, insecure, cmd.Flags().Changed("insecure"))
+			if err != nil {
+				return err
+			}
+
+			return validateLoginFlags(runtimeType, target.ServerURL, username, miqToken, passwordStdin)
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if miqToken != "" {
-				return runLoginWithMIQToken(serverURL, miqToken, insecure)
+				return runLoginWithMIQToken(target, miqToken)
 			}
 
-			return runLogin(serverURL, username, passwordStdin, insecure)
+			return runLogin(target, username, passwordStdin)
 		},
 	}
 
-	cmd.Flags().StringVar(&serverURL, "server", "", "Catalog backend endpoint (required)")
+	cmd.Flags().StringVar(&serverURL, "server", "", "Catalog backend endpoint (required unless the context already has one)")
 	cmd.Flags().StringVar(&username, "username", "", "Username to authenticate with (required)")
 	cmd.Flags().BoolVar(&passwordStdin, "password-stdin", false, "Read password from stdin instead of an interactive prompt")
 	cmd.Flags().StringVar(&miqToken, "miq-token", "", "ManageIQ token for token passthrough login")
 	_ = cmd.Flags().MarkHidden("miq-token")
 	cmd.Flags().BoolVar(&insecure, "insecure", false, "Skip TLS certificate verification (NOT for production use)")
+	cmd.Flags().StringVar(&caFile, "ca-file", "", "Path to a PEM bundle of the CAs to trust for the catalog backend instead of the system roots")
 	common.ConfigureRuntimeFlag(cmd, &runtimeType)
 
-	_ = cmd.MarkFlagRequired("server")
+	cmd.MarkFlagsMutuallyExclusive("insecure", "ca-file")
 
 	return cmd
 }
 
+// resolveLoginTarget returns the server and TLS settings to log in with. Settings not given
+// on the command line are taken from the active context, unless --server names a different
+// server.
+func resolveLoginTarget(serverURL, caFile string, insecure, insecureSet bool) (config.Credentials, error) {
+	contextName, stored, err := config.LoadTarget()
+	if err != nil {
+		return config.Credentials{}, err
+	}
+
+	target := config.Credentials{ServerURL: stored.ServerURL, Insecure: stored.Insecure, CAData: stored.CAData}
+	if serverURL != "" && serverURL != stored.ServerURL {
+		target = config.Credentials{ServerURL: serverURL}
+	}
+	if target.ServerURL == "" {
+		return config.Credentials{}, fmt.Errorf("--server is required: context %q has no catalog backend endpoint", contextName)
+	}
+
+	if insecureSet {
+		target.Insecure = insecure
+		if insecure {
+			target.CAData = ""
+		}
+	}
+
+	if caFile != "" {
+		caData, err := os.ReadFile(filepath.Clean(caFile))
+		if err != nil {
+			return config.Credentials{}, fmt.Errorf("read --ca-file: %w", err)
+		}
+		target.CAData = string(caData)
+		target.Insecure = false
+	}
+
+	// Reject an unusable CA bundle before prompting for the password.
+	if _, err := target.TLSConfig(); err != nil {
+		return config.Credentials{}, err
+	}
+
+	return target, nil
+}
+
 // runLoginWithMIQToken executes Flow B: exchange a ManageIQ token for a Catalog API JWT.
-func runLoginWithMIQToken(serverURL, miqToken string, insecure bool) error {
-	if insecure {
+func runLoginWithMIQToken(target config.Credentials, miqToken string) error {
+	if target.Insecure {
 		logger.Warningln("WARNING: TLS certificate verification is disabled. This should NOT be used in production environments.")
 	}
 
-	logger.Infof("Logging in to %s using ManageIQ token...\n", serverURL)
+	logger.Infof("Logging in to %s using ManageIQ token...\n", target.ServerURL)
 
-	if _, err := client.NewWithMIQToken(serverURL, miqToken, insecure); err != nil {
+	if _, err := client.NewWithMIQToken(target, miqToken); err != nil {
 		return fmt.Errorf("login failed: %w", err)
 	}
 
@@ -93,20 +158,20 @@ func runLoginWithMIQToken(serverURL, miqToken string, insecure bool) error {
 }
 
 // runLogin executes Flow A: authenticate with username and password.
-func runLogin(serverURL, username string, passwordStdin, insecure bool) error {
+func runLogin(target config.Credentials, username string, passwordStdin bool) error {
 	password, err := promptPassword(passwordStdin)
 	if err != nil {
 		return err
 	}
 
 	// Warn user about insecure mode
-	if insecure {
+	if target.Insecure {
 		logger.Warningln("WARNING: TLS certificate verification is disabled. This should NOT be used in production environments.")
 	}
 
-	logger.Infof("Logging in to %s as %q...\n", serverURL, username)
+	logger.Infof("Logging in to %s as %q...\n", target.ServerURL, username)
 
-	if _, err := client.NewWithLogin(serverURL, username, password, insecure); err != nil {
+	if _, err := client.NewWithLogin(target, username, password); err != nil {
 		return fmt.Errorf("login failed: %w", err)
 	}
 
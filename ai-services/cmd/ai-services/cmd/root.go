@@ -6,11 +6,13 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/airgap"
 	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/application"
 	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/bootstrap"
 	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/catalog"
 	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/mustgather"
 	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/version"
+	catalogConfig "github.com/project-ai-services/ai-services/internal/pkg/catalog/config"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 )
 
@@ -42,10 +44,12 @@ func Execute() {
 func init() {
 	logger.Init()
 	RootCmd.PersistentFlags().AddGoFlagSet(flag.CommandLine)
+	catalogConfig.BindContextFlag(RootCmd.PersistentFlags())
 
 	RootCmd.AddCommand(version.VersionCmd)
 	RootCmd.AddCommand(bootstrap.BootstrapCmd())
 	RootCmd.AddCommand(application.ApplicationCmd)
 	RootCmd.AddCommand(catalog.CatalogCmd())
 	RootCmd.AddCommand(mustgather.MustGatherCmd())
+	RootCmd.AddCommand(airgap.AirgapCmd())
 }
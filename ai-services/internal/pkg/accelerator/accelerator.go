@@ -0,0 +1,128 @@
+// Package accelerator abstracts accelerator cards behind providers. A provider discovers the cards
+// attached to the host, tells which of them are free, reports their NUMA node and runs the health
+// checks of its configuration. Providers register themselves by name; the one in use is selected
+// with the AI_SERVICES_ACCELERATOR_PROVIDER environment variable.
+package accelerator
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap/spyreconfig/check"
+)
+
+const (
+	// ProviderEnv selects the accelerator provider by name.
+	ProviderEnv = "AI_SERVICES_ACCELERATOR_PROVIDER"
+
+	// DefaultProvider is used when ProviderEnv is not set.
+	DefaultProvider = "spyre"
+
+	// UnknownNUMANode is the NUMA node of cards whose node is not reported by the host.
+	UnknownNUMANode = -1
+)
+
+// Card is an accelerator card attached to the host.
+type Card struct {
+	PCIAddress string `json:"pci_address"`
+	IOMMUGroup string `json:"iommu_group,omitempty"`
+	NUMANode   int    `json:"numa_node"`
+	Free       bool   `json:"free"`
+}
+
+// Provider discovers the accelerator cards of one kind.
+type Provider interface {
+	// Name identifies the provider, e.g. "spyre".
+	Name() string
+	// ResourceName is the extended resource name the cards are requested with.
+	ResourceName() string
+	// Discover returns the cards attached to the host ordered by PCI address, with their NUMA node
+	// and whether they are free.
+	Discover(ctx context.Context) ([]Card, error)
+	// NodeCPUs returns the CPUs of a NUMA node in cpuset format, e.g. "0-7,16-23", or "" when the
+	// host does not report them.
+	NodeCPUs(ctx context.Context, node int) (string, error)
+	// Health runs the checks of the host configuration the cards depend on.
+	Health(ctx context.Context) []check.CheckResult
+}
+
+// Factory creates a provider.
+type Factory func() (Provider, error)
+
+var (
+	factoriesMu sync.RWMutex
+	factories   = map[string]Factory{}
+)
+
+// Register makes a provider available by name. It is meant to be called from init functions.
+func Register(name string, factory Factory) {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+
+	factories[name] = factory
+}
+
+// Get creates the provider registered under name.
+func Get(name string) (Provider, error) {
+	factoriesMu.RLock()
+	factory, ok := factories[name]
+	factoriesMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unknown accelerator provider %q, registered providers: %s", name, strings.Join(Registered(), ", "))
+	}
+
+	return factory()
+}
+
+// Default creates the provider selected with ProviderEnv, or DefaultProvider when it is not set.
+func Default() (Provider, error) {
+	name := strings.TrimSpace(os.Getenv(ProviderEnv))
+	if name == "" {
+		name = DefaultProvider
+	}
+
+	return Get(name)
+}
+
+// Registered returns the names of the registered providers in alphabetical order.
+func Registered() []string {
+	factoriesMu.RLock()
+	defer factoriesMu.RUnlock()
+
+	names := make([]string, 0, len(factories))
+	for name := range factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Addresses returns the PCI addresses of cards.
+func Addresses(cards []Card) []string {
+	addresses := make([]string, 0, len(cards))
+	for _, card := range cards {
+		addresses = append(addresses, card.PCIAddress)
+	}
+
+	return addresses
+}
+
+// FreeAddresses returns the PCI addresses of the free cards.
+func FreeAddresses(cards []Card) []string {
+	addresses := make([]string, 0, len(cards))
+	for _, card := range cards {
+		if card.Free {
+			addresses = append(addresses, card.PCIAddress)
+		}
+	}
+
+	return addresses
+}
+
+// Made with Bob
@@ -0,0 +1,90 @@
+package accelerator
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Placement is the set of cards chosen for one consumer, e.g. a component, and why they were chosen.
+type Placement struct {
+	Cards []Card
+	// NUMANode is the node all cards are on, or UnknownNUMANode if they span nodes or their node
+	// is not reported by the host.
+	NUMANode int
+	Reason   string
+}
+
+// Place chooses count of cards, keeping them on a single NUMA node when possible. Among the nodes
+// with enough cards, the one with the fewest cards is taken, so that larger nodes stay available
+// for larger consumers. Otherwise the cards are spread over as few nodes as possible. Cards of a
+// node are taken in the order given.
+func Place(cards []Card, count int) (Placement, error) {
+	if count <= 0 {
+		return Placement{NUMANode: UnknownNUMANode}, nil
+	}
+	if len(cards) < count {
+		return Placement{}, fmt.Errorf("insufficient accelerator cards: required %d, available %d", count, len(cards))
+	}
+
+	byNode := map[int][]Card{}
+	nodes := []int{}
+	for _, card := range cards {
+		if _, ok := byNode[card.NUMANode]; !ok {
+			nodes = append(nodes, card.NUMANode)
+		}
+		byNode[card.NUMANode] = append(byNode[card.NUMANode], card)
+	}
+	sort.Ints(nodes)
+
+	if len(nodes) == 1 && nodes[0] == UnknownNUMANode {
+		return Placement{
+			Cards:    cards[:count],
+			NUMANode: UnknownNUMANode,
+			Reason:   "the host does not report the NUMA node of the cards",
+		}, nil
+	}
+
+	best := UnknownNUMANode
+	for _, node := range nodes {
+		if node == UnknownNUMANode || len(byNode[node]) < count {
+			continue
+		}
+		if best == UnknownNUMANode || len(byNode[node]) < len(byNode[best]) {
+			best = node
+		}
+	}
+	if best != UnknownNUMANode {
+		return Placement{
+			Cards:    byNode[best][:count],
+			NUMANode: best,
+			Reason: fmt.Sprintf("all %d card(s) on NUMA node %d, the node with the fewest free cards (%d) that holds them",
+				count, best, len(byNode[best])),
+		}, nil
+	}
+
+	// Spread over the nodes with the most cards first, keeping cards of unknown nodes for last
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if (nodes[i] == UnknownNUMANode) != (nodes[j] == UnknownNUMANode) {
+			return nodes[j] == UnknownNUMANode
+		}
+
+		return len(byNode[nodes[i]]) > len(byNode[nodes[j]])
+	})
+
+	placement := Placement{NUMANode: UnknownNUMANode}
+	used := []int{}
+	for _, node := range nodes {
+		take := min(count-len(placement.Cards), len(byNode[node]))
+		placement.Cards = append(placement.Cards, byNode[node][:take]...)
+		used = append(used, node)
+		if len(placement.Cards) == count {
+			break
+		}
+	}
+	sort.Ints(used)
+	placement.Reason = fmt.Sprintf("no NUMA node has %d free cards, spread over nodes %v", count, used)
+
+	return placement, nil
+}
+
+// Made with Bob
@@ -0,0 +1,87 @@
+package accelerator
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPlace(t *testing.T) {
+	card := func(address string, node int) Card {
+		return Card{PCIAddress: address, NUMANode: node, Free: true}
+	}
+
+	tests := []struct {
+		name          string
+		cards         []Card
+		count         int
+		wantAddresses []string
+		wantNode      int
+		wantErr       bool
+	}{
+		{
+			name:          "nothing requested",
+			cards:         []Card{card("a", 0)},
+			count:         0,
+			wantAddresses: nil,
+			wantNode:      UnknownNUMANode,
+		},
+		{
+			name:          "smallest node that fits",
+			cards:         []Card{card("a", 0), card("b", 0), card("c", 0), card("d", 1), card("e", 1)},
+			count:         2,
+			wantAddresses: []string{"d", "e"},
+			wantNode:      1,
+		},
+		{
+			name:          "only the larger node fits",
+			cards:         []Card{card("a", 0), card("b", 0), card("c", 0), card("d", 1)},
+			count:         3,
+			wantAddresses: []string{"a", "b", "c"},
+			wantNode:      0,
+		},
+		{
+			name:          "spread over nodes with the most cards first",
+			cards:         []Card{card("a", 0), card("b", 1), card("c", 1), card("d", 2), card("e", UnknownNUMANode)},
+			count:         3,
+			wantAddresses: []string{"b", "c", "a"},
+			wantNode:      UnknownNUMANode,
+		},
+		{
+			name:          "unknown nodes",
+			cards:         []Card{card("a", UnknownNUMANode), card("b", UnknownNUMANode)},
+			count:         1,
+			wantAddresses: []string{"a"},
+			wantNode:      UnknownNUMANode,
+		},
+		{
+			name:    "insufficient cards",
+			cards:   []Card{card("a", 0)},
+			count:   2,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			placement, err := Place(tt.cards, tt.count)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Place() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if got := Addresses(placement.Cards); len(got) != 0 || len(tt.wantAddresses) != 0 {
+				if !slices.Equal(got, tt.wantAddresses) {
+					t.Errorf("Place() cards = %v, want %v", got, tt.wantAddresses)
+				}
+			}
+			if placement.NUMANode != tt.wantNode {
+				t.Errorf("Place() NUMA node = %d, want %d", placement.NUMANode, tt.wantNode)
+			}
+			if tt.count > 0 && placement.Reason == "" {
+				t.Error("Place() returned no reason")
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+// Package providers registers the accelerator providers. Every package that calls accelerator.Default
+// imports it for its side effects; it is kept apart from the accelerator package so that the providers
+// do not depend on the bootstrap checks.
+package providers
+
+import (
+	"github.com/project-ai-services/ai-services/internal/pkg/accelerator"
+	"github.com/project-ai-services/ai-services/internal/pkg/accelerator/simulated"
+	"github.com/project-ai-services/ai-services/internal/pkg/accelerator/spyre"
+	spyreconfig "github.com/project-ai-services/ai-services/internal/pkg/bootstrap/spyreconfig/spyre"
+)
+
+func init() {
+	accelerator.Register(spyre.ProviderName, func() (accelerator.Provider, error) {
+		return spyre.NewProvider(spyreconfig.RunChecks), nil
+	})
+	accelerator.Register(simulated.ProviderName, func() (accelerator.Provider, error) {
+		return simulated.NewProviderFromEnv()
+	})
+}
+
+// Made with Bob
@@ -0,0 +1,256 @@
+// Package simulated provides an accelerator provider backed by a fake sysfs tree, so that the
+// deployment flow can run on hosts without accelerator cards, e.g. in CI.
+//
+// The tree has the layout of /sys for the cards it simulates:
+//
+//	<root>/bus/pci/devices/<pci-address>/vendor       0x1014
+//	<root>/bus/pci/devices/<pci-address>/device       0x06a7
+//	<root>/bus/pci/devices/<pci-address>/numa_node    NUMA node of the card
+//	<root>/bus/pci/devices/<pci-address>/iommu_group  link to <root>/kernel/iommu_groups/<n>
+//	<root>/devices/system/node/node<n>/cpulist        CPUs of NUMA node n
+//
+// Two files that do not exist in sysfs control the state of a card: in_use marks the card as busy
+// and fault makes its health check fail with the content of the file as reason.
+package simulated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/accelerator"
+	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap/spyreconfig/check"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+)
+
+const (
+	// ProviderName is the name the simulated provider is registered under.
+	ProviderName = "simulated"
+
+	// RootEnv points to the root of the simulated sysfs tree.
+	RootEnv = "AI_SERVICES_SIMULATED_SYSFS"
+
+	// CardsEnv and NUMANodesEnv configure the tree created when RootEnv points to a missing directory.
+	CardsEnv     = "AI_SERVICES_SIMULATED_CARDS"
+	NUMANodesEnv = "AI_SERVICES_SIMULATED_NUMA_NODES"
+
+	// VendorID and DeviceID identify simulated cards, which pass for Spyre cards.
+	VendorID = "0x1014"
+	DeviceID = "0x06a7"
+
+	inUseFile = "in_use"
+	faultFile = "fault"
+
+	// cpusPerNode is the number of CPUs of each simulated NUMA node.
+	cpusPerNode = 8
+
+	dirPermissions  = 0o755
+	filePermissions = 0o644
+)
+
+// Provider discovers the cards of a simulated sysfs tree.
+type Provider struct {
+	root string
+}
+
+// NewProvider creates a provider for the simulated sysfs tree at root.
+func NewProvider(root string) *Provider {
+	return &Provider{root: root}
+}
+
+// NewProviderFromEnv creates a provider for the simulated sysfs tree RootEnv points to. A missing
+// tree is created with CardsEnv cards spread over NUMANodesEnv NUMA nodes, one node by default.
+func NewProviderFromEnv() (*Provider, error) {
+	root := strings.TrimSpace(os.Getenv(RootEnv))
+	if root == "" {
+		return nil, fmt.Errorf("%s must point to a simulated sysfs tree", RootEnv)
+	}
+
+	_, err := os.Stat(root)
+	if err == nil {
+		return NewProvider(root), nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("simulated sysfs tree %s: %w", root, err)
+	}
+
+	count, err := intFromEnv(CardsEnv, 0)
+	if err != nil {
+		return nil, err
+	}
+	numaNodes, err := intFromEnv(NUMANodesEnv, 1)
+	if err != nil {
+		return nil, err
+	}
+	if err := CreateTree(root, count, numaNodes); err != nil {
+		return nil, err
+	}
+
+	return NewProvider(root), nil
+}
+
+func intFromEnv(name string, defaultValue int) (int, error) {
+	value := strings.TrimSpace(os.Getenv(name))
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("%s must be a non-negative number, got %q", name, value)
+	}
+
+	return n, nil
+}
+
+// Name returns the name of the provider.
+func (p *Provider) Name() string {
+	return ProviderName
+}
+
+// ResourceName returns the extended resource name of Spyre cards, so that templates requesting
+// Spyre cards are deployed on simulated ones.
+func (p *Provider) ResourceName() string {
+	return constants.SpyreResourceName
+}
+
+// Discover returns the simulated cards. A card is free unless it is marked as in use.
+func (p *Provider) Discover(_ context.Context) ([]accelerator.Card, error) {
+	cards, err := accelerator.ScanPCIDevices(p.root, VendorID, DeviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range cards {
+		_, err := os.Stat(p.deviceFile(cards[i].PCIAddress, inUseFile))
+		cards[i].Free = errors.Is(err, os.ErrNotExist)
+	}
+
+	return cards, nil
+}
+
+// NodeCPUs returns the CPUs of a simulated NUMA node.
+func (p *Provider) NodeCPUs(_ context.Context, node int) (string, error) {
+	return accelerator.ReadNodeCPUs(p.root, node)
+}
+
+// Health returns one check per simulated card, failing for the cards with a fault.
+func (p *Provider) Health(ctx context.Context) []check.CheckResult {
+	cards, err := p.Discover(ctx)
+	if err != nil {
+		chk := check.NewCheck(fmt.Sprintf("Simulated sysfs tree is readable: %v", err))
+		chk.SetStatus(false)
+
+		return []check.CheckResult{chk}
+	}
+
+	checks := make([]check.CheckResult, 0, len(cards))
+	for _, card := range cards {
+		chk := check.NewCheck(fmt.Sprintf("Simulated card %s is healthy", card.PCIAddress))
+		if fault, err := os.ReadFile(p.deviceFile(card.PCIAddress, faultFile)); err == nil {
+			chk.Description = fmt.Sprintf("Simulated card %s is healthy (fault: %s)", card.PCIAddress, strings.TrimSpace(string(fault)))
+			chk.SetStatus(false)
+		}
+		checks = append(checks, chk)
+	}
+
+	return checks
+}
+
+// SetInUse marks a simulated card as busy or free.
+func (p *Provider) SetInUse(pciAddress string, inUse bool) error {
+	return p.setMarker(pciAddress, inUseFile, inUse, "")
+}
+
+// SetFault makes the health check of a simulated card fail with reason, or pass if reason is empty.
+func (p *Provider) SetFault(pciAddress, reason string) error {
+	return p.setMarker(pciAddress, faultFile, reason != "", reason)
+}
+
+func (p *Provider) setMarker(pciAddress, name string, set bool, content string) error {
+	path := p.deviceFile(pciAddress, name)
+	if !set {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove %s: %w", path, err)
+		}
+
+		return nil
+	}
+
+	if _, err := os.Stat(filepath.Dir(path)); err != nil {
+		return fmt.Errorf("unknown simulated card %s: %w", pciAddress, err)
+	}
+
+	return os.WriteFile(path, []byte(content), filePermissions)
+}
+
+func (p *Provider) deviceFile(pciAddress, name string) string {
+	return filepath.Join(p.root, accelerator.PCIDevicesDir, pciAddress, name)
+}
+
+// CreateTree writes a simulated sysfs tree at root with count free cards, spread round-robin over
+// numaNodes NUMA nodes of 8 CPUs each. Card i gets the PCI address 0000:<i+1>:00.0 and IOMMU
+// group i.
+func CreateTree(root string, count, numaNodes int) error {
+	if numaNodes < 1 {
+		numaNodes = 1
+	}
+
+	if err := os.MkdirAll(filepath.Join(root, accelerator.PCIDevicesDir), dirPermissions); err != nil {
+		return fmt.Errorf("failed to create simulated sysfs tree %s: %w", root, err)
+	}
+
+	for node := range numaNodes {
+		nodeDir := filepath.Join(root, accelerator.NUMANodesDir, fmt.Sprintf("node%d", node))
+		if err := os.MkdirAll(nodeDir, dirPermissions); err != nil {
+			return fmt.Errorf("failed to create %s: %w", nodeDir, err)
+		}
+
+		cpus := fmt.Sprintf("%d-%d\n", node*cpusPerNode, (node+1)*cpusPerNode-1)
+		if err := os.WriteFile(filepath.Join(nodeDir, "cpulist"), []byte(cpus), filePermissions); err != nil {
+			return fmt.Errorf("failed to write CPUs of NUMA node %d: %w", node, err)
+		}
+	}
+
+	for i := range count {
+		address := fmt.Sprintf("0000:%02x:00.0", i+1)
+		group := strconv.Itoa(i)
+
+		deviceDir := filepath.Join(root, accelerator.PCIDevicesDir, address)
+		groupDir := filepath.Join(root, "kernel", "iommu_groups", group, "devices")
+		for _, dir := range []string{deviceDir, groupDir} {
+			if err := os.MkdirAll(dir, dirPermissions); err != nil {
+				return fmt.Errorf("failed to create %s: %w", dir, err)
+			}
+		}
+
+		attributes := map[string]string{
+			"vendor":    VendorID,
+			"device":    DeviceID,
+			"numa_node": strconv.Itoa(i % numaNodes),
+		}
+		for name, value := range attributes {
+			if err := os.WriteFile(filepath.Join(deviceDir, name), []byte(value+"\n"), filePermissions); err != nil {
+				return fmt.Errorf("failed to write %s of %s: %w", name, address, err)
+			}
+		}
+
+		links := map[string]string{
+			filepath.Join(deviceDir, "iommu_group"): filepath.Join("..", "..", "..", "..", "kernel", "iommu_groups", group),
+			filepath.Join(groupDir, address):        filepath.Join("..", "..", "..", "..", accelerator.PCIDevicesDir, address),
+		}
+		for link, target := range links {
+			if err := os.Symlink(target, link); err != nil && !errors.Is(err, os.ErrExist) {
+				return fmt.Errorf("failed to link %s: %w", link, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Made with Bob
@@ -0,0 +1,121 @@
+package simulated
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/accelerator"
+)
+
+func TestDiscover(t *testing.T) {
+	root := t.TempDir()
+	if err := CreateTree(root, 3, 2); err != nil {
+		t.Fatalf("CreateTree() error = %v", err)
+	}
+
+	provider := NewProvider(root)
+	if err := provider.SetInUse("0000:02:00.0", true); err != nil {
+		t.Fatalf("SetInUse() error = %v", err)
+	}
+
+	cards, err := provider.Discover(context.Background())
+	if err != nil {
+		t.Fatalf("Discover() error = %v", err)
+	}
+
+	want := []accelerator.Card{
+		{PCIAddress: "0000:01:00.0", IOMMUGroup: "0", NUMANode: 0, Free: true},
+		{PCIAddress: "0000:02:00.0", IOMMUGroup: "1", NUMANode: 1, Free: false},
+		{PCIAddress: "0000:03:00.0", IOMMUGroup: "2", NUMANode: 0, Free: true},
+	}
+	if len(cards) != len(want) {
+		t.Fatalf("Discover() returned %d cards, want %d", len(cards), len(want))
+	}
+	for i := range want {
+		if cards[i] != want[i] {
+			t.Errorf("card %d = %+v, want %+v", i, cards[i], want[i])
+		}
+	}
+
+	if err := provider.SetInUse("0000:02:00.0", false); err != nil {
+		t.Fatalf("SetInUse() error = %v", err)
+	}
+	cards, err = provider.Discover(context.Background())
+	if err != nil {
+		t.Fatalf("Discover() error = %v", err)
+	}
+	if got := accelerator.FreeAddresses(cards); len(got) != 3 {
+		t.Errorf("FreeAddresses() = %v, want all 3 cards free", got)
+	}
+}
+
+func TestDiscoverMissingTree(t *testing.T) {
+	cards, err := NewProvider(filepath.Join(t.TempDir(), "missing")).Discover(context.Background())
+	if err != nil {
+		t.Fatalf("Discover() error = %v", err)
+	}
+	if len(cards) != 0 {
+		t.Errorf("Discover() = %v, want no cards", cards)
+	}
+}
+
+func TestHealth(t *testing.T) {
+	root := t.TempDir()
+	if err := CreateTree(root, 2, 1); err != nil {
+		t.Fatalf("CreateTree() error = %v", err)
+	}
+
+	provider := NewProvider(root)
+	if err := provider.SetFault("0000:01:00.0", "link down"); err != nil {
+		t.Fatalf("SetFault() error = %v", err)
+	}
+	if err := provider.SetFault("0000:09:00.0", "link down"); err == nil {
+		t.Error("SetFault() on an unknown card succeeded, want error")
+	}
+
+	checks := provider.Health(context.Background())
+	if len(checks) != 2 {
+		t.Fatalf("Health() returned %d checks, want 2", len(checks))
+	}
+	if checks[0].GetStatus() {
+		t.Errorf("check of the faulty card passed: %s", checks[0].String())
+	}
+	if !checks[1].GetStatus() {
+		t.Errorf("check of the healthy card failed: %s", checks[1].String())
+	}
+}
+
+func TestNewProviderFromEnv(t *testing.T) {
+	root := filepath.Join(t.TempDir(), "sys")
+	t.Setenv(RootEnv, root)
+	t.Setenv(CardsEnv, "4")
+	t.Setenv(NUMANodesEnv, "2")
+
+	provider, err := NewProviderFromEnv()
+	if err != nil {
+		t.Fatalf("NewProviderFromEnv() error = %v", err)
+	}
+
+	cards, err := provider.Discover(context.Background())
+	if err != nil {
+		t.Fatalf("Discover() error = %v", err)
+	}
+	if len(cards) != 4 {
+		t.Errorf("Discover() returned %d cards, want 4", len(cards))
+	}
+
+	cpus, err := provider.NodeCPUs(context.Background(), 1)
+	if err != nil || cpus != "8-15" {
+		t.Errorf("NodeCPUs(1) = %q, %v, want \"8-15\"", cpus, err)
+	}
+	if cpus, err := provider.NodeCPUs(context.Background(), 2); err != nil || cpus != "" {
+		t.Errorf("NodeCPUs(2) = %q, %v, want no CPUs", cpus, err)
+	}
+
+	t.Setenv(CardsEnv, "-1")
+	t.Setenv(RootEnv, filepath.Join(t.TempDir(), "other"))
+	if _, err := NewProviderFromEnv(); err == nil {
+		t.Error("NewProviderFromEnv() with a negative card count succeeded, want error")
+	}
+}
@@ -0,0 +1,104 @@
+package spyre
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/accelerator"
+	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap/spyreconfig/check"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+const (
+	// ProviderName is the name the Spyre provider is registered under.
+	ProviderName = "spyre"
+
+	vendorID     = "0x1014"
+	deviceIDRev1 = "0x06a7"
+	deviceIDRev2 = "0x06a8"
+
+	// chrootEnv is honoured like the ghw library does, so that the cards of the host can be masked.
+	chrootEnv = "GHW_CHROOT"
+)
+
+// Provider discovers the Spyre cards attached to the host from sysfs. A card is free when the
+// device of its VFIO group can be opened; a card passed to a container keeps the device busy.
+type Provider struct {
+	sysfsRoot string
+	vfioDir   string
+	checks    func() []check.CheckResult
+}
+
+// NewProvider creates a Spyre provider. checks runs the health checks of the host configuration
+// and may be nil.
+func NewProvider(checks func() []check.CheckResult) *Provider {
+	root := filepath.Join("/", os.Getenv(chrootEnv))
+
+	return &Provider{
+		sysfsRoot: filepath.Join(root, "sys"),
+		vfioDir:   filepath.Join(root, "dev", "vfio"),
+		checks:    checks,
+	}
+}
+
+// Name returns the name of the provider.
+func (p *Provider) Name() string {
+	return ProviderName
+}
+
+// ResourceName returns the extended resource name of Spyre cards.
+func (p *Provider) ResourceName() string {
+	return constants.SpyreResourceName
+}
+
+// Discover returns the Spyre cards attached to the host.
+func (p *Provider) Discover(ctx context.Context) ([]accelerator.Card, error) {
+	cards, err := accelerator.ScanPCIDevices(p.sysfsRoot, vendorID, deviceIDRev1, deviceIDRev2)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range cards {
+		cards[i].Free = p.isFree(ctx, cards[i])
+		logger.DebugfCtx(ctx, "Spyre card %s: NUMA node %d, free %t\n", cards[i].PCIAddress, cards[i].NUMANode, cards[i].Free)
+	}
+
+	return cards, nil
+}
+
+// isFree reports whether the VFIO group device of a card can be opened.
+func (p *Provider) isFree(ctx context.Context, card accelerator.Card) bool {
+	if card.IOMMUGroup == "" {
+		return false
+	}
+
+	f, err := os.Open(filepath.Join(p.vfioDir, card.IOMMUGroup))
+	if err != nil {
+		logger.DebugfCtx(ctx, "Device or resource busy, skipping.., err: %v", err)
+
+		return false
+	}
+	if err := f.Close(); err != nil {
+		logger.DebuglnCtx(ctx, "Failed to close the device file handle")
+	}
+
+	return true
+}
+
+// NodeCPUs returns the CPUs of a NUMA node of the host.
+func (p *Provider) NodeCPUs(_ context.Context, node int) (string, error) {
+	return accelerator.ReadNodeCPUs(p.sysfsRoot, node)
+}
+
+// Health runs the checks of the VFIO, udev, SELinux and Podman configuration Spyre cards need.
+func (p *Provider) Health(_ context.Context) []check.CheckResult {
+	if p.checks == nil {
+		return nil
+	}
+
+	return p.checks()
+}
+
+// Made with Bob
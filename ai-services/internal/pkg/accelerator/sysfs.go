@@ -0,0 +1,90 @@
+package accelerator
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// PCIDevicesDir is the directory of the PCI devices below a sysfs root.
+	PCIDevicesDir = "bus/pci/devices"
+
+	// NUMANodesDir is the directory of the NUMA nodes below a sysfs root.
+	NUMANodesDir = "devices/system/node"
+)
+
+// ScanPCIDevices returns the PCI devices below sysfsRoot, e.g. "/sys", whose vendor is vendorID and
+// whose device is one of deviceIDs, ordered by PCI address. IDs are given as in sysfs, e.g.
+// "0x1014". The returned cards are not free; it is up to the provider to tell which are.
+func ScanPCIDevices(sysfsRoot, vendorID string, deviceIDs ...string) ([]Card, error) {
+	devicesDir := filepath.Join(sysfsRoot, PCIDevicesDir)
+
+	entries, err := os.ReadDir(devicesDir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return []Card{}, nil
+		}
+
+		return nil, fmt.Errorf("failed to read PCI devices under %s: %w", devicesDir, err)
+	}
+
+	cards := []Card{}
+	for _, entry := range entries {
+		deviceDir := filepath.Join(devicesDir, entry.Name())
+
+		if readSysfsValue(deviceDir, "vendor") != vendorID || !slices.Contains(deviceIDs, readSysfsValue(deviceDir, "device")) {
+			continue
+		}
+
+		card := Card{PCIAddress: entry.Name(), NUMANode: UnknownNUMANode}
+		if node, err := strconv.Atoi(readSysfsValue(deviceDir, "numa_node")); err == nil && node >= 0 {
+			card.NUMANode = node
+		}
+		if group, err := os.Readlink(filepath.Join(deviceDir, "iommu_group")); err == nil {
+			card.IOMMUGroup = filepath.Base(group)
+		}
+
+		cards = append(cards, card)
+	}
+
+	sort.Slice(cards, func(i, j int) bool { return cards[i].PCIAddress < cards[j].PCIAddress })
+
+	return cards, nil
+}
+
+// ReadNodeCPUs returns the CPU list of a NUMA node below sysfsRoot, or "" if the node is unknown.
+func ReadNodeCPUs(sysfsRoot string, node int) (string, error) {
+	if node < 0 {
+		return "", nil
+	}
+
+	path := filepath.Join(sysfsRoot, NUMANodesDir, fmt.Sprintf("node%d", node), "cpulist")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", nil
+		}
+
+		return "", fmt.Errorf("failed to read CPUs of NUMA node %d: %w", node, err)
+	}
+
+	return strings.TrimSpace(string(data)), nil
+}
+
+// readSysfsValue returns the trimmed content of a sysfs attribute, or "" if it cannot be read.
+func readSysfsValue(dir, name string) string {
+	data, err := os.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(string(data))
+}
+
+// Made with Bob
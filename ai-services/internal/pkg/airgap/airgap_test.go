@@ -0,0 +1,207 @@
+package airgap
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/modelstore"
+)
+
+const testModel = "org/llm"
+
+// fakeImages is an image storage whose images are their archive, identified by its checksum.
+type fakeImages map[string]string
+
+func (f fakeImages) SaveImage(_ context.Context, image string, w io.Writer) error {
+	_, err := io.WriteString(w, f[image])
+
+	return err
+}
+
+func (f fakeImages) LoadImage(_ context.Context, r io.Reader, name string) error {
+	data, err := io.ReadAll(r)
+	f[name] = string(data)
+
+	return err
+}
+
+func (f fakeImages) ImageID(_ context.Context, image string) (string, error) {
+	archive, ok := f[image]
+	if !ok {
+		return "", nil
+	}
+	sum := sha256.Sum256([]byte(archive))
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+var testCatalog = fstest.MapFS{
+	"components/llm/metadata.yaml": {Data: []byte("id: llm\n")},
+}
+
+// exportBundle exports a bundle of an image and a model, one file of which has a recorded checksum.
+func exportBundle(t *testing.T) []byte {
+	t.Helper()
+
+	modelsDir := t.TempDir()
+	dir := filepath.Join(modelsDir, testModel)
+	files := map[string]string{"config.json": `{"model_type": "granite"}`, "model.safetensors": strings.Repeat("weights", 1000)}
+	for file, content := range files {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, file), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sum := sha256.Sum256([]byte(files["model.safetensors"]))
+	if err := modelstore.WriteDownloadMetadata(dir, "model.safetensors", modelstore.DownloadMetadata{Commit: "abc123", ETag: hex.EncodeToString(sum[:])}); err != nil {
+		t.Fatal(err)
+	}
+
+	var bundle bytes.Buffer
+	images := fakeImages{"icr.io/ai-services/vllm:v1": "vllm layers"}
+	manifest, err := Export(context.Background(), images, ExportOptions{
+		Template:  "rag",
+		Images:    []string{"icr.io/ai-services/vllm:v1"},
+		Models:    []string{testModel},
+		ModelsDir: modelsDir,
+		Catalog:   testCatalog,
+	}, &bundle)
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	if len(manifest.Images) != 1 || len(manifest.Models) != 1 || len(manifest.Models[0].Files) != 2 || len(manifest.Catalog) != 1 {
+		t.Fatalf("manifest = %+v, want the image, the model files and the catalog", manifest)
+	}
+	if manifest.Models[0].Revision != "abc123" {
+		t.Errorf("model revision = %q, want abc123", manifest.Models[0].Revision)
+	}
+
+	return bundle.Bytes()
+}
+
+func TestExportImport(t *testing.T) {
+	bundle := exportBundle(t)
+
+	images := fakeImages{}
+	modelsDir := t.TempDir()
+	result, err := Import(context.Background(), images, bytes.NewReader(bundle), ImportOptions{ModelsDir: modelsDir, Catalog: testCatalog})
+	if err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+	if result.Manifest.Template != "rag" || len(result.CatalogChanges) != 0 {
+		t.Errorf("Import() = %+v, want template rag without catalog changes", result)
+	}
+	if images["icr.io/ai-services/vllm:v1"] != "vllm layers" {
+		t.Errorf("images = %v, want the image loaded", images)
+	}
+
+	verify, err := modelstore.NewStore(modelsDir).Verify(testModel)
+	if err != nil || !verify.Valid {
+		t.Fatalf("Verify() = %+v, %v, want valid", verify, err)
+	}
+	for _, file := range verify.Files {
+		if file.Status != modelstore.FileStatusOK {
+			t.Errorf("status of %s = %s, want ok", file.Path, file.Status)
+		}
+	}
+
+	// A local catalog other than the one of the bundle is reported
+	changed := fstest.MapFS{"components/llm/metadata.yaml": {Data: []byte("id: llm-v2\n")}}
+	result, err = Import(context.Background(), fakeImages{}, bytes.NewReader(bundle), ImportOptions{ModelsDir: t.TempDir(), Catalog: changed})
+	if err != nil || len(result.CatalogChanges) != 1 {
+		t.Errorf("Import() with another catalog = %+v, %v, want a catalog change", result, err)
+	}
+}
+
+func TestImportCorrupted(t *testing.T) {
+	bundle := exportBundle(t)
+
+	corrupted := bytes.Replace(bundle, []byte("weightsweights"), []byte("weightsWEIGHTS"), 1)
+	modelsDir := t.TempDir()
+	_, err := Import(context.Background(), fakeImages{}, bytes.NewReader(corrupted), ImportOptions{ModelsDir: modelsDir})
+	if err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Errorf("Import() of a corrupted bundle error = %v, want checksum mismatch", err)
+	}
+	if _, err := os.Stat(filepath.Join(modelsDir, testModel, "model.safetensors")); !os.IsNotExist(err) {
+		t.Errorf("corrupted model file was moved into place: %v", err)
+	}
+
+	truncated := truncateBundle(t, bundle, 2)
+	if _, err := Import(context.Background(), fakeImages{}, bytes.NewReader(truncated), ImportOptions{ModelsDir: t.TempDir()}); err == nil || !strings.Contains(err.Error(), "incomplete") {
+		t.Errorf("Import() of a truncated bundle error = %v, want incomplete", err)
+	}
+
+	if _, err := Import(context.Background(), fakeImages{}, bytes.NewReader(truncateBundle(t, bundle, 0)), ImportOptions{}); err == nil {
+		t.Error("Import() of a bundle without manifest succeeded, want error")
+	}
+}
+
+func TestImportImageID(t *testing.T) {
+	bundle := exportBundle(t)
+
+	// A storage loading another image than the archived one
+	images := renamingImages{fakeImages{}}
+	_, err := Import(context.Background(), images, bytes.NewReader(bundle), ImportOptions{ModelsDir: t.TempDir()})
+	if err == nil || !strings.Contains(err.Error(), "loaded with ID") {
+		t.Errorf("Import() error = %v, want an image ID mismatch", err)
+	}
+}
+
+type renamingImages struct {
+	fakeImages
+}
+
+func (r renamingImages) LoadImage(ctx context.Context, rd io.Reader, name string) error {
+	return r.fakeImages.LoadImage(ctx, io.MultiReader(rd, strings.NewReader("changed")), name)
+}
+
+func TestManifestValidate(t *testing.T) {
+	for _, manifest := range []Manifest{
+		{FormatVersion: FormatVersion + 1},
+		{FormatVersion: FormatVersion, Images: []Image{{Name: "x", File: "../x.tar"}}},
+		{FormatVersion: FormatVersion, Models: []Model{{Name: "../etc"}}},
+		{FormatVersion: FormatVersion, Models: []Model{{Name: testModel, Files: []File{{Path: ".cache/huggingface/x"}}}}},
+		{FormatVersion: FormatVersion, Catalog: []File{{Path: "/etc/passwd"}}},
+	} {
+		if err := manifest.validate(); err == nil {
+			t.Errorf("validate(%+v) succeeded, want error", manifest)
+		}
+	}
+}
+
+// truncateBundle rewrites the bundle with its first keep entries only.
+func truncateBundle(t *testing.T, bundle []byte, keep int) []byte {
+	t.Helper()
+
+	var out bytes.Buffer
+	tr := tar.NewReader(bytes.NewReader(bundle))
+	tw := tar.NewWriter(&out)
+	for i := 0; i < keep; i++ {
+		hdr, err := tr.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := io.Copy(tw, tr); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return out.Bytes()
+}
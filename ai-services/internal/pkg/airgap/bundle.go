@@ -0,0 +1,119 @@
+// Package airgap moves what the deployments of a template need to hosts without network access.
+//
+// A bundle is a tar archive holding, after a manifest.json listing its content with checksums:
+//
+//	images/<n>.tar       an OCI archive per container image
+//	models/<model>/...   the files of every model
+//	catalog/...          the catalog assets of the CLI that exported it
+//
+// Export writes a bundle from the local image storage and models directory of a connected host,
+// Import loads it into those of the disconnected one, verifying every digest on the way.
+package airgap
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	// ManifestName is the name of the manifest, the first entry of a bundle.
+	ManifestName = "manifest.json"
+	// FormatVersion is the version of the bundle layout written by Export.
+	FormatVersion = 1
+
+	imagesDir  = "images"
+	modelsDir  = "models"
+	catalogDir = "catalog"
+)
+
+// Manifest lists the content of a bundle.
+type Manifest struct {
+	FormatVersion int       `json:"formatVersion"`
+	Template      string    `json:"template"`
+	CLIVersion    string    `json:"cliVersion,omitempty"`
+	CreatedAt     time.Time `json:"createdAt"`
+	Images        []Image   `json:"images"`
+	Models        []Model   `json:"models"`
+	Catalog       []File    `json:"catalog"`
+}
+
+// Image is a container image of a bundle. ID is the image ID in the storage it was exported from,
+// which the loaded image must have.
+type Image struct {
+	Name   string `json:"name"`
+	ID     string `json:"id"`
+	File   string `json:"file"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// Model is a model of a bundle, its files being below models/<name>/.
+type Model struct {
+	Name     string `json:"name"`
+	Revision string `json:"revision,omitempty"`
+	Files    []File `json:"files"`
+}
+
+// File is a file of a bundle, by its path relative to its model or to the catalog.
+type File struct {
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// ImageStore is the local storage of container images.
+type ImageStore interface {
+	// SaveImage writes an image to w as an OCI archive.
+	SaveImage(ctx context.Context, image string, w io.Writer) error
+	// LoadImage loads an image archive, naming the image name.
+	LoadImage(ctx context.Context, r io.Reader, name string) error
+	// ImageID returns the ID of an image, or an empty string when the storage does not have it.
+	ImageID(ctx context.Context, image string) (string, error)
+}
+
+// validate checks that the manifest can be imported, its paths staying within their directories.
+func (m *Manifest) validate() error {
+	if m.FormatVersion != FormatVersion {
+		return fmt.Errorf("unsupported bundle format version %d, expected %d", m.FormatVersion, FormatVersion)
+	}
+
+	for _, image := range m.Images {
+		if image.Name == "" || path.Dir(image.File) != imagesDir || !validPath(image.File) {
+			return fmt.Errorf("invalid image %q in manifest", image.Name)
+		}
+	}
+	for _, model := range m.Models {
+		if !validPath(model.Name) {
+			return fmt.Errorf("invalid model name %q in manifest", model.Name)
+		}
+		for _, file := range model.Files {
+			if !validPath(file.Path) || isCachePath(file.Path) {
+				return fmt.Errorf("invalid file %q of model %s in manifest", file.Path, model.Name)
+			}
+		}
+	}
+	for _, file := range m.Catalog {
+		if !validPath(file.Path) {
+			return fmt.Errorf("invalid catalog file %q in manifest", file.Path)
+		}
+	}
+
+	return nil
+}
+
+// validPath reports whether a slash-separated path is relative and stays below its directory.
+func validPath(p string) bool {
+	return p != "" && p != "." && !path.IsAbs(p) && path.Clean(p) == p && p != ".." && !strings.HasPrefix(p, "../")
+}
+
+// isCachePath reports whether a path of a model belongs to the download metadata rather than the
+// model.
+func isCachePath(p string) bool {
+	return p == ".cache" || strings.HasPrefix(p, ".cache/")
+}
+
+// Made with Bob
@@ -0,0 +1,256 @@
+package airgap
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/modelstore"
+)
+
+// ExportOptions selects the content of a bundle.
+type ExportOptions struct {
+	Template   string
+	CLIVersion string
+	// Images are the names of the images, which must be in the image storage.
+	Images []string
+	// Models are the names of the models, which must be in ModelsDir.
+	Models    []string
+	ModelsDir string
+	// Catalog holds the catalog assets; none are exported when nil.
+	Catalog fs.FS
+	// TempDir holds the image archives while the bundle is written, the default temporary
+	// directory when empty.
+	TempDir string
+}
+
+// Export writes a bundle of the images, models and catalog assets of opts to w and returns its
+// manifest. The models are verified against the checksums recorded when they were downloaded.
+func Export(ctx context.Context, store ImageStore, opts ExportOptions, w io.Writer) (*Manifest, error) {
+	tmp, err := os.MkdirTemp(opts.TempDir, "airgap-export-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	manifest := &Manifest{
+		FormatVersion: FormatVersion,
+		Template:      opts.Template,
+		CLIVersion:    opts.CLIVersion,
+		CreatedAt:     time.Now().UTC().Truncate(time.Second),
+		Images:        []Image{},
+		Models:        []Model{},
+		Catalog:       []File{},
+	}
+
+	for i, name := range opts.Images {
+		image, err := saveImage(ctx, store, name, filepath.Join(tmp, fmt.Sprintf("%d.tar", i)))
+		if err != nil {
+			return nil, err
+		}
+		image.File = fmt.Sprintf("%s/%d.tar", imagesDir, i)
+		manifest.Images = append(manifest.Images, *image)
+	}
+
+	models := modelstore.NewStore(opts.ModelsDir)
+	for _, name := range opts.Models {
+		model, err := checksumModel(ctx, models, name)
+		if err != nil {
+			return nil, err
+		}
+		manifest.Models = append(manifest.Models, *model)
+	}
+
+	if opts.Catalog != nil {
+		if manifest.Catalog, err = catalogFiles(opts.Catalog); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writeBundle(ctx, w, manifest, tmp, opts); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// saveImage saves an image of the storage to an OCI archive at dest and checksums it.
+func saveImage(ctx context.Context, store ImageStore, name, dest string) (*Image, error) {
+	id, err := store.ImageID(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if id == "" {
+		return nil, fmt.Errorf("image %s is not in the local image storage", name)
+	}
+
+	logger.InfofCtx(ctx, "Saving image %s...\n", name)
+	f, err := os.Create(dest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create archive of image %s: %w", name, err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	counter := &countingWriter{w: io.MultiWriter(f, h)}
+	if err := store.SaveImage(ctx, name, counter); err != nil {
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write archive of image %s: %w", name, err)
+	}
+
+	return &Image{Name: name, ID: id, Size: counter.n, SHA256: hex.EncodeToString(h.Sum(nil))}, nil
+}
+
+// checksumModel lists the files of a model with their checksums, refusing models whose files no
+// longer match the checksums recorded when they were downloaded.
+func checksumModel(ctx context.Context, store *modelstore.Store, name string) (*Model, error) {
+	model, err := store.Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.InfofCtx(ctx, "Checksumming model %s...\n", name)
+	recorded := make(map[string]string, len(model.Files))
+	for _, file := range model.Files {
+		recorded[file.Path] = file.SHA256
+	}
+	if err := modelstore.ComputeChecksums(model); err != nil {
+		return nil, fmt.Errorf("failed to checksum model %s: %w", name, err)
+	}
+
+	files := make([]File, 0, len(model.Files))
+	for _, file := range model.Files {
+		if sum := recorded[file.Path]; sum != "" && sum != file.SHA256 {
+			return nil, fmt.Errorf("file %s of model %s does not match its recorded checksum, download the model again", file.Path, name)
+		}
+		files = append(files, File{Path: file.Path, Size: file.SizeBytes, SHA256: file.SHA256})
+	}
+
+	return &Model{Name: name, Revision: model.Revision, Files: files}, nil
+}
+
+// catalogFiles lists the files of the catalog assets with their checksums.
+func catalogFiles(catalog fs.FS) ([]File, error) {
+	files := []File{}
+	err := fs.WalkDir(catalog, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		data, err := fs.ReadFile(catalog, p)
+		if err != nil {
+			return err
+		}
+		sum := sha256.Sum256(data)
+		files = append(files, File{Path: p, Size: int64(len(data)), SHA256: hex.EncodeToString(sum[:])})
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read catalog assets: %w", err)
+	}
+
+	return files, nil
+}
+
+// writeBundle writes the manifest, then the images archived in tmp, the model files and the
+// catalog assets.
+func writeBundle(ctx context.Context, w io.Writer, manifest *Manifest, tmp string, opts ExportOptions) error {
+	tw := tar.NewWriter(w)
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	if err := writeEntry(tw, ManifestName, manifest.CreatedAt, int64(len(data)), func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}); err != nil {
+		return err
+	}
+
+	for i, image := range manifest.Images {
+		local := filepath.Join(tmp, fmt.Sprintf("%d.tar", i))
+		if err := writeEntry(tw, image.File, manifest.CreatedAt, image.Size, openFile(local)); err != nil {
+			return err
+		}
+	}
+
+	for _, model := range manifest.Models {
+		logger.InfofCtx(ctx, "Adding model %s...\n", model.Name)
+		dir := filepath.Join(opts.ModelsDir, filepath.FromSlash(model.Name))
+		for _, file := range model.Files {
+			name := path.Join(modelsDir, model.Name, file.Path)
+			if err := writeEntry(tw, name, manifest.CreatedAt, file.Size, openFile(filepath.Join(dir, filepath.FromSlash(file.Path)))); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, file := range manifest.Catalog {
+		if err := writeEntry(tw, path.Join(catalogDir, file.Path), manifest.CreatedAt, file.Size, func() (io.ReadCloser, error) {
+			return opts.Catalog.Open(file.Path)
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to write bundle: %w", err)
+	}
+
+	return nil
+}
+
+// writeEntry writes a regular file of size bytes to the bundle.
+func writeEntry(tw *tar.Writer, name string, modTime time.Time, size int64, open func() (io.ReadCloser, error)) error {
+	r, err := open()
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", name, err)
+	}
+	defer r.Close()
+
+	hdr := &tar.Header{Typeflag: tar.TypeReg, Name: name, Size: size, Mode: constants.FilePerm, ModTime: modTime}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return fmt.Errorf("failed to write %s to bundle: %w", name, err)
+	}
+	if _, err := io.CopyN(tw, r, size); err != nil {
+		return fmt.Errorf("failed to write %s to bundle: %w", name, err)
+	}
+
+	return nil
+}
+
+func openFile(name string) func() (io.ReadCloser, error) {
+	return func() (io.ReadCloser, error) {
+		return os.Open(name)
+	}
+}
+
+// countingWriter counts the bytes written through it.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+
+	return n, err
+}
+
+// Made with Bob
@@ -0,0 +1,259 @@
+package airgap
+
+import (
+	"archive/tar"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/modelstore"
+)
+
+// ImportOptions configures where a bundle is imported.
+type ImportOptions struct {
+	// ModelsDir is the models directory the models are written to.
+	ModelsDir string
+	// Catalog holds the catalog assets of the local CLI, compared with those of the bundle when
+	// not nil.
+	Catalog fs.FS
+	// TempDir holds an image archive while it is verified and loaded, the default temporary
+	// directory when empty.
+	TempDir string
+}
+
+// ImportResult is the outcome of an import.
+type ImportResult struct {
+	Manifest *Manifest
+	// CatalogChanges are the catalog assets of the bundle that differ from, or are missing in, the
+	// local catalog: the images and models of the bundle may then not be the ones the local CLI
+	// deploys.
+	CatalogChanges []string
+}
+
+// modelEntry is a model file of the bundle.
+type modelEntry struct {
+	model *Model
+	file  File
+}
+
+// Import loads a bundle read from r: the images into the image storage and the models into the
+// models directory. Every entry is verified against the checksum of the manifest before it is
+// used, and every loaded image must have the ID it was exported with.
+func Import(ctx context.Context, store ImageStore, r io.Reader, opts ImportOptions) (*ImportResult, error) {
+	tr := tar.NewReader(r)
+	manifest, err := readManifest(tr)
+	if err != nil {
+		return nil, err
+	}
+
+	images := map[string]Image{}
+	for _, image := range manifest.Images {
+		images[image.File] = image
+	}
+	models := map[string]modelEntry{}
+	for i := range manifest.Models {
+		model := &manifest.Models[i]
+		for _, file := range model.Files {
+			name := path.Join(modelsDir, model.Name, file.Path)
+			if _, ok := models[name]; ok {
+				return nil, fmt.Errorf("invalid manifest: %s is listed twice", name)
+			}
+			models[name] = modelEntry{model: model, file: file}
+		}
+	}
+	catalog := map[string]File{}
+	for _, file := range manifest.Catalog {
+		catalog[path.Join(catalogDir, file.Path)] = file
+	}
+
+	result := &ImportResult{Manifest: manifest, CatalogChanges: []string{}}
+	seen := map[string]bool{}
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read bundle: %w", err)
+		}
+		if hdr.Typeflag == tar.TypeDir {
+			continue
+		}
+		if seen[hdr.Name] {
+			return nil, fmt.Errorf("bundle holds %s twice", hdr.Name)
+		}
+		seen[hdr.Name] = true
+
+		if image, ok := images[hdr.Name]; ok {
+			err = importImage(ctx, store, tr, image, opts.TempDir)
+		} else if entry, ok := models[hdr.Name]; ok {
+			err = importModelFile(tr, opts.ModelsDir, entry)
+		} else if file, ok := catalog[hdr.Name]; ok {
+			err = compareCatalogFile(tr, opts.Catalog, file, result)
+		} else {
+			err = fmt.Errorf("bundle holds %s, which its manifest does not list", hdr.Name)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	missing := append(append(unseen(images, seen), unseen(models, seen)...), unseen(catalog, seen)...)
+	if len(missing) > 0 {
+		sort.Strings(missing)
+
+		return nil, fmt.Errorf("bundle is incomplete, %d entries of its manifest are missing, e.g. %s", len(missing), missing[0])
+	}
+	sort.Strings(result.CatalogChanges)
+
+	return result, nil
+}
+
+// unseen returns the entries of the bundle that were not read.
+func unseen[V any](entries map[string]V, seen map[string]bool) []string {
+	names := []string{}
+	for name := range entries {
+		if !seen[name] {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// readManifest reads and validates the manifest, the first entry of the bundle.
+func readManifest(tr *tar.Reader) (*Manifest, error) {
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bundle: %w", err)
+	}
+	if hdr.Name != ManifestName {
+		return nil, fmt.Errorf("not an air-gap bundle: %s is not its first entry", ManifestName)
+	}
+
+	var manifest Manifest
+	if err := json.NewDecoder(tr).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest: %w", err)
+	}
+	if err := manifest.validate(); err != nil {
+		return nil, err
+	}
+
+	return &manifest, nil
+}
+
+// importImage verifies the archive of an image, loads it and checks the ID of the loaded image.
+func importImage(ctx context.Context, store ImageStore, r io.Reader, image Image, tempDir string) error {
+	logger.InfofCtx(ctx, "Loading image %s...\n", image.Name)
+	f, err := os.CreateTemp(tempDir, "airgap-image-*.tar")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	if err := copyVerified(f, r, image.File, image.Size, image.SHA256); err != nil {
+		return err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("failed to read archive of image %s: %w", image.Name, err)
+	}
+
+	if err := store.LoadImage(ctx, f, image.Name); err != nil {
+		return err
+	}
+	id, err := store.ImageID(ctx, image.Name)
+	if err != nil {
+		return err
+	}
+	if id != image.ID {
+		return fmt.Errorf("image %s was loaded with ID %s, expected %s", image.Name, id, image.ID)
+	}
+
+	return nil
+}
+
+// importModelFile writes a model file next to its download metadata, then moves it into place
+// once verified and records its checksum as a download would.
+func importModelFile(r io.Reader, root string, entry modelEntry) error {
+	dir := filepath.Join(root, filepath.FromSlash(entry.model.Name))
+	incomplete := modelstore.IncompletePath(dir, entry.file.Path)
+	if err := os.MkdirAll(filepath.Dir(incomplete), constants.DirPerm); err != nil {
+		return fmt.Errorf("failed to create directory of model %s: %w", entry.model.Name, err)
+	}
+
+	f, err := os.Create(incomplete)
+	if err != nil {
+		return fmt.Errorf("failed to create file of model %s: %w", entry.model.Name, err)
+	}
+	what := fmt.Sprintf("file %s of model %s", entry.file.Path, entry.model.Name)
+	err = copyVerified(f, r, what, entry.file.Size, entry.file.SHA256)
+	if closeErr := f.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("failed to write %s: %w", what, closeErr)
+	}
+	if err != nil {
+		_ = os.Remove(incomplete)
+
+		return err
+	}
+
+	dest := filepath.Join(dir, filepath.FromSlash(entry.file.Path))
+	if err := os.MkdirAll(filepath.Dir(dest), constants.DirPerm); err != nil {
+		return fmt.Errorf("failed to create directory of %s: %w", what, err)
+	}
+	if err := os.Rename(incomplete, dest); err != nil {
+		return fmt.Errorf("failed to move %s into place: %w", what, err)
+	}
+
+	return modelstore.WriteDownloadMetadata(dir, entry.file.Path,
+		modelstore.DownloadMetadata{Commit: entry.model.Revision, ETag: entry.file.SHA256})
+}
+
+// compareCatalogFile verifies a catalog asset of the bundle and records it when the local catalog
+// does not have the same.
+func compareCatalogFile(r io.Reader, catalog fs.FS, file File, result *ImportResult) error {
+	if err := copyVerified(io.Discard, r, "catalog asset "+file.Path, file.Size, file.SHA256); err != nil {
+		return err
+	}
+	if catalog == nil {
+		return nil
+	}
+
+	data, err := fs.ReadFile(catalog, file.Path)
+	sum := sha256.Sum256(data)
+	if err != nil || hex.EncodeToString(sum[:]) != file.SHA256 {
+		result.CatalogChanges = append(result.CatalogChanges, file.Path)
+	}
+
+	return nil
+}
+
+// copyVerified copies an entry of the bundle to w and checks its size and checksum.
+func copyVerified(w io.Writer, r io.Reader, what string, size int64, sum string) error {
+	h := sha256.New()
+	n, err := io.Copy(io.MultiWriter(w, h), r)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", what, err)
+	}
+	if n != size {
+		return fmt.Errorf("size mismatch for %s: %d bytes, expected %d", what, n, size)
+	}
+	if actual := hex.EncodeToString(h.Sum(nil)); actual != sum {
+		return fmt.Errorf("checksum mismatch for %s: sha256 %s, expected %s", what, actual, sum)
+	}
+
+	return nil
+}
+
+// Made with Bob
@@ -0,0 +1,123 @@
+package common
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ChecksumFile is the manifest at the root of a backup archive listing the SHA-256 of every
+// other file, in the format of sha256sum.
+const ChecksumFile = "checksums.sha256"
+
+// ErrNoChecksums is returned by VerifyChecksums for archives created before checksums were added.
+var ErrNoChecksums = errors.New("backup archive has no checksum manifest")
+
+// WriteChecksums writes the checksum manifest for all files below rootDir.
+func WriteChecksums(rootDir string) error {
+	var lines []string
+	err := filepath.WalkDir(rootDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		rel, err := filepath.Rel(rootDir, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if rel == ChecksumFile {
+			return nil
+		}
+
+		sum, err := fileSHA256(path)
+		if err != nil {
+			return err
+		}
+		lines = append(lines, sum+"  "+rel)
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to compute backup checksums: %w", err)
+	}
+
+	sort.Slice(lines, func(i, j int) bool { return lines[i][sha256.Size*2:] < lines[j][sha256.Size*2:] })
+
+	content := strings.Join(lines, "\n") + "\n"
+	if err := os.WriteFile(filepath.Join(rootDir, ChecksumFile), []byte(content), defaultFilePermission); err != nil {
+		return fmt.Errorf("failed to write checksum manifest: %w", err)
+	}
+
+	return nil
+}
+
+// VerifyChecksums checks every file listed in the checksum manifest of an extracted archive.
+// It returns ErrNoChecksums when the archive has no manifest.
+func VerifyChecksums(rootDir string) error {
+	file, err := os.Open(filepath.Join(rootDir, ChecksumFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return ErrNoChecksums
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open checksum manifest: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		want, rel, ok := strings.Cut(line, "  ")
+		if !ok || len(want) != sha256.Size*2 {
+			return fmt.Errorf("malformed checksum manifest line: %q", line)
+		}
+
+		path := filepath.Join(rootDir, filepath.FromSlash(rel))
+		if !strings.HasPrefix(path, filepath.Clean(rootDir)+string(os.PathSeparator)) {
+			return fmt.Errorf("checksum manifest refers to a path outside the archive: %s", rel)
+		}
+
+		got, err := fileSHA256(path)
+		if err != nil {
+			return fmt.Errorf("file %s listed in checksum manifest: %w", rel, err)
+		}
+		if got != want {
+			return fmt.Errorf("checksum mismatch for %s: archive is corrupted", rel)
+		}
+	}
+
+	return scanner.Err()
+}
+
+func fileSHA256(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Made with Bob
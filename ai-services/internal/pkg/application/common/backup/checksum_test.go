@@ -0,0 +1,39 @@
+package common
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestChecksums(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "opensearch_backup"), defaultDirPermission); err != nil {
+		t.Fatal(err)
+	}
+
+	dataFile := filepath.Join(dir, "opensearch_backup", "rag_data.json")
+	if err := os.WriteFile(dataFile, []byte(`[]`), defaultFilePermission); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := VerifyChecksums(dir); !errors.Is(err, ErrNoChecksums) {
+		t.Fatalf("VerifyChecksums() without manifest error = %v, want ErrNoChecksums", err)
+	}
+
+	if err := WriteChecksums(dir); err != nil {
+		t.Fatalf("WriteChecksums() error = %v", err)
+	}
+	if err := VerifyChecksums(dir); err != nil {
+		t.Fatalf("VerifyChecksums() error = %v", err)
+	}
+
+	if err := os.WriteFile(dataFile, []byte(`[{}]`), defaultFilePermission); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyChecksums(dir); err == nil || !strings.Contains(err.Error(), "rag_data.json") {
+		t.Errorf("VerifyChecksums() after tampering error = %v, want checksum mismatch", err)
+	}
+}
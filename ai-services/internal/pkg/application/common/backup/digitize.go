@@ -5,6 +5,7 @@ import (
 	"compress/gzip"
 	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -71,7 +72,7 @@ type DigitizeBackupClient struct {
 }
 
 // NewDigitizeBackupClient creates a new digitize backup client.
-func NewDigitizeBackupClient(serviceURL string) *DigitizeBackupClient {
+func NewDigitizeBackupClient(serviceURL string) (*DigitizeBackupClient, error) {
 	client := resty.New().SetBaseURL(serviceURL)
 
 	// Check runtime type
@@ -84,18 +85,39 @@ func NewDigitizeBackupClient(serviceURL string) *DigitizeBackupClient {
 			InsecureSkipVerify: true,
 		})
 	case runtimeTypes.RuntimeTypePodman:
-		// For Podman, check the insecure flag from catalog credentials
-		creds, err := config.Load()
-		if err == nil && creds.Insecure {
-			client.SetTLSClientConfig(&tls.Config{
-				InsecureSkipVerify: true,
-			})
+		// For Podman, apply the TLS settings of the active catalog context
+		tlsConfig, err := CatalogTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		if tlsConfig != nil {
+			client.SetTLSClientConfig(tlsConfig)
 		}
 	}
 
 	return &DigitizeBackupClient{
 		client: client,
+	}, nil
+}
+
+// CatalogTLSConfig returns the TLS settings of the active catalog context, which also apply to the
+// services the catalog deployed. It returns nil when no context is logged in, so that the system
+// trust store is used.
+func CatalogTLSConfig() (*tls.Config, error) {
+	creds, err := config.Load()
+	if errors.Is(err, config.ErrNotLoggedIn) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load catalog context: %w", err)
 	}
+
+	tlsConfig, err := creds.TLSConfig()
+	if err != nil {
+		return nil, fmt.Errorf("invalid TLS settings in catalog context: %w", err)
+	}
+
+	return tlsConfig, nil
 }
 
 // CallExportAPI calls the digitize Export API.
@@ -176,7 +198,7 @@ func writeDigitizeJobFiles(jobsDir string, jobs []map[string]interface{}) error
 		}
 
 		filePath := filepath.Join(jobsDir, fmt.Sprintf("%s_status.json", jobID))
-		if err := writeJSONFile(filePath, job); err != nil {
+		if err := WriteJSONFile(filePath, job); err != nil {
 			return fmt.Errorf("failed to write job file for %s: %w", jobID, err)
 		}
 	}
@@ -192,7 +214,7 @@ func writeDigitizeDocumentFiles(docsDir string, documents []map[string]interface
 		}
 
 		filePath := filepath.Join(docsDir, fmt.Sprintf("%s_metadata.json", docID))
-		if err := writeJSONFile(filePath, document); err != nil {
+		if err := WriteJSONFile(filePath, document); err != nil {
 			return fmt.Errorf("failed to write document file for %s: %w", docID, err)
 		}
 	}
@@ -206,10 +228,11 @@ func writeDigitizeBackupInfo(tempDir string) error {
 		"type":        "digitize",
 	}
 
-	return writeJSONFile(filepath.Join(tempDir, "backup_info.json"), backupInfo)
+	return WriteJSONFile(filepath.Join(tempDir, "backup_info.json"), backupInfo)
 }
 
-func writeJSONFile(path string, data interface{}) error {
+// WriteJSONFile writes data as indented JSON to path.
+func WriteJSONFile(path string, data interface{}) error {
 	content, err := json.MarshalIndent(data, "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal JSON for %s: %w", path, err)
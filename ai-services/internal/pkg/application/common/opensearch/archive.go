@@ -0,0 +1,328 @@
+package opensearch
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	appcommon "github.com/project-ai-services/ai-services/internal/pkg/application/common"
+	commonBackup "github.com/project-ai-services/ai-services/internal/pkg/application/common/backup"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+// BackupDir is the directory of an OpenSearch backup archive holding the index files.
+const BackupDir = "opensearch_backup"
+
+const (
+	// percent converts a fraction to a percentage for progress messages.
+	percent = 100
+	// restoreStateKeyLength is the number of hex characters of the restore state file name key.
+	restoreStateKeyLength = 16
+)
+
+// BackupInfo is written to backup_info.json at the root of an OpenSearch backup archive.
+type BackupInfo struct {
+	BackupDate string           `json:"backup_date"`
+	Type       string           `json:"type"`
+	Patterns   []string         `json:"patterns,omitempty"`
+	Indices    map[string]int64 `json:"indices,omitempty"`
+}
+
+// CreateBackup exports the indices matching patterns through client and writes them with a
+// checksum manifest to a tar.gz archive at backupFile.
+func CreateBackup(ctx context.Context, client *Client, backupFile string, patterns []string) error {
+	logger.Infof("Exporting OpenSearch indices matching %s...\n", strings.Join(patterns, ", "))
+
+	indices, err := client.ListIndices(ctx, patterns)
+	if err != nil {
+		return err
+	}
+
+	if len(indices) == 0 {
+		logger.Warningf("No indices found matching %s\n", strings.Join(patterns, ", "))
+	} else {
+		logger.Infof("Found %d indices to backup\n", len(indices))
+	}
+
+	tempDir, err := os.MkdirTemp("", "opensearch-backup-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp directory: %w", err)
+	}
+	defer func() {
+		if err := os.RemoveAll(tempDir); err != nil {
+			logger.Warningf("Failed to remove temp directory: %v\n", err)
+		}
+	}()
+
+	dataDir := filepath.Join(tempDir, BackupDir)
+	if err := os.MkdirAll(dataDir, constants.DirPerm); err != nil {
+		return fmt.Errorf("failed to create backup directory: %w", err)
+	}
+
+	exported, lastErr := exportIndices(ctx, client, dataDir, indices)
+	if err := commonBackup.HandleBackupResults(len(exported), len(indices), lastErr); err != nil {
+		return err
+	}
+
+	info := BackupInfo{
+		BackupDate: time.Now().Format(time.RFC3339),
+		Type:       "opensearch",
+		Patterns:   patterns,
+		Indices:    exported,
+	}
+	if err := commonBackup.WriteJSONFile(filepath.Join(tempDir, "backup_info.json"), info); err != nil {
+		return err
+	}
+
+	if err := commonBackup.WriteChecksums(tempDir); err != nil {
+		return err
+	}
+
+	logger.Infoln("Creating tar.gz archive...")
+	if err := commonBackup.CreateTarGzArchive(tempDir, backupFile, []string{"backup_info.json", commonBackup.ChecksumFile, BackupDir}); err != nil {
+		return err
+	}
+
+	commonBackup.LogArchiveSize(backupFile)
+
+	return nil
+}
+
+// exportIndices exports each index to dir and returns the document counts of the exported
+// indices together with the last error.
+func exportIndices(ctx context.Context, client *Client, dir string, indices []string) (map[string]int64, error) {
+	exported := make(map[string]int64, len(indices))
+	var lastErr error
+
+	for _, index := range indices {
+		if err := commonBackup.CheckContextCancellation(ctx, len(exported)); err != nil {
+			return exported, err
+		}
+
+		logger.Infof("  Exporting index: %s\n", index)
+
+		count, err := client.ExportIndex(ctx, index, dir, LogProgress("exported"))
+		if err != nil {
+			logger.Errorf("Failed to backup index %s: %v\n", index, err)
+			lastErr = err
+
+			continue
+		}
+
+		logger.Infof("    ✓ %d documents\n", count)
+		exported[index] = count
+	}
+
+	return exported, lastErr
+}
+
+// LogProgress returns a progress callback logging the share of documents handled per batch.
+func LogProgress(verb string) ProgressFunc {
+	return func(index string, done, total int64) {
+		if total <= 0 {
+			logger.Infof("    %d documents %s\n", done, verb)
+
+			return
+		}
+
+		logger.Infof("    %d/%d documents %s (%d%%)\n", done, total, verb, done*percent/total)
+	}
+}
+
+// RestoreBackup verifies a backup archive and restores the indices matching patterns through
+// client. Progress is kept in a state file per archive and target, so running the same restore
+// again after an interruption resumes where it stopped.
+func RestoreBackup(ctx context.Context, client *Client, backupFile, target string, patterns []string) error {
+	backupDir, cleanup, err := commonBackup.ExtractAndLocateBackup(backupFile)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	if err := verifyArchive(filepath.Dir(backupDir)); err != nil {
+		return err
+	}
+
+	backupOSDir, err := determineBackupPath(backupDir)
+	if err != nil {
+		return err
+	}
+
+	indices, err := listBackupIndices(backupOSDir, patterns)
+	if err != nil {
+		return err
+	}
+
+	logger.Infof("Found %d indices to restore\n", len(indices))
+
+	statePath, err := restoreStatePath(backupFile, target)
+	if err != nil {
+		logger.Warningf("Restore progress will not be kept: %v\n", err)
+	}
+
+	state, err := LoadRestoreState(statePath)
+	if err != nil {
+		return err
+	}
+	if state.Resumed() {
+		logger.Infof("Resuming an interrupted restore, %d indices already restored\n", len(state.Completed))
+	}
+
+	if err := restoreAllIndices(ctx, client, backupOSDir, indices, state); err != nil {
+		return fmt.Errorf("restore failed: %w", err)
+	}
+
+	logger.Infoln("OpenSearch import completed!")
+
+	return nil
+}
+
+// verifyArchive checks the extracted files against the checksum manifest of the archive.
+func verifyArchive(rootDir string) error {
+	logger.Infoln("Verifying backup checksums...")
+
+	err := commonBackup.VerifyChecksums(rootDir)
+	if errors.Is(err, commonBackup.ErrNoChecksums) {
+		logger.Warningf("Backup has no checksum manifest, skipping verification\n")
+
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("backup verification failed: %w", err)
+	}
+
+	logger.Infoln("✓ Backup checksums verified")
+
+	return nil
+}
+
+// determineBackupPath determines the directory holding the index files based on format.
+func determineBackupPath(backupDir string) (string, error) {
+	backupOSDir := backupDir
+	if filepath.Base(backupDir) != BackupDir {
+		backupOSDir = filepath.Join(backupDir, "opensearch")
+	}
+
+	if _, err := os.Stat(backupOSDir); os.IsNotExist(err) {
+		return "", fmt.Errorf("OpenSearch backup directory not found: %s", backupOSDir)
+	}
+
+	return backupOSDir, nil
+}
+
+// listBackupIndices lists the valid index names in a backup directory that match patterns.
+func listBackupIndices(backupDir string, patterns []string) ([]string, error) {
+	entries, err := os.ReadDir(backupDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list indices: %w", err)
+	}
+
+	var indices []string
+	for _, entry := range entries {
+		indexName, ok := strings.CutSuffix(entry.Name(), DataFile(""))
+		if entry.IsDir() || !ok || indexName == "" {
+			continue
+		}
+
+		if err := appcommon.ValidateIndexName(indexName); err != nil {
+			logger.Warningf("Skipping invalid index name %s: %v\n", indexName, err)
+
+			continue
+		}
+
+		if !MatchIndex(indexName, patterns) {
+			continue
+		}
+
+		indices = append(indices, indexName)
+	}
+
+	if len(indices) == 0 {
+		return nil, fmt.Errorf("no indices matching %s found in backup directory", strings.Join(patterns, ", "))
+	}
+	sort.Strings(indices)
+
+	return indices, nil
+}
+
+// restoreStatePath returns the file keeping the progress of restoring an archive into a target.
+func restoreStatePath(backupFile, target string) (string, error) {
+	file, err := os.Open(backupFile)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return "", err
+	}
+	h.Write([]byte(target))
+
+	key := hex.EncodeToString(h.Sum(nil))[:restoreStateKeyLength]
+
+	return filepath.Join(os.TempDir(), "ai-services-restore-"+key+".json"), nil
+}
+
+// restoreAllIndices restores all indices and tracks errors. The restore state is removed once
+// every index was restored, and kept for a later resume otherwise.
+func restoreAllIndices(ctx context.Context, client *Client, backupDir string, indices []string, state *RestoreState) error {
+	restoredCount := 0
+	var errs []error
+
+	for _, indexName := range indices {
+		// Check for context cancellation
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("restore cancelled: %w", ctx.Err())
+		default:
+		}
+
+		if state.Completed[indexName] {
+			logger.Infof("  Index %s already restored, skipping\n", indexName)
+			restoredCount++
+
+			continue
+		}
+
+		logger.Infof("  Restoring index: %s\n", indexName)
+		if err := client.RestoreIndex(ctx, backupDir, indexName, state, LogProgress("restored")); err != nil {
+			logger.Errorf("Failed to restore index %s: %v\n", indexName, err)
+			errs = append(errs, fmt.Errorf("index %s: %w", indexName, err))
+
+			continue
+		}
+		logger.Infoln("    ✓ Index restored successfully")
+		restoredCount++
+	}
+
+	if restoredCount == 0 && len(errs) > 0 {
+		return fmt.Errorf("failed to restore any indices: %w", errors.Join(errs...))
+	}
+
+	if len(errs) > 0 {
+		logger.Warningf("Restore completed with %d errors. Successfully restored %d/%d indices. Run the restore again to resume\n", len(errs), restoredCount, len(indices))
+
+		return nil
+	}
+
+	if err := state.Remove(); err != nil {
+		logger.Warningf("Failed to remove restore state: %v\n", err)
+	}
+	logger.Infof("✓ Restore completed successfully. Restored %d indices\n", restoredCount)
+
+	return nil
+}
+
+// Made with Bob
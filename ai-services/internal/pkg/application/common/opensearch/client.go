@@ -0,0 +1,312 @@
+package opensearch
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	// DefaultUsername is the admin user created by the OpenSearch component.
+	DefaultUsername = "admin"
+
+	// DefaultPort is the port of the OpenSearch REST API.
+	DefaultPort = 9200
+
+	// DefaultIndexPattern selects the indices written by the RAG services.
+	DefaultIndexPattern = "rag*"
+
+	// BatchSize is the number of documents fetched per scroll page and sent per bulk request.
+	BatchSize = 1000
+
+	requestTimeout = 5 * time.Minute
+)
+
+// Error is returned when OpenSearch responds with a non-2xx status.
+type Error struct {
+	StatusCode int
+	Type       string
+	Reason     string
+}
+
+func (e *Error) Error() string {
+	if e.Type == "" {
+		return fmt.Sprintf("opensearch: status %d: %s", e.StatusCode, e.Reason)
+	}
+
+	return fmt.Sprintf("opensearch: status %d: %s: %s", e.StatusCode, e.Type, e.Reason)
+}
+
+// errorResponse is the error body returned by the OpenSearch REST API.
+type errorResponse struct {
+	Error struct {
+		Type   string `json:"type"`
+		Reason string `json:"reason"`
+	} `json:"error"`
+}
+
+// Client talks to the OpenSearch REST API of an application.
+type Client struct {
+	http *resty.Client
+}
+
+// NewClient creates a client for the OpenSearch REST API at endpoint, e.g. https://10.88.0.5:9200.
+// Certificate verification is skipped because OpenSearch serves a self-signed certificate
+// generated at deployment time.
+func NewClient(endpoint, username, password string) *Client {
+	r := resty.New().
+		SetBaseURL(strings.TrimSuffix(endpoint, "/")).
+		SetBasicAuth(username, password).
+		SetTimeout(requestTimeout).
+		SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true}). //nolint:gosec
+		SetHeader("Accept", "application/json")
+
+	return &Client{http: r}
+}
+
+func (c *Client) request(ctx context.Context) *resty.Request {
+	return c.http.R().SetContext(ctx)
+}
+
+// check converts a failed request or a non-2xx response into an error.
+func check(resp *resty.Response, err error, action string) error {
+	if err != nil {
+		return fmt.Errorf("failed to %s: %w", action, err)
+	}
+
+	if !resp.IsError() {
+		return nil
+	}
+
+	osErr := &Error{StatusCode: resp.StatusCode(), Reason: strings.TrimSpace(resp.String())}
+
+	var body errorResponse
+	if json.Unmarshal(resp.Body(), &body) == nil && body.Error.Type != "" {
+		osErr.Type = body.Error.Type
+		osErr.Reason = body.Error.Reason
+	}
+
+	return fmt.Errorf("failed to %s: %w", action, osErr)
+}
+
+// ListIndices returns the names of the open indices matching any of the glob patterns, sorted.
+func (c *Client) ListIndices(ctx context.Context, patterns []string) ([]string, error) {
+	var rows []struct {
+		Index string `json:"index"`
+	}
+
+	resp, err := c.request(ctx).
+		SetQueryParams(map[string]string{"format": "json", "h": "index"}).
+		SetResult(&rows).
+		Get("/_cat/indices")
+	if err := check(resp, err, "list indices"); err != nil {
+		return nil, err
+	}
+
+	indices := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if MatchIndex(row.Index, patterns) {
+			indices = append(indices, row.Index)
+		}
+	}
+	sort.Strings(indices)
+
+	return indices, nil
+}
+
+// MatchIndex reports whether an index name matches any of the glob patterns. Hidden and system
+// indices, whose names start with a dot, only match patterns that start with a dot too.
+func MatchIndex(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if strings.HasPrefix(name, ".") && !strings.HasPrefix(pattern, ".") {
+			continue
+		}
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Patterns returns the given index patterns, or DefaultIndexPattern when there are none.
+func Patterns(patterns []string) []string {
+	if len(patterns) == 0 {
+		return []string{DefaultIndexPattern}
+	}
+
+	return patterns
+}
+
+// ValidatePatterns checks that every index pattern is a well-formed glob.
+func ValidatePatterns(patterns []string) error {
+	for _, pattern := range patterns {
+		if pattern == "" {
+			return fmt.Errorf("index pattern must not be empty")
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid index pattern %q: %w", pattern, err)
+		}
+	}
+
+	return nil
+}
+
+// GetMapping returns the raw mapping response of an index, keyed by the index name.
+func (c *Client) GetMapping(ctx context.Context, index string) (json.RawMessage, error) {
+	resp, err := c.request(ctx).
+		SetPathParam("index", index).
+		Get("/{index}/_mapping")
+	if err := check(resp, err, "get mapping of index "+index); err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(resp.Body()), nil
+}
+
+// GetSettings returns the raw settings response of an index, keyed by the index name.
+func (c *Client) GetSettings(ctx context.Context, index string) (json.RawMessage, error) {
+	resp, err := c.request(ctx).
+		SetPathParam("index", index).
+		Get("/{index}/_settings")
+	if err := check(resp, err, "get settings of index "+index); err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(resp.Body()), nil
+}
+
+// CountDocuments returns the number of documents in an index.
+func (c *Client) CountDocuments(ctx context.Context, index string) (int64, error) {
+	var result struct {
+		Count int64 `json:"count"`
+	}
+
+	resp, err := c.request(ctx).
+		SetPathParam("index", index).
+		SetResult(&result).
+		Get("/{index}/_count")
+	if err := check(resp, err, "count documents of index "+index); err != nil {
+		return 0, err
+	}
+
+	return result.Count, nil
+}
+
+// DeleteIndex deletes an index. Deleting a missing index is not an error.
+func (c *Client) DeleteIndex(ctx context.Context, index string) error {
+	resp, err := c.request(ctx).
+		SetPathParam("index", index).
+		Delete("/{index}")
+	if err == nil && resp.StatusCode() == http.StatusNotFound {
+		return nil
+	}
+
+	return check(resp, err, "delete index "+index)
+}
+
+// CreateIndex creates an index from a body holding its settings and mappings.
+func (c *Client) CreateIndex(ctx context.Context, index string, body []byte) error {
+	var result struct {
+		Acknowledged bool `json:"acknowledged"`
+	}
+
+	resp, err := c.request(ctx).
+		SetPathParam("index", index).
+		SetHeader("Content-Type", "application/json").
+		SetBody(body).
+		SetResult(&result).
+		Put("/{index}")
+	if err := check(resp, err, "create index "+index); err != nil {
+		return err
+	}
+
+	if !result.Acknowledged {
+		return fmt.Errorf("creation of index %s was not acknowledged: %s", index, resp.String())
+	}
+
+	return nil
+}
+
+// RefreshIndex makes all documents indexed so far searchable.
+func (c *Client) RefreshIndex(ctx context.Context, index string) error {
+	resp, err := c.request(ctx).
+		SetPathParam("index", index).
+		Post("/{index}/_refresh")
+
+	return check(resp, err, "refresh index "+index)
+}
+
+// bulkResponse is the subset of the bulk API response needed to report failed items.
+type bulkResponse struct {
+	Errors bool `json:"errors"`
+	Items  []map[string]struct {
+		ID     string `json:"_id"`
+		Status int    `json:"status"`
+		Error  *struct {
+			Type   string `json:"type"`
+			Reason string `json:"reason"`
+		} `json:"error"`
+	} `json:"items"`
+}
+
+// Bulk indexes documents into index with a single bulk request. Documents are indexed by ID, so
+// sending a document again overwrites it.
+func (c *Client) Bulk(ctx context.Context, index string, docs []Document) error {
+	if len(docs) == 0 {
+		return nil
+	}
+
+	var body bytes.Buffer
+	enc := json.NewEncoder(&body)
+	for _, doc := range docs {
+		action := map[string]map[string]string{"index": {"_index": index, "_id": doc.ID}}
+		if err := enc.Encode(action); err != nil {
+			return err
+		}
+		if err := enc.Encode(doc.Source); err != nil {
+			return fmt.Errorf("invalid source of document %s: %w", doc.ID, err)
+		}
+	}
+
+	var result bulkResponse
+	resp, err := c.request(ctx).
+		SetHeader("Content-Type", "application/x-ndjson").
+		SetBody(body.Bytes()).
+		SetResult(&result).
+		Post("/_bulk")
+	if err := check(resp, err, "bulk index documents into "+index); err != nil {
+		return err
+	}
+
+	if !result.Errors {
+		return nil
+	}
+
+	failed := 0
+	var first string
+	for _, item := range result.Items {
+		for _, status := range item {
+			if status.Error == nil {
+				continue
+			}
+			if failed == 0 {
+				first = fmt.Sprintf("document %s: %s: %s", status.ID, status.Error.Type, status.Error.Reason)
+			}
+			failed++
+		}
+	}
+
+	return fmt.Errorf("bulk indexing into %s failed for %d of %d documents, first error: %s", index, failed, len(docs), first)
+}
+
+// Made with Bob
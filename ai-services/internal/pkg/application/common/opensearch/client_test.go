@@ -0,0 +1,268 @@
+package opensearch
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeOpenSearch serves the subset of the OpenSearch REST API used by the client from memory.
+type fakeOpenSearch struct {
+	mu      sync.Mutex
+	indices map[string][]Document
+	created []string
+	bulked  int
+}
+
+func (f *fakeOpenSearch) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if user, pass, ok := r.BasicAuth(); !ok || user != "admin" || pass != "secret" {
+		w.WriteHeader(http.StatusUnauthorized)
+
+		return
+	}
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case r.URL.Path == "/_cat/indices":
+		rows := []map[string]string{}
+		for name := range f.indices {
+			rows = append(rows, map[string]string{"index": name})
+		}
+		writeJSON(w, rows)
+	case r.URL.Path == "/_bulk":
+		f.bulk(w, r)
+	case r.URL.Path == "/_search/scroll" && r.Method == http.MethodPost:
+		var body struct {
+			ScrollID string `json:"scroll_id"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		index, offset := parseScrollID(body.ScrollID)
+		f.page(w, index, offset)
+	case r.URL.Path == "/_search/scroll" && r.Method == http.MethodDelete:
+		writeJSON(w, map[string]bool{"succeeded": true})
+	case len(parts) == 2 && parts[1] == "_search":
+		f.page(w, parts[0], 0)
+	case len(parts) == 2 && parts[1] == "_mapping":
+		writeJSON(w, map[string]any{parts[0]: map[string]any{"mappings": map[string]any{"properties": map[string]any{}}}})
+	case len(parts) == 2 && parts[1] == "_settings":
+		writeJSON(w, map[string]any{parts[0]: map[string]any{"settings": map[string]any{"index": map[string]any{
+			"number_of_shards": "1", "uuid": "abc", "creation_date": "1", "provided_name": parts[0], "version": map[string]any{"created": "1"},
+		}}}})
+	case len(parts) == 2 && parts[1] == "_count":
+		writeJSON(w, map[string]int{"count": len(f.indices[parts[0]])})
+	case len(parts) == 2 && parts[1] == "_refresh":
+		writeJSON(w, map[string]any{})
+	case len(parts) == 1 && r.Method == http.MethodDelete:
+		if _, ok := f.indices[parts[0]]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			writeJSON(w, map[string]any{"error": map[string]string{"type": "index_not_found_exception", "reason": "no such index"}})
+
+			return
+		}
+		delete(f.indices, parts[0])
+		writeJSON(w, map[string]bool{"acknowledged": true})
+	case len(parts) == 1 && r.Method == http.MethodPut:
+		var body map[string]map[string]map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if _, ok := body["settings"]["index"]["uuid"]; ok {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(w, map[string]any{"error": map[string]string{"type": "illegal_argument_exception", "reason": "unknown setting [index.uuid]"}})
+
+			return
+		}
+		f.indices[parts[0]] = nil
+		f.created = append(f.created, parts[0])
+		writeJSON(w, map[string]bool{"acknowledged": true})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (f *fakeOpenSearch) page(w http.ResponseWriter, index string, offset int) {
+	docs := f.indices[index]
+	end := min(offset+BatchSize, len(docs))
+	hits := []Document{}
+	if offset < end {
+		hits = docs[offset:end]
+	}
+
+	writeJSON(w, map[string]any{
+		"_scroll_id": fmt.Sprintf("%s@%d", index, end),
+		"hits":       map[string]any{"hits": hits},
+	})
+}
+
+func (f *fakeOpenSearch) bulk(w http.ResponseWriter, r *http.Request) {
+	scanner := bufio.NewScanner(r.Body)
+	scanner.Buffer(make([]byte, 0, 1024*1024), 1024*1024)
+	for scanner.Scan() {
+		var action map[string]map[string]string
+		_ = json.Unmarshal(scanner.Bytes(), &action)
+		if !scanner.Scan() {
+			break
+		}
+		index := action["index"]["_index"]
+		f.indices[index] = append(f.indices[index], Document{ID: action["index"]["_id"], Source: json.RawMessage(scanner.Text())})
+		f.bulked++
+	}
+	writeJSON(w, map[string]any{"errors": false, "items": []any{}})
+}
+
+func parseScrollID(id string) (string, int) {
+	index, offset, _ := strings.Cut(id, "@")
+	var n int
+	_, _ = fmt.Sscanf(offset, "%d", &n)
+
+	return index, n
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func newFakeClient(t *testing.T, indices map[string][]Document) (*Client, *fakeOpenSearch) {
+	t.Helper()
+
+	fake := &fakeOpenSearch{indices: indices}
+	server := httptest.NewTLSServer(fake)
+	t.Cleanup(server.Close)
+
+	return NewClient(server.URL, DefaultUsername, "secret"), fake
+}
+
+func makeDocuments(n int) []Document {
+	docs := make([]Document, n)
+	for i := range docs {
+		docs[i] = Document{ID: fmt.Sprintf("doc-%d", i), Source: json.RawMessage(fmt.Sprintf(`{"n":%d}`, i))}
+	}
+
+	return docs
+}
+
+func TestMatchIndex(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		want     bool
+	}{
+		{"rag_docs", []string{"rag*"}, true},
+		{"docs", []string{"rag*"}, false},
+		{"docs-2024", []string{"rag*", "docs-*"}, true},
+		{".opendistro_security", []string{"*"}, false},
+		{".opendistro_security", []string{".opendistro*"}, true},
+	}
+
+	for _, tt := range tests {
+		if got := MatchIndex(tt.name, tt.patterns); got != tt.want {
+			t.Errorf("MatchIndex(%q, %v) = %v, want %v", tt.name, tt.patterns, got, tt.want)
+		}
+	}
+}
+
+func TestExportAndRestoreIndex(t *testing.T) {
+	ctx := context.Background()
+	const total = 2*BatchSize + 10
+
+	client, fake := newFakeClient(t, map[string][]Document{
+		"rag_docs": makeDocuments(total),
+		"other":    makeDocuments(1),
+	})
+
+	indices, err := client.ListIndices(ctx, []string{DefaultIndexPattern})
+	if err != nil {
+		t.Fatalf("ListIndices() error = %v", err)
+	}
+	if len(indices) != 1 || indices[0] != "rag_docs" {
+		t.Fatalf("ListIndices() = %v, want [rag_docs]", indices)
+	}
+
+	dir := t.TempDir()
+	var lastDone int64
+	count, err := client.ExportIndex(ctx, "rag_docs", dir, func(_ string, done, _ int64) { lastDone = done })
+	if err != nil {
+		t.Fatalf("ExportIndex() error = %v", err)
+	}
+	if count != total || lastDone != total {
+		t.Fatalf("ExportIndex() = %d documents with progress %d, want %d", count, lastDone, total)
+	}
+
+	delete(fake.indices, "rag_docs")
+
+	state, err := LoadRestoreState(filepath.Join(dir, "state.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.RestoreIndex(ctx, dir, "rag_docs", state, nil); err != nil {
+		t.Fatalf("RestoreIndex() error = %v", err)
+	}
+
+	if got := len(fake.indices["rag_docs"]); got != total {
+		t.Errorf("restored %d documents, want %d", got, total)
+	}
+	if !state.Completed["rag_docs"] {
+		t.Error("index not marked completed in restore state")
+	}
+}
+
+func TestRestoreIndexResumes(t *testing.T) {
+	ctx := context.Background()
+	const total = BatchSize + 5
+
+	client, fake := newFakeClient(t, map[string][]Document{"rag_docs": makeDocuments(total)})
+
+	dir := t.TempDir()
+	if _, err := client.ExportIndex(ctx, "rag_docs", dir, nil); err != nil {
+		t.Fatalf("ExportIndex() error = %v", err)
+	}
+
+	statePath := filepath.Join(dir, "state.json")
+	state, err := LoadRestoreState(statePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := state.record("rag_docs", BatchSize); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reload the state as a new restore run would.
+	state, err = LoadRestoreState(statePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.RestoreIndex(ctx, dir, "rag_docs", state, nil); err != nil {
+		t.Fatalf("RestoreIndex() error = %v", err)
+	}
+
+	if len(fake.created) != 0 {
+		t.Errorf("resumed restore recreated indices %v", fake.created)
+	}
+	if fake.bulked != total-BatchSize {
+		t.Errorf("resumed restore sent %d documents, want %d", fake.bulked, total-BatchSize)
+	}
+}
+
+func TestCreateIndexBodyDropsGeneratedSettings(t *testing.T) {
+	mapping := []byte(`{"rag":{"mappings":{"properties":{"text":{"type":"text"}}}}}`)
+	settings := []byte(`{"rag":{"settings":{"index":{"number_of_shards":"1","uuid":"x","creation_date":"1","provided_name":"rag","version":{"created":"1"}}}}}`)
+
+	body, err := CreateIndexBody("rag", mapping, settings)
+	if err != nil {
+		t.Fatalf("CreateIndexBody() error = %v", err)
+	}
+
+	want := `{"mappings":{"properties":{"text":{"type":"text"}}},"settings":{"index":{"number_of_shards":"1"}}}`
+	if string(body) != want {
+		t.Errorf("CreateIndexBody() = %s, want %s", body, want)
+	}
+}
@@ -0,0 +1,181 @@
+package opensearch
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+)
+
+const (
+	scrollKeepAlive    = "5m"
+	clearScrollTimeout = 10 * time.Second
+)
+
+// Document is a single document of an index as stored in a backup.
+type Document struct {
+	Index  string          `json:"_index,omitempty"`
+	ID     string          `json:"_id"`
+	Source json.RawMessage `json:"_source"`
+}
+
+// ProgressFunc is called after every batch of documents with the number of documents handled so
+// far and the total number of documents of the index.
+type ProgressFunc func(index string, done, total int64)
+
+// MappingFile, SettingsFile and DataFile return the names of the files holding an index in a
+// backup directory.
+func MappingFile(index string) string  { return index + "_mapping.json" }
+func SettingsFile(index string) string { return index + "_settings.json" }
+func DataFile(index string) string     { return index + "_data.json" }
+
+type scrollResponse struct {
+	ScrollID string `json:"_scroll_id"`
+	Hits     struct {
+		Hits []Document `json:"hits"`
+	} `json:"hits"`
+}
+
+// ScrollDocuments reads every document of an index in pages of BatchSize with the scroll API
+// and calls fn for each page. The scroll context is released when done.
+func (c *Client) ScrollDocuments(ctx context.Context, index string, fn func([]Document) error) error {
+	var page scrollResponse
+	resp, err := c.request(ctx).
+		SetPathParam("index", index).
+		SetQueryParam("scroll", scrollKeepAlive).
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string]any{
+			"size":  BatchSize,
+			"sort":  []string{"_doc"},
+			"query": map[string]any{"match_all": map[string]any{}},
+		}).
+		SetResult(&page).
+		Post("/{index}/_search")
+	if err := check(resp, err, "start scroll of index "+index); err != nil {
+		return err
+	}
+
+	defer func() {
+		if page.ScrollID != "" {
+			c.clearScroll(page.ScrollID)
+		}
+	}()
+
+	for len(page.Hits.Hits) > 0 {
+		if err := fn(page.Hits.Hits); err != nil {
+			return err
+		}
+
+		scrollID := page.ScrollID
+		page = scrollResponse{}
+		resp, err := c.request(ctx).
+			SetHeader("Content-Type", "application/json").
+			SetBody(map[string]string{"scroll": scrollKeepAlive, "scroll_id": scrollID}).
+			SetResult(&page).
+			Post("/_search/scroll")
+		if err := check(resp, err, "scroll index "+index); err != nil {
+			page.ScrollID = scrollID
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// clearScroll releases a scroll context. Failures are ignored as the context expires anyway.
+func (c *Client) clearScroll(scrollID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), clearScrollTimeout)
+	defer cancel()
+
+	_, _ = c.request(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string][]string{"scroll_id": {scrollID}}).
+		Delete("/_search/scroll")
+}
+
+// ExportIndex writes the mapping, settings and documents of an index to dir and returns the
+// number of exported documents. Documents are streamed to a JSON array so that large indices
+// are never held in memory.
+func (c *Client) ExportIndex(ctx context.Context, index, dir string, progress ProgressFunc) (int64, error) {
+	mapping, err := c.GetMapping(ctx, index)
+	if err != nil {
+		return 0, err
+	}
+	if err := os.WriteFile(filepath.Join(dir, MappingFile(index)), mapping, constants.FilePerm); err != nil {
+		return 0, fmt.Errorf("failed to write mapping of index %s: %w", index, err)
+	}
+
+	settings, err := c.GetSettings(ctx, index)
+	if err != nil {
+		return 0, err
+	}
+	if err := os.WriteFile(filepath.Join(dir, SettingsFile(index)), settings, constants.FilePerm); err != nil {
+		return 0, fmt.Errorf("failed to write settings of index %s: %w", index, err)
+	}
+
+	total, err := c.CountDocuments(ctx, index)
+	if err != nil {
+		return 0, err
+	}
+
+	return c.exportDocuments(ctx, index, filepath.Join(dir, DataFile(index)), total, progress)
+}
+
+func (c *Client) exportDocuments(ctx context.Context, index, dataFile string, total int64, progress ProgressFunc) (int64, error) {
+	file, err := os.OpenFile(dataFile, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, constants.FilePerm)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create data file of index %s: %w", index, err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	w := bufio.NewWriter(file)
+	if _, err := w.WriteString("["); err != nil {
+		return 0, err
+	}
+
+	var done int64
+	err = c.ScrollDocuments(ctx, index, func(docs []Document) error {
+		for _, doc := range docs {
+			if done > 0 {
+				if _, err := w.WriteString(",\n"); err != nil {
+					return err
+				}
+			}
+			data, err := json.Marshal(doc)
+			if err != nil {
+				return fmt.Errorf("failed to encode document %s: %w", doc.ID, err)
+			}
+			if _, err := w.Write(data); err != nil {
+				return err
+			}
+			done++
+		}
+		if progress != nil {
+			progress(index, done, total)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return done, err
+	}
+
+	if _, err := w.WriteString("]\n"); err != nil {
+		return done, err
+	}
+	if err := w.Flush(); err != nil {
+		return done, fmt.Errorf("failed to write data file of index %s: %w", index, err)
+	}
+
+	return done, file.Close()
+}
+
+// Made with Bob
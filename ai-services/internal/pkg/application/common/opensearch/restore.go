@@ -0,0 +1,300 @@
+package opensearch
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+)
+
+// volatileIndexSettings are generated by OpenSearch when an index is created and are rejected
+// when creating an index.
+var volatileIndexSettings = []string{"creation_date", "uuid", "version", "provided_name"}
+
+// RestoreState records how far a restore got so that an interrupted restore can resume. Indices
+// in Completed are skipped, and documents of a partially restored index are only sent again
+// from the last acknowledged batch on.
+type RestoreState struct {
+	path      string
+	Restored  map[string]int64 `json:"restored"`
+	Completed map[string]bool  `json:"completed"`
+}
+
+// LoadRestoreState reads the restore state kept at path, or starts a new one when it does not
+// exist. An empty path keeps the state in memory only.
+func LoadRestoreState(path string) (*RestoreState, error) {
+	state := &RestoreState{path: path, Restored: map[string]int64{}, Completed: map[string]bool{}}
+	if path == "" {
+		return state, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read restore state: %w", err)
+	}
+
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("failed to parse restore state %s: %w", path, err)
+	}
+	if state.Restored == nil {
+		state.Restored = map[string]int64{}
+	}
+	if state.Completed == nil {
+		state.Completed = map[string]bool{}
+	}
+
+	return state, nil
+}
+
+// Resumed reports whether the state carries progress of an earlier restore.
+func (s *RestoreState) Resumed() bool {
+	return len(s.Restored) > 0 || len(s.Completed) > 0
+}
+
+// Remove deletes the persisted state once the restore has finished.
+func (s *RestoreState) Remove() error {
+	if s.path == "" {
+		return nil
+	}
+	if err := os.Remove(s.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *RestoreState) record(index string, restored int64) error {
+	s.Restored[index] = restored
+
+	return s.save()
+}
+
+func (s *RestoreState) complete(index string) error {
+	delete(s.Restored, index)
+	s.Completed[index] = true
+
+	return s.save()
+}
+
+func (s *RestoreState) save() error {
+	if s.path == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, constants.FilePerm); err != nil {
+		return fmt.Errorf("failed to write restore state: %w", err)
+	}
+
+	return os.Rename(tmp, s.path)
+}
+
+// CreateIndexBody builds the body to create an index from the mapping and settings files of a
+// backup, dropping the settings OpenSearch generates itself.
+func CreateIndexBody(index string, mappingData, settingsData []byte) ([]byte, error) {
+	var mapping map[string]struct {
+		Mappings json.RawMessage `json:"mappings"`
+	}
+	if err := json.Unmarshal(mappingData, &mapping); err != nil {
+		return nil, fmt.Errorf("invalid mapping of index %s: %w", index, err)
+	}
+
+	var settings map[string]struct {
+		Settings struct {
+			Index map[string]any `json:"index"`
+		} `json:"settings"`
+	}
+	if err := json.Unmarshal(settingsData, &settings); err != nil {
+		return nil, fmt.Errorf("invalid settings of index %s: %w", index, err)
+	}
+
+	indexMapping, ok := mapping[index]
+	if !ok {
+		return nil, fmt.Errorf("mapping file does not describe index %s", index)
+	}
+	indexSettings, ok := settings[index]
+	if !ok {
+		return nil, fmt.Errorf("settings file does not describe index %s", index)
+	}
+
+	for _, key := range volatileIndexSettings {
+		delete(indexSettings.Settings.Index, key)
+	}
+
+	body := map[string]any{
+		"settings": map[string]any{"index": indexSettings.Settings.Index},
+	}
+	if len(indexMapping.Mappings) > 0 {
+		body["mappings"] = indexMapping.Mappings
+	}
+
+	return json.Marshal(body)
+}
+
+// RestoreIndex recreates an index from the files in dir and bulk loads its documents. An index
+// already completed in state is left alone, and a partially restored one is resumed without
+// recreating it.
+func (c *Client) RestoreIndex(ctx context.Context, dir, index string, state *RestoreState, progress ProgressFunc) error {
+	if state.Completed[index] {
+		return nil
+	}
+
+	skip := state.Restored[index]
+	if skip == 0 {
+		if err := c.recreateIndex(ctx, dir, index); err != nil {
+			return err
+		}
+	}
+
+	total, err := c.loadDocuments(ctx, filepath.Join(dir, DataFile(index)), index, skip, state, progress)
+	if err != nil {
+		return err
+	}
+
+	if err := c.RefreshIndex(ctx, index); err != nil {
+		return err
+	}
+
+	if progress != nil {
+		progress(index, total, total)
+	}
+
+	return state.complete(index)
+}
+
+func (c *Client) recreateIndex(ctx context.Context, dir, index string) error {
+	mapping, err := os.ReadFile(filepath.Join(dir, MappingFile(index)))
+	if err != nil {
+		return fmt.Errorf("failed to read mapping of index %s: %w", index, err)
+	}
+	settings, err := os.ReadFile(filepath.Join(dir, SettingsFile(index)))
+	if err != nil {
+		return fmt.Errorf("failed to read settings of index %s: %w", index, err)
+	}
+
+	body, err := CreateIndexBody(index, mapping, settings)
+	if err != nil {
+		return err
+	}
+
+	if err := c.DeleteIndex(ctx, index); err != nil {
+		return err
+	}
+
+	return c.CreateIndex(ctx, index, body)
+}
+
+// loadDocuments bulk indexes the documents of a data file after the first skip ones, recording
+// progress in state after every batch. It returns the number of documents in the file.
+func (c *Client) loadDocuments(ctx context.Context, dataFile, index string, skip int64, state *RestoreState, progress ProgressFunc) (int64, error) {
+	file, err := os.Open(dataFile)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open data file of index %s: %w", index, err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	total, err := countDocuments(file)
+	if err != nil {
+		return 0, fmt.Errorf("invalid data file of index %s: %w", index, err)
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	done := skip
+	batch := make([]Document, 0, BatchSize)
+	flush := func() error {
+		if err := c.Bulk(ctx, index, batch); err != nil {
+			return err
+		}
+		done += int64(len(batch))
+		batch = batch[:0]
+		if progress != nil {
+			progress(index, done, total)
+		}
+
+		return state.record(index, done)
+	}
+
+	var seen int64
+	err = readDocuments(file, func(doc Document) error {
+		seen++
+		if seen <= skip {
+			return nil
+		}
+
+		batch = append(batch, doc)
+		if len(batch) < BatchSize {
+			return nil
+		}
+
+		return flush()
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if len(batch) > 0 {
+		if err := flush(); err != nil {
+			return 0, err
+		}
+	}
+
+	return total, nil
+}
+
+// readDocuments decodes a JSON array of documents one element at a time.
+func readDocuments(r io.Reader, fn func(Document) error) error {
+	dec := json.NewDecoder(bufio.NewReader(r))
+
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("failed to read documents: %w", err)
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("documents must be a JSON array")
+	}
+
+	for dec.More() {
+		var doc Document
+		if err := dec.Decode(&doc); err != nil {
+			return fmt.Errorf("failed to decode document: %w", err)
+		}
+		if err := fn(doc); err != nil {
+			return err
+		}
+	}
+
+	_, err = dec.Token()
+
+	return err
+}
+
+func countDocuments(r io.Reader) (int64, error) {
+	var n int64
+	err := readDocuments(r, func(Document) error {
+		n++
+
+		return nil
+	})
+
+	return n, err
+}
+
+// Made with Bob
@@ -9,8 +9,7 @@ import (
 	"strings"
 
 	"github.com/go-resty/resty/v2"
-	podmanrestore "github.com/project-ai-services/ai-services/internal/pkg/application/podman/restore"
-	"github.com/project-ai-services/ai-services/internal/pkg/catalog/config"
+	commonBackup "github.com/project-ai-services/ai-services/internal/pkg/application/common/backup"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	runtimeTypes "github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
@@ -151,7 +150,7 @@ type DigitizeRestoreClient struct {
 }
 
 // NewDigitizeRestoreClient creates a new digitize restore client.
-func NewDigitizeRestoreClient(serviceURL string) *DigitizeRestoreClient {
+func NewDigitizeRestoreClient(serviceURL string) (*DigitizeRestoreClient, error) {
 	client := resty.New().SetBaseURL(serviceURL)
 
 	// Check runtime type
@@ -164,18 +163,19 @@ func NewDigitizeRestoreClient(serviceURL string) *DigitizeRestoreClient {
 			InsecureSkipVerify: true,
 		})
 	case runtimeTypes.RuntimeTypePodman:
-		// For Podman, check the insecure flag from catalog credentials
-		creds, err := config.Load()
-		if err == nil && creds.Insecure {
-			client.SetTLSClientConfig(&tls.Config{
-				InsecureSkipVerify: true,
-			})
+		// For Podman, apply the TLS settings of the active catalog context
+		tlsConfig, err := commonBackup.CatalogTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		if tlsConfig != nil {
+			client.SetTLSClientConfig(tlsConfig)
 		}
 	}
 
 	return &DigitizeRestoreClient{
 		client: client,
-	}
+	}, nil
 }
 
 // CallImportAPI calls the digitize service Import API with the metadata payload.
@@ -252,7 +252,7 @@ func logImportWarnings(importResponse map[string]interface{}) {
 }
 
 func GetDigitizeData(backupFile string) (map[string]interface{}, error) {
-	backupDir, cleanup, err := podmanrestore.ExtractAndLocateBackup(backupFile)
+	backupDir, cleanup, err := commonBackup.ExtractAndLocateBackup(backupFile)
 	if err != nil {
 		return nil, err
 	}
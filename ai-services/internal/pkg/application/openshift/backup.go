@@ -2,26 +2,37 @@ package openshift
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"path/filepath"
 	"strings"
 	"time"
 
 	commonBackup "github.com/project-ai-services/ai-services/internal/pkg/application/common/backup"
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common/opensearch"
 	"github.com/project-ai-services/ai-services/internal/pkg/application/openshift/backup"
 	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 )
 
+// errCatalogApplication is returned for backups and restores of applications deployed by the
+// catalog API server. OpenShift backups find an application by its namespace and labels, which
+// follow the CLI conventions and not those of catalog deployments.
+var errCatalogApplication = errors.New("backup and restore of catalog applications are not supported on OpenShift")
+
 // Backup creates a backup of application data for OpenShift runtime.
 func (o *OpenshiftApplication) Backup(ctx context.Context, opts types.BackupOptions) error {
 	logger.Infof("Starting backup for application: %s\n", opts.Name)
 	logger.Infof("Target: %s\n", opts.Target)
 
+	if opts.Application != nil {
+		return errCatalogApplication
+	}
+
 	// Execute backup based on target
 	switch opts.Target {
 	case "opensearch":
-		return o.backupOpenSearch(ctx, opts.Name, opts.BackupFile)
+		return o.backupOpenSearch(ctx, opts.Name, opts.BackupFile, opts.IndexPatterns)
 	case "digitize":
 		return o.backupDigitize(ctx, opts.Name, opts.BackupFile)
 	default:
@@ -29,8 +40,8 @@ func (o *OpenshiftApplication) Backup(ctx context.Context, opts types.BackupOpti
 	}
 }
 
-// backupOpenSearch performs OpenSearch backup using a sidecar pod.
-func (o *OpenshiftApplication) backupOpenSearch(ctx context.Context, appName, backupFile string) error {
+// backupOpenSearch backs up the OpenSearch indices matching patterns through its REST API.
+func (o *OpenshiftApplication) backupOpenSearch(ctx context.Context, appName, backupFile string, patterns []string) error {
 	logger.Infof("Backing up OpenSearch data for application: %s\n", appName)
 
 	// Generate backup filename if not provided
@@ -51,7 +62,7 @@ func (o *OpenshiftApplication) backupOpenSearch(ctx context.Context, appName, ba
 	}
 
 	// Perform backup using the backup package
-	if err := backup.BackupOpenSearch(ctx, appName, absBackupFile); err != nil {
+	if err := backup.BackupOpenSearch(ctx, appName, absBackupFile, opensearch.Patterns(patterns)); err != nil {
 		return err
 	}
 
@@ -80,7 +91,10 @@ func (o *OpenshiftApplication) backupDigitize(ctx context.Context, appName, back
 	logger.Infof("Digitize API URL: %s\n", digitizeURL)
 
 	// Create digitize backup client and call Export API
-	client := commonBackup.NewDigitizeBackupClient(digitizeURL)
+	client, err := commonBackup.NewDigitizeBackupClient(digitizeURL)
+	if err != nil {
+		return err
+	}
 
 	exportResponse, err := client.CallExportAPI()
 	if err != nil {
@@ -1,14 +1,24 @@
 package common
 
 import (
+	"bufio"
+	"bytes"
+	"context"
 	"encoding/base64"
 	"fmt"
 	"os/exec"
 	"strings"
 	"time"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common/opensearch"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
-	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+const (
+	portForwardTimeout = 30 * time.Second
+
+	// forwardLineFields is the number of fields of the "Forwarding from <addr> -> <port>" line.
+	forwardLineFields = 5
 )
 
 // FindOpenSearchPod finds the OpenSearch pod in OpenShift.
@@ -46,60 +56,6 @@ func GetOpenSearchService(appID, namespace string) (string, error) {
 	return strings.TrimSpace(string(output)), nil
 }
 
-// CreateSidecarPod creates a sidecar pod in OpenShift.
-// Returns a boolean indicating if the pod was created (for cleanup purposes) and an error.
-func CreateSidecarPod(podName, namespace string) (bool, error) {
-	podYAML := fmt.Sprintf(`apiVersion: v1
-kind: Pod
-metadata:
-  name: %s
-  namespace: %s
-spec:
-  containers:
-  - name: worker
-    image: %s
-    command: ["sleep", "3600"]
-    securityContext:
-      runAsUser: 0
-  restartPolicy: Never
-`, podName, namespace, vars.ToolImage)
-
-	cmd := exec.Command("oc", "apply", "-f", "-")
-	cmd.Stdin = strings.NewReader(podYAML)
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		return false, fmt.Errorf("failed to create sidecar pod: %w, output: %s", err, string(output))
-	}
-
-	// Pod is now created, so it needs cleanup even if subsequent steps fail
-	podCreated := true
-
-	// Wait for pod to be ready
-	logger.Infoln("Waiting for pod to be ready...")
-	cmd = exec.Command("oc", "wait", "--for=condition=Ready",
-		fmt.Sprintf("pod/%s", podName), "-n", namespace, "--timeout=120s")
-	output, err = cmd.CombinedOutput()
-	if err != nil {
-		return podCreated, fmt.Errorf("pod failed to become ready: %w, output: %s", err, string(output))
-	}
-
-	logger.Infoln("Sidecar pod is ready")
-
-	return podCreated, nil
-}
-
-// CleanupPod deletes an OpenShift pod.
-func CleanupPod(podName, namespace string) {
-	logger.Infoln("Cleaning up sidecar pod...")
-	cmd := exec.Command("oc", "delete", "pod", podName, "-n", namespace, "--wait=false")
-	output, err := cmd.CombinedOutput()
-	if err != nil {
-		logger.Warningf("Failed to cleanup sidecar pod %s: %v, output: %s\n", podName, err, string(output))
-	} else {
-		logger.Infoln("Sidecar pod cleanup initiated")
-	}
-}
-
 // GetOpenSearchPasswordFromSecret retrieves the OpenSearch password from the OpenShift secret.
 func GetOpenSearchPasswordFromSecret(namespace string) (string, error) {
 	// The secret name is "opensearch-credentials" in OpenShift
@@ -128,31 +84,95 @@ func GetOpenSearchPasswordFromSecret(namespace string) (string, error) {
 	return string(passwordBytes), nil
 }
 
-// ExecInPod executes a command in an OpenShift pod.
-func ExecInPod(podName, namespace, script string) error {
-	cmd := exec.Command("oc", "exec", podName, "-n", namespace, "--", "sh", "-c", script)
-	output, err := cmd.CombinedOutput()
+// PortForwardService forwards a free local port to a port of a service with oc port-forward
+// and returns the local address. Call stop to end the forwarding.
+func PortForwardService(ctx context.Context, namespace, service string, port int) (string, func(), error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	cmd := exec.CommandContext(ctx, "oc", "port-forward", "-n", namespace, "--address", "127.0.0.1",
+		"svc/"+service, fmt.Sprintf(":%d", port))
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	stdout, err := cmd.StdoutPipe()
 	if err != nil {
-		return fmt.Errorf("command failed: %w, output: %s", err, string(output))
+		cancel()
+
+		return "", nil, err
+	}
+
+	if err := cmd.Start(); err != nil {
+		cancel()
+
+		return "", nil, fmt.Errorf("failed to start port forwarding: %w", err)
 	}
 
-	return nil
+	stop := func() {
+		cancel()
+		_ = cmd.Wait()
+	}
+
+	// oc prints "Forwarding from 127.0.0.1:43567 -> 9200" once the tunnel is ready, and a line
+	// per connection afterwards, so stdout is drained until the process exits.
+	ready := make(chan string, 1)
+	exited := make(chan struct{})
+	go func() {
+		defer close(exited)
+
+		scanner := bufio.NewScanner(stdout)
+		for scanner.Scan() {
+			fields := strings.Fields(scanner.Text())
+			if len(fields) >= forwardLineFields && fields[0] == "Forwarding" && fields[1] == "from" {
+				select {
+				case ready <- fields[2]:
+				default:
+				}
+			}
+		}
+	}()
+
+	select {
+	case addr := <-ready:
+		return addr, stop, nil
+	case <-exited:
+		stop()
+
+		return "", nil, fmt.Errorf("port forwarding to service %s failed: %s", service, strings.TrimSpace(stderr.String()))
+	case <-time.After(portForwardTimeout):
+		stop()
+
+		return "", nil, fmt.Errorf("timed out waiting for port forwarding to service %s", service)
+	}
 }
 
-// ExecInPodWithOutput executes a command in an OpenShift pod and returns the output.
-func ExecInPodWithOutput(podName, namespace, script string) (string, error) {
-	cmd := exec.Command("oc", "exec", podName, "-n", namespace, "--", "sh", "-c", script)
-	output, err := cmd.CombinedOutput()
+// ConnectOpenSearch forwards a local port to the OpenSearch service of an application and returns a
+// client for it. Call stop to end the forwarding.
+func ConnectOpenSearch(ctx context.Context, applicationID string) (*opensearch.Client, func(), error) {
+	namespace, podName, err := FindOpenSearchPod(applicationID)
 	if err != nil {
-		return string(output), fmt.Errorf("command failed: %w", err)
+		return nil, nil, err
 	}
 
-	return string(output), nil
-}
+	logger.Infof("Namespace: %s\n", namespace)
+	logger.Infof("OpenSearch Pod: %s\n", podName)
+
+	serviceName, err := GetOpenSearchService(applicationID, namespace)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get OpenSearch service: %w", err)
+	}
+
+	osPassword, err := GetOpenSearchPasswordFromSecret(namespace)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get OpenSearch password: %w", err)
+	}
+
+	addr, stop, err := PortForwardService(ctx, namespace, serviceName, opensearch.DefaultPort)
+	if err != nil {
+		return nil, nil, err
+	}
+	logger.Infof("Forwarding %s to OpenSearch service %s\n", addr, serviceName)
 
-// GenerateSidecarName generates a unique sidecar pod name.
-func GenerateSidecarName(prefix string) string {
-	return fmt.Sprintf("%s-%d", prefix, time.Now().Unix())
+	return opensearch.NewClient("https://"+addr, opensearch.DefaultUsername, osPassword), stop, nil
 }
 
 // Made with Bob
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common/opensearch"
 	commonrestore "github.com/project-ai-services/ai-services/internal/pkg/application/common/restore"
 	"github.com/project-ai-services/ai-services/internal/pkg/application/openshift/restore"
 	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
@@ -18,6 +19,10 @@ func (o *OpenshiftApplication) Restore(ctx context.Context, opts types.RestoreOp
 	logger.Infof("Target: %s\n", opts.Target)
 	logger.Infof("Backup file: %s\n", opts.BackupFile)
 
+	if opts.Application != nil {
+		return errCatalogApplication
+	}
+
 	// For OpenShift, use the name as-is (namespace convention)
 	applicationID := opts.Name
 
@@ -30,7 +35,7 @@ func (o *OpenshiftApplication) Restore(ctx context.Context, opts types.RestoreOp
 	// Execute restore based on target
 	switch opts.Target {
 	case "opensearch":
-		return restore.RestoreOpenSearch(ctx, applicationID, absFilename)
+		return restore.RestoreOpenSearch(ctx, applicationID, absFilename, opensearch.Patterns(opts.IndexPatterns))
 	case "digitize":
 		return o.restoreDigitize(ctx, opts.Name, absFilename)
 	default:
@@ -57,7 +62,10 @@ func (o *OpenshiftApplication) restoreDigitize(ctx context.Context, appName, bac
 	logger.Infof("Digitize API URL: %s\n", digitizeURL)
 
 	// Create digitize restore client and call Import API
-	client := commonrestore.NewDigitizeRestoreClient(digitizeURL)
+	client, err := commonrestore.NewDigitizeRestoreClient(digitizeURL)
+	if err != nil {
+		return err
+	}
 	if err := client.CallImportAPI(importPayload); err != nil {
 		return err
 	}
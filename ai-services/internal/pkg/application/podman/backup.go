@@ -8,6 +8,7 @@ import (
 	"time"
 
 	commonBackup "github.com/project-ai-services/ai-services/internal/pkg/application/common/backup"
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common/opensearch"
 	"github.com/project-ai-services/ai-services/internal/pkg/application/podman/backup"
 	"github.com/project-ai-services/ai-services/internal/pkg/application/podman/common"
 	"github.com/project-ai-services/ai-services/internal/pkg/application/podman/restore"
@@ -25,23 +26,22 @@ func (p *PodmanApplication) Backup(ctx context.Context, opts types.BackupOptions
 	// Validate target
 	switch opts.Target {
 	case "opensearch":
-		return p.backupOpenSearch(ctx, opts.Name, opts.BackupFile)
+		return p.backupOpenSearch(ctx, opts.Name, opts.BackupFile, opts.IndexPatterns, opts.Application)
 	case "digitize":
-		return p.backupDigitize(ctx, opts.Name, opts.BackupFile)
+		return p.backupDigitize(ctx, opts.Name, opts.BackupFile, opts.Application)
 	default:
 		return fmt.Errorf("unsupported backup target: %s", opts.Target)
 	}
 }
 
-// backupOpenSearch performs OpenSearch backup using a sidecar container.
-func (p *PodmanApplication) backupOpenSearch(ctx context.Context, appName, backupFile string) error {
+// backupOpenSearch backs up the OpenSearch indices matching patterns through its REST API.
+func (p *PodmanApplication) backupOpenSearch(ctx context.Context, appName, backupFile string, patterns []string, appDetails *catalogTypes.Application) error {
 	logger.Infof("Backing up OpenSearch data for application: %s\n", appName)
-	logger.Infoln("OpenSearch Backup (Sidecar Container Approach)")
 
 	// Get application details from catalog API
-	appDetails, err := cliUtils.GetAppDetailsWithComponents(appName)
+	appDetails, err := resolveAppDetails(appName, appDetails)
 	if err != nil {
-		return fmt.Errorf("failed to get application details: %w", err)
+		return err
 	}
 	logger.Infof("Application ID: %s\n", appDetails.ID)
 
@@ -85,7 +85,7 @@ func (p *PodmanApplication) backupOpenSearch(ctx context.Context, appName, backu
 	logger.Infof("Pod ID: %s\n", podID)
 
 	// Perform backup using the backup package
-	if err := backup.BackupOpenSearch(podmanCtx, podID, absBackupFile); err != nil {
+	if err := backup.BackupOpenSearch(podmanCtx, containerName, absBackupFile, opensearch.Patterns(patterns)); err != nil {
 		return err
 	}
 
@@ -94,13 +94,13 @@ func (p *PodmanApplication) backupOpenSearch(ctx context.Context, appName, backu
 	return nil
 }
 
-func (p *PodmanApplication) backupDigitize(ctx context.Context, appName, backupFile string) error {
+func (p *PodmanApplication) backupDigitize(ctx context.Context, appName, backupFile string, appDetails *catalogTypes.Application) error {
 	logger.Infof("Backing up digitize metadata for application: %s\n", appName)
 	logger.Infoln("Digitize Export (API-based Approach)")
 
-	appDetails, err := cliUtils.GetAppDetailsWithComponents(appName)
+	appDetails, err := resolveAppDetails(appName, appDetails)
 	if err != nil {
-		return fmt.Errorf("failed to get application details: %w", err)
+		return err
 	}
 	logger.Infof("Application ID: %s\n", appDetails.ID)
 
@@ -118,7 +118,10 @@ func (p *PodmanApplication) backupDigitize(ctx context.Context, appName, backupF
 	logger.Infof("Digitize API URL: %s\n", digitizeURL)
 
 	// Create digitize backup client and call Export API
-	client := commonBackup.NewDigitizeBackupClient(digitizeURL)
+	client, err := commonBackup.NewDigitizeBackupClient(digitizeURL)
+	if err != nil {
+		return err
+	}
 	exportResponse, err := client.CallExportAPI()
 	if err != nil {
 		return err
@@ -160,4 +163,19 @@ func logDigitizeBackupSummary(exportResponse *commonBackup.DigitizeExportRespons
 
 var _ *catalogTypes.Application
 
+// resolveAppDetails returns the given application details, or fetches them from the
+// catalog API when the caller did not supply them.
+func resolveAppDetails(appName string, appDetails *catalogTypes.Application) (*catalogTypes.Application, error) {
+	if appDetails != nil {
+		return appDetails, nil
+	}
+
+	appDetails, err := cliUtils.GetAppDetailsWithComponents(appName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get application details: %w", err)
+	}
+
+	return appDetails, nil
+}
+
 // Made with Bob
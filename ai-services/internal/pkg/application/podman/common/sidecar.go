@@ -3,23 +3,22 @@ package common
 import (
 	"context"
 	"fmt"
-	"os/exec"
+	"net"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/containers/podman/v5/pkg/bindings/containers"
 	"github.com/containers/podman/v5/pkg/bindings/pods"
 	"github.com/containers/podman/v5/pkg/bindings/secrets"
-	"github.com/containers/podman/v5/pkg/specgen"
 	"github.com/google/uuid"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common/opensearch"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
-	"github.com/project-ai-services/ai-services/internal/pkg/utils"
-	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
 const (
-	secretKeyValueParts    = 2
-	podmanExecPrefixLength = 2 // "exec" and containerID
+	secretKeyValueParts = 2
 )
 
 // FindContainerAndPod finds the container and its pod ID using the template ID.
@@ -83,55 +82,55 @@ func GetPodID(ctx context.Context, containerName string) (string, error) {
 	return podID, nil
 }
 
-// CreateAndStartSidecar creates and starts a sidecar container.
-func CreateAndStartSidecar(ctx context.Context, sidecarName, podID string) (string, error) {
-	logger.Infoln("Starting sidecar container...")
-
-	s := &specgen.SpecGenerator{
-		ContainerBasicConfig: specgen.ContainerBasicConfig{
-			Name:    sidecarName,
-			Remove:  utils.BoolPtr(true), // Auto-remove container when stopped
-			Command: []string{"sleep", "3600"},
-			Pod:     podID,
-		},
-		ContainerStorageConfig: specgen.ContainerStorageConfig{
-			Image: vars.ToolImage,
-		},
-		ContainerHealthCheckConfig: specgen.ContainerHealthCheckConfig{
-			// Set HealthConfig to nil to disable health checks
-			HealthConfig: nil,
-			// Set HealthLogDestination to /tmp to satisfy directory requirement
-			HealthLogDestination: "/tmp",
-		},
-	}
-
-	createResponse, err := containers.CreateWithSpec(ctx, s, nil)
+// GetOpenSearchEndpoint returns the URL of the OpenSearch REST API of a container on the pod
+// network, the same network the Caddy proxy uses to reach application pods.
+func GetOpenSearchEndpoint(ctx context.Context, containerName string) (string, error) {
+	containerData, err := containers.Inspect(ctx, containerName, nil)
 	if err != nil {
-		return "", fmt.Errorf("failed to create sidecar container: %w", err)
+		return "", fmt.Errorf("failed to inspect container: %w", err)
 	}
 
-	containerID := createResponse.ID
-	if err := containers.Start(ctx, containerID, nil); err != nil {
-		return "", fmt.Errorf("failed to start sidecar container: %w", err)
+	if containerData.Pod == "" {
+		return "", fmt.Errorf("container %s is not part of a pod", containerName)
 	}
 
-	return containerID, nil
-}
-
-// ExecInContainer executes a command in a container using podman exec command.
-func ExecInContainer(ctx context.Context, containerID string, cmd []string) error {
-	// Build podman exec command with preallocated capacity
-	args := make([]string, 0, podmanExecPrefixLength+len(cmd))
-	args = append(args, "exec", containerID)
-	args = append(args, cmd...)
+	podData, err := pods.Inspect(ctx, containerData.Pod, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to inspect pod: %w", err)
+	}
 
-	execCmd := exec.CommandContext(ctx, "podman", args...)
-	output, err := execCmd.CombinedOutput()
+	// Containers of a pod share the network namespace of its infra container.
+	infraData, err := containers.Inspect(ctx, podData.InfraContainerID, nil)
 	if err != nil {
-		return fmt.Errorf("command failed: %w, output: %s", err, string(output))
+		return "", fmt.Errorf("failed to inspect infra container of pod %s: %w", podData.Name, err)
+	}
+
+	if infraData.NetworkSettings == nil {
+		return "", fmt.Errorf("pod %s has no network settings", podData.Name)
+	}
+
+	ip := infraData.NetworkSettings.IPAddress
+	if ip == "" {
+		names := make([]string, 0, len(infraData.NetworkSettings.Networks))
+		for name := range infraData.NetworkSettings.Networks {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			if network := infraData.NetworkSettings.Networks[name]; network != nil && network.IPAddress != "" {
+				ip = network.IPAddress
+
+				break
+			}
+		}
+	}
+
+	if ip == "" {
+		return "", fmt.Errorf("pod %s has no IP address on a podman network", podData.Name)
 	}
 
-	return nil
+	return "https://" + net.JoinHostPort(ip, strconv.Itoa(opensearch.DefaultPort)), nil
 }
 
 // GetOpenSearchPasswordFromSecret retrieves the OpenSearch password from the Podman secret using SDK.
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"slices"
 	"strconv"
 	"sync"
@@ -21,6 +22,7 @@ import (
 	"github.com/project-ai-services/ai-services/internal/pkg/models"
 	"github.com/project-ai-services/ai-services/internal/pkg/specs"
 	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
+	"github.com/project-ai-services/ai-services/internal/pkg/transfer"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
@@ -170,16 +172,25 @@ func (p *PodmanApplication) downloadModels(ctx context.Context, templateName, ap
 
 	logger.Infoln("Downloading models required for application template " + templateName + ":")
 
-	for _, model := range models {
-		s.UpdateMessage("Downloading model: " + model + "...")
-		err = utils.Retry(ctx, vars.RetryCount, vars.RetryInterval, nil, func() error {
-			return helpers.DownloadModel(model, utils.GetModelsPath())
+	// Models are downloaded several at a time, once when another deployment downloads them too
+	s.UpdateMessage(fmt.Sprintf("Downloading %d models...", len(models)))
+	modelsPath := utils.GetModelsPath()
+	err = transfer.Run(ctx, transfer.Concurrency(), models, func(ctx context.Context, model string) error {
+		err := transfer.Shared.Do(ctx, "model:"+filepath.Join(modelsPath, model), nil, func(ctx context.Context, _ transfer.ProgressFunc) error {
+			return utils.Retry(ctx, vars.RetryCount, vars.RetryInterval, nil, func() error {
+				return helpers.DownloadModel(model, modelsPath)
+			})
 		})
 		if err != nil {
-			s.Fail("failed to download model: " + model)
-
-			return fmt.Errorf("failed to download model: %w", err)
+			return fmt.Errorf("model %s: %w", model, err)
 		}
+
+		return nil
+	})
+	if err != nil {
+		s.Fail("failed to download models")
+
+		return fmt.Errorf("failed to download model: %w", err)
 	}
 
 	s.Stop("Model download completed.")
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"path/filepath"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common/opensearch"
 	commonrestore "github.com/project-ai-services/ai-services/internal/pkg/application/common/restore"
 	"github.com/project-ai-services/ai-services/internal/pkg/application/podman/restore"
 	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
@@ -20,9 +21,9 @@ func (p *PodmanApplication) Restore(ctx context.Context, opts types.RestoreOptio
 	logger.Infof("Backup file: %s\n", opts.BackupFile)
 
 	// Get application details from catalog API using existing utility
-	appDetails, err := cliUtils.GetAppDetailsWithComponents(opts.Name)
+	appDetails, err := resolveAppDetails(opts.Name, opts.Application)
 	if err != nil {
-		return fmt.Errorf("failed to get application details: %w", err)
+		return err
 	}
 	logger.Infof("Application ID: %s\n", appDetails.ID)
 
@@ -42,7 +43,7 @@ func (p *PodmanApplication) Restore(ctx context.Context, opts types.RestoreOptio
 		}
 		logger.Infof("Component ID: %s\n", componentID)
 
-		return p.restoreOpenSearch(ctx, componentID, absFilename)
+		return p.restoreOpenSearch(ctx, componentID, absFilename, opts.IndexPatterns)
 	case "digitize":
 		return p.restoreDigitize(ctx, appDetails, absFilename)
 	default:
@@ -50,8 +51,8 @@ func (p *PodmanApplication) Restore(ctx context.Context, opts types.RestoreOptio
 	}
 }
 
-// restoreOpenSearch restores OpenSearch data using podman sidecar approach.
-func (p *PodmanApplication) restoreOpenSearch(ctx context.Context, templateID, backupFile string) error {
+// restoreOpenSearch restores the OpenSearch indices matching patterns through its REST API.
+func (p *PodmanApplication) restoreOpenSearch(ctx context.Context, templateID, backupFile string, patterns []string) error {
 	// Get the Podman context from the runtime client
 	podmanCtx, err := p.getPodmanContext()
 	if err != nil {
@@ -59,7 +60,7 @@ func (p *PodmanApplication) restoreOpenSearch(ctx context.Context, templateID, b
 	}
 
 	// Call the OpenSearch-specific restore function
-	return restore.RestoreOpenSearch(podmanCtx, templateID, backupFile)
+	return restore.RestoreOpenSearch(podmanCtx, templateID, backupFile, opensearch.Patterns(patterns))
 }
 
 // restoreDigitize restores digitize metadata using the Import API.
@@ -81,7 +82,10 @@ func (p *PodmanApplication) restoreDigitize(ctx context.Context, appDetails *cat
 	logger.Infof("Digitize API URL: %s\n", digitizeURL)
 
 	// Create digitize restore client and call Import API
-	client := commonrestore.NewDigitizeRestoreClient(digitizeURL)
+	client, err := commonrestore.NewDigitizeRestoreClient(digitizeURL)
+	if err != nil {
+		return err
+	}
 	if err := client.CallImportAPI(importPayload); err != nil {
 		return err
 	}
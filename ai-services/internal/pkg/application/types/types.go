@@ -3,6 +3,7 @@ package types
 import (
 	"time"
 
+	catalogTypes "github.com/project-ai-services/ai-services/internal/pkg/catalog/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/image"
 )
 
@@ -77,6 +78,12 @@ type RestoreOptions struct {
 	Target     string // opensearch, digitize, etc.
 	BackupFile string
 	AutoYes    bool
+	// IndexPatterns selects the OpenSearch indices to restore from the backup with glob
+	// patterns. All indices starting with "rag" are restored when empty.
+	IndexPatterns []string
+	// Application holds pre-resolved application details. When nil they are fetched
+	// from the catalog API by Name.
+	Application *catalogTypes.Application
 }
 
 // BackupOptions contains parameters for backing up application data.
@@ -84,6 +91,12 @@ type BackupOptions struct {
 	Name       string
 	Target     string // opensearch, digitize, etc.
 	BackupFile string
+	// IndexPatterns selects the OpenSearch indices to back up with glob patterns. All indices
+	// starting with "rag" are backed up when empty.
+	IndexPatterns []string
+	// Application holds pre-resolved application details. When nil they are fetched
+	// from the catalog API by Name.
+	Application *catalogTypes.Application
 }
 
 // ApplicationInfo represents information about a deployed application.
@@ -9,11 +9,17 @@ import (
 	"strings"
 	"time"
 
+	"github.com/containers/podman/v5/pkg/bindings/system"
+	"github.com/project-ai-services/ai-services/internal/pkg/accelerator"
+	// Registers the providers returned by accelerator.Default.
+	_ "github.com/project-ai-services/ai-services/internal/pkg/accelerator/providers"
+	acceleratorspyre "github.com/project-ai-services/ai-services/internal/pkg/accelerator/spyre"
 	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap/spyreconfig/check"
 	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap/spyreconfig/spyre"
 	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap/spyreconfig/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	runtimepodman "github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
 	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils/selinux"
 )
@@ -22,18 +28,27 @@ import (
 func configureSpyre() error {
 	logger.Debugln("Running Spyre configuration validation and repair...")
 
+	provider, err := accelerator.Default()
+	if err != nil {
+		return err
+	}
+
 	// Check if Spyre cards are present
-	if !spyre.IsApplicable() {
+	ctx := context.Background()
+	cards, err := provider.Discover(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to discover Spyre cards: %w", err)
+	}
+	if len(cards) == 0 {
 		logger.Debugln("No Spyre cards detected. Validation not applicable.")
 
 		return nil
 	}
 
-	numCards := spyre.GetNumberOfSpyreCards()
-	logger.Infof("Detected %d Spyre card(s)", numCards)
+	logger.Infof("Detected %d Spyre card(s)", len(cards))
 
 	// Run validation and repair
-	allPassed := runValidationAndRepair()
+	allPassed := runValidationAndRepair(ctx, provider)
 
 	if !allPassed {
 		return fmt.Errorf("some Spyre configuration checks still failed after repair")
@@ -45,16 +60,16 @@ func configureSpyre() error {
 }
 
 // runValidationAndRepair runs validation checks and attempts repairs if needed.
-func runValidationAndRepair() bool {
+func runValidationAndRepair(ctx context.Context, provider accelerator.Provider) bool {
 	// Run all validation checks
-	checks := spyre.RunChecks()
+	checks := provider.Health(ctx)
 
 	// Check if any validation failed
 	allPassed := checkValidationResults(checks)
 
 	// If checks failed, attempt repairs
 	if !allPassed {
-		allPassed = attemptRepairs(checks)
+		allPassed = attemptRepairs(ctx, provider, checks)
 	}
 
 	return allPassed
@@ -73,8 +88,15 @@ func checkValidationResults(checks []check.CheckResult) bool {
 	return allPassed
 }
 
-// attemptRepairs attempts to repair failed checks and re-validates.
-func attemptRepairs(checks []check.CheckResult) bool {
+// attemptRepairs attempts to repair failed checks and re-validates. Only the host configuration
+// of real Spyre cards can be repaired.
+func attemptRepairs(ctx context.Context, provider accelerator.Provider, checks []check.CheckResult) bool {
+	if provider.Name() != acceleratorspyre.ProviderName {
+		logger.Infof("Checks of the %s accelerator provider cannot be repaired", provider.Name())
+
+		return false
+	}
+
 	logger.Debugln("Attempting automatic repairs...")
 	results := spyre.Repair(checks)
 
@@ -82,7 +104,7 @@ func attemptRepairs(checks []check.CheckResult) bool {
 
 	// Re-run checks after repair
 	logger.Debugln("Re-running validation...")
-	checks = spyre.RunChecks()
+	checks = provider.Health(ctx)
 
 	allPassed := true
 	for _, check := range checks {
@@ -464,13 +486,32 @@ func setupPodman() error {
 	logger.Debugln("Waiting for podman socket to be ready...")
 	time.Sleep(podmanSocketWaitDuration) // wait for socket to be ready
 
-	if err := utils.PodmanHealthCheck(); err != nil {
+	if err := podmanHealthCheck(); err != nil {
 		return fmt.Errorf("podman health check failed after configuration: %w", err)
 	}
 
 	return nil
 }
 
+// podmanHealthCheck verifies podman is working.
+func podmanHealthCheck() error {
+	client, err := runtimepodman.NewPodmanClient()
+	if err != nil {
+		return fmt.Errorf("failed to create podman client: %w", err)
+	}
+
+	version, err := system.Version(client.Context, nil)
+	if err != nil {
+		return fmt.Errorf("podman health check failed (cannot get version): %w", err)
+	}
+
+	if version.Server == nil || version.Server.Version == "" {
+		return fmt.Errorf("podman health check failed (invalid version info)")
+	}
+
+	return nil
+}
+
 // enablePodmanServices enables podman services for root or user context.
 func enablePodmanServices(isRoot bool, sudoUser string) error {
 	services := []string{"podman.socket", "podman-restart.service"}
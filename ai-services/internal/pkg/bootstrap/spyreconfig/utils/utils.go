@@ -12,9 +12,7 @@ import (
 	"strings"
 	"syscall"
 
-	"github.com/containers/podman/v5/pkg/bindings/system"
 	"github.com/jaypipes/ghw"
-	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
 )
 
 const (
@@ -263,23 +261,4 @@ func Podman() (string, error) {
 	return path, nil
 }
 
-// PodmanHealthCheck verifies podman is working.
-func PodmanHealthCheck() error {
-	client, err := podman.NewPodmanClient()
-	if err != nil {
-		return fmt.Errorf("failed to create podman client: %w", err)
-	}
-
-	version, err := system.Version(client.Context, nil)
-	if err != nil {
-		return fmt.Errorf("podman health check failed (cannot get version): %w", err)
-	}
-
-	if version.Server == nil || version.Server.Version == "" {
-		return fmt.Errorf("podman health check failed (invalid version info)")
-	}
-
-	return nil
-}
-
 // Made with Bob
@@ -22,6 +22,18 @@
 //	@tag.name					Applications
 //	@tag.description			Application management endpoints
 //
+//	@tag.name					Backups
+//	@tag.description			Application data backup, restore and backup policy endpoints
+//
+//	@tag.name					API Keys
+//	@tag.description			Application API keys granting access to service endpoints
+//
+//	@tag.name					Accelerators
+//	@tag.description			Accelerator cards and the applications owning them
+//
+//	@tag.name					Models
+//	@tag.description			Models downloaded to the host and the applications using them
+//
 //	@tag.name					Catalog
 //	@tag.description			Catalog endpoints for architectures and services
 //
@@ -33,11 +45,16 @@ package apiserver
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
+	"net/http"
+	"time"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/repository"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/services/auth"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/metrics"
 	"github.com/project-ai-services/ai-services/internal/pkg/worker/gateway"
 	"github.com/project-ai-services/ai-services/internal/pkg/worker/registry"
 )
@@ -54,6 +71,9 @@ type APIServerOptions struct {
 	// WorkerGatewayPort is the port the gRPC worker gateway listens on.
 	// Defaults to 9090 when zero.
 	WorkerGatewayPort int
+	// MetricsPort is the port Prometheus metrics are served on, apart from the API so that they
+	// are not routed by the proxy. Metrics are not served when zero.
+	MetricsPort int
 	// WorkerRegistry holds the in-memory state of all connected workers and owns
 	// the bootstrap token store.
 	WorkerRegistry *registry.Registry
@@ -68,6 +88,7 @@ type APIserver struct {
 	applicationService repository.ApplicationServiceInterface
 
 	workerGatewayPort int
+	metricsPort       int
 	workerRegistry    *registry.Registry
 }
 
@@ -88,6 +109,7 @@ func NewAPIserver(options APIServerOptions) *APIserver {
 		blacklist:          options.Blacklist,
 		applicationService: options.ApplicationService,
 		workerGatewayPort:  options.WorkerGatewayPort,
+		metricsPort:        options.MetricsPort,
 		workerRegistry:     options.WorkerRegistry,
 	}
 }
@@ -109,16 +131,49 @@ func (a *APIserver) Start(ctx context.Context) error {
 	}
 	logger.InfofCtx(ctx, "Worker gateway started on %s", gatewayAddr)
 
+	if a.metricsPort != 0 {
+		metricsAddr := fmt.Sprintf(":%d", a.metricsPort)
+		if err := startMetricsServer(ctx, cancel, metricsAddr); err != nil {
+			return fmt.Errorf("failed to start metrics server: %w", err)
+		}
+		logger.InfofCtx(ctx, "Metrics server started on %s", metricsAddr)
+	}
+
 	r := CreateRouter(a.authService, a.tokenManager, a.blacklist, a.applicationService, a.workerRegistry)
 
 	if err := r.Run(fmt.Sprintf(":%d", a.port)); err != nil {
 		return err
 	}
 
-	// If ctx was cancelled by a gateway failure, surface that cause.
+	// If ctx was cancelled by a gateway or metrics server failure, surface that cause.
 	if cause := context.Cause(ctx); cause != nil {
 		return cause
 	}
 
 	return nil
 }
+
+// startMetricsServer serves the Prometheus metrics on addr until ctx is done, cancelling ctx with
+// the error when serving fails.
+func startMetricsServer(ctx context.Context, cancel context.CancelCauseFunc, addr string) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+	srv := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+	go func() {
+		if err := srv.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			cancel(fmt.Errorf("metrics server: %w", err))
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		_ = srv.Close()
+	}()
+
+	return nil
+}
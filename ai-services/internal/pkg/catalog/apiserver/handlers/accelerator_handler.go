@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListAccelerators godoc
+//
+//	@Summary		List accelerator cards
+//	@Description	Retrieves the Spyre cards of the host with the application and component owning them. Cards are reserved for an application when its deployment is planned, stay reserved while it is stopped and are released when it is deleted. The unreserved cards are listed too, as free or in use outside the catalog. Only supported with the Podman runtime.
+//	@Tags			Accelerators
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{object}	models.AcceleratorListResponse
+//	@Failure		401	{object}	ErrorResponse	"Unauthorized"
+//	@Failure		500	{object}	ErrorResponse	"Internal Server Error"
+//	@Failure		501	{object}	ErrorResponse	"Not supported on the runtime"
+//	@Router			/accelerators [get]
+func (h *ApplicationHandler) ListAccelerators(c *gin.Context) {
+	response, err := h.appService.ListAccelerators(c.Request.Context())
+	if err != nil {
+		respondServiceError(c, err, "Failed to list accelerators")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Made with Bob
@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/middleware"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/models"
+)
+
+var ErrInvalidAPIKeyIDParameter = ErrorResponse{Error: "Invalid API key ID format"}
+
+// ListAPIKeys godoc
+//
+//	@Summary		List application API keys
+//	@Description	Retrieves the API keys granting access to the service endpoints of an application owned by the user. Only the leading characters of each key are returned.
+//	@Tags			API Keys
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Application ID (UUID)"
+//	@Success		200	{object}	models.APIKeyListResponse
+//	@Failure		400	{object}	ErrorResponse	"Invalid application ID"
+//	@Failure		401	{object}	ErrorResponse	"Unauthorized"
+//	@Failure		403	{object}	ErrorResponse	"User doesn't own this application"
+//	@Failure		404	{object}	ErrorResponse	"Application not found"
+//	@Failure		500	{object}	ErrorResponse	"Internal Server Error"
+//	@Router			/applications/{id}/api-keys [get]
+func (h *ApplicationHandler) ListAPIKeys(c *gin.Context) {
+	appID, ok := parseIDParam(c, "id", ErrInvalidIDParameter)
+	if !ok {
+		return
+	}
+
+	response, err := h.appService.ListAPIKeys(c.Request.Context(), appID, c.GetString(middleware.CtxUserIDKey))
+	if err != nil {
+		respondServiceError(c, err, "Failed to list API keys")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateAPIKey godoc
+//
+//	@Summary		Create an application API key
+//	@Description	Generates an API key for the service endpoints of an application. Clients send it as "Authorization: Bearer <key>" or in the X-API-Key header. The key is only returned in this response.
+//	@Tags			API Keys
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string						true	"Application ID (UUID)"
+//	@Param			request	body		models.CreateAPIKeyRequest	true	"API key request"
+//	@Success		201		{object}	models.APIKeySecretResponse
+//	@Failure		400		{object}	ErrorResponse	"Invalid request body"
+//	@Failure		401		{object}	ErrorResponse	"Unauthorized"
+//	@Failure		403		{object}	ErrorResponse	"User doesn't own this application"
+//	@Failure		404		{object}	ErrorResponse	"Application not found"
+//	@Failure		409		{object}	ErrorResponse	"An API key with this name already exists"
+//	@Failure		500		{object}	ErrorResponse	"Internal Server Error"
+//	@Router			/applications/{id}/api-keys [post]
+func (h *ApplicationHandler) CreateAPIKey(c *gin.Context) {
+	appID, ok := parseIDParam(c, "id", ErrInvalidIDParameter)
+	if !ok {
+		return
+	}
+
+	var req models.CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: fmt.Sprintf("Invalid request body: %v", err)})
+
+		return
+	}
+
+	response, err := h.appService.CreateAPIKey(c.Request.Context(), appID, c.GetString(middleware.CtxUserIDKey), req)
+	if err != nil {
+		respondServiceError(c, err, "Failed to create API key")
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// RotateAPIKey godoc
+//
+//	@Summary		Rotate an application API key
+//	@Description	Replaces an API key with a newly generated one. The previous key stops working immediately. The new key is only returned in this response.
+//	@Tags			API Keys
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string	true	"Application ID (UUID)"
+//	@Param			key_id	path		string	true	"API key ID (UUID)"
+//	@Success		200		{object}	models.APIKeySecretResponse
+//	@Failure		400		{object}	ErrorResponse	"Invalid application or API key ID"
+//	@Failure		401		{object}	ErrorResponse	"Unauthorized"
+//	@Failure		403		{object}	ErrorResponse	"User doesn't own this application"
+//	@Failure		404		{object}	ErrorResponse	"Application or API key not found"
+//	@Failure		500		{object}	ErrorResponse	"Internal Server Error"
+//	@Router			/applications/{id}/api-keys/{key_id}/rotate [post]
+func (h *ApplicationHandler) RotateAPIKey(c *gin.Context) {
+	appID, ok := parseIDParam(c, "id", ErrInvalidIDParameter)
+	if !ok {
+		return
+	}
+
+	keyID, ok := parseIDParam(c, "key_id", ErrInvalidAPIKeyIDParameter)
+	if !ok {
+		return
+	}
+
+	response, err := h.appService.RotateAPIKey(c.Request.Context(), appID, keyID, c.GetString(middleware.CtxUserIDKey))
+	if err != nil {
+		respondServiceError(c, err, "Failed to rotate API key")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// DeleteAPIKey godoc
+//
+//	@Summary		Revoke an application API key
+//	@Description	Deletes an API key. Requests using it are rejected from then on.
+//	@Tags			API Keys
+//	@Security		BearerAuth
+//	@Param			id		path	string	true	"Application ID (UUID)"
+//	@Param			key_id	path	string	true	"API key ID (UUID)"
+//	@Success		204
+//	@Failure		400	{object}	ErrorResponse	"Invalid application or API key ID"
+//	@Failure		401	{object}	ErrorResponse	"Unauthorized"
+//	@Failure		403	{object}	ErrorResponse	"User doesn't own this application"
+//	@Failure		404	{object}	ErrorResponse	"Application or API key not found"
+//	@Failure		500	{object}	ErrorResponse	"Internal Server Error"
+//	@Router			/applications/{id}/api-keys/{key_id} [delete]
+func (h *ApplicationHandler) DeleteAPIKey(c *gin.Context) {
+	appID, ok := parseIDParam(c, "id", ErrInvalidIDParameter)
+	if !ok {
+		return
+	}
+
+	keyID, ok := parseIDParam(c, "key_id", ErrInvalidAPIKeyIDParameter)
+	if !ok {
+		return
+	}
+
+	if err := h.appService.DeleteAPIKey(c.Request.Context(), appID, keyID, c.GetString(middleware.CtxUserIDKey)); err != nil {
+		respondServiceError(c, err, "Failed to delete API key")
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Made with Bob
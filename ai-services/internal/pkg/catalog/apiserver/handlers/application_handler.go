@@ -1,12 +1,15 @@
 package handlers
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	k8syaml "sigs.k8s.io/yaml"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/middleware"
 
@@ -204,7 +207,8 @@ func (h *ApplicationHandler) CreateApplication(c *gin.Context) {
 		// Check if it's a validation error with specific status code
 		if valErr, ok := err.(*repository.ValidationError); ok {
 			c.JSON(valErr.Code, ErrorResponse{
-				Error: valErr.Message,
+				Error:      valErr.Message,
+				Violations: valErr.Violations,
 			})
 
 			return
@@ -419,4 +423,223 @@ func (h *ApplicationHandler) ApplicationPS(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// lifecycleFunc is the signature shared by the start, stop and restart service methods.
+type lifecycleFunc func(ctx context.Context, id uuid.UUID, user string, serviceID *uuid.UUID) (*models.ApplicationLifecycleResponse, error)
+
+// StartApplication godoc
+//
+//	@Summary		Start application
+//	@Description	Initiates async start of a stopped application, or of a single service when service_id is given. Returns 202 immediately.
+//	@Tags			Applications
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id			path		string	true	"Application ID (UUID)"
+//	@Param			service_id	query		string	false	"Service ID (UUID) to start instead of the whole application"
+//	@Success		202			{object}	models.ApplicationLifecycleResponse
+//	@Failure		400			{object}	ErrorResponse	"Invalid application or service ID"
+//	@Failure		401			{object}	ErrorResponse	"Unauthorized"
+//	@Failure		403			{object}	ErrorResponse	"User doesn't own this application"
+//	@Failure		404			{object}	ErrorResponse	"Application or service not found"
+//	@Failure		409			{object}	ErrorResponse	"Application cannot be started in its current state"
+//	@Failure		500			{object}	ErrorResponse	"Internal Server Error"
+//	@Router			/applications/{id}/start [post]
+func (h *ApplicationHandler) StartApplication(c *gin.Context) {
+	h.changeApplicationState(c, h.appService.StartApplication)
+}
+
+// StopApplication godoc
+//
+//	@Summary		Stop application
+//	@Description	Initiates async stop of an application, or of a single service when service_id is given. Returns 202 immediately.
+//	@Tags			Applications
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id			path		string	true	"Application ID (UUID)"
+//	@Param			service_id	query		string	false	"Service ID (UUID) to stop instead of the whole application"
+//	@Success		202			{object}	models.ApplicationLifecycleResponse
+//	@Failure		400			{object}	ErrorResponse	"Invalid application or service ID"
+//	@Failure		401			{object}	ErrorResponse	"Unauthorized"
+//	@Failure		403			{object}	ErrorResponse	"User doesn't own this application"
+//	@Failure		404			{object}	ErrorResponse	"Application or service not found"
+//	@Failure		409			{object}	ErrorResponse	"Application cannot be stopped in its current state"
+//	@Failure		500			{object}	ErrorResponse	"Internal Server Error"
+//	@Router			/applications/{id}/stop [post]
+func (h *ApplicationHandler) StopApplication(c *gin.Context) {
+	h.changeApplicationState(c, h.appService.StopApplication)
+}
+
+// RestartApplication godoc
+//
+//	@Summary		Restart application
+//	@Description	Initiates async restart of an application, or of a single service when service_id is given. Returns 202 immediately.
+//	@Tags			Applications
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id			path		string	true	"Application ID (UUID)"
+//	@Param			service_id	query		string	false	"Service ID (UUID) to restart instead of the whole application"
+//	@Success		202			{object}	models.ApplicationLifecycleResponse
+//	@Failure		400			{object}	ErrorResponse	"Invalid application or service ID"
+//	@Failure		401			{object}	ErrorResponse	"Unauthorized"
+//	@Failure		403			{object}	ErrorResponse	"User doesn't own this application"
+//	@Failure		404			{object}	ErrorResponse	"Application or service not found"
+//	@Failure		409			{object}	ErrorResponse	"Application cannot be restarted in its current state"
+//	@Failure		500			{object}	ErrorResponse	"Internal Server Error"
+//	@Router			/applications/{id}/restart [post]
+func (h *ApplicationHandler) RestartApplication(c *gin.Context) {
+	h.changeApplicationState(c, h.appService.RestartApplication)
+}
+
+// changeApplicationState parses the common start/stop/restart parameters and invokes fn.
+func (h *ApplicationHandler) changeApplicationState(c *gin.Context, fn lifecycleFunc) {
+	appID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrInvalidIDParameter)
+
+		return
+	}
+
+	var serviceID *uuid.UUID
+	if raw := c.Query("service_id"); raw != "" {
+		parsed, err := uuid.Parse(raw)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid service ID format"})
+
+			return
+		}
+		serviceID = &parsed
+	}
+
+	response, err := fn(c.Request.Context(), appID, c.GetString(middleware.CtxUserIDKey), serviceID)
+	if err != nil {
+		if valErr, ok := err.(*repository.ValidationError); ok {
+			c.JSON(valErr.Code, ErrorResponse{
+				Error: valErr.Message,
+			})
+
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: "internal server error"})
+
+		return
+	}
+
+	c.JSON(http.StatusAccepted, response)
+}
+
+// ExportApplication godoc
+//
+//	@Summary		Export application definition
+//	@Description	Returns a portable YAML definition of an application. Sensitive parameters are omitted and listed under secret_refs; connectors are referenced by name.
+//	@Tags			Applications
+//	@Produce		application/yaml
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Application ID (UUID)"
+//	@Success		200	{object}	models.ApplicationDefinition
+//	@Failure		400	{object}	ErrorResponse	"Invalid application ID"
+//	@Failure		401	{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404	{object}	ErrorResponse	"Application not found"
+//	@Failure		500	{object}	ErrorResponse	"Internal Server Error"
+//	@Router			/applications/{id}/export [get]
+func (h *ApplicationHandler) ExportApplication(c *gin.Context) {
+	appID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrInvalidIDParameter)
+
+		return
+	}
+
+	def, err := h.appService.ExportApplication(c.Request.Context(), appID)
+	if err != nil {
+		if valErr, ok := err.(*repository.ValidationError); ok {
+			c.JSON(valErr.Code, ErrorResponse{
+				Error: valErr.Message,
+			})
+
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: fmt.Sprintf("Failed to export application: %v", err)})
+
+		return
+	}
+
+	data, err := k8syaml.Marshal(def)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, ErrorResponse{Error: fmt.Sprintf("Failed to encode application definition: %v", err)})
+
+		return
+	}
+
+	c.Data(http.StatusOK, "application/yaml", data)
+}
+
+// ImportApplication godoc
+//
+//	@Summary		Import application definition
+//	@Description	Creates a new application from a YAML or JSON definition produced by the export endpoint. Referenced connectors must already exist.
+//	@Tags			Applications
+//	@Accept			application/yaml
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			name		query		string							false	"Override metadata.name from the definition"
+//	@Param			definition	body		models.ApplicationDefinition		true	"Application definition"
+//	@Success		202			{object}	models.CreateApplicationResponse	"Application creation initiated"
+//	@Failure		400			{object}	ErrorResponse						"Invalid definition"
+//	@Failure		401			{object}	ErrorResponse						"Unauthorized"
+//	@Failure		409			{object}	ErrorResponse						"Application name already exists"
+//	@Failure		422			{object}	ErrorResponse						"Unsupported definition, parameter validation failed or connector not found"
+//	@Failure		500			{object}	ErrorResponse						"Internal Server Error"
+//	@Router			/applications/import [post]
+func (h *ApplicationHandler) ImportApplication(c *gin.Context) {
+	userID := c.GetString(middleware.CtxUserIDKey)
+	if userID == "" {
+		c.JSON(http.StatusUnauthorized, ErrorResponse{
+			Error: "Unauthorized: user ID not found in context",
+		})
+
+		return
+	}
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: fmt.Sprintf("Failed to read request body: %v", err)})
+
+		return
+	}
+
+	// YAML is a superset of JSON, so both content types are accepted here.
+	var def models.ApplicationDefinition
+	if err := k8syaml.UnmarshalStrict(body, &def); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: fmt.Sprintf("Invalid application definition: %v", err)})
+
+		return
+	}
+
+	if name := c.Query("name"); name != "" {
+		def.Metadata.Name = name
+	}
+
+	response, err := h.appService.ImportApplication(c.Request.Context(), def, userID)
+	if err != nil {
+		if valErr, ok := err.(*repository.ValidationError); ok {
+			c.JSON(valErr.Code, ErrorResponse{
+				Error:      valErr.Message,
+				Violations: valErr.Violations,
+			})
+
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: fmt.Sprintf("Failed to import application: %v", err),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusAccepted, response)
+}
+
 // Made with Bob
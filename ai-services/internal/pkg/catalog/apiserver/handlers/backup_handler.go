@@ -0,0 +1,301 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/middleware"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/repository"
+	dbmodels "github.com/project-ai-services/ai-services/internal/pkg/catalog/db/models"
+)
+
+// Ensure dbmodels is imported for Swagger documentation.
+var _ dbmodels.Backup
+
+var (
+	ErrInvalidBackupIDParameter = ErrorResponse{Error: "Invalid backup ID format"}
+	ErrInvalidPolicyIDParameter = ErrorResponse{Error: "Invalid backup policy ID format"}
+)
+
+// ListBackups godoc
+//
+//	@Summary		List application backups
+//	@Description	Retrieves the backups of an application, newest first
+//	@Tags			Backups
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Application ID (UUID)"
+//	@Success		200	{object}	models.BackupListResponse
+//	@Failure		400	{object}	ErrorResponse	"Invalid application ID"
+//	@Failure		401	{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404	{object}	ErrorResponse	"Application not found"
+//	@Failure		500	{object}	ErrorResponse	"Internal Server Error"
+//	@Router			/applications/{id}/backups [get]
+func (h *ApplicationHandler) ListBackups(c *gin.Context) {
+	appID, ok := parseIDParam(c, "id", ErrInvalidIDParameter)
+	if !ok {
+		return
+	}
+
+	response, err := h.appService.ListBackups(c.Request.Context(), appID)
+	if err != nil {
+		respondServiceError(c, err, "Failed to list backups")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateBackup godoc
+//
+//	@Summary		Back up application data
+//	@Description	Initiates an async backup of one target (opensearch or digitize) of a running application. The archive is kept on the API server's disk, or uploaded to an object_storage connector when connector_id is given. Returns 202 with the InProgress backup record.
+//	@Tags			Backups
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string						true	"Application ID (UUID)"
+//	@Param			request	body		models.CreateBackupRequest	true	"Backup request"
+//	@Success		202		{object}	dbmodels.Backup
+//	@Failure		400		{object}	ErrorResponse	"Invalid request body or target"
+//	@Failure		401		{object}	ErrorResponse	"Unauthorized"
+//	@Failure		403		{object}	ErrorResponse	"User doesn't own this application"
+//	@Failure		404		{object}	ErrorResponse	"Application not found"
+//	@Failure		409		{object}	ErrorResponse	"Application is not running or a backup is already in progress"
+//	@Failure		422		{object}	ErrorResponse	"Connector does not exist or is not an object_storage connector"
+//	@Failure		500		{object}	ErrorResponse	"Internal Server Error"
+//	@Router			/applications/{id}/backups [post]
+func (h *ApplicationHandler) CreateBackup(c *gin.Context) {
+	appID, ok := parseIDParam(c, "id", ErrInvalidIDParameter)
+	if !ok {
+		return
+	}
+
+	var req models.CreateBackupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: fmt.Sprintf("Invalid request body: %v", err)})
+
+		return
+	}
+
+	backup, err := h.appService.CreateBackup(c.Request.Context(), appID, c.GetString(middleware.CtxUserIDKey), req)
+	if err != nil {
+		respondServiceError(c, err, "Failed to start backup")
+
+		return
+	}
+
+	c.JSON(http.StatusAccepted, backup)
+}
+
+// RestoreBackup godoc
+//
+//	@Summary		Restore application data from a backup
+//	@Description	Initiates an async restore of a completed backup into the running application it was taken from. The outcome is recorded in the message of the backup. Returns 202 immediately.
+//	@Tags			Backups
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id			path		string	true	"Application ID (UUID)"
+//	@Param			backup_id	path		string	true	"Backup ID (UUID)"
+//	@Success		202			{object}	models.RestoreBackupResponse
+//	@Failure		400			{object}	ErrorResponse	"Invalid application or backup ID"
+//	@Failure		401			{object}	ErrorResponse	"Unauthorized"
+//	@Failure		403			{object}	ErrorResponse	"User doesn't own this application"
+//	@Failure		404			{object}	ErrorResponse	"Application or backup not found"
+//	@Failure		409			{object}	ErrorResponse	"Backup not completed, application not running or a backup is already in progress"
+//	@Failure		500			{object}	ErrorResponse	"Internal Server Error"
+//	@Router			/applications/{id}/backups/{backup_id}/restore [post]
+func (h *ApplicationHandler) RestoreBackup(c *gin.Context) {
+	appID, ok := parseIDParam(c, "id", ErrInvalidIDParameter)
+	if !ok {
+		return
+	}
+
+	backupID, ok := parseIDParam(c, "backup_id", ErrInvalidBackupIDParameter)
+	if !ok {
+		return
+	}
+
+	response, err := h.appService.RestoreBackup(c.Request.Context(), appID, backupID, c.GetString(middleware.CtxUserIDKey))
+	if err != nil {
+		respondServiceError(c, err, "Failed to start restore")
+
+		return
+	}
+
+	c.JSON(http.StatusAccepted, response)
+}
+
+// DeleteBackup godoc
+//
+//	@Summary		Delete a backup
+//	@Description	Removes a backup archive from its destination and deletes its record
+//	@Tags			Backups
+//	@Security		BearerAuth
+//	@Param			id			path	string	true	"Application ID (UUID)"
+//	@Param			backup_id	path	string	true	"Backup ID (UUID)"
+//	@Success		204
+//	@Failure		400	{object}	ErrorResponse	"Invalid application or backup ID"
+//	@Failure		401	{object}	ErrorResponse	"Unauthorized"
+//	@Failure		403	{object}	ErrorResponse	"User doesn't own this application"
+//	@Failure		404	{object}	ErrorResponse	"Application or backup not found"
+//	@Failure		409	{object}	ErrorResponse	"Backup is in progress"
+//	@Failure		500	{object}	ErrorResponse	"Internal Server Error"
+//	@Router			/applications/{id}/backups/{backup_id} [delete]
+func (h *ApplicationHandler) DeleteBackup(c *gin.Context) {
+	appID, ok := parseIDParam(c, "id", ErrInvalidIDParameter)
+	if !ok {
+		return
+	}
+
+	backupID, ok := parseIDParam(c, "backup_id", ErrInvalidBackupIDParameter)
+	if !ok {
+		return
+	}
+
+	if err := h.appService.DeleteBackup(c.Request.Context(), appID, backupID, c.GetString(middleware.CtxUserIDKey)); err != nil {
+		respondServiceError(c, err, "Failed to delete backup")
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListBackupPolicies godoc
+//
+//	@Summary		List backup policies
+//	@Description	Retrieves the scheduled backup policies of an application
+//	@Tags			Backups
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Application ID (UUID)"
+//	@Success		200	{object}	models.BackupPolicyListResponse
+//	@Failure		400	{object}	ErrorResponse	"Invalid application ID"
+//	@Failure		401	{object}	ErrorResponse	"Unauthorized"
+//	@Failure		404	{object}	ErrorResponse	"Application not found"
+//	@Failure		500	{object}	ErrorResponse	"Internal Server Error"
+//	@Router			/applications/{id}/backup-policies [get]
+func (h *ApplicationHandler) ListBackupPolicies(c *gin.Context) {
+	appID, ok := parseIDParam(c, "id", ErrInvalidIDParameter)
+	if !ok {
+		return
+	}
+
+	response, err := h.appService.ListBackupPolicies(c.Request.Context(), appID)
+	if err != nil {
+		respondServiceError(c, err, "Failed to list backup policies")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateBackupPolicy godoc
+//
+//	@Summary		Create a backup policy
+//	@Description	Schedules recurring backups of an application target with a cron expression. Completed backups beyond retention are removed after each run.
+//	@Tags			Backups
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string								true	"Application ID (UUID)"
+//	@Param			request	body		models.CreateBackupPolicyRequest	true	"Backup policy"
+//	@Success		201		{object}	dbmodels.BackupPolicy
+//	@Failure		400		{object}	ErrorResponse	"Invalid request body, target, schedule or retention"
+//	@Failure		401		{object}	ErrorResponse	"Unauthorized"
+//	@Failure		403		{object}	ErrorResponse	"User doesn't own this application"
+//	@Failure		404		{object}	ErrorResponse	"Application not found"
+//	@Failure		422		{object}	ErrorResponse	"Connector does not exist or is not an object_storage connector"
+//	@Failure		500		{object}	ErrorResponse	"Internal Server Error"
+//	@Router			/applications/{id}/backup-policies [post]
+func (h *ApplicationHandler) CreateBackupPolicy(c *gin.Context) {
+	appID, ok := parseIDParam(c, "id", ErrInvalidIDParameter)
+	if !ok {
+		return
+	}
+
+	var req models.CreateBackupPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, ErrorResponse{Error: fmt.Sprintf("Invalid request body: %v", err)})
+
+		return
+	}
+
+	policy, err := h.appService.CreateBackupPolicy(c.Request.Context(), appID, c.GetString(middleware.CtxUserIDKey), req)
+	if err != nil {
+		respondServiceError(c, err, "Failed to create backup policy")
+
+		return
+	}
+
+	c.JSON(http.StatusCreated, policy)
+}
+
+// DeleteBackupPolicy godoc
+//
+//	@Summary		Delete a backup policy
+//	@Description	Stops scheduled backups of a policy. Backups already taken by the policy are kept.
+//	@Tags			Backups
+//	@Security		BearerAuth
+//	@Param			id			path	string	true	"Application ID (UUID)"
+//	@Param			policy_id	path	string	true	"Backup policy ID (UUID)"
+//	@Success		204
+//	@Failure		400	{object}	ErrorResponse	"Invalid application or policy ID"
+//	@Failure		401	{object}	ErrorResponse	"Unauthorized"
+//	@Failure		403	{object}	ErrorResponse	"User doesn't own this application"
+//	@Failure		404	{object}	ErrorResponse	"Application or policy not found"
+//	@Failure		500	{object}	ErrorResponse	"Internal Server Error"
+//	@Router			/applications/{id}/backup-policies/{policy_id} [delete]
+func (h *ApplicationHandler) DeleteBackupPolicy(c *gin.Context) {
+	appID, ok := parseIDParam(c, "id", ErrInvalidIDParameter)
+	if !ok {
+		return
+	}
+
+	policyID, ok := parseIDParam(c, "policy_id", ErrInvalidPolicyIDParameter)
+	if !ok {
+		return
+	}
+
+	if err := h.appService.DeleteBackupPolicy(c.Request.Context(), appID, policyID, c.GetString(middleware.CtxUserIDKey)); err != nil {
+		respondServiceError(c, err, "Failed to delete backup policy")
+
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// parseIDParam parses a UUID path parameter, responding with 400 when it is malformed.
+func parseIDParam(c *gin.Context, name string, invalid ErrorResponse) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param(name))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, invalid)
+
+		return uuid.Nil, false
+	}
+
+	return id, true
+}
+
+// respondServiceError writes a ValidationError with its status code, and any other error as 500.
+func respondServiceError(c *gin.Context, err error, message string) {
+	if valErr, ok := err.(*repository.ValidationError); ok {
+		c.JSON(valErr.Code, ErrorResponse{
+			Error: valErr.Message,
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusInternalServerError, ErrorResponse{Error: fmt.Sprintf("%s: %v", message, err)})
+}
+
+// Made with Bob
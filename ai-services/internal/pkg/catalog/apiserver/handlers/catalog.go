@@ -6,6 +6,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/repository"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
@@ -333,6 +334,9 @@ func (h *CatalogHandler) GetServiceParams(c *gin.Context) {
 // ErrorResponse represents an error response.
 type ErrorResponse struct {
 	Error string `json:"error"`
+	// Violations lists the parameters that do not satisfy their schemas, for 422 responses to
+	// application creation.
+	Violations []repository.Violation `json:"violations,omitempty"`
 }
 
 // Made with Bob
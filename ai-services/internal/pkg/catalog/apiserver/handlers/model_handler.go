@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListModels godoc
+//
+//	@Summary		List downloaded models
+//	@Description	Retrieves the models downloaded to the models directory of the host with their size, source revision, files and the applications using them. File checksums are the ones recorded when the files were downloaded unless checksums=true, which computes them by reading every file and may take minutes. Only supported on Podman.
+//	@Tags			Models
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			checksums	query		bool	false	"Compute the checksums of the model files"	default(false)
+//	@Success		200			{object}	models.ModelListResponse
+//	@Failure		400			{object}	ErrorResponse	"Invalid checksums parameter"
+//	@Failure		401			{object}	ErrorResponse	"Unauthorized"
+//	@Failure		500			{object}	ErrorResponse	"Internal Server Error"
+//	@Failure		501			{object}	ErrorResponse	"Not supported on the runtime"
+//	@Router			/models [get]
+func (h *ApplicationHandler) ListModels(c *gin.Context) {
+	checksums := false
+	if param := c.Query("checksums"); param != "" {
+		if param != "true" && param != "false" {
+			c.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid checksums parameter: must be 'true' or 'false'"})
+
+			return
+		}
+		checksums = param == "true"
+	}
+
+	response, err := h.appService.ListModels(c.Request.Context(), checksums)
+	if err != nil {
+		respondServiceError(c, err, "Failed to list models")
+
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Made with Bob
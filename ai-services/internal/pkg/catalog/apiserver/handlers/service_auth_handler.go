@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/repository"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/services/auth"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/constants"
+)
+
+// ServiceAuthHandler verifies the credentials of requests to the service endpoints of
+// applications on behalf of the Caddy proxy.
+type ServiceAuthHandler struct {
+	appService repository.ApplicationServiceInterface
+	tokenMgr   *auth.TokenManager
+	blacklist  repository.TokenBlacklist
+}
+
+// NewServiceAuthHandler creates a new service authentication handler.
+func NewServiceAuthHandler(appService repository.ApplicationServiceInterface, tokenMgr *auth.TokenManager, blacklist repository.TokenBlacklist) *ServiceAuthHandler {
+	return &ServiceAuthHandler{
+		appService: appService,
+		tokenMgr:   tokenMgr,
+		blacklist:  blacklist,
+	}
+}
+
+// Verify godoc
+//
+//	@Summary		Verify access to application services
+//	@Description	Forward authentication endpoint called by the Caddy proxy before a request reaches a service endpoint of an application. The request is admitted with an API key of the application, sent as a Bearer token or in the X-API-Key header, or, unless mode is api_key, with an access token of the application owner. On success the subject the request is attributed to is returned in the X-Auth-Subject header.
+//	@Tags			Authentication
+//	@Param			application_id	query	string	true	"Application ID (UUID)"
+//	@Param			mode			query	string	false	"Accepted credentials"	Enums(forward_auth, api_key)	default(forward_auth)
+//	@Success		200
+//	@Header			200	{string}	X-Auth-Subject	"Verified user or API key"
+//	@Failure		400	{object}	ErrorResponse	"Invalid application ID"
+//	@Failure		401	{object}	ErrorResponse	"Missing or invalid credentials"
+//	@Failure		403	{object}	ErrorResponse	"User doesn't own this application"
+//	@Failure		404	{object}	ErrorResponse	"Application not found"
+//	@Failure		500	{object}	ErrorResponse	"Internal Server Error"
+//	@Router			/auth/verify [get]
+func (h *ServiceAuthHandler) Verify(c *gin.Context) {
+	appID, err := uuid.Parse(c.Query("application_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, ErrInvalidIDParameter)
+
+		return
+	}
+
+	apiKey, token := serviceCredentials(c)
+	tokensAccepted := c.DefaultQuery("mode", constants.ServiceAuthModeForwardAuth) != constants.ServiceAuthModeAPIKey
+	if apiKey == "" && (token == "" || !tokensAccepted) {
+		unauthorized(c, "missing API key")
+
+		return
+	}
+
+	var user string
+	if apiKey == "" {
+		if h.blacklist.Contains(c.Request.Context(), token, constants.TokenTypeAccess) {
+			unauthorized(c, "token revoked")
+
+			return
+		}
+
+		uid, _, err := h.tokenMgr.ValidateAccessToken(token)
+		if err != nil || uid == "" {
+			unauthorized(c, "invalid token")
+
+			return
+		}
+		user = uid
+	}
+
+	subject, err := h.appService.VerifyServiceAccess(c.Request.Context(), appID, user, apiKey)
+	if err != nil {
+		if valErr, ok := err.(*repository.ValidationError); ok && valErr.Code == http.StatusUnauthorized {
+			unauthorized(c, valErr.Message)
+
+			return
+		}
+		respondServiceError(c, err, "Failed to verify credentials")
+
+		return
+	}
+
+	c.Header(constants.ServiceAuthSubjectHeader, subject)
+	c.Status(http.StatusOK)
+}
+
+// serviceCredentials returns the API key or the access token of a request. API keys are read from
+// the X-API-Key header, or from the Authorization header when the Bearer token is an API key.
+func serviceCredentials(c *gin.Context) (string, string) {
+	if key := c.GetHeader(constants.APIKeyHeader); key != "" {
+		return key, ""
+	}
+
+	bearer, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if !ok || bearer == "" {
+		return "", ""
+	}
+
+	if strings.HasPrefix(bearer, constants.APIKeyPrefix) {
+		return bearer, ""
+	}
+
+	return "", bearer
+}
+
+// unauthorized rejects a request with 401 and a challenge for a Bearer credential.
+func unauthorized(c *gin.Context, message string) {
+	c.Header("WWW-Authenticate", `Bearer realm="ai-services"`)
+	c.JSON(http.StatusUnauthorized, ErrorResponse{Error: message})
+}
+
+// Made with Bob
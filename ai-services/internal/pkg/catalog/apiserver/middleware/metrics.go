@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/project-ai-services/ai-services/internal/pkg/metrics"
+)
+
+// unmatchedRoute labels the requests matching no route, so that arbitrary paths do not create
+// metric series.
+const unmatchedRoute = "unmatched"
+
+// MetricsMiddleware is a Gin middleware that counts the requests and observes their latency by
+// route template, e.g. /api/v1/applications/:id, rather than by path.
+func MetricsMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		metrics.HTTPRequests.WithLabelValues(c.Request.Method, route, strconv.Itoa(c.Writer.Status())).Inc()
+		metrics.HTTPRequestDuration.WithLabelValues(c.Request.Method, route).Observe(time.Since(start).Seconds())
+	}
+}
+
+// Made with Bob
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	dbmodels "github.com/project-ai-services/ai-services/internal/pkg/catalog/db/models"
+)
+
+// Accelerator card statuses.
+const (
+	// AcceleratorStatusFree means the card is not reserved and not in use.
+	AcceleratorStatusFree = "free"
+	// AcceleratorStatusReserved means the card is reserved by an application, which may be stopped.
+	AcceleratorStatusReserved = "reserved"
+	// AcceleratorStatusInUse means the card is busy but not reserved by any application of the
+	// catalog, e.g. because it was deployed with the CLI.
+	AcceleratorStatusInUse = "in_use"
+)
+
+// Accelerator describes an accelerator card and the application and component owning it.
+type Accelerator struct {
+	PCIAddress        string                     `json:"pci_address" example:"0381:50:00.0"`
+	ResourceName      string                     `json:"resource_name" example:"ibm.com/spyre_pf"`
+	Status            string                     `json:"status" enums:"free,reserved,in_use" example:"reserved"`
+	ApplicationID     *uuid.UUID                 `json:"application_id,omitempty"`
+	ApplicationName   string                     `json:"application_name,omitempty" example:"rag-dev"`
+	ApplicationStatus dbmodels.ApplicationStatus `json:"application_status,omitempty" swaggertype:"string" example:"Stopped"`
+	ComponentID       *uuid.UUID                 `json:"component_id,omitempty"`
+	ComponentType     string                     `json:"component_type,omitempty" example:"llm"`
+	ReservedAt        *time.Time                 `json:"reserved_at,omitempty"`
+}
+
+// AcceleratorListResponse is the response body for listing the accelerator cards.
+type AcceleratorListResponse struct {
+	Accelerators []Accelerator `json:"accelerators"`
+}
+
+// Made with Bob
@@ -0,0 +1,22 @@
+package models
+
+import dbmodels "github.com/project-ai-services/ai-services/internal/pkg/catalog/db/models"
+
+// CreateAPIKeyRequest represents the request body for creating an application API key.
+type CreateAPIKeyRequest struct {
+	Name string `json:"name" binding:"required,max=100" example:"chat-client"`
+}
+
+// APIKeyListResponse is the response body for listing the API keys of an application.
+type APIKeyListResponse struct {
+	Keys []dbmodels.APIKey `json:"keys"`
+}
+
+// APIKeySecretResponse is returned when an API key is created or rotated. Key is only ever
+// returned here; the API server keeps a hash of it.
+type APIKeySecretResponse struct {
+	dbmodels.APIKey
+	Key string `json:"key" example:"ais_3q2-7wEjQy0X9rFkAtY1sPbV6mZ8uLcN4hGdJ5oRiKe"`
+}
+
+// Made with Bob
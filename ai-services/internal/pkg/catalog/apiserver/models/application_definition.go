@@ -0,0 +1,117 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/proxy"
+)
+
+// Application definition document identifiers.
+const (
+	ApplicationDefinitionAPIVersion = "ai-services.io/v1"
+	ApplicationDefinitionKind       = "Application"
+)
+
+// ApplicationDefinition is the portable, runtime-independent description of a deployed
+// application. It is produced by the export endpoint and accepted by the import endpoint.
+// Sensitive parameters are never embedded; they are listed in SecretRefs instead and are
+// regenerated or supplied through params on import.
+type ApplicationDefinition struct {
+	APIVersion string                        `json:"apiVersion"`
+	Kind       string                        `json:"kind"`
+	Metadata   ApplicationDefinitionMetadata `json:"metadata"`
+	Spec       ApplicationDefinitionSpec     `json:"spec"`
+}
+
+// ApplicationDefinitionMetadata holds identifying information for an application definition.
+type ApplicationDefinitionMetadata struct {
+	Name string `json:"name"`
+}
+
+// ApplicationDefinitionSpec describes what to deploy.
+type ApplicationDefinitionSpec struct {
+	CatalogID string              `json:"catalog_id"`
+	Version   string              `json:"version"`
+	Services  []ServiceDefinition `json:"services"`
+}
+
+// ServiceDefinition describes a single service of an application definition.
+type ServiceDefinition struct {
+	CatalogID string         `json:"catalog_id"`
+	Version   string         `json:"version"`
+	Params    map[string]any `json:"params,omitempty"`
+	// RoutePolicies override the proxy policies of the service routes, keyed by route type.
+	RoutePolicies map[string]*proxy.RoutePolicy `json:"route_policies,omitempty"`
+	Components    []ComponentDefinition         `json:"components"`
+	Connectors    []ConnectorReference          `json:"connectors,omitempty"`
+}
+
+// ComponentDefinition describes the provider and non-secret parameters of a component.
+type ComponentDefinition struct {
+	ComponentType string         `json:"component_type"`
+	ProviderID    string         `json:"provider_id"`
+	Version       string         `json:"version"`
+	Params        map[string]any `json:"params,omitempty"`
+	// SecretRefs lists the dotted parameter paths that were omitted from the export because
+	// they are sensitive. They are regenerated on import unless supplied in Params.
+	SecretRefs []string `json:"secret_refs,omitempty"`
+}
+
+// ConnectorReference refers to a tenant connector by name. The connector must already
+// exist on the target catalog; its credentials are never exported.
+type ConnectorReference struct {
+	Name     string `json:"name"`
+	Type     string `json:"type,omitempty"`
+	Provider string `json:"provider,omitempty"`
+}
+
+// Validate checks that the document header is one this version understands.
+func (d *ApplicationDefinition) Validate() error {
+	if d.APIVersion != ApplicationDefinitionAPIVersion {
+		return fmt.Errorf("unsupported apiVersion %q: expected %q", d.APIVersion, ApplicationDefinitionAPIVersion)
+	}
+	if d.Kind != ApplicationDefinitionKind {
+		return fmt.Errorf("unsupported kind %q: expected %q", d.Kind, ApplicationDefinitionKind)
+	}
+	if d.Metadata.Name == "" {
+		return fmt.Errorf("metadata.name is required")
+	}
+	if d.Spec.CatalogID == "" {
+		return fmt.Errorf("spec.catalog_id is required")
+	}
+
+	return nil
+}
+
+// ToCreateRequest converts the definition into a create application request.
+func (d *ApplicationDefinition) ToCreateRequest() CreateApplicationRequest {
+	req := CreateApplicationRequest{
+		Name:      d.Metadata.Name,
+		CatalogID: d.Spec.CatalogID,
+		Version:   d.Spec.Version,
+		Services:  make([]Service, 0, len(d.Spec.Services)),
+	}
+
+	for _, svc := range d.Spec.Services {
+		service := Service{
+			CatalogID:     svc.CatalogID,
+			Version:       svc.Version,
+			Params:        svc.Params,
+			RoutePolicies: svc.RoutePolicies,
+			Components:    make([]Component, 0, len(svc.Components)),
+		}
+		for _, comp := range svc.Components {
+			service.Components = append(service.Components, Component{
+				ComponentType: comp.ComponentType,
+				ProviderID:    comp.ProviderID,
+				Version:       comp.Version,
+				Params:        comp.Params,
+			})
+		}
+		req.Services = append(req.Services, service)
+	}
+
+	return req
+}
+
+// Made with Bob
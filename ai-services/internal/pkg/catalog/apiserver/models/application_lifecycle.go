@@ -0,0 +1,11 @@
+package models
+
+// ApplicationLifecycleResponse is the response body for start, stop and restart requests.
+type ApplicationLifecycleResponse struct {
+	ID        string `json:"id"`
+	ServiceID string `json:"service_id,omitempty"`
+	Action    string `json:"action"`
+	Message   string `json:"message"`
+}
+
+// Made with Bob
@@ -0,0 +1,43 @@
+package models
+
+import dbmodels "github.com/project-ai-services/ai-services/internal/pkg/catalog/db/models"
+
+// CreateBackupRequest represents the request body for taking a backup of an application.
+type CreateBackupRequest struct {
+	Target string `json:"target" binding:"required" example:"opensearch"`
+	// ConnectorID selects an object_storage connector to upload the archive to. When empty the
+	// archive is kept on the API server's disk.
+	ConnectorID string `json:"connector_id,omitempty"`
+}
+
+// CreateBackupPolicyRequest represents the request body for scheduling recurring backups.
+type CreateBackupPolicyRequest struct {
+	Target string `json:"target" binding:"required" example:"opensearch"`
+	// Schedule is a 5-field cron expression or one of @hourly, @daily, @weekly and @monthly,
+	// evaluated in the API server's time zone.
+	Schedule string `json:"schedule" binding:"required" example:"0 2 * * *"`
+	// ConnectorID selects an object_storage connector to upload archives to. When empty archives
+	// are kept on the API server's disk.
+	ConnectorID string `json:"connector_id,omitempty"`
+	// Retention is the number of completed backups of the policy to keep.
+	Retention int `json:"retention" binding:"required" example:"7"`
+}
+
+// BackupListResponse is the response body for listing the backups of an application.
+type BackupListResponse struct {
+	Backups []dbmodels.Backup `json:"backups"`
+}
+
+// BackupPolicyListResponse is the response body for listing the backup policies of an application.
+type BackupPolicyListResponse struct {
+	Policies []dbmodels.BackupPolicy `json:"policies"`
+}
+
+// RestoreBackupResponse is the response body for a restore request.
+type RestoreBackupResponse struct {
+	ID       string `json:"id"`
+	BackupID string `json:"backup_id"`
+	Message  string `json:"message"`
+}
+
+// Made with Bob
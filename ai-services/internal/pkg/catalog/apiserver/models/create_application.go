@@ -1,5 +1,10 @@
 package models
 
+import (
+	"github.com/google/uuid"
+	"github.com/project-ai-services/ai-services/internal/pkg/proxy"
+)
+
 // CreateApplicationRequest represents the request body for creating a new application.
 type CreateApplicationRequest struct {
 	Name      string    `json:"name" binding:"required,min=3,max=100"`
@@ -15,6 +20,12 @@ type Service struct {
 	Version    string         `json:"version" binding:"required"`
 	Components []Component    `json:"components" binding:"required,dive"`
 	Params     map[string]any `json:"params"` // Service-level parameters
+	// RoutePolicies override the proxy policies the service metadata sets for its routes, keyed
+	// by route type (e.g., "api"). Only the limits set here replace the defaults.
+	RoutePolicies map[string]*proxy.RoutePolicy `json:"route_policies,omitempty"`
+	// ConnectorIDs are the connectors the service depends on, recorded with the service. Set when
+	// importing a definition, not from the request body.
+	ConnectorIDs []uuid.UUID `json:"-"`
 }
 
 // Component represents a component configuration for a service.
@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// Model describes a model downloaded to the models directory of the host.
+type Model struct {
+	Name       string      `json:"name" example:"ibm-granite/granite-3.3-8b-instruct"`
+	Path       string      `json:"path" example:"/var/lib/ai-services/models/ibm-granite/granite-3.3-8b-instruct"`
+	SizeBytes  int64       `json:"size_bytes" example:"16340000000"`
+	Revision   string      `json:"revision,omitempty" example:"51dd4bc2ade4059a6bd87649d68aa11e4fb2529b"`
+	ModifiedAt time.Time   `json:"modified_at"`
+	Files      []ModelFile `json:"files"`
+	UsedBy     []string    `json:"used_by" example:"rag-dev"`
+}
+
+// ModelFile describes a file of a model. The checksum is the one recorded when the file was
+// downloaded, or the computed one when checksums are requested.
+type ModelFile struct {
+	Path      string `json:"path" example:"model-00001-of-00004.safetensors"`
+	SizeBytes int64  `json:"size_bytes" example:"4976698672"`
+	SHA256    string `json:"sha256,omitempty" example:"0b3cb6a3a3c6d0ac8e05e9b0f0a6f2d1d5e5e4fa2c1b9c8d7e6f5a4b3c2d1e0f"`
+}
+
+// ModelListResponse is the list of models downloaded to the host.
+type ModelListResponse struct {
+	Models []Model `json:"models"`
+}
+
+// Made with Bob
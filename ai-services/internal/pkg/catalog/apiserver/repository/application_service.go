@@ -5,8 +5,10 @@ import (
 
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog"
 	appservice "github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/repository/application_service"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/services/backup"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/services/deletion"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/services/deployment"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/services/lifecycle"
 	dbrepo "github.com/project-ai-services/ai-services/internal/pkg/catalog/db/repository"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/validators"
 	runtimeTypes "github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
@@ -16,6 +18,10 @@ import (
 // Re-exported from the applicationservice subpackage so callers use repository.ValidationError.
 type ValidationError = appservice.ValidationError
 
+// Violation is a parameter that does not satisfy its schema.
+// Re-exported from the applicationservice subpackage.
+type Violation = appservice.Violation
+
 // ListApplicationsRequest re-exported from the applicationservice subpackage.
 type ListApplicationsRequest = appservice.ListApplicationsRequest
 
@@ -34,6 +40,11 @@ func NewApplicationService(
 	serviceRepo dbrepo.ServiceRepository,
 	componentRepo dbrepo.ComponentRepository,
 	serviceDependencyRepo dbrepo.ServiceDependencyRepository,
+	connectorRepo dbrepo.ConnectorRepository,
+	backupRepo dbrepo.BackupRepository,
+	apiKeyRepo dbrepo.APIKeyRepository,
+	allocationRepo dbrepo.AcceleratorAllocationRepository,
+	backupDir string,
 	provider *catalog.CatalogProvider,
 	runtimeType runtimeTypes.RuntimeType,
 ) ApplicationServiceInterface {
@@ -42,10 +53,16 @@ func NewApplicationService(
 		ServiceRepo:           serviceRepo,
 		ComponentRepo:         componentRepo,
 		ServiceDependencyRepo: serviceDependencyRepo,
+		ConnectorRepo:         connectorRepo,
 		Provider:              provider,
-		DeploymentPlanner:     deployment.NewDeploymentPlanner(provider, componentRepo),
-		DeploymentExecutor:    deployment.NewDeploymentExecutor(provider, appRepo, serviceRepo, componentRepo),
+		DeploymentPlanner:     deployment.NewDeploymentPlanner(provider, componentRepo, allocationRepo),
+		DeploymentExecutor:    deployment.NewDeploymentExecutor(provider, appRepo, serviceRepo, componentRepo, allocationRepo, connectorRepo),
 		DeletionExecutor:      deletion.NewDeletionExecutor(appRepo, serviceRepo, componentRepo, serviceDependencyRepo),
+		LifecycleExecutor:     lifecycle.NewLifecycleExecutor(serviceRepo, componentRepo),
+		BackupRepo:            backupRepo,
+		BackupExecutor:        backup.NewBackupExecutor(backupRepo, connectorRepo, runtimeType, backupDir),
+		APIKeyRepo:            apiKeyRepo,
+		AllocationRepo:        allocationRepo,
 		Validator:             validators.NewApplicationValidator(provider),
 	}
 
@@ -0,0 +1,100 @@
+package applicationservice
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/accelerator"
+	apimodels "github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/db/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+)
+
+// errAcceleratorsNotSupported is returned on runtimes whose accelerator cards are not reserved by
+// the catalog.
+var errAcceleratorsNotSupported = &ValidationError{
+	Code:    http.StatusNotImplemented,
+	Message: "accelerator cards are only reserved by the catalog with the podman runtime",
+}
+
+// ListAccelerators returns the accelerator cards with their owning application and component.
+// The Spyre cards attached to the host are listed too, including the unreserved ones.
+func (s *ApplicationServiceBase) ListAccelerators(ctx context.Context) (*apimodels.AcceleratorListResponse, error) {
+	allocations, err := s.AllocationRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accelerator allocations: %w", err)
+	}
+
+	cards, err := helpers.DiscoverAccelerators(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover Spyre cards: %w", err)
+	}
+
+	return &apimodels.AcceleratorListResponse{
+		Accelerators: buildAccelerators(allocations, accelerator.Addresses(cards), accelerator.FreeAddresses(cards)),
+	}, nil
+}
+
+// buildAccelerators merges the reservation ledger with the Spyre cards attached to the host and
+// the ones that are free on it. The result is ordered by PCI address.
+func buildAccelerators(allocations []models.AcceleratorAllocation, attached, free []string) []apimodels.Accelerator {
+	byAddress := make(map[string]apimodels.Accelerator)
+
+	for _, addr := range attached {
+		addr = normalizePCIAddress(addr)
+		byAddress[addr] = apimodels.Accelerator{
+			PCIAddress:   addr,
+			ResourceName: constants.SpyreResourceName,
+			Status:       apimodels.AcceleratorStatusInUse,
+		}
+	}
+	for _, addr := range free {
+		addr = normalizePCIAddress(addr)
+		byAddress[addr] = apimodels.Accelerator{
+			PCIAddress:   addr,
+			ResourceName: constants.SpyreResourceName,
+			Status:       apimodels.AcceleratorStatusFree,
+		}
+	}
+
+	// Reserved cards of stopped applications look free on the host; the ledger takes precedence
+	for _, a := range allocations {
+		applicationID, reservedAt := a.ApplicationID, a.ReservedAt
+		byAddress[normalizePCIAddress(a.PCIAddress)] = apimodels.Accelerator{
+			PCIAddress:        normalizePCIAddress(a.PCIAddress),
+			ResourceName:      a.ResourceName,
+			Status:            apimodels.AcceleratorStatusReserved,
+			ApplicationID:     &applicationID,
+			ApplicationName:   a.ApplicationName,
+			ApplicationStatus: a.ApplicationStatus,
+			ComponentID:       a.ComponentID,
+			ComponentType:     a.ComponentType,
+			ReservedAt:        &reservedAt,
+		}
+	}
+
+	accelerators := make([]apimodels.Accelerator, 0, len(byAddress))
+	for _, accelerator := range byAddress {
+		accelerators = append(accelerators, accelerator)
+	}
+	sort.Slice(accelerators, func(i, j int) bool { return accelerators[i].PCIAddress < accelerators[j].PCIAddress })
+
+	return accelerators
+}
+
+// normalizePCIAddress trims a PCI address and adds the 0000 domain that lspci leaves out on hosts
+// with a single PCI domain, so addresses from lspci and sysfs compare equal.
+func normalizePCIAddress(addr string) string {
+	addr = strings.TrimSpace(addr)
+	if strings.Count(addr, ":") == 1 {
+		addr = "0000:" + addr
+	}
+
+	return addr
+}
+
+// Made with Bob
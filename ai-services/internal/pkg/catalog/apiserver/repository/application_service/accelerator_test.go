@@ -0,0 +1,55 @@
+package applicationservice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	apimodels "github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/db/models"
+)
+
+func TestBuildAccelerators(t *testing.T) {
+	appID, componentID := uuid.New(), uuid.New()
+	allocations := []models.AcceleratorAllocation{{
+		PCIAddress:        "0381:50:00.0",
+		ResourceName:      "ibm.com/spyre_pf",
+		ApplicationID:     appID,
+		ApplicationName:   "rag-dev",
+		ApplicationStatus: models.ApplicationStatusStopped,
+		ComponentID:       &componentID,
+		ComponentType:     "llm",
+		ReservedAt:        time.Now(),
+	}}
+	attached := []string{"0381:50:00.0", "0382:60:00.0", "70:00.0"}
+	// The card of the stopped application looks free on the host
+	free := []string{"0381:50:00.0\n", "0000:70:00.0\n"}
+
+	got := buildAccelerators(allocations, attached, free)
+
+	want := map[string]string{
+		"0000:70:00.0": apimodels.AcceleratorStatusFree,
+		"0381:50:00.0": apimodels.AcceleratorStatusReserved,
+		"0382:60:00.0": apimodels.AcceleratorStatusInUse,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d accelerators, got %+v", len(want), got)
+	}
+	for i, accelerator := range got {
+		if i > 0 && got[i-1].PCIAddress >= accelerator.PCIAddress {
+			t.Errorf("expected accelerators ordered by PCI address, got %s before %s", got[i-1].PCIAddress, accelerator.PCIAddress)
+		}
+		if want[accelerator.PCIAddress] != accelerator.Status {
+			t.Errorf("expected %s to be %s, got %s", accelerator.PCIAddress, want[accelerator.PCIAddress], accelerator.Status)
+		}
+	}
+
+	reserved := got[1]
+	if reserved.ApplicationID == nil || *reserved.ApplicationID != appID || reserved.ApplicationName != "rag-dev" ||
+		reserved.ComponentID == nil || *reserved.ComponentID != componentID {
+		t.Errorf("expected the reserved card to be owned by rag-dev/llm, got %+v", reserved)
+	}
+	if got[0].ApplicationID != nil || got[2].ApplicationID != nil {
+		t.Errorf("expected unreserved cards without owner, got %+v and %+v", got[0], got[2])
+	}
+}
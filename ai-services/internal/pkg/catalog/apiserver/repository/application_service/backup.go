@@ -0,0 +1,382 @@
+package applicationservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	apimodels "github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/services/backup"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/db/models"
+	dbrepo "github.com/project-ai-services/ai-services/internal/pkg/catalog/db/repository"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+// ListBackups returns the backups of an application, newest first. Backups of a deleted application
+// with the same name are included, so that they can be restored after the application is recreated.
+func (s *ApplicationServiceBase) ListBackups(ctx context.Context, id uuid.UUID) (*apimodels.BackupListResponse, error) {
+	app, err := s.getApplication(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	backups, err := s.BackupRepo.ListByApplication(ctx, id, app.Name, app.CreatedBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list backups: %w", err)
+	}
+
+	return &apimodels.BackupListResponse{Backups: backups}, nil
+}
+
+// CreateBackup validates the request and asynchronously backs up one target of a running
+// application. The returned record is InProgress until the backup finishes.
+func (s *ApplicationServiceBase) CreateBackup(ctx context.Context, id uuid.UUID, user string, req apimodels.CreateBackupRequest) (*models.Backup, error) {
+	app, err := s.getOwnedApplication(ctx, id, user)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateBackupTarget(req.Target); err != nil {
+		return nil, err
+	}
+
+	destination, connectorID, err := s.resolveBackupDestination(ctx, req.ConnectorID)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &models.Backup{
+		ApplicationID:   &app.ID,
+		ApplicationName: app.Name,
+		CatalogID:       app.CatalogID,
+		Target:          req.Target,
+		Destination:     destination,
+		ConnectorID:     connectorID,
+		Status:          models.BackupStatusInProgress,
+		CreatedBy:       user,
+	}
+
+	if err := s.startBackup(ctx, app, record, 0); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// RunBackupPolicy asynchronously takes a backup for a policy. It is called by the backup scheduler.
+func (s *ApplicationServiceBase) RunBackupPolicy(ctx context.Context, policy *models.BackupPolicy) error {
+	app, err := s.getApplication(ctx, policy.ApplicationID)
+	if err != nil {
+		return err
+	}
+
+	record := &models.Backup{
+		ApplicationID:   &app.ID,
+		ApplicationName: app.Name,
+		CatalogID:       app.CatalogID,
+		PolicyID:        &policy.ID,
+		Target:          policy.Target,
+		Destination:     policy.Destination,
+		ConnectorID:     policy.ConnectorID,
+		Status:          models.BackupStatusInProgress,
+		CreatedBy:       policy.CreatedBy,
+	}
+
+	return s.startBackup(ctx, app, record, policy.Retention)
+}
+
+// startBackup records an InProgress backup and runs it in the background.
+func (s *ApplicationServiceBase) startBackup(ctx context.Context, app *models.Application, record *models.Backup, retention int) error {
+	if app.Status != models.ApplicationStatusRunning {
+		return &ValidationError{Code: http.StatusConflict, Message: fmt.Sprintf(ErrMsgApplicationNotRunning, app.Status)}
+	}
+
+	if !s.BackupExecutor.Acquire(app.ID) {
+		return &ValidationError{Code: http.StatusConflict, Message: ErrMsgBackupInProgress}
+	}
+
+	details, err := s.GetApplicationByID(ctx, app.ID)
+	if err != nil {
+		s.BackupExecutor.Release(app.ID)
+
+		return err
+	}
+
+	if err := s.BackupRepo.Insert(ctx, record); err != nil {
+		s.BackupExecutor.Release(app.ID)
+
+		return fmt.Errorf("failed to create backup record: %w", err)
+	}
+
+	go func(ctx context.Context) {
+		defer s.BackupExecutor.Release(app.ID)
+		defer func() {
+			if r := recover(); r != nil {
+				logger.ErrorfCtx(ctx, "Panic recovered in backup goroutine for application %s: %v", app.ID, r)
+			}
+		}()
+
+		_ = s.BackupExecutor.Run(ctx, record, details, retention)
+	}(detachedContext(ctx))
+
+	return nil
+}
+
+// RestoreBackup validates the request and asynchronously restores a completed backup into the
+// running application it was taken from, or into the application that replaced it. The outcome
+// is recorded in the message of the backup.
+func (s *ApplicationServiceBase) RestoreBackup(ctx context.Context, id, backupID uuid.UUID, user string) (*apimodels.RestoreBackupResponse, error) {
+	app, err := s.getOwnedApplication(ctx, id, user)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := s.getBackup(ctx, app, backupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if record.Status != models.BackupStatusCompleted {
+		return nil, &ValidationError{Code: http.StatusConflict, Message: fmt.Sprintf(ErrMsgBackupNotCompleted, record.Status)}
+	}
+
+	if record.CatalogID != app.CatalogID {
+		return nil, &ValidationError{Code: http.StatusConflict, Message: fmt.Sprintf(ErrMsgBackupCatalogMismatch, record.CatalogID, app.CatalogID)}
+	}
+
+	if app.Status != models.ApplicationStatusRunning {
+		return nil, &ValidationError{Code: http.StatusConflict, Message: fmt.Sprintf(ErrMsgApplicationNotRunning, app.Status)}
+	}
+
+	if !s.BackupExecutor.Acquire(id) {
+		return nil, &ValidationError{Code: http.StatusConflict, Message: ErrMsgBackupInProgress}
+	}
+
+	details, err := s.GetApplicationByID(ctx, id)
+	if err != nil {
+		s.BackupExecutor.Release(id)
+
+		return nil, err
+	}
+
+	go func(ctx context.Context) {
+		defer s.BackupExecutor.Release(id)
+		defer func() {
+			if r := recover(); r != nil {
+				logger.ErrorfCtx(ctx, "Panic recovered in restore goroutine for application %s: %v", id, r)
+			}
+		}()
+
+		_ = s.BackupExecutor.Restore(ctx, record, details)
+	}(detachedContext(ctx))
+
+	return &apimodels.RestoreBackupResponse{
+		ID:       id.String(),
+		BackupID: backupID.String(),
+		Message:  "Restore initiated successfully",
+	}, nil
+}
+
+// DeleteBackup removes a backup archive and its record.
+func (s *ApplicationServiceBase) DeleteBackup(ctx context.Context, id, backupID uuid.UUID, user string) error {
+	app, err := s.getOwnedApplication(ctx, id, user)
+	if err != nil {
+		return err
+	}
+
+	record, err := s.getBackup(ctx, app, backupID)
+	if err != nil {
+		return err
+	}
+
+	if record.Status == models.BackupStatusInProgress {
+		return &ValidationError{Code: http.StatusConflict, Message: fmt.Sprintf(ErrMsgBackupNotCompleted, record.Status)}
+	}
+
+	return s.BackupExecutor.Remove(ctx, record)
+}
+
+// ListBackupPolicies returns the backup policies of an application.
+func (s *ApplicationServiceBase) ListBackupPolicies(ctx context.Context, id uuid.UUID) (*apimodels.BackupPolicyListResponse, error) {
+	if _, err := s.getApplication(ctx, id); err != nil {
+		return nil, err
+	}
+
+	policies, err := s.BackupRepo.ListPoliciesByApplication(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list backup policies: %w", err)
+	}
+
+	return &apimodels.BackupPolicyListResponse{Policies: policies}, nil
+}
+
+// CreateBackupPolicy validates the request and schedules recurring backups of an application target.
+func (s *ApplicationServiceBase) CreateBackupPolicy(ctx context.Context, id uuid.UUID, user string, req apimodels.CreateBackupPolicyRequest) (*models.BackupPolicy, error) {
+	if _, err := s.getOwnedApplication(ctx, id, user); err != nil {
+		return nil, err
+	}
+
+	if err := validateBackupTarget(req.Target); err != nil {
+		return nil, err
+	}
+
+	if _, err := backup.ParseSchedule(req.Schedule); err != nil {
+		return nil, &ValidationError{Code: http.StatusBadRequest, Message: fmt.Sprintf(ErrMsgInvalidBackupSchedule, err)}
+	}
+
+	if req.Retention < 1 {
+		return nil, &ValidationError{Code: http.StatusBadRequest, Message: ErrMsgInvalidBackupRetention}
+	}
+
+	destination, connectorID, err := s.resolveBackupDestination(ctx, req.ConnectorID)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &models.BackupPolicy{
+		ApplicationID: id,
+		Target:        req.Target,
+		Schedule:      strings.TrimSpace(req.Schedule),
+		Destination:   destination,
+		ConnectorID:   connectorID,
+		Retention:     req.Retention,
+		Enabled:       true,
+		CreatedBy:     user,
+	}
+
+	if err := s.BackupRepo.InsertPolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// DeleteBackupPolicy removes a backup policy. Backups already taken by the policy are kept.
+func (s *ApplicationServiceBase) DeleteBackupPolicy(ctx context.Context, id, policyID uuid.UUID, user string) error {
+	if _, err := s.getOwnedApplication(ctx, id, user); err != nil {
+		return err
+	}
+
+	policy, err := s.BackupRepo.GetPolicy(ctx, policyID)
+	if err != nil {
+		return err
+	}
+	if policy == nil || policy.ApplicationID != id {
+		return &ValidationError{Code: http.StatusNotFound, Message: ErrMsgBackupPolicyNotFound}
+	}
+
+	if _, err := s.BackupRepo.DeletePolicy(ctx, policyID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// getApplication retrieves an application, returning a 404 ValidationError if it does not exist.
+func (s *ApplicationServiceBase) getApplication(ctx context.Context, id uuid.UUID) (*models.Application, error) {
+	app, err := s.AppRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get application: %w", err)
+	}
+	if app == nil {
+		return nil, &ValidationError{Code: http.StatusNotFound, Message: ErrMsgApplicationNotFound}
+	}
+
+	return app, nil
+}
+
+// getOwnedApplication retrieves an application and checks that user owns it.
+func (s *ApplicationServiceBase) getOwnedApplication(ctx context.Context, id uuid.UUID, user string) (*models.Application, error) {
+	app, err := s.getApplication(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if app.CreatedBy != user {
+		return nil, &ValidationError{Code: http.StatusForbidden, Message: ErrMsgUserNotOwner}
+	}
+
+	return app, nil
+}
+
+// getBackup retrieves a backup of an application, returning a 404 ValidationError if the backup
+// does not exist or belongs to another application. Backups the owner took of a deleted
+// application with the same name belong to app, as in ListBackups.
+func (s *ApplicationServiceBase) getBackup(ctx context.Context, app *models.Application, backupID uuid.UUID) (*models.Backup, error) {
+	record, err := s.BackupRepo.GetByID(ctx, backupID)
+	if err != nil {
+		return nil, err
+	}
+	if record == nil || !backupBelongsTo(record, app) {
+		return nil, &ValidationError{Code: http.StatusNotFound, Message: ErrMsgBackupNotFound}
+	}
+
+	return record, nil
+}
+
+func backupBelongsTo(record *models.Backup, app *models.Application) bool {
+	if record.ApplicationID != nil {
+		return *record.ApplicationID == app.ID
+	}
+
+	return record.ApplicationName == app.Name && record.CreatedBy == app.CreatedBy
+}
+
+func validateBackupTarget(target string) error {
+	if !slices.Contains(backup.Targets, target) {
+		return &ValidationError{
+			Code:    http.StatusBadRequest,
+			Message: fmt.Sprintf(ErrMsgInvalidBackupTarget, target, strings.Join(backup.Targets, ", ")),
+		}
+	}
+
+	return nil
+}
+
+// resolveBackupDestination returns where archives are stored: on the API server's disk when
+// connectorID is empty, otherwise in the referenced object_storage connector.
+func (s *ApplicationServiceBase) resolveBackupDestination(ctx context.Context, connectorID string) (models.BackupDestination, *uuid.UUID, error) {
+	if connectorID == "" {
+		return models.BackupDestinationLocal, nil, nil
+	}
+
+	id, err := uuid.Parse(connectorID)
+	if err != nil {
+		return "", nil, &ValidationError{Code: http.StatusBadRequest, Message: ErrMsgInvalidConnectorID}
+	}
+
+	connector, err := s.ConnectorRepo.GetByID(ctx, id, false)
+	if err != nil {
+		if errors.Is(err, dbrepo.ErrConnectorNotFound) {
+			return "", nil, &ValidationError{Code: http.StatusUnprocessableEntity, Message: fmt.Sprintf(ErrMsgBackupConnectorNotFound, connectorID)}
+		}
+
+		return "", nil, fmt.Errorf("failed to get connector: %w", err)
+	}
+
+	if connector.Provider != backup.ObjectStorageProvider {
+		return "", nil, &ValidationError{
+			Code:    http.StatusUnprocessableEntity,
+			Message: fmt.Sprintf(ErrMsgBackupConnectorProvider, connector.Name, connector.Provider, backup.ObjectStorageProvider),
+		}
+	}
+
+	return models.BackupDestinationConnector, &id, nil
+}
+
+// detachedContext returns a background context carrying the request ID of ctx, for work that
+// outlives the request.
+func detachedContext(ctx context.Context) context.Context {
+	detached := context.Background()
+	if requestID, ok := ctx.Value(logger.RequestIDKey).(string); ok && requestID != "" {
+		detached = context.WithValue(detached, logger.RequestIDKey, requestID)
+	}
+
+	return detached
+}
+
+// Made with Bob
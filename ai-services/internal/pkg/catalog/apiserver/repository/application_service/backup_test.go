@@ -0,0 +1,31 @@
+package applicationservice
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/db/models"
+)
+
+func TestBackupBelongsTo(t *testing.T) {
+	app := &models.Application{ID: uuid.New(), Name: "rag-dev", CreatedBy: "alice"}
+	otherID := uuid.New()
+
+	tests := []struct {
+		name   string
+		record models.Backup
+		want   bool
+	}{
+		{"same application", models.Backup{ApplicationID: &app.ID, ApplicationName: "rag-dev"}, true},
+		{"other application", models.Backup{ApplicationID: &otherID, ApplicationName: "rag-dev", CreatedBy: "alice"}, false},
+		{"deleted application of the same name", models.Backup{ApplicationName: "rag-dev", CreatedBy: "alice"}, true},
+		{"deleted application of another owner", models.Backup{ApplicationName: "rag-dev", CreatedBy: "bob"}, false},
+		{"deleted application of another name", models.Backup{ApplicationName: "rag-prod", CreatedBy: "alice"}, false},
+	}
+
+	for _, tt := range tests {
+		if got := backupBelongsTo(&tt.record, app); got != tt.want {
+			t.Errorf("%s: backupBelongsTo() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
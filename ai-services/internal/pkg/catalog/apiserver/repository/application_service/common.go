@@ -6,12 +6,15 @@ import (
 	"math"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog"
 	apimodels "github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/services/backup"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/services/deletion"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/services/deployment"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/services/lifecycle"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/db/models"
 	dbrepo "github.com/project-ai-services/ai-services/internal/pkg/catalog/db/repository"
@@ -21,6 +24,7 @@ import (
 	clitemplates "github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
 	consts "github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/metrics"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/common"
 	runtimeTypes "github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
@@ -30,6 +34,9 @@ import (
 // ValidationError represents a validation error with HTTP status code.
 type ValidationError = validators.ValidationError
 
+// Violation is a parameter that does not satisfy its schema, reported in a ValidationError.
+type Violation = validators.Violation
+
 // ListApplicationsRequest contains parameters for listing applications.
 type ListApplicationsRequest struct {
 	Page           int
@@ -85,10 +92,16 @@ type ApplicationServiceBase struct {
 	ServiceRepo           dbrepo.ServiceRepository
 	ComponentRepo         dbrepo.ComponentRepository
 	ServiceDependencyRepo dbrepo.ServiceDependencyRepository
+	ConnectorRepo         dbrepo.ConnectorRepository
 	Provider              *catalog.CatalogProvider
 	DeploymentPlanner     *deployment.DeploymentPlanner
 	DeploymentExecutor    *deployment.DeploymentExecutor
 	DeletionExecutor      *deletion.DeletionExecutor
+	LifecycleExecutor     *lifecycle.LifecycleExecutor
+	BackupRepo            dbrepo.BackupRepository
+	BackupExecutor        *backup.BackupExecutor
+	APIKeyRepo            dbrepo.APIKeyRepository
+	AllocationRepo        dbrepo.AcceleratorAllocationRepository
 	Validator             *validators.ApplicationValidator
 
 	// DeploymentRegistry tracks in-flight deployments so they can be cancelled
@@ -472,6 +485,13 @@ func (s *ApplicationServiceBase) insertApplicationRecord(
 	return nil
 }
 
+// spyrePlacementMetadataKey is the component metadata key of the Spyre card placement. It is not a
+// param of the component.
+const spyrePlacementMetadataKey = "spyre_placement"
+
+// modelParamKey is the component parameter naming the model the component serves.
+const modelParamKey = "model"
+
 // insertComponentRecords inserts component records and returns a map of component hashes to UUIDs.
 func (s *ApplicationServiceBase) insertComponentRecords(
 	ctx context.Context,
@@ -488,6 +508,22 @@ func (s *ApplicationServiceBase) insertComponentRecords(
 			return nil, fmt.Errorf("failed to filter component metadata for %s: %w", hash, err)
 		}
 
+		// Record the model the component serves, which services may set rather than the request
+		if model, ok := comp.Values[modelParamKey].(string); ok && model != "" {
+			if metadata == nil {
+				metadata = map[string]any{}
+			}
+			metadata[modelParamKey] = model
+		}
+
+		// Record where the Spyre cards of the component were placed and why
+		if comp.Placement != nil {
+			if metadata == nil {
+				metadata = map[string]any{}
+			}
+			metadata[spyrePlacementMetadataKey] = comp.Placement.Metadata()
+		}
+
 		component := &models.Component{
 			ID:       instanceUUID,
 			Type:     comp.ComponentType,
@@ -515,12 +551,18 @@ func (s *ApplicationServiceBase) insertServiceRecords(
 	componentIDMap map[string]uuid.UUID,
 ) error {
 	for serviceID, svc := range plan.Services {
+		metadata, err := s.buildServiceMetadata(ctx, svc)
+		if err != nil {
+			return fmt.Errorf("failed to build service metadata for %s: %w", serviceID, err)
+		}
+
 		service := &models.Service{
 			ID:        uuid.Nil,
 			AppID:     plan.ApplicationID,
 			CatalogID: svc.CatalogID,
 			Status:    models.ServiceStatusInitializing,
 			Version:   svc.Version,
+			Metadata:  metadata,
 		}
 
 		if err := s.ServiceRepo.Insert(ctx, service); err != nil {
@@ -532,11 +574,53 @@ func (s *ApplicationServiceBase) insertServiceRecords(
 		if err := s.insertServiceDependencies(ctx, service.ID, svc.ComponentRefs, componentIDMap); err != nil {
 			return err
 		}
+
+		for _, connectorID := range svc.ConnectorIDs {
+			if err := s.ServiceDependencyRepo.AddDependency(ctx, &models.ServiceDependency{
+				ServiceID:      service.ID,
+				DependencyID:   connectorID,
+				DependencyType: models.DependencyTypeConnector,
+			}); err != nil {
+				return fmt.Errorf("failed to add connector dependency of service %s: %w", serviceID, err)
+			}
+		}
 	}
 
 	return nil
 }
 
+// routePoliciesMetadataKey is the service metadata key of the route policy overrides of the
+// request. It is not a param of the service.
+const routePoliciesMetadataKey = "route_policies"
+
+// buildServiceMetadata returns the non-secret params and the route policy overrides a service is
+// deployed with.
+func (s *ApplicationServiceBase) buildServiceMetadata(ctx context.Context, svc *deployment.ServicePlan) (map[string]any, error) {
+	var metadata map[string]any
+	if svc.Params != nil {
+		schema, err := s.Provider.GetServiceParams(ctx, svc.CatalogID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load schema for service %s: %w", svc.CatalogID, err)
+		}
+
+		// Without a schema no param is known to be safe to keep
+		properties, _ := schema["properties"].(map[string]any)
+		metadata, err = s.filterSensitiveFields(ctx, svc.Params, properties)
+		if err != nil {
+			return nil, fmt.Errorf("failed to filter sensitive fields: %w", err)
+		}
+	}
+
+	if len(svc.PolicyOverrides) > 0 {
+		if metadata == nil {
+			metadata = map[string]any{}
+		}
+		metadata[routePoliciesMetadataKey] = svc.PolicyOverrides
+	}
+
+	return metadata, nil
+}
+
 // insertServiceDependencies inserts dependencies between services and components.
 func (s *ApplicationServiceBase) insertServiceDependencies(
 	ctx context.Context,
@@ -633,10 +717,13 @@ func (s *ApplicationServiceBase) CreateApplication(ctx context.Context, req apim
 		}
 	}
 
-	// Phase 2: validate payload
+	// Phase 2: validate payload, then the params of all services and components against their schemas
 	if err := s.Validator.ValidateDeploymentRequest(ctx, req); err != nil {
 		return nil, err
 	}
+	if err := s.DeploymentPlanner.ValidateParams(ctx, req); err != nil {
+		return nil, err
+	}
 
 	// Phase 3: create deployment plan
 	plan, err := s.DeploymentPlanner.PlanDeployment(ctx, req, runtimeType.String())
@@ -644,8 +731,12 @@ func (s *ApplicationServiceBase) CreateApplication(ctx context.Context, req apim
 		return nil, fmt.Errorf("failed to create deployment plan: %w", err)
 	}
 
-	// Phase 4: persist DB records
+	// Phase 4: persist DB records. The Spyre cards reserved while planning are released on failure.
 	if err := s.InsertDeploymentRecords(ctx, plan, req.CreatedBy); err != nil {
+		if releaseErr := s.DeploymentPlanner.ReleaseReservations(ctx, plan.ApplicationID); releaseErr != nil {
+			logger.ErrorfCtx(ctx, "Failed to release Spyre cards of application %s: %v", plan.ApplicationName, releaseErr)
+		}
+
 		return nil, fmt.Errorf("failed to insert deployment records: %w", err)
 	}
 
@@ -672,6 +763,7 @@ func (s *ApplicationServiceBase) CreateApplication(ctx context.Context, req apim
 // deployCtx is already derived and registered with the DeploymentRegistry by the caller.
 func (s *ApplicationServiceBase) executeDeploymentAsync(deployCtx context.Context, plan *deployment.DeploymentPlan, req apimodels.CreateApplicationRequest, runtimeType runtimeTypes.RuntimeType) {
 	ctx := deployCtx
+	start := time.Now()
 
 	// Deregister on any exit path — success, error, or panic.
 	if s.DeploymentRegistry != nil {
@@ -680,6 +772,7 @@ func (s *ApplicationServiceBase) executeDeploymentAsync(deployCtx context.Contex
 
 	defer func() {
 		if r := recover(); r != nil {
+			metrics.ObserveDeployment(plan.CatalogID, metrics.OutcomeFailure, start)
 			logger.ErrorfCtx(ctx, "Panic recovered in deployment goroutine for application %s: %v", plan.ApplicationName, r)
 
 			errMsg := fmt.Sprintf("Deployment panic: %v", r)
@@ -693,11 +786,13 @@ func (s *ApplicationServiceBase) executeDeploymentAsync(deployCtx context.Contex
 	if err != nil {
 		// Context cancelled — deletion is in charge of status, exit silently.
 		if ctx.Err() != nil {
+			metrics.ObserveDeployment(plan.CatalogID, metrics.OutcomeCancelled, start)
 			logger.InfofCtx(ctx, "Deployment cancelled for application %s (deletion in progress)", plan.ApplicationName)
 
 			return
 		}
 
+		metrics.ObserveDeployment(plan.CatalogID, metrics.OutcomeFailure, start)
 		logger.ErrorfCtx(ctx, "Deployment failed for application %s: %v", plan.ApplicationName, err)
 
 		if updateErr := catalogutils.UpdateApplicationStatus(ctx, s.AppRepo, plan.ApplicationID.String(), models.ApplicationStatusError, err.Error()); updateErr != nil {
@@ -707,6 +802,7 @@ func (s *ApplicationServiceBase) executeDeploymentAsync(deployCtx context.Contex
 		return
 	}
 
+	metrics.ObserveDeployment(plan.CatalogID, metrics.OutcomeSuccess, start)
 	logger.InfolnCtx(ctx, fmt.Sprintf("Deployment completed successfully for application %s", plan.ApplicationName))
 }
 
@@ -1089,6 +1185,13 @@ func (s *ApplicationServiceBase) DeleteApplication(ctx context.Context, id uuid.
 		}
 	}
 
+	if app.Status == models.ApplicationStatusStarting || app.Status == models.ApplicationStatusStopping {
+		return nil, &ValidationError{
+			Code:    http.StatusConflict,
+			Message: fmt.Sprintf(ErrMsgApplicationLifecycleInProgress, app.Status),
+		}
+	}
+
 	// Cancel any in-flight deployment before transitioning to Deleting.
 	// No-op when DeploymentRegistry is nil (e.g. OpenShift stub).
 	if s.DeploymentRegistry != nil {
@@ -1114,7 +1217,7 @@ func (s *ApplicationServiceBase) DeleteApplication(ctx context.Context, id uuid.
 		deletionCtx = context.WithValue(deletionCtx, logger.RequestIDKey, requestID)
 	}
 
-	go s.executeDeletionAsync(deletionCtx, id, app.Services, orphanedComponentIDs, keepData, runtimeType)
+	go s.executeDeletionAsync(deletionCtx, id, app.CatalogID, app.Services, orphanedComponentIDs, keepData, runtimeType)
 
 	return &DeleteApplicationResponse{
 		ID:      id.String(),
@@ -1126,6 +1229,7 @@ func (s *ApplicationServiceBase) DeleteApplication(ctx context.Context, id uuid.
 func (s *ApplicationServiceBase) executeDeletionAsync(
 	parentCtx context.Context,
 	appID uuid.UUID,
+	catalogID string,
 	services []models.Service,
 	orphanedComponentIDs []uuid.UUID,
 	keepData bool,
@@ -1140,8 +1244,10 @@ func (s *ApplicationServiceBase) executeDeletionAsync(
 	if requestID != "" {
 		ctx = context.WithValue(ctx, logger.RequestIDKey, requestID)
 	}
+	start := time.Now()
 	defer func() {
 		if r := recover(); r != nil {
+			metrics.ObserveDeletion(catalogID, metrics.OutcomeFailure, start)
 			logger.ErrorfCtx(ctx, "Panic recovered in deletion goroutine for application %s: %v", appID, r)
 
 			errMsg := fmt.Sprintf("Deletion panic: %v", r)
@@ -1153,6 +1259,7 @@ func (s *ApplicationServiceBase) executeDeletionAsync(
 
 	err := s.DeletionExecutor.Execute(ctx, appID, services, orphanedComponentIDs, keepData, runtimeType)
 	if err != nil {
+		metrics.ObserveDeletion(catalogID, metrics.OutcomeFailure, start)
 		logger.ErrorfCtx(ctx, "Deletion failed for application %s: %v", appID.String(), err)
 
 		if updateErr := catalogutils.UpdateApplicationStatus(ctx, s.AppRepo, appID.String(), models.ApplicationStatusError, err.Error()); updateErr != nil {
@@ -1162,6 +1269,24 @@ func (s *ApplicationServiceBase) executeDeletionAsync(
 		return
 	}
 
+	// Deletions report their failures in the status of the application, which is kept
+	app, err := s.AppRepo.GetByID(ctx, appID)
+	if err != nil || app != nil {
+		metrics.ObserveDeletion(catalogID, metrics.OutcomeFailure, start)
+		if err != nil {
+			logger.ErrorfCtx(ctx, "Failed to check the deletion of application %s, keeping its Spyre cards reserved: %v", appID.String(), err)
+		}
+
+		// The pods of a partially deleted application may still use its cards, which stay reserved
+		return
+	}
+	metrics.ObserveDeletion(catalogID, metrics.OutcomeSuccess, start)
+
+	// Cards stay reserved while the application exists, including while it is stopped
+	if err := s.DeploymentPlanner.ReleaseReservations(ctx, appID); err != nil {
+		logger.ErrorfCtx(ctx, "Failed to release Spyre cards of application %s: %v", appID.String(), err)
+	}
+
 	logger.InfolnCtx(ctx, fmt.Sprintf("Deletion completed successfully for application id '%s'", appID.String()))
 }
 
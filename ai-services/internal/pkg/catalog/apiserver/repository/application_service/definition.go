@@ -0,0 +1,270 @@
+package applicationservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	apimodels "github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/db/models"
+	dbrepo "github.com/project-ai-services/ai-services/internal/pkg/catalog/db/repository"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	runtimeTypes "github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+// ExportApplication builds a portable definition of an application from its DB records.
+// Component parameters are taken from the stored (already secret-filtered) metadata and the
+// sensitive parameter paths are listed as secret references.
+func (s *ApplicationServiceBase) ExportApplication(ctx context.Context, id uuid.UUID) (*apimodels.ApplicationDefinition, error) {
+	app, err := s.AppRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get application: %w", err)
+	}
+	if app == nil {
+		return nil, &ValidationError{Code: http.StatusNotFound, Message: ErrMsgApplicationNotFound}
+	}
+
+	def := &apimodels.ApplicationDefinition{
+		APIVersion: apimodels.ApplicationDefinitionAPIVersion,
+		Kind:       apimodels.ApplicationDefinitionKind,
+		Metadata:   apimodels.ApplicationDefinitionMetadata{Name: app.Name},
+		Spec: apimodels.ApplicationDefinitionSpec{
+			CatalogID: app.CatalogID,
+			Version:   app.Version,
+			Services:  make([]apimodels.ServiceDefinition, 0, len(app.Services)),
+		},
+	}
+
+	for _, svc := range app.Services {
+		svcDef, err := s.exportService(ctx, svc)
+		if err != nil {
+			return nil, err
+		}
+		def.Spec.Services = append(def.Spec.Services, *svcDef)
+	}
+
+	return def, nil
+}
+
+// exportService resolves the params, route policy overrides, components and connectors of a
+// service.
+func (s *ApplicationServiceBase) exportService(ctx context.Context, svc models.Service) (*apimodels.ServiceDefinition, error) {
+	deps, err := s.ServiceDependencyRepo.GetDependenciesByServiceID(ctx, svc.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dependencies for service %s: %w", svc.ID, err)
+	}
+
+	svcDef := &apimodels.ServiceDefinition{
+		CatalogID:  svc.CatalogID,
+		Version:    svc.Version,
+		Components: []apimodels.ComponentDefinition{},
+	}
+	if err := exportServiceMetadata(svc.Metadata, svcDef); err != nil {
+		return nil, fmt.Errorf("failed to export metadata of service %s: %w", svc.ID, err)
+	}
+
+	for _, dep := range deps {
+		switch dep.DependencyType {
+		case models.DependencyTypeComponent:
+			compDef, err := s.exportComponent(ctx, dep.DependencyID)
+			if err != nil {
+				return nil, err
+			}
+			if compDef != nil {
+				svcDef.Components = append(svcDef.Components, *compDef)
+			}
+		case models.DependencyTypeConnector:
+			connector, err := s.ConnectorRepo.GetByID(ctx, dep.DependencyID, false)
+			if errors.Is(err, dbrepo.ErrConnectorNotFound) {
+				logger.WarningfCtx(ctx, "Skipping deleted connector %s of service %s", dep.DependencyID, svc.ID)
+
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to get connector %s: %w", dep.DependencyID, err)
+			}
+			svcDef.Connectors = append(svcDef.Connectors, apimodels.ConnectorReference{
+				Name:     connector.Name,
+				Type:     connector.Type,
+				Provider: connector.Provider,
+			})
+		case models.DependencyTypeService:
+			// Service-to-service dependencies are re-created by the planner from the catalog.
+		}
+	}
+
+	return svcDef, nil
+}
+
+// exportServiceMetadata splits the stored metadata of a service into its params and route policy
+// overrides.
+func exportServiceMetadata(metadata map[string]any, svcDef *apimodels.ServiceDefinition) error {
+	if len(metadata) == 0 {
+		return nil
+	}
+
+	params := maps.Clone(metadata)
+	if policies, ok := params[routePoliciesMetadataKey]; ok {
+		delete(params, routePoliciesMetadataKey)
+
+		// Stored as JSON, so the policies are read back through it
+		data, err := json.Marshal(policies)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(data, &svcDef.RoutePolicies); err != nil {
+			return err
+		}
+	}
+	if len(params) > 0 {
+		svcDef.Params = params
+	}
+
+	return nil
+}
+
+func (s *ApplicationServiceBase) exportComponent(ctx context.Context, componentID uuid.UUID) (*apimodels.ComponentDefinition, error) {
+	component, err := s.ComponentRepo.GetByID(ctx, componentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get component %s: %w", componentID, err)
+	}
+	if component == nil {
+		return nil, nil
+	}
+
+	compDef := &apimodels.ComponentDefinition{
+		ComponentType: component.Type,
+		ProviderID:    component.Provider,
+		Version:       component.Version,
+		Params:        component.Metadata,
+	}
+	if _, ok := compDef.Params[spyrePlacementMetadataKey]; ok {
+		compDef.Params = maps.Clone(compDef.Params)
+		delete(compDef.Params, spyrePlacementMetadataKey)
+	}
+
+	schema, err := s.Provider.GetComponentProviderParams(ctx, component.Type, component.Provider)
+	if err != nil {
+		// The provider may have been removed from the catalog; the export is still useful.
+		logger.WarningfCtx(ctx, "Unable to load schema for component %s/%s: %v", component.Type, component.Provider, err)
+
+		return compDef, nil
+	}
+
+	if properties, ok := schema["properties"].(map[string]any); ok {
+		compDef.SecretRefs = collectSensitiveFields(properties, "")
+	}
+
+	return compDef, nil
+}
+
+// collectSensitiveFields returns the dotted paths of all schema properties with format "password".
+func collectSensitiveFields(properties map[string]any, prefix string) []string {
+	var fields []string
+
+	for key, raw := range properties {
+		fieldSchema, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+
+		if format, _ := fieldSchema["format"].(string); format == "password" {
+			fields = append(fields, path)
+
+			continue
+		}
+
+		if nested, ok := fieldSchema["properties"].(map[string]any); ok {
+			fields = append(fields, collectSensitiveFields(nested, path)...)
+		}
+	}
+
+	sort.Strings(fields)
+
+	return fields
+}
+
+// ImportApplication recreates an application from a definition. Connector references are
+// resolved by name before anything is persisted and recorded with the new services before their
+// deployment starts.
+func (s *ApplicationServiceBase) ImportApplication(
+	ctx context.Context,
+	def apimodels.ApplicationDefinition,
+	createdBy string,
+	runtimeType runtimeTypes.RuntimeType,
+) (*apimodels.CreateApplicationResponse, error) {
+	if err := def.Validate(); err != nil {
+		return nil, &ValidationError{Code: http.StatusUnprocessableEntity, Message: err.Error()}
+	}
+
+	connectorIDs, err := s.resolveConnectorReferences(ctx, def)
+	if err != nil {
+		return nil, err
+	}
+
+	req := def.ToCreateRequest()
+	req.CreatedBy = createdBy
+	for i, svcDef := range def.Spec.Services {
+		for _, ref := range svcDef.Connectors {
+			req.Services[i].ConnectorIDs = append(req.Services[i].ConnectorIDs, connectorIDs[ref.Name])
+		}
+	}
+
+	return s.CreateApplication(ctx, req, runtimeType)
+}
+
+// resolveConnectorReferences maps every referenced connector name to its ID and reports all
+// missing connectors at once.
+func (s *ApplicationServiceBase) resolveConnectorReferences(ctx context.Context, def apimodels.ApplicationDefinition) (map[string]uuid.UUID, error) {
+	wanted := map[string]bool{}
+	for _, svc := range def.Spec.Services {
+		for _, ref := range svc.Connectors {
+			wanted[ref.Name] = true
+		}
+	}
+	if len(wanted) == 0 {
+		return nil, nil
+	}
+
+	connectors, err := s.ConnectorRepo.List(ctx, &dbrepo.ConnectorFilters{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list connectors: %w", err)
+	}
+
+	ids := make(map[string]uuid.UUID, len(wanted))
+	for _, c := range connectors {
+		if wanted[c.Name] {
+			ids[c.Name] = c.ID
+		}
+	}
+
+	var missing []string
+	for name := range wanted {
+		if _, ok := ids[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+
+		return nil, &ValidationError{
+			Code:    http.StatusUnprocessableEntity,
+			Message: fmt.Sprintf(ErrMsgConnectorsNotFound, strings.Join(missing, ", ")),
+		}
+	}
+
+	return ids, nil
+}
+
+// Made with Bob
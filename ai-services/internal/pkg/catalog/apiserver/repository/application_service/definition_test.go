@@ -0,0 +1,62 @@
+package applicationservice
+
+import (
+	"reflect"
+	"testing"
+
+	apimodels "github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/proxy"
+)
+
+func TestCollectSensitiveFields(t *testing.T) {
+	properties := map[string]any{
+		"apiKey": map[string]any{"type": "string", "format": "password"},
+		"url":    map[string]any{"type": "string", "format": "uri"},
+		"auth": map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"user":     map[string]any{"type": "string"},
+				"password": map[string]any{"type": "string", "format": "password"},
+			},
+		},
+		"invalid": "not a schema",
+	}
+
+	got := collectSensitiveFields(properties, "")
+	want := []string{"apiKey", "auth.password"}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("collectSensitiveFields() = %v, want %v", got, want)
+	}
+}
+
+func TestCollectSensitiveFieldsNone(t *testing.T) {
+	got := collectSensitiveFields(map[string]any{"url": map[string]any{"type": "string"}}, "")
+	if len(got) != 0 {
+		t.Errorf("collectSensitiveFields() = %v, want none", got)
+	}
+}
+
+func TestExportServiceMetadata(t *testing.T) {
+	metadata := map[string]any{
+		"ui": map[string]any{"title": "Chat"},
+		routePoliciesMetadataKey: map[string]any{
+			"api": map[string]any{"requests_per_second": float64(5)},
+		},
+	}
+
+	var svcDef apimodels.ServiceDefinition
+	if err := exportServiceMetadata(metadata, &svcDef); err != nil {
+		t.Fatalf("exportServiceMetadata() error = %v", err)
+	}
+
+	if want := map[string]any{"ui": map[string]any{"title": "Chat"}}; !reflect.DeepEqual(svcDef.Params, want) {
+		t.Errorf("Params = %v, want %v", svcDef.Params, want)
+	}
+	if want := map[string]*proxy.RoutePolicy{"api": {RequestsPerSecond: 5}}; !reflect.DeepEqual(svcDef.RoutePolicies, want) {
+		t.Errorf("RoutePolicies = %v, want %v", svcDef.RoutePolicies, want)
+	}
+	if _, ok := metadata[routePoliciesMetadataKey]; !ok {
+		t.Error("stored metadata modified")
+	}
+}
@@ -11,6 +11,64 @@ const (
 	// ErrMsgApplicationAlreadyDeleting is returned when an application is already being deleted.
 	ErrMsgApplicationAlreadyDeleting = "application is already being deleted"
 
+	// ErrMsgApplicationNotManageable is returned when an application cannot be started or stopped in its current state.
+	ErrMsgApplicationNotManageable = "application cannot be started or stopped while in '%s' state"
+
+	// ErrMsgApplicationLifecycleInProgress is returned when an application is deleted while being started or stopped.
+	ErrMsgApplicationLifecycleInProgress = "application cannot be deleted while in '%s' state"
+
+	// ErrMsgServiceNotFound is returned when a service does not belong to the application.
+	ErrMsgServiceNotFound = "service does not exist in this application"
+
+	// ErrMsgConnectorsNotFound is returned when an imported definition references unknown connectors.
+	ErrMsgConnectorsNotFound = "referenced connectors do not exist: %s"
+
 	// ErrMsgApplicationNameExists is returned when an application with the given name already exists.
 	ErrMsgApplicationNameExists = "application with name '%s' already exists"
+
+	// ErrMsgApplicationNotRunning is returned when a backup or restore is requested for an application that is not running.
+	ErrMsgApplicationNotRunning = "application data can only be backed up or restored while the application is Running, current state is '%s'"
+
+	// ErrMsgInvalidBackupTarget is returned when a backup target is not supported.
+	ErrMsgInvalidBackupTarget = "invalid backup target '%s', valid targets are: %s"
+
+	// ErrMsgInvalidBackupSchedule is returned when a backup policy schedule cannot be parsed.
+	ErrMsgInvalidBackupSchedule = "invalid backup schedule: %v"
+
+	// ErrMsgInvalidBackupRetention is returned when a backup policy keeps no backups.
+	ErrMsgInvalidBackupRetention = "retention must be at least 1"
+
+	// ErrMsgInvalidConnectorID is returned when a connector ID is not a valid UUID.
+	ErrMsgInvalidConnectorID = "invalid connector ID format"
+
+	// ErrMsgBackupConnectorNotFound is returned when a backup references an unknown connector.
+	ErrMsgBackupConnectorNotFound = "connector '%s' does not exist"
+
+	// ErrMsgBackupConnectorProvider is returned when a backup references a connector that cannot store archives.
+	ErrMsgBackupConnectorProvider = "connector '%s' is a '%s' connector, backups require an '%s' connector"
+
+	// ErrMsgBackupInProgress is returned when a backup or restore of the application is already running.
+	ErrMsgBackupInProgress = "a backup or restore of this application is already in progress"
+
+	// ErrMsgBackupNotFound is returned when a backup does not belong to the application.
+	ErrMsgBackupNotFound = "backup does not exist for this application"
+
+	// ErrMsgBackupCatalogMismatch is returned when restoring a backup of a deleted application into
+	// an application deployed from another catalog entry.
+	ErrMsgBackupCatalogMismatch = "backup was taken from a '%s' application and cannot be restored into a '%s' application"
+
+	// ErrMsgBackupNotCompleted is returned when restoring or deleting a backup that has not completed.
+	ErrMsgBackupNotCompleted = "backup is in '%s' state"
+
+	// ErrMsgBackupPolicyNotFound is returned when a backup policy does not belong to the application.
+	ErrMsgBackupPolicyNotFound = "backup policy does not exist for this application"
+
+	// ErrMsgAPIKeyNotFound is returned when an API key does not belong to the application.
+	ErrMsgAPIKeyNotFound = "API key does not exist for this application"
+
+	// ErrMsgAPIKeyNameExists is returned when the application already has an API key with the given name.
+	ErrMsgAPIKeyNameExists = "API key with name '%s' already exists for this application"
+
+	// ErrMsgInvalidAPIKey is returned when an API key is unknown or belongs to another application.
+	ErrMsgInvalidAPIKey = "invalid API key"
 )
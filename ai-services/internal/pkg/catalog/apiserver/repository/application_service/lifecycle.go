@@ -0,0 +1,205 @@
+package applicationservice
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	apimodels "github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/services/lifecycle"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/db/models"
+	catalogutils "github.com/project-ai-services/ai-services/internal/pkg/catalog/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	runtimeTypes "github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+// lifecycleTargets holds the services and components an action applies to.
+type lifecycleTargets struct {
+	serviceIDs   []uuid.UUID
+	componentIDs []uuid.UUID
+	allServices  bool
+	appStatus    models.ApplicationStatus
+}
+
+// ChangeApplicationState validates the request and asynchronously starts, stops or restarts
+// an application. When serviceID is set only that service and its components are affected;
+// components shared with other services are started with it but never stopped or restarted.
+func (s *ApplicationServiceBase) ChangeApplicationState(
+	ctx context.Context,
+	id uuid.UUID,
+	user string,
+	action lifecycle.Action,
+	serviceID *uuid.UUID,
+	runtimeType runtimeTypes.RuntimeType,
+) (*apimodels.ApplicationLifecycleResponse, error) {
+	app, err := s.AppRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get application: %w", err)
+	}
+	if app == nil {
+		return nil, &ValidationError{Code: http.StatusNotFound, Message: ErrMsgApplicationNotFound}
+	}
+
+	if app.CreatedBy != user {
+		return nil, &ValidationError{Code: http.StatusForbidden, Message: ErrMsgUserNotOwner}
+	}
+
+	if !isLifecycleManageable(app.Status) {
+		return nil, &ValidationError{
+			Code:    http.StatusConflict,
+			Message: fmt.Sprintf(ErrMsgApplicationNotManageable, app.Status),
+		}
+	}
+
+	targets, err := s.resolveLifecycleTargets(ctx, app, action, serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The transitional status keeps other lifecycle actions and deletions off the application
+	// until this one completes
+	status, message := models.ApplicationStatusStarting, fmt.Sprintf("Application %s in progress...", action)
+	if action == lifecycle.ActionStop {
+		status = models.ApplicationStatusStopping
+	}
+	if err := catalogutils.UpdateApplicationStatus(ctx, s.AppRepo, id, status, message); err != nil {
+		return nil, err
+	}
+
+	var requestID string
+	if reqID, ok := ctx.Value(logger.RequestIDKey).(string); ok {
+		requestID = reqID
+	}
+
+	lifecycleCtx := context.Background()
+	if requestID != "" {
+		lifecycleCtx = context.WithValue(lifecycleCtx, logger.RequestIDKey, requestID)
+	}
+
+	go s.executeLifecycleAsync(lifecycleCtx, id, action, targets, runtimeType)
+
+	resp := &apimodels.ApplicationLifecycleResponse{
+		ID:      id.String(),
+		Action:  string(action),
+		Message: fmt.Sprintf("Application %s initiated successfully", action),
+	}
+	if serviceID != nil {
+		resp.ServiceID = serviceID.String()
+	}
+
+	return resp, nil
+}
+
+// isLifecycleManageable reports whether an application is in a state where its workloads exist
+// and are not being changed by a deployment, a deletion or another lifecycle action.
+func isLifecycleManageable(status models.ApplicationStatus) bool {
+	switch status {
+	case models.ApplicationStatusRunning, models.ApplicationStatusStopped, models.ApplicationStatusError:
+		return true
+	default:
+		return false
+	}
+}
+
+// resolveLifecycleTargets determines which services and components an action applies to. A
+// service cannot work without its components, so starting it starts all of them; stopping or
+// restarting it leaves the components shared with services outside the target set untouched.
+func (s *ApplicationServiceBase) resolveLifecycleTargets(ctx context.Context, app *models.Application, action lifecycle.Action, serviceID *uuid.UUID) (*lifecycleTargets, error) {
+	services := app.Services
+	if serviceID != nil {
+		services = nil
+		for _, svc := range app.Services {
+			if svc.ID == *serviceID {
+				services = []models.Service{svc}
+
+				break
+			}
+		}
+		if len(services) == 0 {
+			return nil, &ValidationError{Code: http.StatusNotFound, Message: ErrMsgServiceNotFound}
+		}
+	}
+
+	var componentIDs []uuid.UUID
+	if action == lifecycle.ActionStart {
+		candidates, err := s.collectComponentCandidates(ctx, app.ID, services)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get application components: %w", err)
+		}
+		for componentID := range candidates {
+			componentIDs = append(componentIDs, componentID)
+		}
+	} else {
+		var err error
+		componentIDs, err = s.identifyOrphanedComponents(ctx, app.ID, services)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get application components: %w", err)
+		}
+	}
+
+	serviceIDs := make([]uuid.UUID, 0, len(services))
+	for _, svc := range services {
+		serviceIDs = append(serviceIDs, svc.ID)
+	}
+
+	return &lifecycleTargets{
+		serviceIDs:   serviceIDs,
+		componentIDs: componentIDs,
+		allServices:  serviceID == nil,
+		appStatus:    app.Status,
+	}, nil
+}
+
+func (s *ApplicationServiceBase) executeLifecycleAsync(
+	ctx context.Context,
+	appID uuid.UUID,
+	action lifecycle.Action,
+	targets *lifecycleTargets,
+	runtimeType runtimeTypes.RuntimeType,
+) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.ErrorfCtx(ctx, "Panic recovered in %s goroutine for application %s: %v", action, appID, r)
+
+			errMsg := fmt.Sprintf("Application %s panic: %v", action, r)
+			if updateErr := catalogutils.UpdateApplicationStatus(ctx, s.AppRepo, appID, models.ApplicationStatusError, errMsg); updateErr != nil {
+				logger.ErrorfCtx(ctx, "Failed to update application status after panic: %v", updateErr)
+			}
+		}
+	}()
+
+	err := s.LifecycleExecutor.Execute(ctx, action, appID, targets.serviceIDs, targets.componentIDs, runtimeType)
+	if err != nil {
+		logger.ErrorfCtx(ctx, "Application %s failed for application %s: %v", action, appID, err)
+
+		if updateErr := catalogutils.UpdateApplicationStatus(ctx, s.AppRepo, appID, models.ApplicationStatusError, err.Error()); updateErr != nil {
+			logger.ErrorfCtx(ctx, "Failed to update application status to Error: %v", updateErr)
+		}
+
+		return
+	}
+
+	// Stopping a single service leaves a running application running; the sync loop keeps
+	// monitoring the remaining services.
+	status := models.ApplicationStatusRunning
+	message := ""
+	if action == lifecycle.ActionStop {
+		switch {
+		case targets.allServices || targets.appStatus == models.ApplicationStatusStopped:
+			status = models.ApplicationStatusStopped
+		default:
+			message = fmt.Sprintf("%d service(s) stopped", len(targets.serviceIDs))
+		}
+	}
+
+	if err := catalogutils.UpdateApplicationStatus(ctx, s.AppRepo, appID, status, message); err != nil {
+		logger.ErrorfCtx(ctx, "Failed to update application status to %s: %v", status, err)
+
+		return
+	}
+
+	logger.InfolnCtx(ctx, fmt.Sprintf("Application %s completed successfully for application id '%s'", action, appID.String()))
+}
+
+// Made with Bob
@@ -0,0 +1,144 @@
+package applicationservice
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	apimodels "github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/db/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/modelstore"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// ListModels returns the models downloaded to the models directory of the host with the
+// applications using them. With checksums, the checksum of every file is computed rather than
+// taken from the download metadata, which reads every file of every model.
+func (s *ApplicationServiceBase) ListModels(ctx context.Context, checksums bool) (*apimodels.ModelListResponse, error) {
+	store := modelstore.NewStore(utils.GetModelsPath())
+	models, err := store.List()
+	if err != nil {
+		return nil, err
+	}
+
+	usage, err := s.modelUsage(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the applications using the models: %w", err)
+	}
+	rt, err := vars.RuntimeFactory.Create("")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create runtime client: %w", err)
+	}
+	if err := usage.AddLegacy(rt); err != nil {
+		return nil, fmt.Errorf("failed to find the legacy applications using the models: %w", err)
+	}
+	usage.Apply(models)
+
+	response := &apimodels.ModelListResponse{Models: make([]apimodels.Model, 0, len(models))}
+	for i := range models {
+		if checksums {
+			logger.InfofCtx(ctx, "Computing checksums of model %s\n", models[i].Name)
+			if err := modelstore.ComputeChecksums(&models[i]); err != nil {
+				return nil, err
+			}
+		}
+		response.Models = append(response.Models, toAPIModel(models[i]))
+	}
+
+	return response, nil
+}
+
+// modelUsage returns the applications using each model from the catalog: the model parameter recorded
+// for every component their services depend on. A component whose provider takes a model but has
+// none recorded fails the lookup rather than being reported as using no model.
+func (s *ApplicationServiceBase) modelUsage(ctx context.Context) (modelstore.Usage, error) {
+	apps, err := s.AppRepo.GetAll(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list applications: %w", err)
+	}
+
+	usage := modelstore.Usage{}
+	for _, app := range apps {
+		services, err := s.ServiceRepo.GetByAppID(ctx, app.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list services of application %s: %w", app.Name, err)
+		}
+
+		for _, svc := range services {
+			deps, err := s.ServiceDependencyRepo.GetDependenciesByServiceID(ctx, svc.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list dependencies of service %s: %w", svc.ID, err)
+			}
+
+			for _, dep := range deps {
+				if dep.DependencyType != models.DependencyTypeComponent {
+					continue
+				}
+				model, err := s.componentModel(ctx, dep.DependencyID)
+				if err != nil {
+					return nil, fmt.Errorf("failed to find the model of application %s: %w", app.Name, err)
+				}
+				if model != "" {
+					usage.Add(model, app.Name)
+				}
+			}
+		}
+	}
+
+	return usage, nil
+}
+
+// componentModel returns the model recorded for a component, or "" when its provider takes no model.
+func (s *ApplicationServiceBase) componentModel(ctx context.Context, id uuid.UUID) (string, error) {
+	component, err := s.ComponentRepo.GetByID(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("failed to get component %s: %w", id, err)
+	}
+	if component == nil {
+		return "", fmt.Errorf("component %s not found", id)
+	}
+	if model, ok := component.Metadata[modelParamKey].(string); ok && model != "" {
+		return model, nil
+	}
+
+	schema, err := s.Provider.GetComponentProviderParams(ctx, component.Type, component.Provider)
+	if err != nil {
+		return "", fmt.Errorf("failed to load schema for component %s/%s: %w", component.Type, component.Provider, err)
+	}
+	if properties, ok := schema["properties"].(map[string]any); ok {
+		if _, takesModel := properties[modelParamKey]; takesModel {
+			return "", fmt.Errorf("component %s (%s/%s) has no model recorded", id, component.Type, component.Provider)
+		}
+	}
+
+	return "", nil
+}
+
+// toAPIModel converts a model of the store to its API representation.
+func toAPIModel(model modelstore.Model) apimodels.Model {
+	files := make([]apimodels.ModelFile, 0, len(model.Files))
+	for _, f := range model.Files {
+		files = append(files, apimodels.ModelFile{Path: f.Path, SizeBytes: f.SizeBytes, SHA256: f.SHA256})
+	}
+
+	return apimodels.Model{
+		Name:       model.Name,
+		Path:       model.Path,
+		SizeBytes:  model.SizeBytes,
+		Revision:   model.Revision,
+		ModifiedAt: model.ModifiedAt,
+		Files:      files,
+		UsedBy:     model.UsedBy,
+	}
+}
+
+// errModelsNotSupported is returned on runtimes whose models are not stored on the host.
+var errModelsNotSupported = &ValidationError{
+	Code:    http.StatusNotImplemented,
+	Message: "models are only stored on the host with the podman runtime",
+}
+
+// Made with Bob
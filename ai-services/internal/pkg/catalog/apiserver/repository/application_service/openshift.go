@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	apimodels "github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/services/lifecycle"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/types"
 	catalogutils "github.com/project-ai-services/ai-services/internal/pkg/catalog/utils"
 	runtimeTypes "github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
@@ -45,3 +46,35 @@ func (s *OpenShiftApplicationService) GetApplicationResources(ctx context.Contex
 func (s *OpenShiftApplicationService) ApplicationsPs(ctx context.Context, appID uuid.UUID) (*types.ApplicationPSResponse, error) {
 	return s.ApplicationServiceBase.ApplicationsPs(ctx, appID, catalogutils.AppNamespace(appID))
 }
+
+// StartApplication starts a stopped application, or a single service of it, on OpenShift.
+func (s *OpenShiftApplicationService) StartApplication(ctx context.Context, id uuid.UUID, user string, serviceID *uuid.UUID) (*apimodels.ApplicationLifecycleResponse, error) {
+	return s.ApplicationServiceBase.ChangeApplicationState(ctx, id, user, lifecycle.ActionStart, serviceID, runtimeTypes.RuntimeTypeOpenShift)
+}
+
+// StopApplication stops an application, or a single service of it, on OpenShift.
+func (s *OpenShiftApplicationService) StopApplication(ctx context.Context, id uuid.UUID, user string, serviceID *uuid.UUID) (*apimodels.ApplicationLifecycleResponse, error) {
+	return s.ApplicationServiceBase.ChangeApplicationState(ctx, id, user, lifecycle.ActionStop, serviceID, runtimeTypes.RuntimeTypeOpenShift)
+}
+
+// RestartApplication stops and starts an application, or a single service of it, on OpenShift.
+func (s *OpenShiftApplicationService) RestartApplication(ctx context.Context, id uuid.UUID, user string, serviceID *uuid.UUID) (*apimodels.ApplicationLifecycleResponse, error) {
+	return s.ApplicationServiceBase.ChangeApplicationState(ctx, id, user, lifecycle.ActionRestart, serviceID, runtimeTypes.RuntimeTypeOpenShift)
+}
+
+// ImportApplication creates an application from an exported definition on OpenShift.
+func (s *OpenShiftApplicationService) ImportApplication(ctx context.Context, def apimodels.ApplicationDefinition, user string) (*apimodels.CreateApplicationResponse, error) {
+	return s.ApplicationServiceBase.ImportApplication(ctx, def, user, runtimeTypes.RuntimeTypeOpenShift)
+}
+
+// ListAccelerators is not supported on OpenShift, where accelerator cards are scheduled by the
+// cluster and never reserved by the catalog.
+func (s *OpenShiftApplicationService) ListAccelerators(_ context.Context) (*apimodels.AcceleratorListResponse, error) {
+	return nil, errAcceleratorsNotSupported
+}
+
+// ListModels is not supported on OpenShift, where models are stored in persistent volumes of the
+// cluster rather than on a host.
+func (s *OpenShiftApplicationService) ListModels(_ context.Context, _ bool) (*apimodels.ModelListResponse, error) {
+	return nil, errModelsNotSupported
+}
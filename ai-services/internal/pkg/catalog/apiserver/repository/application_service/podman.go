@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	apimodels "github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/services/lifecycle"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/types"
 	runtimeTypes "github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 )
@@ -46,4 +47,34 @@ func (s *PodmanApplicationService) GetApplicationResources(ctx context.Context,
 	return s.ApplicationServiceBase.GetApplicationResources(ctx, id, "")
 }
 
+// StartApplication starts a stopped application, or a single service of it, on Podman.
+func (s *PodmanApplicationService) StartApplication(ctx context.Context, id uuid.UUID, user string, serviceID *uuid.UUID) (*apimodels.ApplicationLifecycleResponse, error) {
+	return s.ApplicationServiceBase.ChangeApplicationState(ctx, id, user, lifecycle.ActionStart, serviceID, runtimeTypes.RuntimeTypePodman)
+}
+
+// StopApplication stops an application, or a single service of it, on Podman.
+func (s *PodmanApplicationService) StopApplication(ctx context.Context, id uuid.UUID, user string, serviceID *uuid.UUID) (*apimodels.ApplicationLifecycleResponse, error) {
+	return s.ApplicationServiceBase.ChangeApplicationState(ctx, id, user, lifecycle.ActionStop, serviceID, runtimeTypes.RuntimeTypePodman)
+}
+
+// RestartApplication stops and starts an application, or a single service of it, on Podman.
+func (s *PodmanApplicationService) RestartApplication(ctx context.Context, id uuid.UUID, user string, serviceID *uuid.UUID) (*apimodels.ApplicationLifecycleResponse, error) {
+	return s.ApplicationServiceBase.ChangeApplicationState(ctx, id, user, lifecycle.ActionRestart, serviceID, runtimeTypes.RuntimeTypePodman)
+}
+
+// ImportApplication creates an application from an exported definition on Podman.
+func (s *PodmanApplicationService) ImportApplication(ctx context.Context, def apimodels.ApplicationDefinition, user string) (*apimodels.CreateApplicationResponse, error) {
+	return s.ApplicationServiceBase.ImportApplication(ctx, def, user, runtimeTypes.RuntimeTypePodman)
+}
+
+// ListAccelerators lists the Spyre cards of the host with their owning application and component.
+func (s *PodmanApplicationService) ListAccelerators(ctx context.Context) (*apimodels.AcceleratorListResponse, error) {
+	return s.ApplicationServiceBase.ListAccelerators(ctx)
+}
+
+// ListModels lists the models downloaded to the host with the applications using them.
+func (s *PodmanApplicationService) ListModels(ctx context.Context, checksums bool) (*apimodels.ModelListResponse, error) {
+	return s.ApplicationServiceBase.ListModels(ctx, checksums)
+}
+
 // Made with Bob
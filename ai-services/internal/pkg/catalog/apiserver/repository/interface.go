@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	apimodels "github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/db/models"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/types"
 )
 
@@ -28,6 +29,68 @@ type ApplicationServiceInterface interface {
 	// DeleteApplication initiates async deletion of an application and returns 202 immediately.
 	DeleteApplication(ctx context.Context, id uuid.UUID, user string, keepData bool) (*DeleteApplicationResponse, error)
 
+	// StartApplication initiates async start of a stopped application or one of its services.
+	StartApplication(ctx context.Context, id uuid.UUID, user string, serviceID *uuid.UUID) (*apimodels.ApplicationLifecycleResponse, error)
+
+	// StopApplication initiates async stop of an application or one of its services.
+	StopApplication(ctx context.Context, id uuid.UUID, user string, serviceID *uuid.UUID) (*apimodels.ApplicationLifecycleResponse, error)
+
+	// RestartApplication initiates async restart of an application or one of its services.
+	RestartApplication(ctx context.Context, id uuid.UUID, user string, serviceID *uuid.UUID) (*apimodels.ApplicationLifecycleResponse, error)
+
+	// ExportApplication returns the portable definition of an application without secrets.
+	ExportApplication(ctx context.Context, id uuid.UUID) (*apimodels.ApplicationDefinition, error)
+
+	// ImportApplication creates a new application from a definition and initiates async deployment.
+	ImportApplication(ctx context.Context, def apimodels.ApplicationDefinition, user string) (*apimodels.CreateApplicationResponse, error)
+
+	// ListBackups returns the backups of an application, newest first.
+	ListBackups(ctx context.Context, id uuid.UUID) (*apimodels.BackupListResponse, error)
+
+	// CreateBackup initiates an async backup of one target of a running application.
+	CreateBackup(ctx context.Context, id uuid.UUID, user string, req apimodels.CreateBackupRequest) (*models.Backup, error)
+
+	// RestoreBackup initiates an async restore of a completed backup into its application.
+	RestoreBackup(ctx context.Context, id, backupID uuid.UUID, user string) (*apimodels.RestoreBackupResponse, error)
+
+	// DeleteBackup removes a backup archive and its record.
+	DeleteBackup(ctx context.Context, id, backupID uuid.UUID, user string) error
+
+	// ListBackupPolicies returns the backup policies of an application.
+	ListBackupPolicies(ctx context.Context, id uuid.UUID) (*apimodels.BackupPolicyListResponse, error)
+
+	// CreateBackupPolicy schedules recurring backups of an application target.
+	CreateBackupPolicy(ctx context.Context, id uuid.UUID, user string, req apimodels.CreateBackupPolicyRequest) (*models.BackupPolicy, error)
+
+	// DeleteBackupPolicy removes a backup policy, keeping the backups it already took.
+	DeleteBackupPolicy(ctx context.Context, id, policyID uuid.UUID, user string) error
+
+	// RunBackupPolicy initiates an async backup for a policy. Called by the backup scheduler.
+	RunBackupPolicy(ctx context.Context, policy *models.BackupPolicy) error
+
+	// ListAPIKeys returns the API keys of an application owned by user, without the keys themselves.
+	ListAPIKeys(ctx context.Context, id uuid.UUID, user string) (*apimodels.APIKeyListResponse, error)
+
+	// CreateAPIKey generates a new API key for the service endpoints of an application.
+	CreateAPIKey(ctx context.Context, id uuid.UUID, user string, req apimodels.CreateAPIKeyRequest) (*apimodels.APIKeySecretResponse, error)
+
+	// RotateAPIKey replaces an API key with a newly generated one.
+	RotateAPIKey(ctx context.Context, id, keyID uuid.UUID, user string) (*apimodels.APIKeySecretResponse, error)
+
+	// DeleteAPIKey revokes an API key.
+	DeleteAPIKey(ctx context.Context, id, keyID uuid.UUID, user string) error
+
+	// VerifyServiceAccess decides whether a request may reach the service endpoints of an application
+	// and returns the subject the request is attributed to. Called by the proxy's forward authentication.
+	VerifyServiceAccess(ctx context.Context, id uuid.UUID, user, apiKey string) (string, error)
+
+	// ListAccelerators returns the accelerator cards with the application and component owning them.
+	ListAccelerators(ctx context.Context) (*apimodels.AcceleratorListResponse, error)
+
+	// ListModels returns the models downloaded to the host with the applications using them,
+	// computing the checksums of their files if checksums is set.
+	ListModels(ctx context.Context, checksums bool) (*apimodels.ModelListResponse, error)
+
 	// ApplicationsPs retrieves runtime pod/container status for an application.
 	ApplicationsPs(ctx context.Context, appID uuid.UUID) (*types.ApplicationPSResponse, error)
 }
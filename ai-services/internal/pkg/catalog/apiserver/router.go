@@ -22,8 +22,8 @@ func CreateRouter(authSvc auth.Service, tokenMgr *auth.TokenManager, blacklist r
 	}
 	router := gin.Default()
 
-	// Apply RequestID middleware to all routes
-	router.Use(middleware.RequestIDMiddleware())
+	// Apply RequestID and metrics middlewares to all routes
+	router.Use(middleware.RequestIDMiddleware(), middleware.MetricsMiddleware())
 	// Health check endpoint
 	router.GET("/healthz", func(c *gin.Context) { c.JSON(http.StatusOK, gin.H{"message": "ok"}) })
 	// Expose /health for liveness probes
@@ -32,10 +32,15 @@ func CreateRouter(authSvc auth.Service, tokenMgr *auth.TokenManager, blacklist r
 
 	v1 := router.Group("/api/v1")
 	registerAuthRoutes(v1, handlers.NewAuthHandler(authSvc), tokenMgr, blacklist)
+	// Forward authentication for the proxy; the handler validates credentials itself
+	v1.GET("/auth/verify", handlers.NewServiceAuthHandler(appService, tokenMgr, blacklist).Verify)
 
 	auth := middleware.AuthMiddleware(tokenMgr, blacklist)
 	registerCatalogRoutes(v1, handlers.NewCatalogHandler(), handlers.NewResourcesHandler(), auth)
-	registerApplicationRoutes(v1, handlers.NewApplicationHandler(appService), auth)
+	appHandler := handlers.NewApplicationHandler(appService)
+	registerApplicationRoutes(v1, appHandler, auth)
+	v1.GET("/accelerators", auth, appHandler.ListAccelerators)
+	v1.GET("/models", auth, appHandler.ListModels)
 	registerWorkerRoutes(v1, handlers.NewWorkerHandler(workerReg), auth)
 
 	return router
@@ -76,9 +81,25 @@ func registerApplicationRoutes(v1 *gin.RouterGroup, h *handlers.ApplicationHandl
 		g.GET("/:id", h.GetApplicationByID)
 		g.GET("/:id/resources", h.GetApplicationResources)
 		g.POST("/", h.CreateApplication)
+		g.POST("/import", h.ImportApplication)
 		g.PUT("/:id", h.UpdateApplication)
 		g.DELETE("/:id", h.DeleteApplication)
 		g.GET("/:id/ps", h.ApplicationPS)
+		g.GET("/:id/export", h.ExportApplication)
+		g.POST("/:id/start", h.StartApplication)
+		g.POST("/:id/stop", h.StopApplication)
+		g.POST("/:id/restart", h.RestartApplication)
+		g.GET("/:id/backups", h.ListBackups)
+		g.POST("/:id/backups", h.CreateBackup)
+		g.DELETE("/:id/backups/:backup_id", h.DeleteBackup)
+		g.POST("/:id/backups/:backup_id/restore", h.RestoreBackup)
+		g.GET("/:id/backup-policies", h.ListBackupPolicies)
+		g.POST("/:id/backup-policies", h.CreateBackupPolicy)
+		g.DELETE("/:id/backup-policies/:policy_id", h.DeleteBackupPolicy)
+		g.GET("/:id/api-keys", h.ListAPIKeys)
+		g.POST("/:id/api-keys", h.CreateAPIKey)
+		g.POST("/:id/api-keys/:key_id/rotate", h.RotateAPIKey)
+		g.DELETE("/:id/api-keys/:key_id", h.DeleteAPIKey)
 	}
 }
 
@@ -0,0 +1,44 @@
+package backup
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// scheduleParser parses standard 5-field cron expressions (minute hour day-of-month month
+// day-of-week) and the @hourly, @daily, @midnight, @weekly, @monthly, @yearly and @annually
+// macros.
+var scheduleParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// Schedule is a parsed cron expression.
+type Schedule struct {
+	cron.Schedule
+}
+
+// ParseSchedule parses a cron expression. When both day fields are restricted a time matches
+// if either of them does, as in cron(8).
+func ParseSchedule(expr string) (*Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	if strings.HasPrefix(expr, "@every") {
+		return nil, fmt.Errorf("invalid schedule %q: intervals are not supported", expr)
+	}
+
+	s, err := scheduleParser.Parse(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule %q: %w", expr, err)
+	}
+
+	return &Schedule{Schedule: s}, nil
+}
+
+// Matches reports whether the schedule fires in the minute containing t.
+func (s *Schedule) Matches(t time.Time) bool {
+	minute := t.Truncate(time.Minute)
+
+	return s.Next(minute.Add(-time.Nanosecond)).Equal(minute)
+}
+
+// Made with Bob
@@ -0,0 +1,123 @@
+package backup
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseScheduleErrors(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"* * * * 7",
+		"@every 1h",
+		"0 * * * * *",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+	} {
+		if _, err := ParseSchedule(expr); err == nil {
+			t.Errorf("ParseSchedule(%q) expected error", expr)
+		}
+	}
+}
+
+func TestScheduleMatches(t *testing.T) {
+	// 2026-03-02 is a Monday.
+	monday := func(hour, minute int) time.Time {
+		return time.Date(2026, time.March, 2, hour, minute, 30, 0, time.UTC)
+	}
+
+	tests := []struct {
+		expr string
+		at   time.Time
+		want bool
+	}{
+		{"* * * * *", monday(13, 7), true},
+		{"*/15 * * * *", monday(13, 45), true},
+		{"*/15 * * * *", monday(13, 46), false},
+		{"30 2 * * *", monday(2, 30), true},
+		{"30 2 * * *", monday(3, 30), false},
+		{"0 9-17/4 * * *", monday(13, 0), true},
+		{"0 9-17/4 * * *", monday(15, 0), false},
+		{"0 0 * * 1-5", monday(0, 0), true},
+		{"0 0 * * 0", monday(0, 0), false},
+		{"0 0 * * 0", time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC), true},
+		{"0 0 * * MON", monday(0, 0), true},
+		{"0 0 * JAN-MAR *", monday(0, 0), true},
+		{"0,30 * * * *", monday(8, 30), true},
+		{"5/20 * * * *", monday(8, 45), true},
+		{"@daily", monday(0, 0), true},
+		{"@daily", monday(0, 1), false},
+		{"@monthly", time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC), true},
+		// Both day fields restricted: either may match.
+		{"0 0 15 * 1", monday(0, 0), true},
+		{"0 0 2 * 0", monday(0, 0), true},
+		{"0 0 15 * 0", monday(0, 0), false},
+		// Only one day field restricted: it alone decides.
+		{"0 0 15 * *", monday(0, 0), false},
+		{"0 0 * * 1", time.Date(2026, time.March, 15, 0, 0, 0, 0, time.UTC), false},
+		// Steps over a range and a list of ranges.
+		{"10-50/20 * * * *", monday(8, 30), true},
+		{"10-50/20 * * * *", monday(8, 40), false},
+		{"0 1-3,20-22/2 * * *", monday(22, 0), true},
+		{"0 1-3,20-22/2 * * *", monday(21, 0), false},
+	}
+
+	for _, tt := range tests {
+		s, err := ParseSchedule(tt.expr)
+		if err != nil {
+			t.Fatalf("ParseSchedule(%q) error = %v", tt.expr, err)
+		}
+		if got := s.Matches(tt.at); got != tt.want {
+			t.Errorf("%q.Matches(%s) = %v, want %v", tt.expr, tt.at.Format(time.RFC3339), got, tt.want)
+		}
+	}
+}
+
+func TestLatestMatch(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data not available: %v", err)
+	}
+	// Clocks in New York go forward from 02:00 to 03:00 on 2026-03-08 and back from 02:00 to
+	// 01:00 on 2026-11-01.
+	at := func(month time.Month, day, hour, minute int) time.Time {
+		return time.Date(2026, month, day, hour, minute, 0, 0, ny)
+	}
+
+	tests := []struct {
+		name     string
+		expr     string
+		from, to time.Time
+		want     time.Time
+		ok       bool
+	}{
+		{"latest of several", "*/15 * * * *", at(time.March, 2, 8, 0), at(time.March, 2, 8, 50), at(time.March, 2, 8, 45), true},
+		{"from is excluded", "0 8 * * *", at(time.March, 2, 8, 0), at(time.March, 2, 8, 30), time.Time{}, false},
+		{"to is included", "30 8 * * *", at(time.March, 2, 8, 0), at(time.March, 2, 8, 30), at(time.March, 2, 8, 30), true},
+		{"skipped hour of spring forward", "30 2 * * *", at(time.March, 8, 1, 0), at(time.March, 8, 4, 0), time.Time{}, false},
+		{"hour after spring forward", "30 3 * * *", at(time.March, 8, 1, 0), at(time.March, 8, 4, 0), at(time.March, 8, 3, 30), true},
+		// time.Date picks the first of the repeated 01:30s; the schedule fires at both.
+		{"first of repeated hour of fall back", "30 1 * * *", at(time.November, 1, 0, 0), at(time.November, 1, 1, 45), at(time.November, 1, 1, 30), true},
+		{"second of repeated hour of fall back", "30 1 * * *", at(time.November, 1, 1, 45), at(time.November, 1, 3, 0), at(time.November, 1, 1, 30).Add(time.Hour), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := ParseSchedule(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseSchedule(%q) error = %v", tt.expr, err)
+			}
+			got, ok := latestMatch(s, tt.from, tt.to)
+			if ok != tt.ok || !got.Equal(tt.want) {
+				t.Errorf("latestMatch() = %s, %v, want %s, %v", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,258 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/project-ai-services/ai-services/internal/pkg/application"
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/db/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/db/repository"
+	catalogTypes "github.com/project-ai-services/ai-services/internal/pkg/catalog/types"
+	consts "github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	runtimeTypes "github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+// Targets lists the application data that can be backed up.
+var Targets = []string{"opensearch", "digitize"}
+
+// BackupExecutor takes and restores application backups and keeps the backup records up to date.
+type BackupExecutor struct {
+	backupRepo    repository.BackupRepository
+	connectorRepo repository.ConnectorRepository
+	runtimeType   runtimeTypes.RuntimeType
+	localDir      string
+
+	// busy holds the applications with a backup or restore in progress.
+	busyMu sync.Mutex
+	busy   map[uuid.UUID]bool
+}
+
+// NewBackupExecutor creates a new BackupExecutor. Archives are written to localDir before they
+// are uploaded, and stay there for local backups.
+func NewBackupExecutor(
+	backupRepo repository.BackupRepository,
+	connectorRepo repository.ConnectorRepository,
+	runtimeType runtimeTypes.RuntimeType,
+	localDir string,
+) *BackupExecutor {
+	return &BackupExecutor{
+		backupRepo:    backupRepo,
+		connectorRepo: connectorRepo,
+		runtimeType:   runtimeType,
+		localDir:      localDir,
+		busy:          make(map[uuid.UUID]bool),
+	}
+}
+
+// Acquire marks an application as busy. It returns false if a backup or restore of the
+// application is already in progress. Callers must Release the application when done.
+func (e *BackupExecutor) Acquire(appID uuid.UUID) bool {
+	e.busyMu.Lock()
+	defer e.busyMu.Unlock()
+
+	if e.busy[appID] {
+		return false
+	}
+	e.busy[appID] = true
+
+	return true
+}
+
+// Release marks an application as no longer busy.
+func (e *BackupExecutor) Release(appID uuid.UUID) {
+	e.busyMu.Lock()
+	defer e.busyMu.Unlock()
+
+	delete(e.busy, appID)
+}
+
+// Run takes the backup described by the InProgress record, stores it at its destination and
+// records the outcome. When the backup belongs to a policy, completed backups of the policy
+// beyond retention are removed afterwards.
+func (e *BackupExecutor) Run(ctx context.Context, backup *models.Backup, app *catalogTypes.Application, retention int) error {
+	location, size, err := e.run(ctx, backup, app)
+	if err != nil {
+		logger.ErrorfCtx(ctx, "Backup %s of application %s failed: %v", backup.ID, app.Name, err)
+
+		if updateErr := e.backupRepo.Complete(ctx, backup.ID, repository.BackupUpdate{
+			Status:  models.BackupStatusFailed,
+			Message: err.Error(),
+		}); updateErr != nil {
+			logger.ErrorfCtx(ctx, "Failed to update backup %s: %v", backup.ID, updateErr)
+		}
+
+		return err
+	}
+
+	if err := e.backupRepo.Complete(ctx, backup.ID, repository.BackupUpdate{
+		Status:    models.BackupStatusCompleted,
+		Message:   "Backup completed successfully",
+		Location:  location,
+		SizeBytes: size,
+	}); err != nil {
+		return fmt.Errorf("failed to update backup %s: %w", backup.ID, err)
+	}
+
+	logger.InfofCtx(ctx, "Backup %s of application %s completed (%d bytes)", backup.ID, app.Name, size)
+
+	if backup.PolicyID != nil {
+		e.prune(ctx, *backup.PolicyID, retention)
+	}
+
+	return nil
+}
+
+func (e *BackupExecutor) run(ctx context.Context, backup *models.Backup, app *catalogTypes.Application) (string, int64, error) {
+	store, err := e.storeFor(ctx, backup)
+	if err != nil {
+		return "", 0, err
+	}
+
+	localFile := filepath.Join(e.localDir, backup.ApplicationName, archiveName(backup))
+	if err := os.MkdirAll(filepath.Dir(localFile), consts.DirPerm); err != nil {
+		return "", 0, fmt.Errorf("failed to create backup directory: %w", err)
+	}
+
+	appInstance, err := application.NewFactory(e.runtimeType).Create(app.Name)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to create application instance: %w", err)
+	}
+
+	if err := appInstance.Backup(ctx, appTypes.BackupOptions{
+		Name:        app.Name,
+		Target:      backup.Target,
+		BackupFile:  localFile,
+		Application: app,
+	}); err != nil {
+		_ = os.Remove(localFile)
+
+		return "", 0, err
+	}
+
+	info, err := os.Stat(localFile)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to stat backup archive: %w", err)
+	}
+
+	location, err := store.Put(ctx, backup, localFile)
+	if backup.Destination != models.BackupDestinationLocal {
+		_ = os.Remove(localFile)
+	}
+	if err != nil {
+		return "", 0, err
+	}
+
+	return location, info.Size(), nil
+}
+
+// Restore restores a completed backup into the application and records the outcome in the
+// message of the backup.
+func (e *BackupExecutor) Restore(ctx context.Context, backup *models.Backup, app *catalogTypes.Application) error {
+	err := e.restore(ctx, backup, app)
+
+	message := fmt.Sprintf("Restored at %s", time.Now().UTC().Format(time.RFC3339))
+	if err != nil {
+		logger.ErrorfCtx(ctx, "Restore of backup %s into application %s failed: %v", backup.ID, app.Name, err)
+		message = fmt.Sprintf("Restore failed: %v", err)
+	} else {
+		logger.InfofCtx(ctx, "Backup %s restored into application %s", backup.ID, app.Name)
+	}
+
+	if updateErr := e.backupRepo.UpdateMessage(ctx, backup.ID, message); updateErr != nil {
+		logger.ErrorfCtx(ctx, "Failed to update backup %s: %v", backup.ID, updateErr)
+	}
+
+	return err
+}
+
+func (e *BackupExecutor) restore(ctx context.Context, backup *models.Backup, app *catalogTypes.Application) error {
+	store, err := e.storeFor(ctx, backup)
+	if err != nil {
+		return err
+	}
+
+	tmpDir, err := os.MkdirTemp("", "ai-services-restore-")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	localFile := filepath.Join(tmpDir, archiveName(backup))
+	if err := store.Fetch(ctx, backup.Location, localFile); err != nil {
+		return err
+	}
+
+	appInstance, err := application.NewFactory(e.runtimeType).Create(app.Name)
+	if err != nil {
+		return fmt.Errorf("failed to create application instance: %w", err)
+	}
+
+	return appInstance.Restore(ctx, appTypes.RestoreOptions{
+		Name:        app.Name,
+		Target:      backup.Target,
+		BackupFile:  localFile,
+		AutoYes:     true,
+		Application: app,
+	})
+}
+
+// Remove deletes the archive of a backup and its record.
+func (e *BackupExecutor) Remove(ctx context.Context, backup *models.Backup) error {
+	if backup.Location != "" {
+		store, err := e.storeFor(ctx, backup)
+		if err != nil {
+			return err
+		}
+
+		if err := store.Delete(ctx, backup.Location); err != nil {
+			return err
+		}
+	}
+
+	return e.backupRepo.Delete(ctx, backup.ID)
+}
+
+// prune removes the completed backups of a policy beyond the newest retention ones.
+func (e *BackupExecutor) prune(ctx context.Context, policyID uuid.UUID, retention int) {
+	backups, err := e.backupRepo.ListCompletedByPolicy(ctx, policyID)
+	if err != nil {
+		logger.WarningfCtx(ctx, "Failed to list backups of policy %s for retention: %v", policyID, err)
+
+		return
+	}
+
+	if len(backups) <= retention {
+		return
+	}
+
+	for i := range backups[retention:] {
+		expired := &backups[retention+i]
+		if err := e.Remove(ctx, expired); err != nil {
+			logger.WarningfCtx(ctx, "Failed to remove expired backup %s: %v", expired.ID, err)
+
+			continue
+		}
+		logger.InfofCtx(ctx, "Removed expired backup %s of policy %s", expired.ID, policyID)
+	}
+}
+
+func (e *BackupExecutor) storeFor(ctx context.Context, backup *models.Backup) (Store, error) {
+	if backup.Destination == models.BackupDestinationLocal {
+		return localStore{}, nil
+	}
+
+	if backup.ConnectorID == nil {
+		return nil, fmt.Errorf("the connector of backup %s no longer exists", backup.ID)
+	}
+
+	return newObjectStore(ctx, e.connectorRepo, *backup.ConnectorID)
+}
+
+// Made with Bob
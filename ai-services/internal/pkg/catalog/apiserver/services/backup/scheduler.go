@@ -0,0 +1,139 @@
+package backup
+
+import (
+	"context"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/db/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/db/repository"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+const (
+	// scheduleResolution is the granularity of cron schedules.
+	scheduleResolution = time.Minute
+
+	// maxCatchUp bounds how far back a tick looks for missed schedule minutes, e.g. after the
+	// host was suspended.
+	maxCatchUp = time.Hour
+)
+
+// PolicyRunner starts a backup for a policy. It must not block until the backup completes.
+type PolicyRunner func(ctx context.Context, policy *models.BackupPolicy) error
+
+// Scheduler triggers backup policies whose cron schedule is due. Schedules are evaluated in
+// the local time zone of the API server.
+type Scheduler struct {
+	backupRepo repository.BackupRepository
+	run        PolicyRunner
+	stopChan   chan struct{}
+	lastTick   time.Time
+}
+
+// NewScheduler creates a new Scheduler that calls run for every due policy.
+func NewScheduler(backupRepo repository.BackupRepository, run PolicyRunner) *Scheduler {
+	return &Scheduler{
+		backupRepo: backupRepo,
+		run:        run,
+		stopChan:   make(chan struct{}),
+	}
+}
+
+// Start marks backups interrupted by a previous shutdown as failed and begins the scheduler goroutine.
+func (s *Scheduler) Start(ctx context.Context) {
+	if n, err := s.backupRepo.FailInProgress(ctx, "Backup interrupted by an API server restart"); err != nil {
+		logger.WarningfCtx(ctx, "Failed to mark interrupted backups as failed: %v", err)
+	} else if n > 0 {
+		logger.InfofCtx(ctx, "Marked %d interrupted backup(s) as failed", n)
+	}
+
+	go s.loop(ctx)
+	logger.InfolnCtx(ctx, "Backup scheduler started")
+}
+
+// Stop gracefully stops the scheduler goroutine.
+func (s *Scheduler) Stop(ctx context.Context) {
+	close(s.stopChan)
+	logger.InfolnCtx(ctx, "Backup scheduler stopped")
+}
+
+func (s *Scheduler) loop(ctx context.Context) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.ErrorfCtx(ctx, "Panic recovered in backup scheduler goroutine: %v", r)
+		}
+	}()
+
+	s.lastTick = time.Now().Truncate(scheduleResolution)
+
+	ticker := time.NewTicker(scheduleResolution)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			s.tick(ctx, now)
+		case <-s.stopChan:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// tick triggers every enabled policy with a schedule minute after the previous tick that it
+// has not run for yet. A policy runs at most once per tick even if several minutes were missed.
+func (s *Scheduler) tick(ctx context.Context, now time.Time) {
+	minute := now.Truncate(scheduleResolution)
+	from := s.lastTick
+	if minute.Sub(from) > maxCatchUp {
+		from = minute.Add(-maxCatchUp)
+	}
+	s.lastTick = minute
+
+	policies, err := s.backupRepo.ListEnabledPolicies(ctx)
+	if err != nil {
+		logger.WarningfCtx(ctx, "Failed to list backup policies: %v", err)
+
+		return
+	}
+
+	for i := range policies {
+		policy := &policies[i]
+
+		schedule, err := ParseSchedule(policy.Schedule)
+		if err != nil {
+			logger.WarningfCtx(ctx, "Skipping backup policy %s: %v", policy.ID, err)
+
+			continue
+		}
+
+		due, ok := latestMatch(schedule, from, minute)
+		if !ok || (policy.LastRunAt != nil && !policy.LastRunAt.Before(due)) {
+			continue
+		}
+
+		if err := s.backupRepo.UpdatePolicyLastRun(ctx, policy.ID, due); err != nil {
+			logger.WarningfCtx(ctx, "Failed to record run of backup policy %s: %v", policy.ID, err)
+
+			continue
+		}
+
+		logger.InfofCtx(ctx, "Running backup policy %s of application %s", policy.ID, policy.ApplicationID)
+		if err := s.run(ctx, policy); err != nil {
+			logger.WarningfCtx(ctx, "Backup policy %s did not start: %v", policy.ID, err)
+		}
+	}
+}
+
+// latestMatch returns the latest time in (from, to] the schedule fires at.
+func latestMatch(schedule *Schedule, from, to time.Time) (time.Time, bool) {
+	var due time.Time
+	for next := schedule.Next(from); !next.After(to); next = schedule.Next(next) {
+		due = next
+	}
+
+	return due, !due.IsZero()
+}
+
+// Made with Bob
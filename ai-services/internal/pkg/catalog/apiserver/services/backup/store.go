@@ -0,0 +1,155 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/google/uuid"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/db/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/db/repository"
+	consts "github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/objectstorage"
+)
+
+const (
+	// ObjectStorageProvider is the connector provider that can hold backups.
+	ObjectStorageProvider = objectstorage.ConnectorProvider
+
+	// objectKeyDir groups backups below the connector prefix.
+	objectKeyDir = "ai-services-backups"
+
+	archiveExt = ".tar.gz"
+)
+
+// Store keeps backup archives produced on the local disk.
+type Store interface {
+	// Put stores the archive at localFile and returns its location.
+	Put(ctx context.Context, backup *models.Backup, localFile string) (string, error)
+	// Fetch copies the archive at location to localFile.
+	Fetch(ctx context.Context, location, localFile string) error
+	// Delete removes the archive at location. A missing archive is not an error.
+	Delete(ctx context.Context, location string) error
+}
+
+// archiveName returns the file name of a backup archive.
+func archiveName(backup *models.Backup) string {
+	return backup.ID.String() + archiveExt
+}
+
+// localStore keeps archives in the backup directory of the API server.
+type localStore struct{}
+
+func (localStore) Put(_ context.Context, _ *models.Backup, localFile string) (string, error) {
+	return localFile, nil
+}
+
+func (localStore) Fetch(_ context.Context, location, localFile string) error {
+	return copyFile(location, localFile)
+}
+
+func (localStore) Delete(_ context.Context, location string) error {
+	if err := os.Remove(location); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove backup archive: %w", err)
+	}
+
+	return nil
+}
+
+// objectStore keeps archives in the bucket of an object_storage connector.
+type objectStore struct {
+	client *objectstorage.Client
+	prefix string
+}
+
+// newObjectStore builds a store from the credentials of an object_storage connector.
+func newObjectStore(ctx context.Context, connectorRepo repository.ConnectorRepository, connectorID uuid.UUID) (*objectStore, error) {
+	connector, err := connectorRepo.GetByID(ctx, connectorID, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get connector %s: %w", connectorID, err)
+	}
+
+	if connector.Provider != ObjectStorageProvider {
+		return nil, fmt.Errorf("connector %q is a %s connector, backups require %s", connector.Name, connector.Provider, ObjectStorageProvider)
+	}
+
+	client, prefix, err := objectstorage.NewClientFromConnector(connector)
+	if err != nil {
+		return nil, err
+	}
+
+	return &objectStore{client: client, prefix: prefix}, nil
+}
+
+func (s *objectStore) Put(ctx context.Context, backup *models.Backup, localFile string) (string, error) {
+	f, err := os.Open(localFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to open backup archive: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	info, err := f.Stat()
+	if err != nil {
+		return "", fmt.Errorf("failed to stat backup archive: %w", err)
+	}
+
+	key := path.Join(s.prefix, objectKeyDir, backup.ApplicationName, archiveName(backup))
+	if err := s.client.Put(ctx, key, f, info.Size(), "application/gzip"); err != nil {
+		return "", fmt.Errorf("failed to upload backup archive: %w", err)
+	}
+
+	return key, nil
+}
+
+func (s *objectStore) Fetch(ctx context.Context, location, localFile string) error {
+	body, err := s.client.Get(ctx, location)
+	if err != nil {
+		return fmt.Errorf("failed to download backup archive: %w", err)
+	}
+	defer func() { _ = body.Close() }()
+
+	return writeFile(localFile, body)
+}
+
+func (s *objectStore) Delete(ctx context.Context, location string) error {
+	if err := s.client.Delete(ctx, location); err != nil {
+		return fmt.Errorf("failed to delete backup archive: %w", err)
+	}
+
+	return nil
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("failed to open backup archive: %w", err)
+	}
+	defer func() { _ = in.Close() }()
+
+	return writeFile(dst, in)
+}
+
+func writeFile(dst string, r io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(dst), consts.DirPerm); err != nil {
+		return fmt.Errorf("failed to create backup directory: %w", err)
+	}
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, consts.FilePerm)
+	if err != nil {
+		return fmt.Errorf("failed to create backup archive: %w", err)
+	}
+
+	if _, err := io.Copy(out, r); err != nil {
+		_ = out.Close()
+
+		return fmt.Errorf("failed to write backup archive: %w", err)
+	}
+
+	return out.Close()
+}
+
+// Made with Bob
@@ -24,6 +24,8 @@ type DeploymentExecutor struct {
 	appRepo         repository.ApplicationRepository
 	serviceRepo     repository.ServiceRepository
 	componentRepo   repository.ComponentRepository
+	allocationRepo  repository.AcceleratorAllocationRepository
+	connectorRepo   repository.ConnectorRepository
 }
 
 // NewDeploymentExecutor creates a new DeploymentExecutor instance.
@@ -32,13 +34,17 @@ func NewDeploymentExecutor(
 	appRepo repository.ApplicationRepository,
 	serviceRepo repository.ServiceRepository,
 	componentRepo repository.ComponentRepository,
+	allocationRepo repository.AcceleratorAllocationRepository,
+	connectorRepo repository.ConnectorRepository,
 ) *DeploymentExecutor {
 	return &DeploymentExecutor{
-		planner:         NewDeploymentPlanner(catalogProvider, componentRepo),
+		planner:         NewDeploymentPlanner(catalogProvider, componentRepo, allocationRepo),
 		catalogProvider: catalogProvider,
 		appRepo:         appRepo,
 		serviceRepo:     serviceRepo,
 		componentRepo:   componentRepo,
+		allocationRepo:  allocationRepo,
+		connectorRepo:   connectorRepo,
 	}
 }
 
@@ -95,6 +101,8 @@ func (e *DeploymentExecutor) executePodmanDeployment(
 		e.appRepo,
 		e.serviceRepo,
 		e.componentRepo,
+		e.allocationRepo,
+		e.connectorRepo,
 	)
 
 	// Execute deployment - handles both architectures and standalone services
@@ -2,17 +2,24 @@ package deployment
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 
 	"github.com/google/uuid"
+	"github.com/project-ai-services/ai-services/internal/pkg/accelerator"
+	// Registers the providers returned by accelerator.Default.
+	_ "github.com/project-ai-services/ai-services/internal/pkg/accelerator/providers"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog"
 	apimodels "github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/models"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/services/deployment/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/services/params"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/db/repository"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/utils"
-	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/proxy"
 	runtimeTypes "github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 )
 
@@ -23,17 +30,21 @@ import (
 type DeploymentPlanner struct {
 	catalogProvider *catalog.CatalogProvider
 	componentRepo   repository.ComponentRepository
+	allocationRepo  repository.AcceleratorAllocationRepository
 	paramBuilder    *params.ParamBuilder
 }
 
-// NewDeploymentPlanner creates a new deployment planner.
+// NewDeploymentPlanner creates a new deployment planner. Spyre cards are reserved in the
+// allocation ledger when allocationRepo is set; otherwise any free card may be used.
 func NewDeploymentPlanner(
 	provider *catalog.CatalogProvider,
 	componentRepo repository.ComponentRepository,
+	allocationRepo repository.AcceleratorAllocationRepository,
 ) *DeploymentPlanner {
 	return &DeploymentPlanner{
 		catalogProvider: provider,
 		componentRepo:   componentRepo,
+		allocationRepo:  allocationRepo,
 		paramBuilder:    params.NewParamBuilder(provider),
 	}
 }
@@ -45,6 +56,12 @@ type (
 	ServicePlan    = types.ServicePlan
 )
 
+// ValidateParams validates the params of every service and component of the request against
+// their schemas, reporting all violations at once. It is called before PlanDeployment.
+func (p *DeploymentPlanner) ValidateParams(ctx context.Context, req apimodels.CreateApplicationRequest) error {
+	return p.paramBuilder.ValidateParams(ctx, req)
+}
+
 // PlanDeployment creates a deployment plan for an application (architecture or standalone service).
 func (p *DeploymentPlanner) PlanDeployment(
 	ctx context.Context,
@@ -106,10 +123,13 @@ func (p *DeploymentPlanner) processService(
 	}
 
 	servicePlan := &ServicePlan{
-		CatalogID:     svc.CatalogID,
-		CatalogPath:   fmt.Sprintf("%s/%s", servicePath, runtimeType),
-		Version:       svc.Version,
-		ComponentRefs: make([]string, 0),
+		CatalogID:       svc.CatalogID,
+		CatalogPath:     fmt.Sprintf("%s/%s", servicePath, runtimeType),
+		Version:         svc.Version,
+		ComponentRefs:   make([]string, 0),
+		Params:          svc.Params,
+		PolicyOverrides: svc.RoutePolicies,
+		ConnectorIDs:    svc.ConnectorIDs,
 	}
 
 	// Process each component in the service
@@ -141,12 +161,40 @@ func (p *DeploymentPlanner) processService(
 		}
 	}
 
+	// Apply the requested route policy overrides to the defaults of the service
+	routePolicies, err := p.buildRoutePolicies(svc)
+	if err != nil {
+		return err
+	}
+	servicePlan.RoutePolicies = routePolicies
+
 	// Add service to plan
 	plan.Services[svc.CatalogID] = servicePlan
 
 	return nil
 }
 
+// buildRoutePolicies merges the route policy overrides of a service request into the defaults of
+// the service metadata.
+func (p *DeploymentPlanner) buildRoutePolicies(svc apimodels.Service) (map[string]*proxy.RoutePolicy, error) {
+	service, err := p.catalogProvider.LoadService(svc.CatalogID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load service: %w", err)
+	}
+
+	policies := map[string]*proxy.RoutePolicy{}
+	for routeType, policy := range service.RoutePolicies {
+		policies[routeType] = policy.Merge(svc.RoutePolicies[routeType])
+	}
+	for routeType, override := range svc.RoutePolicies {
+		if _, ok := policies[routeType]; !ok {
+			policies[routeType] = override.Merge(nil)
+		}
+	}
+
+	return policies, nil
+}
+
 // processComponent processes a single component from the request and returns its hash.
 // If the same component configuration already exists, it reuses it.
 func (p *DeploymentPlanner) processComponent(
@@ -195,19 +243,22 @@ func (p *DeploymentPlanner) processComponent(
 	return componentHash, nil
 }
 
-// calculateAndAllocateSpyreCards calculates required Spyre cards and creates allocation pool.
-func (p *DeploymentPlanner) calculateAndAllocateSpyreCards(ctx context.Context, plan *DeploymentPlan) error {
+// calculateAndAllocateSpyreCards calculates required Spyre cards, places them per component and
+// creates the allocation pool. The cards reserved for the plan are released again if it fails.
+func (p *DeploymentPlanner) calculateAndAllocateSpyreCards(ctx context.Context, plan *DeploymentPlan) (err error) {
 	totalRequired := 0
+	required := make(map[string]int)
 
 	// Calculate total required Spyre cards from all components
-	for _, comp := range plan.Components {
-		required, err := p.getRequiredSpyreCardsForComponent(ctx, comp)
+	for hash, comp := range plan.Components {
+		n, err := p.getRequiredSpyreCardsForComponent(ctx, comp)
 		if err != nil {
 			return fmt.Errorf("failed to get Spyre card requirements for component %s: %w", comp.ComponentType, err)
 		}
-		totalRequired += required
-		if required > 0 {
-			logger.InfofCtx(ctx, "Component %s/%s requires %d Spyre cards\n", comp.ComponentType, comp.ProviderID, required)
+		totalRequired += n
+		if n > 0 {
+			required[hash] = n
+			logger.InfofCtx(ctx, "Component %s/%s requires %d Spyre cards\n", comp.ComponentType, comp.ProviderID, n)
 		}
 	}
 
@@ -220,12 +271,20 @@ func (p *DeploymentPlanner) calculateAndAllocateSpyreCards(ctx context.Context,
 	logger.InfofCtx(ctx, "Total Spyre cards required: %d\n", totalRequired)
 
 	// Find available Spyre cards
-	pciAddresses, err := helpers.FindFreeSpyreCards(ctx)
+	provider, err := accelerator.Default()
+	if err != nil {
+		return err
+	}
+	cards, err := provider.Discover(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to find free Spyre cards: %w", err)
 	}
+	free, err := p.unreservedFreeCards(ctx, cards)
+	if err != nil {
+		return err
+	}
 
-	availableCount := len(pciAddresses)
+	availableCount := len(free)
 	logger.InfofCtx(ctx, "Available Spyre cards: %d\n", availableCount)
 
 	// Validate we have enough Spyre cards
@@ -233,7 +292,68 @@ func (p *DeploymentPlanner) calculateAndAllocateSpyreCards(ctx context.Context,
 		return fmt.Errorf("insufficient Spyre cards: required %d, available %d", totalRequired, availableCount)
 	}
 
-	// Create pool with available addresses and store in plan
+	placements, err := placeComponents(plan, free, required)
+	if err != nil {
+		return err
+	}
+	pciAddresses := placedAddresses(plan, placements)
+
+	// Reserve the cards in the ledger so that concurrent deployments and stopped applications,
+	// whose cards look free on the host, never get the same cards
+	if p.allocationRepo != nil {
+		// The placed cards come first; the others are only taken if a concurrent deployment
+		// reserved some of them in the meantime
+		candidates := append(slices.Clone(pciAddresses), accelerator.Addresses(free)...)
+
+		reserved, err := p.allocationRepo.Reserve(ctx, plan.ApplicationID, provider.ResourceName(), candidates, totalRequired)
+		if err != nil {
+			var insufficient *repository.InsufficientAcceleratorsError
+			if errors.As(err, &insufficient) {
+				return fmt.Errorf("insufficient Spyre cards: required %d, available %d", totalRequired, insufficient.Available)
+			}
+
+			return fmt.Errorf("failed to reserve Spyre cards: %w", err)
+		}
+		logger.InfofCtx(ctx, "Reserved Spyre cards: %v\n", reserved)
+		defer func() {
+			if err != nil {
+				p.releaseCards(ctx, plan.ApplicationID, reserved)
+			}
+		}()
+
+		if !sameAddresses(reserved, pciAddresses) {
+			reservedCards := slices.DeleteFunc(slices.Clone(free), func(card accelerator.Card) bool {
+				return !slices.Contains(reserved, card.PCIAddress)
+			})
+			if placements, err = placeComponents(plan, reservedCards, required); err != nil {
+				return err
+			}
+			pciAddresses = placedAddresses(plan, placements)
+		}
+	}
+
+	for hash, placement := range placements {
+		comp := plan.Components[hash]
+		comp.Placement = &types.CardPlacement{
+			Addresses: accelerator.Addresses(placement.Cards),
+			NUMANode:  placement.NUMANode,
+			Reason:    placement.Reason,
+		}
+
+		// Pin the component to the CPUs of the node its cards are on
+		if placement.NUMANode != accelerator.UnknownNUMANode {
+			cpus, err := provider.NodeCPUs(ctx, placement.NUMANode)
+			if err != nil {
+				return err
+			}
+			comp.Placement.CPUSet = cpus
+		}
+
+		logger.InfofCtx(ctx, "Placed Spyre cards %v for component %s/%s: %s\n",
+			comp.Placement.Addresses, comp.ComponentType, comp.ProviderID, comp.Placement.Reason)
+	}
+
+	// Create pool with reserved addresses and store in plan
 	plan.SpyreCardPool = &types.SpyreCardPool{
 		Addresses: pciAddresses,
 	}
@@ -241,6 +361,106 @@ func (p *DeploymentPlanner) calculateAndAllocateSpyreCards(ctx context.Context,
 	return nil
 }
 
+// releaseCards releases the cards reserved for a plan that could not be completed, so that they
+// are not held until the abandoned reservation expires.
+func (p *DeploymentPlanner) releaseCards(ctx context.Context, applicationID uuid.UUID, addresses []string) {
+	if err := p.allocationRepo.Release(context.WithoutCancel(ctx), applicationID, addresses); err != nil {
+		logger.ErrorfCtx(ctx, "Failed to release Spyre cards %v: %v", addresses, err)
+
+		return
+	}
+	logger.InfofCtx(ctx, "Released Spyre cards %v\n", addresses)
+}
+
+// unreservedFreeCards returns the free cards that are not reserved in the allocation ledger.
+func (p *DeploymentPlanner) unreservedFreeCards(ctx context.Context, cards []accelerator.Card) ([]accelerator.Card, error) {
+	free := slices.DeleteFunc(slices.Clone(cards), func(card accelerator.Card) bool { return !card.Free })
+	if p.allocationRepo == nil {
+		return free, nil
+	}
+
+	allocations, err := p.allocationRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Spyre card reservations: %w", err)
+	}
+
+	reserved := make([]string, 0, len(allocations))
+	for _, allocation := range allocations {
+		reserved = append(reserved, allocation.PCIAddress)
+	}
+
+	return slices.DeleteFunc(free, func(card accelerator.Card) bool {
+		return slices.Contains(reserved, card.PCIAddress)
+	}), nil
+}
+
+// placeComponents places the required cards of each component, keeping the cards of a component on
+// one NUMA node when possible. Components needing more cards are placed first, as they are the
+// hardest to fit on a single node.
+func placeComponents(plan *DeploymentPlan, cards []accelerator.Card, required map[string]int) (map[string]accelerator.Placement, error) {
+	hashes := slices.Collect(maps.Keys(required))
+	slices.SortFunc(hashes, func(a, b string) int {
+		if required[a] != required[b] {
+			return required[b] - required[a]
+		}
+
+		return strings.Compare(a, b)
+	})
+
+	remaining := slices.Clone(cards)
+	placements := make(map[string]accelerator.Placement, len(required))
+	for _, hash := range hashes {
+		placement, err := accelerator.Place(remaining, required[hash])
+		if err != nil {
+			comp := plan.Components[hash]
+
+			return nil, fmt.Errorf("failed to place Spyre cards for component %s/%s: %w", comp.ComponentType, comp.ProviderID, err)
+		}
+		placements[hash] = placement
+
+		remaining = slices.DeleteFunc(remaining, func(card accelerator.Card) bool {
+			return slices.Contains(placement.Cards, card)
+		})
+	}
+
+	return placements, nil
+}
+
+// placedAddresses returns the addresses of all placed cards, ordered by component hash.
+func placedAddresses(plan *DeploymentPlan, placements map[string]accelerator.Placement) []string {
+	addresses := []string{}
+	for _, hash := range slices.Sorted(maps.Keys(plan.Components)) {
+		if placement, ok := placements[hash]; ok {
+			addresses = append(addresses, accelerator.Addresses(placement.Cards)...)
+		}
+	}
+
+	return addresses
+}
+
+// sameAddresses reports whether a and b hold the same addresses in any order.
+func sameAddresses(a, b []string) bool {
+	return len(a) == len(b) && !slices.ContainsFunc(a, func(addr string) bool { return !slices.Contains(b, addr) })
+}
+
+// ReleaseReservations releases the Spyre cards reserved for an application. It is a no-op
+// without an allocation ledger.
+func (p *DeploymentPlanner) ReleaseReservations(ctx context.Context, applicationID uuid.UUID) error {
+	if p.allocationRepo == nil {
+		return nil
+	}
+
+	released, err := p.allocationRepo.ReleaseByApplication(ctx, applicationID)
+	if err != nil {
+		return err
+	}
+	if released > 0 {
+		logger.InfofCtx(ctx, "Released %d Spyre cards of application %s\n", released, applicationID)
+	}
+
+	return nil
+}
+
 // getRequiredSpyreCardsForComponent calculates Spyre cards needed for a component.
 func (p *DeploymentPlanner) getRequiredSpyreCardsForComponent(ctx context.Context, comp *ComponentPlan) (int, error) {
 	// Load component templates using catalog provider
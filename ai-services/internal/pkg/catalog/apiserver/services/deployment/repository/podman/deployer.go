@@ -6,10 +6,14 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"path"
+	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
 	"text/template"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog"
@@ -20,21 +24,26 @@ import (
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/db/repository"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/types"
 	catalogutils "github.com/project-ai-services/ai-services/internal/pkg/catalog/utils"
-	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	clipodman "github.com/project-ai-services/ai-services/internal/pkg/cli/podman"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/image"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	podmodels "github.com/project-ai-services/ai-services/internal/pkg/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/modelsource"
 	"github.com/project-ai-services/ai-services/internal/pkg/proxy"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
 	"github.com/project-ai-services/ai-services/internal/pkg/specs"
+	"github.com/project-ai-services/ai-services/internal/pkg/transfer"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 	k8syaml "sigs.k8s.io/yaml"
 )
 
+// progressInterval is how often the status of an application reports the progress of its image
+// pulls and model downloads.
+const progressInterval = 3 * time.Second
+
 // ComponentInfo holds the information derived from a deployed component.
 type ComponentInfo struct {
 	Endpoint string
@@ -58,15 +67,21 @@ type PodmanDeployer struct {
 	appRepo         repository.ApplicationRepository
 	serviceRepo     repository.ServiceRepository
 	componentRepo   repository.ComponentRepository
+	allocationRepo  repository.AcceleratorAllocationRepository
+	connectorRepo   repository.ConnectorRepository
 }
 
-// NewPodmanDeployer creates a new PodmanDeployer instance.
+// NewPodmanDeployer creates a new PodmanDeployer instance. allocationRepo may be nil, in which
+// case the owning component of allocated Spyre cards is not recorded. connectorRepo may be nil, in
+// which case models cannot be downloaded from object_storage connectors.
 func NewPodmanDeployer(
 	rt runtime.Runtime,
 	catalogProvider *catalog.CatalogProvider,
 	appRepo repository.ApplicationRepository,
 	serviceRepo repository.ServiceRepository,
 	componentRepo repository.ComponentRepository,
+	allocationRepo repository.AcceleratorAllocationRepository,
+	connectorRepo repository.ConnectorRepository,
 ) *PodmanDeployer {
 	return &PodmanDeployer{
 		runtime:         rt,
@@ -74,6 +89,8 @@ func NewPodmanDeployer(
 		appRepo:         appRepo,
 		serviceRepo:     serviceRepo,
 		componentRepo:   componentRepo,
+		allocationRepo:  allocationRepo,
+		connectorRepo:   connectorRepo,
 	}
 }
 
@@ -140,19 +157,26 @@ func (d *PodmanDeployer) ExecuteDeployment(
 // prepareDeployment pulls images, downloads models, and transitions the
 // application status to Deploying. It is a prerequisite for all deploy steps.
 func (d *PodmanDeployer) prepareDeployment(ctx context.Context, plan *DeploymentPlan) error {
+	// Report the progress of the pulls and downloads in the status message of the application
+	tracker := transfer.NewTracker()
+	stopReporting := d.reportProgress(ctx, plan.ApplicationID, tracker)
+
 	// Step 1a: Pull container images for all components and services
-	if err := d.pullImagesForDeployment(ctx, plan); err != nil {
+	if err := d.pullImagesForDeployment(ctx, plan, tracker); err != nil {
+		stopReporting()
 		catalogutils.HandleDeploymentStepError(ctx, d.appRepo, plan.ApplicationID, "Image pull failed", err)
 
 		return fmt.Errorf("failed to pull images: %w", err)
 	}
 
 	// Step 1b: Download models specified in parameters
-	if err := d.downloadModelsForDeployment(ctx, plan); err != nil {
+	if err := d.downloadModelsForDeployment(ctx, plan, tracker); err != nil {
+		stopReporting()
 		catalogutils.HandleDeploymentStepError(ctx, d.appRepo, plan.ApplicationID, "Model download failed", err)
 
 		return fmt.Errorf("failed to download models: %w", err)
 	}
+	stopReporting()
 
 	// Transition status to Deploying before pod creation begins.
 	// Skip if the context was cancelled — deletion is now in charge of the status.
@@ -165,12 +189,54 @@ func (d *PodmanDeployer) prepareDeployment(ctx context.Context, plan *Deployment
 	return nil
 }
 
+// reportProgress updates the status message of the application with the progress of the tracked
+// transfers every progressInterval, until the returned func is called. The func waits for an
+// update in flight, so that it cannot override the status set after it.
+func (d *PodmanDeployer) reportProgress(ctx context.Context, appID uuid.UUID, tracker *transfer.Tracker) func() {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(progressInterval)
+		defer ticker.Stop()
+
+		last := ""
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			summary := tracker.Summary()
+			if summary == "" || summary == last {
+				continue
+			}
+			if err := catalogutils.UpdateApplicationStatus(ctx, d.appRepo, appID, models.ApplicationStatusDownloading, "Downloading: "+summary); err != nil {
+				logger.WarningfCtx(ctx, "Failed to report download progress: %v\n", err)
+			}
+			last = summary
+		}
+	}()
+
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
 // downloadModelsForDeployment downloads all models specified in component and service parameters.
-// Models are extracted from params that contain "model" in their key name.
-func (d *PodmanDeployer) downloadModelsForDeployment(ctx context.Context, plan *DeploymentPlan) error {
+// Models are extracted from params that contain "model" in their key name, and downloaded from the
+// model source of their component.
+func (d *PodmanDeployer) downloadModelsForDeployment(ctx context.Context, plan *DeploymentPlan, tracker *transfer.Tracker) error {
 	logger.InfofCtx(ctx, "Downloading models for application '%s'\n", plan.ApplicationName)
 
-	modelSet := d.collectModelsFromPlan(ctx, plan)
+	modelSet, err := d.collectModelsFromPlan(ctx, plan)
+	if err != nil {
+		return err
+	}
 
 	if len(modelSet) == 0 {
 		logger.InfofCtx(ctx, "No models to download for application '%s'\n", plan.ApplicationName)
@@ -178,7 +244,7 @@ func (d *PodmanDeployer) downloadModelsForDeployment(ctx context.Context, plan *
 		return nil
 	}
 
-	if err := d.downloadModels(ctx, modelSet); err != nil {
+	if err := d.downloadModels(ctx, modelSet, tracker); err != nil {
 		return err
 	}
 
@@ -187,9 +253,10 @@ func (d *PodmanDeployer) downloadModelsForDeployment(ctx context.Context, plan *
 	return nil
 }
 
-// collectModelsFromPlan collects all unique model names from the deployment plan.
-func (d *PodmanDeployer) collectModelsFromPlan(ctx context.Context, plan *DeploymentPlan) map[string]bool {
-	modelSet := make(map[string]bool)
+// collectModelsFromPlan collects all unique model names from the deployment plan with the source
+// to download them from.
+func (d *PodmanDeployer) collectModelsFromPlan(ctx context.Context, plan *DeploymentPlan) (map[string]modelsource.Config, error) {
+	modelSet := make(map[string]modelsource.Config)
 
 	// Extract models from component params
 	for _, comp := range plan.Components {
@@ -199,14 +266,31 @@ func (d *PodmanDeployer) collectModelsFromPlan(ctx context.Context, plan *Deploy
 
 			continue
 		}
-		d.extractModelsFromParams(comp.Params, modelSet)
+
+		// The values hold the model source of values.yaml overridden by the params
+		values := comp.Values
+		if values == nil {
+			values = comp.Params
+		}
+		source, err := modelsource.ConfigFromValues(values)
+		if err != nil {
+			return nil, fmt.Errorf("component %s/%s: %w", comp.ComponentType, comp.ProviderID, err)
+		}
+
+		for model := range d.extractModelsFromParams(comp.Params) {
+			if existing, ok := modelSet[model]; ok && existing != source {
+				return nil, fmt.Errorf("model %s is configured with different sources: %s and %s", model, existing, source)
+			}
+			modelSet[model] = source
+		}
 	}
 
-	return modelSet
+	return modelSet, nil
 }
 
 // extractModelsFromParams extracts model names from parameter maps.
-func (d *PodmanDeployer) extractModelsFromParams(params map[string]any, modelSet map[string]bool) {
+func (d *PodmanDeployer) extractModelsFromParams(params map[string]any) map[string]bool {
+	modelSet := make(map[string]bool)
 	for key, value := range params {
 		if strings.Contains(strings.ToLower(key), "model") {
 			if modelName, ok := value.(string); ok && modelName != "" {
@@ -214,25 +298,45 @@ func (d *PodmanDeployer) extractModelsFromParams(params map[string]any, modelSet
 			}
 		}
 	}
+
+	return modelSet
 }
 
-// downloadModels downloads all models in the provided set.
-func (d *PodmanDeployer) downloadModels(ctx context.Context, modelSet map[string]bool) error {
+// downloadModels downloads all models in the provided set from their source, several at a time. A
+// model already being downloaded for another deployment is waited for rather than downloaded again.
+func (d *PodmanDeployer) downloadModels(ctx context.Context, modelSet map[string]modelsource.Config, tracker *transfer.Tracker) error {
 	modelsPath := utils.GetModelsPath()
 
-	for modelName := range modelSet {
-		logger.InfofCtx(ctx, "Downloading model: %s\n", modelName)
-
-		if err := helpers.DownloadModelContainer(ctx, modelName, modelsPath); err != nil {
+	return transfer.Run(ctx, transfer.Concurrency(), slices.Sorted(maps.Keys(modelSet)), func(ctx context.Context, modelName string) error {
+		key := "model:" + filepath.Join(modelsPath, modelName)
+		err := transfer.Shared.Do(ctx, key, tracker.Progress(transfer.KindModel, modelName), func(ctx context.Context, progress transfer.ProgressFunc) error {
+			return modelsource.DownloadWithProgress(ctx, modelSet[modelName], d.resolveConnector, modelName, modelsPath, progress)
+		})
+		if err != nil {
 			return fmt.Errorf("failed to download model %s: %w", modelName, err)
 		}
+		tracker.Done(transfer.KindModel, modelName)
+
+		return nil
+	})
+}
+
+// resolveConnector returns the connector of an s3 model source with its credentials.
+func (d *PodmanDeployer) resolveConnector(ctx context.Context, id string) (*models.Connector, error) {
+	if d.connectorRepo == nil {
+		return nil, fmt.Errorf("connectors are not available")
 	}
 
-	return nil
+	connectorID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid connector ID %q", id)
+	}
+
+	return d.connectorRepo.GetByID(ctx, connectorID, true)
 }
 
 // pullImagesForDeployment pulls all container images required for components and services.
-func (d *PodmanDeployer) pullImagesForDeployment(ctx context.Context, plan *DeploymentPlan) error {
+func (d *PodmanDeployer) pullImagesForDeployment(ctx context.Context, plan *DeploymentPlan, tracker *transfer.Tracker) error {
 	logger.InfofCtx(ctx, "Pulling container images for application '%s'\n", plan.ApplicationName)
 
 	imageSet, err := d.collectImagesFromPlan(ctx, plan)
@@ -246,7 +350,7 @@ func (d *PodmanDeployer) pullImagesForDeployment(ctx context.Context, plan *Depl
 		return nil
 	}
 
-	if err := d.pullImages(ctx, imageSet); err != nil {
+	if err := d.pullImages(ctx, imageSet, tracker); err != nil {
 		return err
 	}
 
@@ -311,25 +415,34 @@ func (d *PodmanDeployer) extractImagesFromService(ctx context.Context, svc *Serv
 	return nil
 }
 
-// pullImages pulls only missing images from the provided set using the runtime.
-// Images that are already present locally are skipped.
-func (d *PodmanDeployer) pullImages(ctx context.Context, imageSet map[string]bool) error {
+// pullImages verifies the images of the provided set against the image policy, then pulls only
+// the missing ones using the runtime, reporting their progress to tracker. Images that are already
+// present locally are not pulled, but must match the verified digests like the pulled ones.
+func (d *PodmanDeployer) pullImages(ctx context.Context, imageSet map[string]bool, tracker *transfer.Tracker) error {
 	// Convert map to slice
 	images := make([]string, 0, len(imageSet))
 	for img := range imageSet {
 		images = append(images, img)
 	}
 
+	verified, err := image.Verify(ctx, images)
+	if err != nil {
+		return err
+	}
+
 	// Use the image package's IfNotPresent method
 	imgHelper := &image.Images{
 		Runtime: d.runtime,
+		Tracker: tracker,
 	}
 
 	if err := imgHelper.IfNotPresent(ctx, images); err != nil {
 		return fmt.Errorf("failed to pull images: %w", err)
 	}
 
-	return nil
+	// The images are pulled by tag and may have been present already, so check that they are
+	// the verified ones
+	return image.CheckDigests(d.runtime, verified)
 }
 
 // deployComponents deploys all components concurrently.
@@ -818,7 +931,8 @@ func (d *PodmanDeployer) renderFinalPodTemplate(
 	podSpec *podmodels.PodSpec,
 	plan *DeploymentPlan,
 ) (*podmodels.PodSpec, []byte, error) {
-	env, err := d.getEnvParamsForComponent(ctx, podSpec, plan)
+	componentID, _ := initialParams["TemplateID"].(uuid.UUID)
+	env, annotations, err := d.getEnvParamsForComponent(ctx, podSpec, plan, componentID)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to get env params: %w", err)
 	}
@@ -833,6 +947,13 @@ func (d *PodmanDeployer) renderFinalPodTemplate(
 
 	renderedBytes := finalRendered.Bytes()
 
+	// Pin containers to the NUMA node of their Spyre cards
+	if len(annotations) > 0 {
+		if renderedBytes, err = addPodAnnotations(renderedBytes, annotations); err != nil {
+			return nil, nil, fmt.Errorf("failed to pin template %s to its NUMA node: %w", templateName, err)
+		}
+	}
+
 	// Parse into PodSpec for metadata (annotations, name, etc.) but don't use it for deployment
 	var finalPodSpec podmodels.PodSpec
 	if err := k8syaml.Unmarshal(renderedBytes, &finalPodSpec); err != nil {
@@ -1020,8 +1141,17 @@ func (d *PodmanDeployer) fetchSpyreCardsFromPodAnnotations(annotations map[strin
 }
 
 // getEnvParamsForComponent returns environment parameters for a component including Spyre card PCI addresses.
-func (d *PodmanDeployer) getEnvParamsForComponent(ctx context.Context, podSpec *podmodels.PodSpec, plan *DeploymentPlan) (map[string]map[string]string, error) {
+// The allocated cards are recorded as owned by componentID in the allocation ledger. It also returns the
+// pod annotations pinning the containers whose cards are all on one NUMA node to the CPUs and memory of
+// that node.
+func (d *PodmanDeployer) getEnvParamsForComponent(
+	ctx context.Context,
+	podSpec *podmodels.PodSpec,
+	plan *DeploymentPlan,
+	componentID uuid.UUID,
+) (map[string]map[string]string, map[string]string, error) {
 	env := make(map[string]map[string]string)
+	annotations := make(map[string]string)
 
 	// Get container names from pod spec
 	for _, container := range podSpec.Spec.Containers {
@@ -1029,26 +1159,32 @@ func (d *PodmanDeployer) getEnvParamsForComponent(ctx context.Context, podSpec *
 	}
 
 	if plan.SpyreCardPool == nil {
-		return env, nil
+		return env, annotations, nil
 	}
 
 	// Fetch Spyre card requirements from annotations
 	spyreCards, spyreCardContainerMap, err := d.fetchSpyreCardsFromPodAnnotations(podSpec.Annotations)
 	if err != nil {
-		return env, err
+		return env, annotations, err
 	}
 
 	if spyreCards == 0 {
-		return env, nil
+		return env, annotations, nil
 	}
 
+	placement := componentPlacement(plan, componentID)
+
 	// Allocate PCI addresses to containers that need them
 	for containerName, spyreCount := range spyreCardContainerMap {
 		if spyreCount != 0 {
-			// Allocate addresses from the pool (thread-safe)
-			allocatedAddresses, err := plan.SpyreCardPool.Allocate(spyreCount)
+			// Allocate addresses from the pool (thread-safe), taking the cards placed for the component first
+			var preferred []string
+			if placement != nil {
+				preferred = placement.Addresses
+			}
+			allocatedAddresses, err := plan.SpyreCardPool.Allocate(spyreCount, preferred...)
 			if err != nil {
-				return env, fmt.Errorf("failed to allocate Spyre cards for container %s: %w", containerName, err)
+				return env, annotations, fmt.Errorf("failed to allocate Spyre cards for container %s: %w", containerName, err)
 			}
 
 			// Join addresses with space separator
@@ -1062,12 +1198,65 @@ func (d *PodmanDeployer) getEnvParamsForComponent(ctx context.Context, podSpec *
 
 			env[containerName][string(constants.PCIAddressKey)] = pciAddressStr
 
+			if d.allocationRepo != nil && componentID != uuid.Nil {
+				if err := d.allocationRepo.AssignComponent(ctx, plan.ApplicationID, componentID, allocatedAddresses); err != nil {
+					return env, annotations, err
+				}
+			}
+
+			if pinned := placement != nil && placement.CPUSet != "" &&
+				!slices.ContainsFunc(allocatedAddresses, func(addr string) bool { return !slices.Contains(placement.Addresses, addr) }); pinned {
+				annotations[constants.CPUSetAnnotation+"/"+containerName] = placement.CPUSet
+				annotations[constants.MemoryNodesAnnotation+"/"+containerName] = strconv.Itoa(placement.NUMANode)
+				logger.DebugfCtx(ctx, "Pinned container '%s' in pod '%s' to CPUs %s of NUMA node %d\n",
+					containerName, podSpec.Name, placement.CPUSet, placement.NUMANode)
+			}
+
 			logger.DebugfCtx(ctx, "Allocated %d Spyre cards to container '%s' in pod '%s': %s\n",
 				spyreCount, containerName, podSpec.Name, pciAddressStr)
 		}
 	}
 
-	return env, nil
+	return env, annotations, nil
+}
+
+// componentPlacement returns the card placement of the component with the given database ID.
+func componentPlacement(plan *DeploymentPlan, componentID uuid.UUID) *deploymenttypes.CardPlacement {
+	if componentID == uuid.Nil {
+		return nil
+	}
+
+	for _, comp := range plan.Components {
+		if comp.DatabaseID == componentID {
+			return comp.Placement
+		}
+	}
+
+	return nil
+}
+
+// addPodAnnotations adds annotations to the metadata of a rendered pod spec.
+func addPodAnnotations(rendered []byte, annotations map[string]string) ([]byte, error) {
+	var pod map[string]any
+	if err := k8syaml.Unmarshal(rendered, &pod); err != nil {
+		return nil, fmt.Errorf("failed to parse rendered pod spec: %w", err)
+	}
+
+	metadata, _ := pod["metadata"].(map[string]any)
+	if metadata == nil {
+		metadata = map[string]any{}
+		pod["metadata"] = metadata
+	}
+	podAnnotations, _ := metadata["annotations"].(map[string]any)
+	if podAnnotations == nil {
+		podAnnotations = map[string]any{}
+		metadata["annotations"] = podAnnotations
+	}
+	for key, value := range annotations {
+		podAnnotations[key] = value
+	}
+
+	return k8syaml.Marshal(pod)
 }
 
 // registerApplicationRoutes registers routes for all services with Caddy proxy and updates endpoints in database.
@@ -1079,6 +1268,19 @@ func (d *PodmanDeployer) registerApplicationRoutes(ctx context.Context, plan *De
 		return err
 	}
 
+	layout := routeLayout{domainSuffix: domainSuffix, httpsPort: httpsPort}
+	if utils.GetEnv(catalogconstants.RoutingModeEnv, proxy.RoutingModeHost) == proxy.RoutingModePath {
+		layout.pathBase = path.Join(proxy.ApplicationsPathBase, plan.ApplicationName)
+		// Routes are named after the pods of the application, which end in its instance slug
+		layout.nameSuffix = "-" + catalogutils.GenerateInstanceSlug(plan.ApplicationID.String())
+	}
+
+	// Service APIs are only reachable by clients the API server authorizes
+	authPolicy, err := catalogutils.ServiceAuthPolicy(plan.ApplicationID)
+	if err != nil {
+		return err
+	}
+
 	// Register routes for each service and update endpoints in database
 	var registrationErrors []error
 	for _, svc := range plan.Services {
@@ -1086,7 +1288,7 @@ func (d *PodmanDeployer) registerApplicationRoutes(ctx context.Context, plan *De
 			continue
 		}
 
-		if err := d.registerServiceRoutes(ctx, svc, proxyManager, domainSuffix, httpsPort, &registrationErrors); err != nil {
+		if err := d.registerServiceRoutes(ctx, svc, proxyManager, layout, authPolicy, &registrationErrors); err != nil {
 			registrationErrors = append(registrationErrors, err)
 		}
 	}
@@ -1120,40 +1322,82 @@ func (d *PodmanDeployer) getCaddyConfiguration() (string, string, proxy.ProxyMan
 	return domainSuffix, httpsPort, proxyManager, nil
 }
 
+// routeLayout describes where the routes of an application are served.
+type routeLayout struct {
+	domainSuffix string
+	httpsPort    string
+	// pathBase is the path the API routes are served under in path routing mode, empty in host routing mode
+	pathBase string
+	// nameSuffix is removed from route IDs to name the routes in path routing mode
+	nameSuffix string
+}
+
+// applyServicePathRouting serves the API routes of a service under the path base of the layout.
+// The service UIs are not built to be served under a path, since they load their assets and call
+// their APIs from the root, so they keep their own hostnames in path routing mode too.
+func applyServicePathRouting(routes []proxy.Route, layout routeLayout) {
+	for i := range routes {
+		if routes[i].Type == catalogconstants.ServiceRouteTypeUI {
+			continue
+		}
+		proxy.ApplyPathRouting(routes[i:i+1], layout.domainSuffix, layout.pathBase, layout.nameSuffix)
+	}
+}
+
 // registerServiceRoutes registers routes for a single service and updates its endpoints in the database.
 func (d *PodmanDeployer) registerServiceRoutes(
 	ctx context.Context,
 	svc *ServicePlan,
 	proxyManager proxy.ProxyManager,
-	domainSuffix string,
-	httpsPort string,
+	layout routeLayout,
+	authPolicy *proxy.AuthPolicy,
 	registrationErrors *[]error,
 ) error {
 	var serviceEndpoints []map[string]any
 
 	// Register routes for each pod in the service
 	for podName, routesAnnotation := range svc.Routes {
-		registeredRoutes, err := proxy.RegisterRoutesForAppAndReturn(
-			ctx,
-			catalogconstants.CatalogAppName,
-			proxyManager,
-			routesAnnotation,
-			domainSuffix,
-			podName,
-		)
+		registeredRoutes, err := proxy.BuildRoutesFromAnnotation(routesAnnotation, layout.domainSuffix, podName)
 		if err != nil {
+			*registrationErrors = append(*registrationErrors, fmt.Errorf("pod %s: failed to build routes: %w", podName, err))
+
+			continue
+		}
+
+		if layout.pathBase != "" {
+			applyServicePathRouting(registeredRoutes, layout)
+		}
+
+		// UIs call their APIs from within the pod network, so only API routes require credentials
+		for i := range registeredRoutes {
+			if registeredRoutes[i].Type == catalogconstants.ServiceRouteTypeAPI {
+				registeredRoutes[i].Auth = authPolicy
+			}
+			registeredRoutes[i].Policy = svc.RoutePolicies[registeredRoutes[i].Type]
+		}
+
+		if err := proxy.RegisterRoutes(ctx, proxyManager, registeredRoutes); err != nil {
 			*registrationErrors = append(*registrationErrors, fmt.Errorf("pod %s: %w", podName, err))
 
 			continue
 		}
 
-		// Convert registered routes to endpoint format using route type
+		// Convert registered routes to endpoint format using route type. The upstream and policy
+		// are kept so that the sync service can register the route again should it drift.
 		for _, route := range registeredRoutes {
-			url := catalogutils.BuildExternalURL(route.Domain, httpsPort)
+			url := catalogutils.BuildExternalURL(route.Domain, layout.httpsPort) + route.PathPrefix
 
 			endpoint := map[string]any{
-				"type": route.Type,
-				"url":  url,
+				"type":     route.Type,
+				"url":      url,
+				"upstream": route.Upstream,
+			}
+			if route.PathPrefix != "" {
+				// The route ID cannot be derived from the hostname shared by all path routes
+				endpoint["route_id"] = route.ID
+			}
+			if route.Policy != nil {
+				endpoint["policy"] = route.Policy
 			}
 			serviceEndpoints = append(serviceEndpoints, endpoint)
 		}
@@ -2,9 +2,11 @@ package types
 
 import (
 	"fmt"
+	"slices"
 	"sync"
 
 	"github.com/google/uuid"
+	"github.com/project-ai-services/ai-services/internal/pkg/proxy"
 )
 
 // DeploymentPlan represents the complete deployment plan for an application.
@@ -31,17 +33,46 @@ type ComponentPlan struct {
 	UsedByServices []string       // List of service IDs that use this component
 	Values         map[string]any // Structured values from LoadComponentValues
 	Endpoints      map[string]any // Extracted endpoints after deployment (populated by deployer)
+	Placement      *CardPlacement // Spyre cards chosen for this component (nil if it needs none)
+}
+
+// CardPlacement records the Spyre cards chosen for a component and why they were chosen.
+type CardPlacement struct {
+	Addresses []string // PCI addresses of the cards
+	NUMANode  int      // NUMA node of all cards, -1 if they span nodes or the node is unknown
+	CPUSet    string   // CPUs of NUMANode the containers of the component are pinned to ("" if not pinned)
+	Reason    string   // Explanation of the placement
+}
+
+// Metadata returns the placement as stored in the component metadata.
+func (p *CardPlacement) Metadata() map[string]any {
+	metadata := map[string]any{
+		"pci_addresses": p.Addresses,
+		"reason":        p.Reason,
+	}
+	if p.NUMANode >= 0 {
+		metadata["numa_node"] = p.NUMANode
+	}
+	if p.CPUSet != "" {
+		metadata["cpuset"] = p.CPUSet
+	}
+
+	return metadata
 }
 
 // ServicePlan represents a single service deployment.
 type ServicePlan struct {
-	CatalogID     string            // Service catalog ID (e.g., "chat", "digitize")
-	CatalogPath   string            // Dynamic catalog path (e.g., "services/chat/podman")
-	DatabaseID    uuid.UUID         // Database UUID for this service record (set after DB insertion)
-	Version       string            // Service version
-	ComponentRefs []string          // List of component hashes this service uses
-	Values        map[string]any    // Structured values from LoadServiceValues + component values
-	Routes        map[string]string // Routes extracted during deployment: podName -> routes annotation
+	CatalogID       string                        // Service catalog ID (e.g., "chat", "digitize")
+	CatalogPath     string                        // Dynamic catalog path (e.g., "services/chat/podman")
+	DatabaseID      uuid.UUID                     // Database UUID for this service record (set after DB insertion)
+	Version         string                        // Service version
+	ComponentRefs   []string                      // List of component hashes this service uses
+	Values          map[string]any                // Structured values from LoadServiceValues + component values
+	Routes          map[string]string             // Routes extracted during deployment: podName -> routes annotation
+	RoutePolicies   map[string]*proxy.RoutePolicy // Proxy policies of the service routes keyed by route type
+	Params          map[string]any                // Service-level parameters of the request
+	PolicyOverrides map[string]*proxy.RoutePolicy // Route policy overrides of the request keyed by route type
+	ConnectorIDs    []uuid.UUID                   // Connectors the service depends on
 }
 
 // SpyreCardPool manages allocation of PCI addresses to components.
@@ -50,8 +81,9 @@ type SpyreCardPool struct {
 	mutex     sync.Mutex
 }
 
-// Allocate takes n addresses from the pool and returns them.
-func (p *SpyreCardPool) Allocate(n int) ([]string, error) {
+// Allocate takes n addresses from the pool and returns them. The preferred addresses still in the
+// pool are taken first, e.g. the cards placed for the component being deployed.
+func (p *SpyreCardPool) Allocate(n int, preferred ...string) ([]string, error) {
 	p.mutex.Lock()
 	defer p.mutex.Unlock()
 
@@ -59,9 +91,21 @@ func (p *SpyreCardPool) Allocate(n int) ([]string, error) {
 		return nil, ErrInsufficientSpyreCards{Need: n, Have: len(p.Addresses)}
 	}
 
-	allocated := make([]string, n)
-	copy(allocated, p.Addresses[:n])
-	p.Addresses = p.Addresses[n:]
+	allocated := make([]string, 0, n)
+	for _, addr := range preferred {
+		if len(allocated) < n && slices.Contains(p.Addresses, addr) && !slices.Contains(allocated, addr) {
+			allocated = append(allocated, addr)
+		}
+	}
+	for _, addr := range p.Addresses {
+		if len(allocated) < n && !slices.Contains(allocated, addr) {
+			allocated = append(allocated, addr)
+		}
+	}
+
+	p.Addresses = slices.DeleteFunc(p.Addresses, func(addr string) bool {
+		return slices.Contains(allocated, addr)
+	})
 
 	return allocated, nil
 }
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestSpyreCardPoolAllocate(t *testing.T) {
+	pool := &SpyreCardPool{Addresses: []string{"a", "b", "c", "d"}}
+
+	got, err := pool.Allocate(2, "c", "x", "d")
+	if err != nil {
+		t.Fatalf("Allocate() error = %v", err)
+	}
+	if want := []string{"c", "d"}; !slices.Equal(got, want) {
+		t.Errorf("Allocate() = %v, want %v", got, want)
+	}
+
+	// Preferred addresses already taken are skipped
+	got, err = pool.Allocate(1, "c")
+	if err != nil {
+		t.Fatalf("Allocate() error = %v", err)
+	}
+	if want := []string{"a"}; !slices.Equal(got, want) {
+		t.Errorf("Allocate() = %v, want %v", got, want)
+	}
+
+	var insufficient ErrInsufficientSpyreCards
+	if _, err := pool.Allocate(2); !errors.As(err, &insufficient) {
+		t.Errorf("Allocate() error = %v, want ErrInsufficientSpyreCards", err)
+	}
+	if want := []string{"b"}; !slices.Equal(pool.Addresses, want) {
+		t.Errorf("remaining addresses = %v, want %v", pool.Addresses, want)
+	}
+}
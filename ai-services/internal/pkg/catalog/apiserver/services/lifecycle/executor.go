@@ -0,0 +1,168 @@
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/services/lifecycle/repository/openshift"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/services/lifecycle/repository/podman"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/db/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/db/repository"
+	catalogutils "github.com/project-ai-services/ai-services/internal/pkg/catalog/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	openshiftRuntime "github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
+	podmanRuntime "github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+// Action is a lifecycle operation that can be applied to a deployed application.
+type Action string
+
+const (
+	ActionStart   Action = "start"
+	ActionStop    Action = "stop"
+	ActionRestart Action = "restart"
+)
+
+// WorkloadController stops and starts the runtime workloads labelled with a service or component ID.
+type WorkloadController interface {
+	Stop(ctx context.Context, templateID uuid.UUID) error
+	Start(ctx context.Context, templateID uuid.UUID) error
+}
+
+// LifecycleExecutor orchestrates starting, stopping and restarting application workloads.
+type LifecycleExecutor struct {
+	serviceRepo   repository.ServiceRepository
+	componentRepo repository.ComponentRepository
+}
+
+// NewLifecycleExecutor creates a new LifecycleExecutor instance.
+func NewLifecycleExecutor(
+	serviceRepo repository.ServiceRepository,
+	componentRepo repository.ComponentRepository,
+) *LifecycleExecutor {
+	return &LifecycleExecutor{
+		serviceRepo:   serviceRepo,
+		componentRepo: componentRepo,
+	}
+}
+
+// Execute applies the action to the given services and components. Services are stopped before
+// the components they depend on and started after them. Service and component statuses are
+// updated as each workload changes state; the caller is responsible for the application status.
+func (e *LifecycleExecutor) Execute(
+	ctx context.Context,
+	action Action,
+	appID uuid.UUID,
+	serviceIDs []uuid.UUID,
+	componentIDs []uuid.UUID,
+	runtimeType types.RuntimeType,
+) error {
+	controller, err := newController(appID, runtimeType)
+	if err != nil {
+		return err
+	}
+
+	var errorMessages []string
+
+	switch action {
+	case ActionStop:
+		errorMessages = e.stop(ctx, controller, serviceIDs, componentIDs)
+	case ActionStart:
+		errorMessages = e.start(ctx, controller, serviceIDs, componentIDs)
+	case ActionRestart:
+		errorMessages = e.stop(ctx, controller, serviceIDs, componentIDs)
+		if len(errorMessages) == 0 {
+			errorMessages = e.start(ctx, controller, serviceIDs, componentIDs)
+		}
+	default:
+		return fmt.Errorf("unsupported lifecycle action: %s", action)
+	}
+
+	if len(errorMessages) > 0 {
+		return fmt.Errorf("%s failed with %d error(s): %s", action, len(errorMessages), strings.Join(errorMessages, "; "))
+	}
+
+	return nil
+}
+
+func newController(appID uuid.UUID, runtimeType types.RuntimeType) (WorkloadController, error) {
+	switch runtimeType {
+	case types.RuntimeTypePodman:
+		rt, err := podmanRuntime.NewPodmanClient()
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize Podman runtime: %w", err)
+		}
+
+		return podman.NewPodmanLifecycle(rt), nil
+	case types.RuntimeTypeOpenShift:
+		rt, err := openshiftRuntime.NewOpenshiftClientWithNamespace(catalogutils.AppNamespace(appID))
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize openshift runtime: %w", err)
+		}
+
+		return openshift.NewOpenshiftLifecycle(rt), nil
+	default:
+		return nil, fmt.Errorf("unsupported runtime type: %s", runtimeType)
+	}
+}
+
+// stop marks each service and component as Stopped before scaling it down, so the sync
+// loop does not report the intentionally stopped workloads as failed.
+func (e *LifecycleExecutor) stop(ctx context.Context, controller WorkloadController, serviceIDs, componentIDs []uuid.UUID) []string {
+	var errorMessages []string
+
+	for _, id := range serviceIDs {
+		_ = catalogutils.UpdateServiceStatus(ctx, e.serviceRepo, id, models.ServiceStatusStopped, "")
+		if err := controller.Stop(ctx, id); err != nil {
+			errorMessages = append(errorMessages, fmt.Sprintf("service %s: %s", id, err))
+			_ = catalogutils.UpdateServiceStatus(ctx, e.serviceRepo, id, models.ServiceStatusError, fmt.Sprintf("failed to stop: %s", err))
+		}
+	}
+
+	for _, id := range componentIDs {
+		_ = catalogutils.UpdateComponentStatus(ctx, e.componentRepo, id, models.ComponentStatusStopped, "")
+		if err := controller.Stop(ctx, id); err != nil {
+			errorMessages = append(errorMessages, fmt.Sprintf("component %s: %s", id, err))
+			_ = catalogutils.UpdateComponentStatus(ctx, e.componentRepo, id, models.ComponentStatusError, fmt.Sprintf("failed to stop: %s", err))
+		}
+	}
+
+	logger.InfofCtx(ctx, "Stopped %d service(s) and %d component(s)", len(serviceIDs), len(componentIDs))
+
+	return errorMessages
+}
+
+// start brings components up first so that services find their dependencies available.
+// Statuses are set to Running and subsequently verified by the sync loop.
+func (e *LifecycleExecutor) start(ctx context.Context, controller WorkloadController, serviceIDs, componentIDs []uuid.UUID) []string {
+	var errorMessages []string
+
+	for _, id := range componentIDs {
+		if err := controller.Start(ctx, id); err != nil {
+			errorMessages = append(errorMessages, fmt.Sprintf("component %s: %s", id, err))
+			_ = catalogutils.UpdateComponentStatus(ctx, e.componentRepo, id, models.ComponentStatusError, fmt.Sprintf("failed to start: %s", err))
+
+			continue
+		}
+		_ = catalogutils.UpdateComponentStatus(ctx, e.componentRepo, id, models.ComponentStatusRunning, "")
+	}
+
+	for _, id := range serviceIDs {
+		if err := controller.Start(ctx, id); err != nil {
+			errorMessages = append(errorMessages, fmt.Sprintf("service %s: %s", id, err))
+			_ = catalogutils.UpdateServiceStatus(ctx, e.serviceRepo, id, models.ServiceStatusError, fmt.Sprintf("failed to start: %s", err))
+
+			continue
+		}
+		_ = catalogutils.UpdateServiceStatus(ctx, e.serviceRepo, id, models.ServiceStatusRunning, "")
+	}
+
+	logger.InfofCtx(ctx, "Started %d component(s) and %d service(s)", len(componentIDs), len(serviceIDs))
+
+	return errorMessages
+}
+
+// Made with Bob
@@ -0,0 +1,45 @@
+package openshift
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+// workloadScaler is the subset of the OpenShift runtime client used to scale workloads.
+type workloadScaler interface {
+	ScaleWorkloads(labelSelector string, stop bool) error
+}
+
+// OpenshiftLifecycle stops and starts the workloads of a service or component by scaling
+// them to zero and back within the application namespace.
+type OpenshiftLifecycle struct {
+	rt workloadScaler
+}
+
+// NewOpenshiftLifecycle creates a new OpenshiftLifecycle instance.
+func NewOpenshiftLifecycle(rt workloadScaler) *OpenshiftLifecycle {
+	return &OpenshiftLifecycle{rt: rt}
+}
+
+// Stop scales every workload labelled with the given template ID to zero.
+func (l *OpenshiftLifecycle) Stop(ctx context.Context, templateID uuid.UUID) error {
+	return l.scale(ctx, templateID, true)
+}
+
+// Start restores every workload labelled with the given template ID to its previous size.
+func (l *OpenshiftLifecycle) Start(ctx context.Context, templateID uuid.UUID) error {
+	return l.scale(ctx, templateID, false)
+}
+
+func (l *OpenshiftLifecycle) scale(ctx context.Context, templateID uuid.UUID, stop bool) error {
+	selector := fmt.Sprintf("%s=%s", constants.ApplicationTemplateKey, templateID)
+	logger.InfofCtx(ctx, "Scaling workloads with label '%s' (stop=%t)", selector, stop)
+
+	return l.rt.ScaleWorkloads(selector, stop)
+}
+
+// Made with Bob
@@ -0,0 +1,51 @@
+package podman
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+)
+
+// PodmanLifecycle stops and starts the pods of a service or component.
+type PodmanLifecycle struct {
+	rt runtime.Runtime
+}
+
+// NewPodmanLifecycle creates a new PodmanLifecycle instance.
+func NewPodmanLifecycle(rt runtime.Runtime) *PodmanLifecycle {
+	return &PodmanLifecycle{rt: rt}
+}
+
+// Stop stops every pod labelled with the given template ID.
+func (l *PodmanLifecycle) Stop(ctx context.Context, templateID uuid.UUID) error {
+	return l.forEachPod(ctx, templateID, "stop", l.rt.StopPod)
+}
+
+// Start starts every pod labelled with the given template ID.
+func (l *PodmanLifecycle) Start(ctx context.Context, templateID uuid.UUID) error {
+	return l.forEachPod(ctx, templateID, "start", l.rt.StartPod)
+}
+
+func (l *PodmanLifecycle) forEachPod(ctx context.Context, templateID uuid.UUID, verb string, fn func(id string) error) error {
+	pods, err := l.rt.ListPods(map[string][]string{
+		"label": {fmt.Sprintf("%s=%s", constants.ApplicationTemplateKey, templateID)},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	for _, pod := range pods {
+		logger.InfofCtx(ctx, "Running %s on pod %s", verb, pod.Name)
+		if err := fn(pod.ID); err != nil {
+			return fmt.Errorf("failed to %s pod %s: %w", verb, pod.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// Made with Bob
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"maps"
+	"strconv"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog"
 	apimodels "github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/models"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/validators"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 )
 
@@ -46,6 +48,47 @@ type ServiceParams struct {
 	Values    map[string]any    // Service values with component values nested under component_type
 }
 
+// ValidateParams validates the service and component params of every service of the request
+// against their values.schema.json files. All violations are returned at once in a
+// validators.ValidationError with status 422, addressed by JSON pointers into the request body
+// (e.g. /services/0/components/1/params/model).
+func (b *ParamBuilder) ValidateParams(ctx context.Context, req apimodels.CreateApplicationRequest) error {
+	var violations []validators.Violation
+
+	for i, svcReq := range req.Services {
+		base := validators.JSONPointer("services", strconv.Itoa(i))
+
+		schema, err := b.catalogProvider.GetServiceParams(ctx, svcReq.CatalogID)
+		if err != nil {
+			return fmt.Errorf("failed to load schema of service '%s': %w", svcReq.CatalogID, err)
+		}
+
+		found, err := validators.SchemaViolations(svcReq.Params, schema, base+"/params", fmt.Sprintf("service '%s'", svcReq.CatalogID))
+		if err != nil {
+			return err
+		}
+		violations = append(violations, found...)
+
+		for j, compReq := range svcReq.Components {
+			contextName := fmt.Sprintf("component '%s/%s'", compReq.ComponentType, compReq.ProviderID)
+
+			schema, err := b.catalogProvider.GetComponentProviderParams(ctx, compReq.ComponentType, compReq.ProviderID)
+			if err != nil {
+				return fmt.Errorf("failed to load schema of %s: %w", contextName, err)
+			}
+
+			pointer := base + validators.JSONPointer("components", strconv.Itoa(j), "params")
+			found, err := validators.SchemaViolations(compReq.Params, schema, pointer, contextName)
+			if err != nil {
+				return err
+			}
+			violations = append(violations, found...)
+		}
+	}
+
+	return validators.NewParamsValidationError(fmt.Sprintf("application '%s'", req.Name), violations)
+}
+
 // BuildServiceParams builds parameters for a single service deployment.
 func (b *ParamBuilder) BuildServiceParams(
 	ctx context.Context,
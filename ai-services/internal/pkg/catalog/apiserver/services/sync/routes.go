@@ -0,0 +1,258 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/db/models"
+	dbrepo "github.com/project-ai-services/ai-services/internal/pkg/catalog/db/repository"
+	catalogutils "github.com/project-ai-services/ai-services/internal/pkg/catalog/utils"
+	consts "github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/metrics"
+	"github.com/project-ai-services/ai-services/internal/pkg/proxy"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/common"
+)
+
+// catalogPodPrefix is the name prefix of the catalog's own pods. Routes to these pods are
+// registered by the catalog CLI and are never treated as orphans.
+const catalogPodPrefix = constants.CatalogAppName + "--"
+
+// routesRestoredPrefix starts the status message of a running application whose drifted routes
+// were restored. The message is kept until the status of the application changes.
+const routesRestoredPrefix = "Restored drifted proxy routes"
+
+// routeReconciler keeps the routes of the Caddy proxy in line with the service endpoints recorded
+// in the database. Routes that went missing from Caddy or were changed outside of the API server
+// are registered again, and routes no application accounts for are removed.
+type routeReconciler struct {
+	proxyManager proxy.ProxyManager
+	serviceRepo  dbrepo.ServiceRepository
+}
+
+// newRouteReconciler creates a route reconciler for the Caddy proxy. It returns nil, which
+// disables route reconciliation, when the Caddy admin API is not configured.
+func newRouteReconciler(serviceRepo dbrepo.ServiceRepository) *routeReconciler {
+	proxyManager, err := proxy.GetCaddyProxyManager()
+	if err != nil {
+		logger.Warningf("Route reconciliation disabled: %v\n", err)
+
+		return nil
+	}
+
+	return &routeReconciler{proxyManager: proxyManager, serviceRepo: serviceRepo}
+}
+
+// routeSnapshot holds the routes registered with Caddy at the start of a sync cycle.
+type routeSnapshot struct {
+	routes []proxy.Route
+}
+
+// listRoutes takes a snapshot of the routes registered with Caddy. It returns nil when the routes
+// cannot be listed, in which case they are not reconciled this cycle.
+func (r *routeReconciler) listRoutes(ctx context.Context) *routeSnapshot {
+	routes, err := r.proxyManager.ListRoutes(ctx)
+	if err != nil {
+		logger.WarningfCtx(ctx, "Skipping route reconciliation: %v", err)
+
+		return nil
+	}
+
+	return &routeSnapshot{routes: routes}
+}
+
+// routeReport is the outcome of reconciling the routes of an application.
+type routeReport struct {
+	// Restored is the status message listing the routes registered again, empty if none drifted
+	Restored string
+	// Failures has a message for every route that could not be restored
+	Failures []string
+}
+
+// reconcileApplication registers the routes of an application's service endpoints that are missing
+// from Caddy or differ from the recorded endpoints. Endpoints recorded without an upstream are
+// backfilled from the routes annotation of their pods first; those still without one can only be
+// reported when their route is missing.
+func (r *routeReconciler) reconcileApplication(ctx context.Context, rt runtime.Runtime, app *models.Application, snapshot *routeSnapshot) routeReport {
+	var report routeReport
+
+	authPolicy, err := catalogutils.ServiceAuthPolicy(app.ID)
+	if err != nil {
+		report.Failures = []string{fmt.Sprintf("Routes: %v", err)}
+
+		return report
+	}
+
+	registered := make(map[string]bool, len(snapshot.routes))
+	for _, route := range snapshot.routes {
+		registered[route.ID] = true
+	}
+
+	var (
+		desired []proxy.Route
+		owners  = map[string]string{}
+	)
+	for i := range app.Services {
+		service := &app.Services[i]
+		r.backfillUpstreams(ctx, rt, service)
+
+		for _, endpoint := range service.Endpoints {
+			route, err := proxy.RouteFromEndpoint(endpoint)
+			if err != nil {
+				// Without an upstream the route can only be checked for presence
+				for routeID := range proxy.RouteIDsFromEndpoints([]map[string]any{endpoint}) {
+					if !registered[routeID] {
+						report.Failures = append(report.Failures, fmt.Sprintf("Service %s: route %s is missing from the proxy and cannot be restored: %v", service.CatalogID, routeID, err))
+					}
+				}
+
+				continue
+			}
+
+			if route.Type == constants.ServiceRouteTypeAPI {
+				route.Auth = authPolicy
+			}
+			desired = append(desired, *route)
+			owners[route.ID] = service.CatalogID
+		}
+	}
+
+	var restored []string
+	drift := proxy.DiffRoutes(desired, snapshot.routes)
+	for _, route := range append(drift.Missing, drift.Changed...) {
+		if err := r.proxyManager.RegisterRoute(ctx, route); err != nil {
+			report.Failures = append(report.Failures, fmt.Sprintf("Service %s: route %s has drifted from its endpoint and could not be restored: %v", owners[route.ID], route.ID, err))
+
+			continue
+		}
+		logger.WarningfCtx(ctx, "Restored drifted route %s of application %s", route.ID, app.Name)
+		metrics.SyncDrift.WithLabelValues(metrics.DriftRoute).Inc()
+		restored = append(restored, fmt.Sprintf("%s of service %s", route.ID, owners[route.ID]))
+	}
+
+	if len(restored) > 0 {
+		report.Restored = fmt.Sprintf("%s at %s: %s", routesRestoredPrefix, time.Now().UTC().Format(time.RFC3339), strings.Join(restored, ", "))
+	}
+
+	return report
+}
+
+// backfillUpstreams records the upstream of endpoints registered before upstreams were recorded.
+// The upstreams are rebuilt from the routes annotation of the service's pods, which deployment
+// built the routes from, and saved so that later cycles can restore the routes.
+func (r *routeReconciler) backfillUpstreams(ctx context.Context, rt runtime.Runtime, service *models.Service) {
+	var upstreams map[string]string
+	changed := false
+
+	for _, endpoint := range service.Endpoints {
+		if upstream, _ := endpoint["upstream"].(string); upstream != "" {
+			continue
+		}
+
+		if upstreams == nil {
+			upstreams = podUpstreams(rt, service.ID.String())
+		}
+
+		for routeID := range proxy.RouteIDsFromEndpoints([]map[string]any{endpoint}) {
+			if upstream, ok := upstreams[routeID]; ok {
+				endpoint["upstream"] = upstream
+				changed = true
+			}
+		}
+	}
+
+	if !changed {
+		return
+	}
+
+	if err := r.serviceRepo.UpdateEndpoints(ctx, service.ID, service.Endpoints); err != nil {
+		logger.ErrorfCtx(ctx, "Failed to record route upstreams of service %s: %v", service.ID, err)
+
+		return
+	}
+	logger.InfofCtx(ctx, "Recorded route upstreams of service %s", service.ID)
+}
+
+// podUpstreams returns the upstream of every route declared in the routes annotation of the pods
+// of a service, by route ID. The route ID does not depend on the domain or routing mode.
+func podUpstreams(rt runtime.Runtime, serviceID string) map[string]string {
+	upstreams := map[string]string{}
+
+	pods, err := common.FetchFilteredPods(rt, serviceID)
+	if err != nil {
+		logger.Warningf("Failed to list pods of service %s: %v\n", serviceID, err)
+
+		return upstreams
+	}
+
+	for _, pod := range pods {
+		for _, ctr := range pod.Containers {
+			info, err := rt.InspectContainer(ctr.ID)
+			if err != nil {
+				continue
+			}
+
+			annotation := info.Annotations[consts.PodRoutesAnnotationKey]
+			if annotation == "" {
+				continue
+			}
+
+			routes, err := proxy.BuildRoutesFromAnnotation(annotation, "", pod.Name)
+			if err != nil {
+				logger.Warningf("Invalid routes annotation on pod %s: %v\n", pod.Name, err)
+
+				break
+			}
+			for _, route := range routes {
+				upstreams[route.ID] = route.Upstream
+			}
+
+			break
+		}
+	}
+
+	return upstreams
+}
+
+// removeOrphans unregisters the routes no application endpoint accounts for. Routes are registered
+// before the endpoints are recorded, so nothing is removed while an application is being deployed
+// or deleted. The snapshot must be taken before the applications are fetched, so that routes
+// registered in between are not mistaken for orphans.
+func (r *routeReconciler) removeOrphans(ctx context.Context, applications []models.Application, snapshot *routeSnapshot) {
+	known := map[string]bool{}
+	for _, app := range applications {
+		switch app.Status {
+		case models.ApplicationStatusDownloading, models.ApplicationStatusDeploying, models.ApplicationStatusDeleting:
+			logger.DebugfCtx(ctx, "Skipping orphaned route removal: application %s is %s", app.Name, app.Status)
+
+			return
+		}
+
+		for _, service := range app.Services {
+			for routeID := range proxy.RouteIDsFromEndpoints(service.Endpoints) {
+				known[routeID] = true
+			}
+		}
+	}
+
+	for _, route := range snapshot.routes {
+		if known[route.ID] || strings.HasPrefix(route.Upstream, catalogPodPrefix) {
+			continue
+		}
+
+		if err := r.proxyManager.UnregisterRoute(route.ID); err != nil && !errors.Is(err, proxy.ErrRouteNotFound) {
+			logger.ErrorfCtx(ctx, "Failed to remove orphaned route %s: %v", route.ID, err)
+
+			continue
+		}
+		logger.InfofCtx(ctx, "Removed orphaned route %s", route.ID)
+		metrics.SyncDrift.WithLabelValues(metrics.DriftRoute).Inc()
+	}
+}
+
+// Made with Bob
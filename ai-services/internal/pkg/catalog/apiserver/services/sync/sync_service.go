@@ -15,6 +15,7 @@ import (
 	catalogutils "github.com/project-ai-services/ai-services/internal/pkg/catalog/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/metrics"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
 	openshiftRuntime "github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
 	runtimeTypes "github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
@@ -51,9 +52,10 @@ type SyncService struct {
 	serviceDepsRepo dbrepo.ServiceDependencyRepository
 	syncInterval    time.Duration
 	stopChan        chan struct{}
-	syncMutex       sync.Mutex  // Prevents overlapping sync cycles
-	isSyncing       bool        // Tracks if a sync is currently running
-	runtimeSync     RuntimeSync // Runtime-specific sync backend
+	syncMutex       sync.Mutex       // Prevents overlapping sync cycles
+	isSyncing       bool             // Tracks if a sync is currently running
+	runtimeSync     RuntimeSync      // Runtime-specific sync backend
+	routeReconciler *routeReconciler // Caddy route reconciliation, nil when not applicable
 }
 
 // newRuntimeSync constructs the appropriate RuntimeSync for the configured runtime type.
@@ -90,6 +92,12 @@ func NewSyncService(
 		return nil, fmt.Errorf("failed to create runtime sync: %w", err)
 	}
 
+	// Service routes go through Caddy on Podman only; OpenShift exposes them with Routes
+	var reconciler *routeReconciler
+	if vars.RuntimeFactory.GetRuntimeType() == runtimeTypes.RuntimeTypePodman {
+		reconciler = newRouteReconciler(serviceRepo)
+	}
+
 	return &SyncService{
 		appRepo:         appRepo,
 		serviceRepo:     serviceRepo,
@@ -98,6 +106,7 @@ func NewSyncService(
 		syncInterval:    syncInterval,
 		stopChan:        make(chan struct{}),
 		runtimeSync:     runtimeSync,
+		routeReconciler: reconciler,
 	}, nil
 }
 
@@ -152,7 +161,9 @@ func (s *SyncService) performSync(ctx context.Context) {
 	s.syncMutex.Unlock()
 
 	// Ensure we mark sync as complete when done
+	start := time.Now()
 	defer func() {
+		metrics.SyncDuration.Observe(time.Since(start).Seconds())
 		s.syncMutex.Lock()
 		s.isSyncing = false
 		s.syncMutex.Unlock()
@@ -160,6 +171,12 @@ func (s *SyncService) performSync(ctx context.Context) {
 
 	logger.DebuglnCtx(ctx, "Starting DB-Pod sync cycle")
 
+	// Snapshot the proxy routes before fetching the applications, see removeOrphans
+	var routes *routeSnapshot
+	if s.routeReconciler != nil {
+		routes = s.routeReconciler.listRoutes(ctx)
+	}
+
 	// Get all applications with Running or Error status
 	filters := &dbrepo.ApplicationFilters{}
 	applications, err := s.appRepo.GetAll(ctx, filters)
@@ -172,20 +189,25 @@ func (s *SyncService) performSync(ctx context.Context) {
 	// Filter applications that need syncing (Running or Error state)
 	for _, app := range applications {
 		if app.Status == models.ApplicationStatusRunning || app.Status == models.ApplicationStatusError {
-			if err := s.syncApplication(ctx, &app); err != nil {
+			if err := s.syncApplication(ctx, &app, routes); err != nil {
 				logger.ErrorfCtx(ctx, "Failed to sync application %s: %v", app.Name, err)
 			}
 		}
 	}
 
+	if routes != nil {
+		s.routeReconciler.removeOrphans(ctx, applications, routes)
+	}
+
 	logger.DebuglnCtx(ctx, "Completed DB-Pod sync cycle")
 }
 
 // syncApplication syncs a single application using bottom-up approach:
 // 1. Sync all components
 // 2. Sync services
-// 3. Update application status based on collected errors.
-func (s *SyncService) syncApplication(ctx context.Context, app *models.Application) error {
+// 3. Reconcile the proxy routes of services, when a route snapshot is given
+// 4. Update application status based on collected errors.
+func (s *SyncService) syncApplication(ctx context.Context, app *models.Application, routes *routeSnapshot) error {
 	// Initialize runtime client in the application namespace.
 	rt, err := vars.RuntimeFactory.Create(catalogutils.AppNamespace(app.ID))
 	if err != nil {
@@ -197,7 +219,7 @@ func (s *SyncService) syncApplication(ctx context.Context, app *models.Applicati
 	// Fail early if the namespace does not exist
 	if rt.Type() == runtimeTypes.RuntimeTypeOpenShift {
 		if _, err := rt.GetNamespace(); errors.Is(err, openshiftRuntime.ErrNamespaceNotFound) {
-			return s.updateApplicationStatus(ctx, app, false, []string{err.Error()})
+			return s.updateApplicationStatus(ctx, app, false, []string{err.Error()}, "")
 		}
 	}
 
@@ -227,8 +249,19 @@ func (s *SyncService) syncApplication(ctx context.Context, app *models.Applicati
 		return nil
 	}
 
-	// Step 4: Update application status based on collected errors
-	if err := s.updateApplicationStatus(ctx, app, allHealthy, errorMessages); err != nil {
+	// Step 4: Restore drifted routes; those that cannot be restored leave the application in error
+	var notice string
+	if routes != nil {
+		report := s.routeReconciler.reconcileApplication(ctx, rt, app, routes)
+		if len(report.Failures) > 0 {
+			errorMessages = append(errorMessages, report.Failures...)
+			allHealthy = false
+		}
+		notice = report.Restored
+	}
+
+	// Step 5: Update application status based on collected errors
+	if err := s.updateApplicationStatus(ctx, app, allHealthy, errorMessages, notice); err != nil {
 		return fmt.Errorf("failed to update application status: %w", err)
 	}
 
@@ -273,6 +306,13 @@ func (s *SyncService) syncAllComponents(ctx context.Context, rt runtime.Runtime,
 				continue
 			}
 
+			// Stopped components are intentionally down; they are neither synced nor pending.
+			if component.Status == models.ComponentStatusStopped {
+				processedComponents[dep.DependencyID] = true
+
+				continue
+			}
+
 			if component.Status != models.ComponentStatusRunning && component.Status != models.ComponentStatusError {
 				logger.InfofCtx(ctx, "Skipping component %s sync: status is %s", dep.DependencyID, component.Status)
 				processedComponents[dep.DependencyID] = true
@@ -306,6 +346,11 @@ func (s *SyncService) syncAllServices(ctx context.Context, rt runtime.Runtime, a
 	pending := false
 
 	for _, service := range app.Services {
+		// Stopped services are intentionally down; they are neither synced nor pending.
+		if service.Status == models.ServiceStatusStopped {
+			continue
+		}
+
 		// Only sync services that are in a stable, observable state
 		if service.Status != models.ServiceStatusRunning && service.Status != models.ServiceStatusError {
 			logger.InfofCtx(ctx, "Skipping service %s sync: status is %s", service.ID, service.Status)
@@ -363,6 +408,7 @@ func (s *SyncService) handleServicePodFetchError(ctx context.Context, service mo
 			return "", fmt.Errorf("failed to update service status: %w", err)
 		}
 		logger.InfofCtx(ctx, "Updated service %s status to %s", service.ID, newStatus)
+		metrics.SyncDrift.WithLabelValues(metrics.DriftService).Inc()
 	}
 
 	return message, nil
@@ -406,6 +452,7 @@ func (s *SyncService) updateServiceStatusIfChanged(ctx context.Context, service
 			return fmt.Errorf("failed to update service status: %w", err)
 		}
 		logger.InfofCtx(ctx, "Updated service %s status to %s", service.ID, newStatus)
+		metrics.SyncDrift.WithLabelValues(metrics.DriftService).Inc()
 	}
 
 	return nil
@@ -470,6 +517,7 @@ func (s *SyncService) handleComponentPodFetchError(ctx context.Context, componen
 			return newStatus, "", fmt.Errorf("failed to update component status: %w", err)
 		}
 		logger.InfofCtx(ctx, "Updated component %s status to %s", componentID, newStatus)
+		metrics.SyncDrift.WithLabelValues(metrics.DriftComponent).Inc()
 	}
 
 	return newStatus, fmt.Sprintf("Component %s/%s: %s", component.Type, component.Provider, message), nil
@@ -498,6 +546,7 @@ func (s *SyncService) updateComponentStatusIfChanged(ctx context.Context, compon
 			return fmt.Errorf("failed to update component status: %w", err)
 		}
 		logger.InfofCtx(ctx, "Updated component %s status to %s", componentID, newStatus)
+		metrics.SyncDrift.WithLabelValues(metrics.DriftComponent).Inc()
 	}
 
 	return nil
@@ -519,7 +568,8 @@ func (s *SyncService) determinePodStatus(pod *PodStatus) (bool, string) {
 
 // updateApplicationStatus updates application status based on collected errors during sync
 // This is much simpler since we already collected all errors during component and service sync.
-func (s *SyncService) updateApplicationStatus(ctx context.Context, app *models.Application, allHealthy bool, errorMessages []string) error {
+// The notice becomes the message of a healthy application, e.g. that drifted routes were restored.
+func (s *SyncService) updateApplicationStatus(ctx context.Context, app *models.Application, allHealthy bool, errorMessages []string, notice string) error {
 	var newStatus models.ApplicationStatus
 	var message string
 
@@ -534,7 +584,12 @@ func (s *SyncService) updateApplicationStatus(ctx context.Context, app *models.A
 	} else {
 		// All services and components are healthy
 		newStatus = models.ApplicationStatusRunning
-		message = ""
+		message = notice
+
+		// Keep reporting restored routes until the application status changes
+		if message == "" && app.Status == newStatus && strings.HasPrefix(app.Message, routesRestoredPrefix) {
+			message = app.Message
+		}
 	}
 
 	// Update if status or message changed
@@ -543,6 +598,7 @@ func (s *SyncService) updateApplicationStatus(ctx context.Context, app *models.A
 			return fmt.Errorf("failed to update application status: %w", err)
 		}
 		logger.InfofCtx(ctx, "Updated application %s status to %s", app.Name, newStatus)
+		metrics.SyncDrift.WithLabelValues(metrics.DriftApplication).Inc()
 	}
 
 	return nil
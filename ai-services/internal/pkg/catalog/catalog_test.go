@@ -1,8 +1,17 @@
 package catalog
 
 import (
+	"context"
+	"encoding/json"
+	"slices"
 	"testing"
 	"time"
+
+	"github.com/project-ai-services/ai-services/assets"
+	"github.com/project-ai-services/ai-services/internal/pkg/modelsource"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	runtimeTypes "github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
 func TestListArchitectures(t *testing.T) {
@@ -218,4 +227,64 @@ func TestLoadComponent(t *testing.T) {
 	}
 }
 
+func TestResolveSchemaRefs(t *testing.T) {
+	const schemaPath = "components/llm/vllm-cpu/podman/values.schema.json"
+	data, err := assets.CatalogFS.ReadFile(schemaPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var schema map[string]any
+	if err := json.Unmarshal(data, &schema); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := resolveSchemaRefs(schemaPath, schema, nil); err != nil {
+		t.Fatalf("resolveSchemaRefs() error = %v", err)
+	}
+	modelSource, _ := schema["properties"].(map[string]any)["modelSource"].(map[string]any)
+	if _, ok := modelSource["$ref"]; ok {
+		t.Fatalf("modelSource still references its schema: %v", modelSource)
+	}
+	token, _ := modelSource["properties"].(map[string]any)["token"].(map[string]any)
+	if token["format"] != "password" {
+		t.Errorf("modelSource.token = %v, want the password property of the shared schema", token)
+	}
+
+	if err := resolveSchemaRefs(schemaPath, map[string]any{"$ref": "missing.schema.json"}, nil); err == nil {
+		t.Error("resolveSchemaRefs() of a missing schema succeeded, want error")
+	}
+}
+
+func TestGetCatalogModelSources(t *testing.T) {
+	if vars.RuntimeFactory == nil {
+		vars.RuntimeFactory = runtime.NewRuntimeFactory(runtimeTypes.RuntimeTypePodman)
+	}
+	provider, err := NewCatalogProvider()
+	if err != nil {
+		t.Fatalf("Failed to create catalog provider: %v", err)
+	}
+
+	ctx := context.Background()
+	sources, err := provider.GetCatalogModelSources(ctx, "rag", "watsonx")
+	if err != nil {
+		t.Fatalf("GetCatalogModelSources() error = %v", err)
+	}
+	models, err := provider.GetCatalogModels(ctx, "rag", "watsonx")
+	if err != nil {
+		t.Fatalf("GetCatalogModels() error = %v", err)
+	}
+
+	if len(sources) != len(models) {
+		t.Fatalf("GetCatalogModelSources() = %v, want a source for each of %v", sources, models)
+	}
+	for model, source := range sources {
+		if !slices.Contains(models, model) {
+			t.Errorf("GetCatalogModelSources() returned %s, not a model of the template", model)
+		}
+		if source.Type != modelsource.TypeHuggingFace {
+			t.Errorf("source of %s = %s, want the huggingface source of values.yaml", model, source)
+		}
+	}
+}
+
 // Made with Bob
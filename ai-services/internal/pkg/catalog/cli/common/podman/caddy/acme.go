@@ -0,0 +1,318 @@
+package caddy
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+
+	catalogUtils "github.com/project-ai-services/ai-services/internal/pkg/catalog/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
+)
+
+// Certificate modes reported by GetCertificateStatus.
+const (
+	CertificateModeACME     = "acme"
+	CertificateModeCustom   = "custom"
+	CertificateModeInternal = "internal"
+)
+
+const (
+	// acmeRootFilename is the staged name of the root certificate trusted for the ACME directory.
+	acmeRootFilename = "acme-ca-root.pem"
+	// wildcardPrefix is the prefix of the wildcard name covering the domain suffix.
+	wildcardPrefix = "*."
+	// httpChallengePort is the container port Caddy answers HTTP-01 challenges on.
+	httpChallengePort = "80"
+	// certificateExpiryWarning is how long before expiry a certificate is reported as expiring soon.
+	// ACME certificates are renewed a third of their lifetime ahead, so this only triggers when renewal fails.
+	certificateExpiryWarning = 14 * 24 * time.Hour
+	// adminAPITimeout is the timeout for Caddy admin API requests.
+	adminAPITimeout = 10 * time.Second
+	// tlsDialTimeout is the timeout for fetching the certificate served by Caddy.
+	tlsDialTimeout = 10 * time.Second
+)
+
+// CertificateStatus describes the certificate Caddy serves for a domain.
+type CertificateStatus struct {
+	Mode     string
+	Issuer   string
+	NotAfter time.Time
+}
+
+// ExpiresSoon reports whether the certificate expires within the warning window. Caddy's internal
+// certificates are short-lived and renewed continuously, so they never expire soon.
+func (s *CertificateStatus) ExpiresSoon(now time.Time) bool {
+	return s.Mode != CertificateModeInternal && s.NotAfter.Sub(now) < certificateExpiryWarning
+}
+
+// ConfigureACME sets up Caddy to obtain the certificates of the domain suffix from an ACME CA.
+// Certificates of the catalog and service domains are obtained and renewed by Caddy itself; with
+// the dns-01 challenge a single wildcard certificate covers all of them.
+func (c *Context) ConfigureACME(baseDir string, opts *catalogUtils.ACMEOptions) error {
+	if opts == nil {
+		return nil
+	}
+	logger.Debugln("configuring acme certificate issuance in caddy...")
+
+	if opts.Challenge == catalogUtils.ACMEChallengeHTTP01 {
+		if err := c.checkHTTPChallengePort(); err != nil {
+			return err
+		}
+	}
+
+	var rootFile string
+	if opts.CARootPath != "" {
+		if err := stageACMERoot(baseDir, opts.CARootPath); err != nil {
+			return fmt.Errorf("failed to stage ACME CA root for Caddy: %w", err)
+		}
+		rootFile = filepath.ToSlash(filepath.Join(containerDataDir, certsDirName, acmeRootFilename))
+	}
+
+	client, err := c.newAdminClient()
+	if err != nil {
+		return err
+	}
+
+	tlsApp, err := getTLSApp(client)
+	if err != nil {
+		return err
+	}
+
+	applyACMEConfig(tlsApp, c.domainSuffix, opts, rootFile)
+
+	resp, err := client.R().SetBody(tlsApp).Patch("/config/apps/tls")
+	if err != nil {
+		return fmt.Errorf("failed to configure ACME issuance: %w", err)
+	}
+	if resp.IsError() {
+		return fmt.Errorf("caddy returned error (status %d): %s", resp.StatusCode(), resp.String())
+	}
+
+	if opts.Challenge == catalogUtils.ACMEChallengeDNS01 {
+		// Serve every domain from the wildcard certificate instead of obtaining one per domain
+		resp, err = client.R().
+			SetBody(map[string]any{"prefer_wildcard": true}).
+			Post(fmt.Sprintf("/config/apps/http/servers/%s/automatic_https", constants.CaddyServerName))
+		if err != nil {
+			return fmt.Errorf("failed to enable wildcard certificate: %w", err)
+		}
+		if resp.IsError() {
+			return fmt.Errorf("caddy returned error (status %d): %s", resp.StatusCode(), resp.String())
+		}
+	}
+
+	logger.Infof("ACME certificate issuance configured for %s%s (%s challenge)\n", wildcardPrefix, c.domainSuffix, opts.Challenge)
+
+	return nil
+}
+
+// GetCertificateStatus fetches the certificate Caddy serves for the domain on the HTTPS port.
+func (c *Context) GetCertificateStatus(domain, httpsPort string) (*CertificateStatus, error) {
+	client, err := c.newAdminClient()
+	if err != nil {
+		return nil, err
+	}
+
+	tlsApp, err := getTLSApp(client)
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := &net.Dialer{Timeout: tlsDialTimeout}
+	// The served certificate is only inspected, so it does not need to be trusted
+	conn, err := tls.DialWithDialer(dialer, "tcp", net.JoinHostPort("localhost", httpsPort), &tls.Config{
+		ServerName:         domain,
+		InsecureSkipVerify: true, //nolint:gosec
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch certificate for %s: %w", domain, err)
+	}
+	defer conn.Close()
+
+	peerCertificates := conn.ConnectionState().PeerCertificates
+	if len(peerCertificates) == 0 {
+		return nil, fmt.Errorf("no certificate served for %s", domain)
+	}
+	leaf := peerCertificates[0]
+
+	issuer := leaf.Issuer.CommonName
+	if issuer == "" && len(leaf.Issuer.Organization) > 0 {
+		issuer = leaf.Issuer.Organization[0]
+	}
+
+	return &CertificateStatus{
+		Mode:     certificateMode(tlsApp),
+		Issuer:   issuer,
+		NotAfter: leaf.NotAfter,
+	}, nil
+}
+
+// checkHTTPChallengePort verifies that port 80 of the Caddy pod is published, which the CA must
+// reach to validate HTTP-01 challenges.
+func (c *Context) checkHTTPChallengePort() error {
+	rt, err := podman.NewPodmanClient()
+	if err != nil {
+		return fmt.Errorf("failed to initialize podman client: %w", err)
+	}
+
+	pod, err := rt.InspectPod(c.podName)
+	if err != nil {
+		return fmt.Errorf("failed to inspect Caddy pod: %w", err)
+	}
+
+	if hostPorts, ok := pod.Ports[httpChallengePort+"/tcp"]; ok && len(hostPorts) > 0 {
+		return nil
+	}
+
+	return fmt.Errorf("the %s ACME challenge requires port %s of the Caddy pod to be published. Please uninstall the catalog deployment and re-run configure with ACME enabled", catalogUtils.ACMEChallengeHTTP01, httpChallengePort)
+}
+
+// getTLSApp retrieves the configuration of Caddy's TLS app.
+func getTLSApp(client *resty.Client) (map[string]any, error) {
+	tlsApp := map[string]any{}
+	resp, err := client.R().SetResult(&tlsApp).Get("/config/apps/tls")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Caddy TLS config: %w", err)
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("caddy returned error (status %d): %s", resp.StatusCode(), resp.String())
+	}
+
+	// An unconfigured TLS app is returned as null
+	if tlsApp == nil {
+		tlsApp = map[string]any{}
+	}
+
+	return tlsApp, nil
+}
+
+// applyACMEConfig updates the configuration of Caddy's TLS app so that the domains under the domain
+// suffix are issued by the ACME CA. The ACME policy goes ahead of the existing policies, which keep
+// covering any other names, and replaces a policy set up for the suffix before.
+func applyACMEConfig(tlsApp map[string]any, domainSuffix string, opts *catalogUtils.ACMEOptions, rootFile string) {
+	subject := wildcardPrefix + domainSuffix
+
+	issuer := map[string]any{"module": "acme"}
+	if opts.Email != "" {
+		issuer["email"] = opts.Email
+	}
+	if opts.CAURL != "" {
+		issuer["ca"] = opts.CAURL
+	}
+	if rootFile != "" {
+		issuer["trusted_roots_pem_files"] = []any{rootFile}
+	}
+
+	switch opts.Challenge {
+	case catalogUtils.ACMEChallengeDNS01:
+		provider := map[string]any{"name": opts.DNSProvider}
+		for key, value := range opts.DNSConfig {
+			provider[key] = value
+		}
+		issuer["challenges"] = map[string]any{
+			"dns":      map[string]any{"provider": provider},
+			"http":     map[string]any{"disabled": true},
+			"tls-alpn": map[string]any{"disabled": true},
+		}
+	default:
+		// The HTTPS port may be remapped on the host, so TLS-ALPN challenges cannot be relied on
+		issuer["challenges"] = map[string]any{
+			"tls-alpn": map[string]any{"disabled": true},
+		}
+	}
+
+	automation, _ := tlsApp["automation"].(map[string]any)
+	if automation == nil {
+		automation = map[string]any{}
+	}
+	existing, _ := automation["policies"].([]any)
+
+	// The domain suffix itself is the hostname of every route in path routing mode
+	policies := []any{map[string]any{
+		"subjects": []any{subject, domainSuffix},
+		"issuers":  []any{issuer},
+	}}
+	for _, policy := range existing {
+		if !hasSubject(policy, subject) {
+			policies = append(policies, policy)
+		}
+	}
+	automation["policies"] = policies
+	tlsApp["automation"] = automation
+
+	if opts.Challenge != catalogUtils.ACMEChallengeDNS01 {
+		return
+	}
+
+	// Policy subjects only select the issuer; the wildcard certificate itself must be requested
+	certificates, _ := tlsApp["certificates"].(map[string]any)
+	if certificates == nil {
+		certificates = map[string]any{}
+	}
+	automate, _ := certificates["automate"].([]any)
+	if !slices.Contains(automate, any(subject)) {
+		automate = append(automate, subject)
+	}
+	certificates["automate"] = automate
+	tlsApp["certificates"] = certificates
+}
+
+// certificateMode determines how the certificates served by Caddy are obtained from its TLS app config.
+func certificateMode(tlsApp map[string]any) string {
+	if certificates, ok := tlsApp["certificates"].(map[string]any); ok {
+		if loadFiles, _ := certificates["load_files"].([]any); len(loadFiles) > 0 {
+			return CertificateModeCustom
+		}
+	}
+
+	automation, _ := tlsApp["automation"].(map[string]any)
+	policies, _ := automation["policies"].([]any)
+	for _, policy := range policies {
+		policyMap, _ := policy.(map[string]any)
+		issuers, _ := policyMap["issuers"].([]any)
+		for _, issuer := range issuers {
+			if issuerMap, _ := issuer.(map[string]any); issuerMap["module"] == "acme" {
+				return CertificateModeACME
+			}
+		}
+	}
+
+	return CertificateModeInternal
+}
+
+// hasSubject reports whether an automation policy applies to the subject.
+func hasSubject(policy any, subject string) bool {
+	policyMap, _ := policy.(map[string]any)
+	subjects, _ := policyMap["subjects"].([]any)
+
+	return slices.Contains(subjects, any(subject))
+}
+
+// stageACMERoot copies the root certificate of the ACME CA into the Caddy data directory.
+func stageACMERoot(baseDir, rootPath string) error {
+	certDir := filepath.Join(baseDir, "common", "caddy", certsDirName)
+	if err := os.MkdirAll(certDir, dirPerm); err != nil {
+		return fmt.Errorf("failed to create Caddy cert directory: %w", err)
+	}
+
+	rootBytes, err := os.ReadFile(rootPath)
+	if err != nil {
+		return fmt.Errorf("failed to read ACME CA root: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(certDir, acmeRootFilename), rootBytes, filePerm); err != nil {
+		return fmt.Errorf("failed to write staged ACME CA root: %w", err)
+	}
+
+	return nil
+}
+
+// Made with Bob
@@ -0,0 +1,145 @@
+package caddy
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	catalogUtils "github.com/project-ai-services/ai-services/internal/pkg/catalog/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/proxy"
+)
+
+// internalTLSApp is the TLS app config Caddy adapts from the catalog's Caddyfile.
+const internalTLSApp = `{"automation":{"policies":[{"issuers":[{"module":"internal"}]}]}}`
+
+func TestApplyACMEConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		opts         catalogUtils.ACMEOptions
+		wantAutomate bool
+	}{
+		{
+			name: "http-01",
+			opts: catalogUtils.ACMEOptions{Email: "admin@example.com", Challenge: catalogUtils.ACMEChallengeHTTP01},
+		},
+		{
+			name: "dns-01",
+			opts: catalogUtils.ACMEOptions{
+				Email:       "admin@example.com",
+				CAURL:       "https://pebble:14000/dir",
+				Challenge:   catalogUtils.ACMEChallengeDNS01,
+				DNSProvider: "cloudflare",
+				DNSConfig:   map[string]string{"api_token": "secret"},
+			},
+			wantAutomate: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tlsApp := map[string]any{}
+			if err := json.Unmarshal([]byte(internalTLSApp), &tlsApp); err != nil {
+				t.Fatal(err)
+			}
+
+			// Applying twice must not stack policies
+			applyACMEConfig(tlsApp, "example.com", &tt.opts, "")
+			applyACMEConfig(tlsApp, "example.com", &tt.opts, "")
+
+			policies := tlsApp["automation"].(map[string]any)["policies"].([]any)
+			if len(policies) != 2 {
+				t.Fatalf("expected the ACME policy ahead of the internal policy, got %v", policies)
+			}
+			if !hasSubject(policies[0], "*.example.com") || hasSubject(policies[1], "*.example.com") {
+				t.Errorf("unexpected policy subjects: %v", policies)
+			}
+
+			issuer := policies[0].(map[string]any)["issuers"].([]any)[0].(map[string]any)
+			if issuer["module"] != "acme" || issuer["email"] != tt.opts.Email {
+				t.Errorf("unexpected issuer: %v", issuer)
+			}
+			challenges := issuer["challenges"].(map[string]any)
+			if _, ok := challenges["dns"]; ok != tt.wantAutomate {
+				t.Errorf("unexpected challenges: %v", challenges)
+			}
+
+			certificates, _ := tlsApp["certificates"].(map[string]any)
+			if automate, _ := certificates["automate"].([]any); (len(automate) == 1) != tt.wantAutomate {
+				t.Errorf("unexpected automated certificates: %v", automate)
+			}
+
+			if mode := certificateMode(tlsApp); mode != CertificateModeACME {
+				t.Errorf("expected mode %s, got %s", CertificateModeACME, mode)
+			}
+		})
+	}
+}
+
+func TestConfigureACME(t *testing.T) {
+	var (
+		patched        map[string]any
+		preferWildcard bool
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/config/apps/tls":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(internalTLSApp))
+		case r.Method == http.MethodPatch && r.URL.Path == "/config/apps/tls":
+			if err := json.Unmarshal(body, &patched); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+			}
+		case r.Method == http.MethodPost && r.URL.Path == "/config/apps/http/servers/"+constants.CaddyServerName+"/automatic_https":
+			preferWildcard = string(body) == `{"prefer_wildcard":true}`
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := NewContext("catalog--caddy", "example.com", "")
+	c.hostAdmin = &proxy.AdminEndpoint{URL: server.URL}
+
+	err := c.ConfigureACME(t.TempDir(), &catalogUtils.ACMEOptions{
+		Email:       "admin@example.com",
+		Challenge:   catalogUtils.ACMEChallengeDNS01,
+		DNSProvider: "cloudflare",
+	})
+	if err != nil {
+		t.Fatalf("ConfigureACME failed: %v", err)
+	}
+
+	if certificateMode(patched) != CertificateModeACME {
+		t.Errorf("expected an ACME policy to be patched, got %v", patched)
+	}
+	if !preferWildcard {
+		t.Error("expected the wildcard certificate to be preferred")
+	}
+}
+
+func TestCertificateStatusExpiresSoon(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name   string
+		status CertificateStatus
+		want   bool
+	}{
+		{"acme renewed", CertificateStatus{Mode: CertificateModeACME, NotAfter: now.Add(60 * 24 * time.Hour)}, false},
+		{"acme renewal failing", CertificateStatus{Mode: CertificateModeACME, NotAfter: now.Add(3 * 24 * time.Hour)}, true},
+		{"custom expired", CertificateStatus{Mode: CertificateModeCustom, NotAfter: now.Add(-time.Hour)}, true},
+		{"internal short-lived", CertificateStatus{Mode: CertificateModeInternal, NotAfter: now.Add(12 * time.Hour)}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.status.ExpiresSoon(now); got != tt.want {
+				t.Errorf("ExpiresSoon() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
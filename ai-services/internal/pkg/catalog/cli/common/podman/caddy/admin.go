@@ -0,0 +1,229 @@
+package caddy
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/proxy"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
+)
+
+const (
+	// adminDirName is the directory below <BaseDir>/common holding the admin socket and the client
+	// certificate of the remote admin API.
+	adminDirName = "caddy-admin"
+	// adminSocketFilename is the name of the admin socket in the admin directory.
+	adminSocketFilename = "admin.sock"
+	// adminSocketScheme prefixes the path of the admin socket in admin URLs.
+	adminSocketScheme = "unix://"
+	// containerAdminListen is the admin address of Caddy: the admin socket in the admin directory,
+	// mounted at /run/caddy, accessible to its owner only.
+	containerAdminListen = "unix//run/caddy/" + adminSocketFilename + "|0600"
+	// remoteAdminPort is the container port of the remote admin API in mtls mode.
+	remoteAdminPort = "2021"
+	// legacyAdminPort is the container port of the unauthenticated admin API of older deployments.
+	legacyAdminPort = "2019"
+	// localCARootPath is the root certificate of Caddy's internal CA below its data directory,
+	// which issues the identity certificate of the remote admin API.
+	localCARootPath = "pki/authorities/local/root.crt"
+	// autosavePath is the configuration Caddy resumes from below <BaseDir>/common.
+	autosavePath = "caddy-config/caddy/autosave.json"
+
+	adminClientCertFilename = "client.crt"
+	adminClientKeyFilename  = "client.key"
+	// adminClientCertValidity is the lifetime of the client certificate of the remote admin API.
+	// Caddy pins its public key, so it is only replaced when it expires.
+	adminClientCertValidity = 10 * 365 * 24 * time.Hour
+	// adminClientCertRenewal is how long before expiry the client certificate is replaced.
+	adminClientCertRenewal = 30 * 24 * time.Hour
+	secretFilePerm         = 0o600
+)
+
+// adminDir returns the host directory holding the admin socket.
+func (c *Context) adminDir() string {
+	return filepath.Join(c.baseDir, "common", adminDirName)
+}
+
+// adminSocketPath returns the host path of the admin socket.
+func (c *Context) adminSocketPath() string {
+	return filepath.Join(c.adminDir(), adminSocketFilename)
+}
+
+// UsesLegacyAdmin reports whether the deployed Caddy pod predates the admin socket and serves its
+// admin API on a published port without authentication.
+func (c *Context) UsesLegacyAdmin(rt *podman.PodmanClient) (bool, error) {
+	exists, err := rt.PodExists(c.podName)
+	if err != nil {
+		return false, fmt.Errorf("failed to check Caddy pod: %w", err)
+	}
+	if !exists {
+		return false, nil
+	}
+
+	port, err := getLegacyAdminPort(rt, c.podName)
+	if err != nil {
+		return false, err
+	}
+
+	return port != "", nil
+}
+
+// ConfigureAdmin applies the admin mode to the running Caddy. In mtls mode it enables the remote
+// admin API, with an identity certificate issued by Caddy's internal CA for the pod name and access
+// restricted to the client certificate of the catalog backend; in unix mode it removes any remote
+// admin API an earlier configuration enabled. Caddy persists the change across restarts.
+func (c *Context) ConfigureAdmin() error {
+	logger.Debugln("configuring caddy admin api...")
+
+	admin := map[string]any{"listen": containerAdminListen}
+	if c.GetAdminMode() == proxy.AdminModeMTLS {
+		clientCert, err := ensureAdminClientCertificate(c.adminDir())
+		if err != nil {
+			return fmt.Errorf("failed to prepare Caddy admin client certificate: %w", err)
+		}
+		admin = remoteAdminConfig(c.podName, clientCert)
+	}
+
+	client, err := c.newAdminClient()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.R().SetBody(admin).Patch("/config/admin")
+	if err != nil {
+		return fmt.Errorf("failed to configure Caddy admin API: %w", err)
+	}
+	if resp.IsError() {
+		return fmt.Errorf("caddy returned error (status %d): %s", resp.StatusCode(), resp.String())
+	}
+
+	logger.Debugf("Caddy admin API configured in %s mode\n", c.GetAdminMode())
+
+	return nil
+}
+
+// remoteAdminConfig returns the admin configuration serving the remote admin API next to the admin
+// socket. Caddy pins client certificates by their public key.
+func remoteAdminConfig(identifier string, clientCert *x509.Certificate) map[string]any {
+	return map[string]any{
+		"listen": containerAdminListen,
+		"identity": map[string]any{
+			"identifiers": []string{identifier},
+			"issuers":     []map[string]any{{"module": "internal"}},
+		},
+		"remote": map[string]any{
+			"listen": ":" + remoteAdminPort,
+			"access_control": []map[string]any{
+				{"public_keys": []string{base64.StdEncoding.EncodeToString(clientCert.Raw)}},
+			},
+		},
+	}
+}
+
+// ensureAdminClientCertificate returns the client certificate of the remote admin API from dir,
+// generating a self-signed one if there is none or it is about to expire.
+func ensureAdminClientCertificate(dir string) (*x509.Certificate, error) {
+	certPath := filepath.Join(dir, adminClientCertFilename)
+	keyPath := filepath.Join(dir, adminClientKeyFilename)
+
+	if pair, err := tls.LoadX509KeyPair(certPath, keyPath); err == nil {
+		if cert, err := x509.ParseCertificate(pair.Certificate[0]); err == nil &&
+			time.Until(cert.NotAfter) > adminClientCertRenewal {
+			return cert, nil
+		}
+	}
+
+	logger.Debugln("generating caddy admin client certificate...")
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate key: %w", err)
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate serial number: %w", err)
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: "ai-services catalog"},
+		NotBefore:    now.Add(-time.Minute),
+		NotAfter:     now.Add(adminClientCertValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create certificate: %w", err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal key: %w", err)
+	}
+
+	if err := os.MkdirAll(dir, dirPerm); err != nil {
+		return nil, fmt.Errorf("failed to create Caddy admin directory: %w", err)
+	}
+	if err := os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}), secretFilePerm); err != nil {
+		return nil, fmt.Errorf("failed to write client key: %w", err)
+	}
+	if err := os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}), filePerm); err != nil {
+		return nil, fmt.Errorf("failed to write client certificate: %w", err)
+	}
+
+	return x509.ParseCertificate(certDER)
+}
+
+// MigrateAdminConfig moves the admin API of the configuration Caddy resumes from onto the admin
+// socket. Caddy must be stopped, since it saves its running configuration on every change; without
+// a saved configuration Caddy starts from the Caddyfile, which already uses the admin socket.
+func MigrateAdminConfig(baseDir string) error {
+	path := filepath.Join(baseDir, "common", autosavePath)
+	info, err := os.Stat(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to read saved Caddy configuration: %w", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read saved Caddy configuration: %w", err)
+	}
+
+	var config map[string]any
+	if err := json.Unmarshal(data, &config); err != nil {
+		return fmt.Errorf("failed to parse saved Caddy configuration: %w", err)
+	}
+	config["admin"] = map[string]any{"listen": containerAdminListen}
+
+	data, err = json.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("failed to encode Caddy configuration: %w", err)
+	}
+	if err := os.WriteFile(path, data, info.Mode().Perm()); err != nil {
+		return fmt.Errorf("failed to write saved Caddy configuration: %w", err)
+	}
+
+	return nil
+}
+
+// Made with Bob
@@ -0,0 +1,132 @@
+package caddy
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/proxy"
+)
+
+func TestConfigureAdmin(t *testing.T) {
+	tests := []struct {
+		name       string
+		adminMode  string
+		wantRemote bool
+	}{
+		{name: "unix mode removes the remote admin API", adminMode: proxy.AdminModeUnix},
+		{name: "mtls mode pins the client certificate", adminMode: proxy.AdminModeMTLS, wantRemote: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var patched map[string]any
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch || r.URL.Path != "/config/admin" {
+					w.WriteHeader(http.StatusNotFound)
+
+					return
+				}
+				if err := json.NewDecoder(r.Body).Decode(&patched); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+				}
+			}))
+			defer server.Close()
+
+			baseDir := t.TempDir()
+			c := NewContext("catalog--caddy", "example.com", "")
+			c.SetAdminAccess(baseDir, tt.adminMode)
+			c.hostAdmin = &proxy.AdminEndpoint{URL: server.URL}
+
+			if err := c.ConfigureAdmin(); err != nil {
+				t.Fatalf("ConfigureAdmin failed: %v", err)
+			}
+
+			if patched["listen"] != containerAdminListen {
+				t.Errorf("expected admin listen %s, got %v", containerAdminListen, patched["listen"])
+			}
+			remote, hasRemote := patched["remote"].(map[string]any)
+			if hasRemote != tt.wantRemote {
+				t.Fatalf("expected remote admin API %v, got %v", tt.wantRemote, patched["remote"])
+			}
+			if !tt.wantRemote {
+				return
+			}
+
+			endpoint := c.GetContainerAdminEndpoint()
+			if endpoint.URL != "https://catalog--caddy:"+remoteAdminPort {
+				t.Errorf("unexpected container admin URL %s", endpoint.URL)
+			}
+			certPEM, err := os.ReadFile(endpoint.ClientCertFile)
+			if err != nil {
+				t.Fatalf("client certificate not written: %v", err)
+			}
+			cert, err := ensureAdminClientCertificate(filepath.Dir(endpoint.ClientCertFile))
+			if err != nil {
+				t.Fatalf("failed to load client certificate: %v", err)
+			}
+			if !containsCertificate(certPEM, cert) {
+				t.Error("expected the existing client certificate to be reused")
+			}
+
+			accessControl := remote["access_control"].([]any)[0].(map[string]any)
+			publicKeys := accessControl["public_keys"].([]any)
+			if len(publicKeys) != 1 || publicKeys[0] != base64.StdEncoding.EncodeToString(cert.Raw) {
+				t.Errorf("expected the client certificate to be pinned, got %v", publicKeys)
+			}
+		})
+	}
+}
+
+// containsCertificate reports whether the PEM data holds the certificate.
+func containsCertificate(certPEM []byte, cert *x509.Certificate) bool {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(certPEM) {
+		return false
+	}
+	want := x509.NewCertPool()
+	want.AddCert(cert)
+
+	return pool.Equal(want)
+}
+
+func TestMigrateAdminConfig(t *testing.T) {
+	baseDir := t.TempDir()
+	path := filepath.Join(baseDir, "common", autosavePath)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	saved := `{"admin":{"listen":"0.0.0.0:2019"},"apps":{"http":{"servers":{"srv0":{"listen":[":443"]}}}}}`
+	if err := os.WriteFile(path, []byte(saved), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := MigrateAdminConfig(baseDir); err != nil {
+		t.Fatalf("MigrateAdminConfig failed: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var config map[string]any
+	if err := json.Unmarshal(data, &config); err != nil {
+		t.Fatalf("migrated configuration is not valid JSON: %v", err)
+	}
+	if listen := config["admin"].(map[string]any)["listen"]; listen != containerAdminListen {
+		t.Errorf("expected admin listen %s, got %v", containerAdminListen, listen)
+	}
+	if _, ok := config["apps"]; !ok {
+		t.Error("expected the apps of the saved configuration to be kept")
+	}
+
+	// Caddy starts from the Caddyfile when it never saved a configuration
+	if err := MigrateAdminConfig(t.TempDir()); err != nil {
+		t.Errorf("expected no error without a saved configuration, got %v", err)
+	}
+}
@@ -36,10 +36,10 @@ func (c *Context) LoadSSLCertificates(baseDir, sslCertPath, sslKeyPath string) e
 	stagedCertPath := filepath.Join(baseDir, "common", "caddy", certsDirName, certFilename)
 	stagedKeyPath := filepath.Join(baseDir, "common", "caddy", certsDirName, keyFilename)
 
-	// Get admin URL
-	adminURL, err := c.GetHostAdminURL()
+	// Get admin client
+	adminClient, err := c.newAdminClient()
 	if err != nil {
-		return fmt.Errorf("failed to get Caddy admin URL: %w", err)
+		return err
 	}
 
 	// Load certificates via Admin API with timestamped paths
@@ -48,7 +48,7 @@ func (c *Context) LoadSSLCertificates(baseDir, sslCertPath, sslKeyPath string) e
 		stagedKeyPath,
 		filepath.Join(containerDataDir, certsDirName, certFilename),
 		filepath.Join(containerDataDir, certsDirName, keyFilename),
-		adminURL,
+		adminClient,
 	); err != nil {
 		return fmt.Errorf("failed to load certificates via Admin API: %w", err)
 	}
@@ -4,7 +4,11 @@ package caddy
 
 import (
 	"fmt"
+	"path/filepath"
 
+	"github.com/go-resty/resty/v2"
+
+	catalogUtils "github.com/project-ai-services/ai-services/internal/pkg/catalog/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/proxy"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
@@ -17,51 +21,106 @@ type Context struct {
 	podName string
 	// Network configuration
 	domainSuffix string
+	routingMode  string
 
 	// Admin API access
-	containerAdminURL string // http://<podName>:2019 (for container use in templates)
-	hostAdminURL      string // http://localhost:<port> (for host VM use in post-deployment)
+	baseDir   string
+	adminMode string
+	hostAdmin *proxy.AdminEndpoint // admin socket on the host (for host VM use in post-deployment)
 }
 
 // NewContext creates a new Caddy context with the pod name provided by configure.go.
 // The pod name should be obtained from deployContext, not looked up here.
-func NewContext(podName string, domainSuffix string) *Context {
+// The domain suffix and routing mode are only needed to register routes.
+func NewContext(podName, domainSuffix, routingMode string) *Context {
 	return &Context{
 		podName:      podName,
 		domainSuffix: domainSuffix,
+		routingMode:  routingMode,
 	}
 }
 
-// GetHostAdminURL retrieves the Caddy admin URL for host VM use, caching the result.
-func (c *Context) GetHostAdminURL() (string, error) {
-	if c.hostAdminURL != "" {
-		return c.hostAdminURL, nil
+// SetAdminAccess sets the base directory holding the admin socket and the admin mode being
+// configured. Contexts that only query a deployed Caddy pod resolve the base directory from the
+// catalog pod instead.
+func (c *Context) SetAdminAccess(baseDir, adminMode string) {
+	c.baseDir = baseDir
+	c.adminMode = adminMode
+}
+
+// GetAdminMode returns the admin mode, proxy.AdminModeUnix if none was set.
+func (c *Context) GetAdminMode() string {
+	if c.adminMode == "" {
+		return proxy.AdminModeUnix
+	}
+
+	return c.adminMode
+}
+
+// GetHostAdminEndpoint retrieves the Caddy admin endpoint for host VM use, caching the result.
+// The host always uses the admin socket, except for deployments that predate it, whose Caddy pod
+// still publishes the admin API on a host port.
+func (c *Context) GetHostAdminEndpoint() (proxy.AdminEndpoint, error) {
+	if c.hostAdmin != nil {
+		return *c.hostAdmin, nil
 	}
 
 	rt, err := podman.NewPodmanClient()
 	if err != nil {
-		return "", fmt.Errorf("failed to initialize podman client: %w", err)
+		return proxy.AdminEndpoint{}, fmt.Errorf("failed to initialize podman client: %w", err)
 	}
 
-	adminPort, err := getCaddyAdminPort(rt, c.podName)
+	legacyPort, err := getLegacyAdminPort(rt, c.podName)
 	if err != nil {
-		return "", fmt.Errorf("failed to get Caddy admin port: %w", err)
+		return proxy.AdminEndpoint{}, fmt.Errorf("failed to get Caddy admin port: %w", err)
 	}
+	if legacyPort != "" {
+		c.hostAdmin = &proxy.AdminEndpoint{URL: fmt.Sprintf("http://localhost:%s", legacyPort)}
+
+		return *c.hostAdmin, nil
+	}
+
+	if c.baseDir == "" {
+		opts, _, err := catalogUtils.GetCatalogPodConfig(rt)
+		if err != nil {
+			return proxy.AdminEndpoint{}, fmt.Errorf("failed to get base directory of the catalog: %w", err)
+		}
+		c.baseDir = opts.BaseDir
+	}
+
+	c.hostAdmin = &proxy.AdminEndpoint{URL: adminSocketScheme + c.adminSocketPath()}
+
+	return *c.hostAdmin, nil
+}
 
-	c.hostAdminURL = fmt.Sprintf("http://localhost:%s", adminPort)
+// GetContainerAdminEndpoint returns the Caddy admin endpoint for container use: the admin socket,
+// which the catalog backend sees at its host path, or the remote admin API in mtls mode.
+func (c *Context) GetContainerAdminEndpoint() proxy.AdminEndpoint {
+	if c.GetAdminMode() != proxy.AdminModeMTLS {
+		return proxy.AdminEndpoint{URL: adminSocketScheme + c.adminSocketPath()}
+	}
 
-	return c.hostAdminURL, nil
+	return proxy.AdminEndpoint{
+		URL:            fmt.Sprintf("https://%s:%s", c.podName, remoteAdminPort),
+		ClientCertFile: filepath.Join(c.adminDir(), adminClientCertFilename),
+		ClientKeyFile:  filepath.Join(c.adminDir(), adminClientKeyFilename),
+		CAFile:         filepath.Join(c.baseDir, "common", "caddy", localCARootPath),
+	}
 }
 
-// GetContainerAdminURL returns the Caddy admin URL for container use.
-func (c *Context) GetContainerAdminURL() string {
-	if c.containerAdminURL != "" {
-		return c.containerAdminURL
+// newAdminClient creates a client for the admin API from the host.
+func (c *Context) newAdminClient() (*resty.Client, error) {
+	endpoint, err := c.GetHostAdminEndpoint()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Caddy admin endpoint: %w", err)
 	}
 
-	c.containerAdminURL = fmt.Sprintf("http://%s:2019", c.podName)
+	client, err := proxy.NewAdminClient(endpoint)
+	if err != nil {
+		return nil, err
+	}
 
-	return c.containerAdminURL
+	return client.SetTimeout(adminAPITimeout), nil
 }
 
 // GetHTTPSPort retrieves the Caddy HTTPS port.
@@ -71,12 +130,17 @@ func (c *Context) GetHTTPSPort(rt *podman.PodmanClient) (string, error) {
 
 // CreateProxyManager creates a Caddy proxy manager.
 func (c *Context) CreateProxyManager() (proxy.ProxyManager, error) {
-	adminURL, err := c.GetHostAdminURL()
+	endpoint, err := c.GetHostAdminEndpoint()
 	if err != nil {
 		return nil, err
 	}
 
-	return proxy.NewCaddyManager(adminURL, constants.CaddyServerName), nil
+	return proxy.NewCaddyManager(endpoint, constants.CaddyServerName)
+}
+
+// GetPodName returns the name of the Caddy pod.
+func (c *Context) GetPodName() string {
+	return c.podName
 }
 
 // GetDomainSuffix returns the domain suffix.
@@ -84,4 +148,13 @@ func (c *Context) GetDomainSuffix() string {
 	return c.domainSuffix
 }
 
+// GetRoutingMode returns the routing mode, proxy.RoutingModeHost if none was set.
+func (c *Context) GetRoutingMode() string {
+	if c.routingMode == "" {
+		return proxy.RoutingModeHost
+	}
+
+	return c.routingMode
+}
+
 // Made with Bob
@@ -42,8 +42,9 @@ func ComputeDomainConfig(sslCertPath, sslKeyPath, domainName string) (string, er
 	return domainSuffix, nil
 }
 
-// getCaddyAdminPort retrieves the host port mapped to Caddy's admin API (container port 2019).
-func getCaddyAdminPort(runtime *podman.PodmanClient, podName string) (string, error) {
+// getLegacyAdminPort retrieves the host port mapped to Caddy's admin API (container port 2019), which
+// only Caddy pods deployed before the admin socket publish. Returns an empty port for other pods.
+func getLegacyAdminPort(runtime *podman.PodmanClient, podName string) (string, error) {
 	pod, err := runtime.InspectPod(podName)
 	if err != nil {
 		return "", fmt.Errorf("failed to inspect Caddy pod: %w", err)
@@ -54,12 +55,12 @@ func getCaddyAdminPort(runtime *podman.PodmanClient, podName string) (string, er
 	// Example: {"2019/tcp": ["37249"], "443/tcp": ["39341"]}
 	for containerPort, hostPorts := range pod.Ports {
 		// Check if this is the admin API port (2019)
-		if strings.HasPrefix(containerPort, "2019/") && len(hostPorts) > 0 {
+		if strings.HasPrefix(containerPort, legacyAdminPort+"/") && len(hostPorts) > 0 {
 			return hostPorts[0], nil
 		}
 	}
 
-	return "", fmt.Errorf("admin port mapping not found in pod ports")
+	return "", nil
 }
 
 // getHTTPSPort retrieves the HTTPS port from the Caddy pod.
@@ -107,9 +108,10 @@ func GenerateCaddyfile(baseDir string) error {
 		return fmt.Errorf("failed to parse Caddyfile template: %w", err)
 	}
 
-	// Prepare template data with the server name constant
+	// Prepare template data with the server name constant and the admin socket
 	templateData := map[string]any{
 		"CaddyServerName": constants.CaddyServerName,
+		"AdminListen":     containerAdminListen,
 	}
 
 	// Execute the template
@@ -41,9 +41,19 @@ func RegisterCatalogRoutes(runtime *podman.PodmanClient, caddyCtx *Context, rout
 	for _, info := range routeInfos {
 		logger.Debugf("Registering routes for pod: %s\n", info.PodName)
 
-		// Register routes and get the built routes back
-		routes, err := proxy.RegisterRoutesForAppAndReturn(context.Background(), constants.CatalogAppName, proxyManager, info.RoutesAnnotation, caddyCtx.GetDomainSuffix(), info.PodName)
+		// Build routes using the pod name for upstreams
+		routes, err := proxy.BuildRoutesFromAnnotation(info.RoutesAnnotation, caddyCtx.GetDomainSuffix(), info.PodName)
 		if err != nil {
+			registrationErrors = append(registrationErrors, fmt.Errorf("pod %s: failed to build routes: %w", info.PodName, err))
+
+			continue
+		}
+
+		if caddyCtx.GetRoutingMode() == proxy.RoutingModePath {
+			applyCatalogPathRouting(routes, caddyCtx.GetDomainSuffix())
+		}
+
+		if err := proxy.RegisterRoutes(context.Background(), proxyManager, routes); err != nil {
 			registrationErrors = append(registrationErrors, fmt.Errorf("pod %s: %w", info.PodName, err))
 
 			continue
@@ -88,6 +98,23 @@ func GetCatalogRouteInfo(caddyCtx *Context, runtime *podman.PodmanClient, routeI
 
 // Helper functions for route processing
 
+// applyCatalogPathRouting serves the catalog's routes from the domain suffix itself. The UI is not
+// built to be served under a path and takes the root; every other route is served under its name.
+func applyCatalogPathRouting(routes []proxy.Route, domainSuffix string) {
+	proxy.ApplyPathRouting(routes, domainSuffix, "/", "")
+	for i := range routes {
+		if routes[i].Type == constants.ServiceRouteTypeUI {
+			routes[i].PathPrefix = ""
+		}
+	}
+}
+
+// createRoutePathVariableName creates the variable name of a route's path prefix from its subdomain.
+// Converts "catalog-api" to "CATALOG_API_PATH".
+func createRoutePathVariableName(subdomain string) string {
+	return strings.TrimSuffix(createRouteVariableName(subdomain), "_DOMAIN") + "_PATH"
+}
+
 // createRouteVariableName creates a standardized environment variable name from a subdomain.
 // Converts "catalog-ui" to "CATALOG_UI_DOMAIN".
 func createRouteVariableName(subdomain string) string {
@@ -96,24 +123,13 @@ func createRouteVariableName(subdomain string) string {
 	return strings.ToUpper(fmt.Sprintf("%s_DOMAIN", sanitized))
 }
 
-// extractSubdomainFromDomain extracts the subdomain from a full domain.
-// For "catalog-ui.example.com", returns "catalog-ui".
-func extractSubdomainFromDomain(domain string) string {
-	parts := strings.Split(domain, ".")
-	if len(parts) > 0 {
-		return parts[0]
-	}
-
-	return ""
-}
-
-// addRoutesToDomainMap adds routes to the domain map with standardized variable names.
+// addRoutesToDomainMap adds the domains and path prefixes of routes to the domain map with
+// standardized variable names. Route IDs are the subdomains of the route annotations.
 func addRoutesToDomainMap(routes []proxy.Route, routeDomains map[string]string) {
 	for _, route := range routes {
-		subdomain := extractSubdomainFromDomain(route.Domain)
-		if subdomain != "" {
-			varName := createRouteVariableName(subdomain)
-			routeDomains[varName] = route.Domain
+		if route.ID != "" {
+			routeDomains[createRouteVariableName(route.ID)] = route.Domain
+			routeDomains[createRoutePathVariableName(route.ID)] = route.PathPrefix
 		}
 	}
 }
@@ -154,8 +170,8 @@ func processRouteInfo(info TemplateRouteInfo, proxyManager proxy.ProxyManager, r
 		}
 
 		// Use standardized variable name creation
-		varName := createRouteVariableName(subdomain)
-		routeDomains[varName] = actualRoute.Domain
+		routeDomains[createRouteVariableName(subdomain)] = actualRoute.Domain
+		routeDomains[createRoutePathVariableName(subdomain)] = actualRoute.PathPrefix
 	}
 }
 
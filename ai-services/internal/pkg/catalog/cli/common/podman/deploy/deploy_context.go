@@ -175,7 +175,7 @@ func (d *DeployContext) executePodTemplate(podTemplateName string,
 		"AppTemplateName": catalogconstants.CatalogAppTemplate,
 		"Version":         d.appMetadata.Version,
 		"BaseDir":         baseDir,
-		"CaddyAdminURL":   caddyCtx.GetContainerAdminURL(),
+		"CaddyAdmin":      caddyCtx.GetContainerAdminEndpoint(),
 		"DomainSuffix":    caddyCtx.GetDomainSuffix(),
 		"Values":          d.values,
 		"env":             map[string]map[string]string{},
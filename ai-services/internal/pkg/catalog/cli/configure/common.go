@@ -15,5 +15,8 @@ const (
 	ArgParamPodmanAuthFileContent = "backend.podman.authFileContent"
 	ArgParamPodmanURI             = "backend.podman.uri"
 	ArgParamCaddyHTTPSPort        = "caddy.httpsPort"
+	ArgParamCaddyHTTPPort         = "caddy.httpPort"
 	ArgParamWorkerGatewayPort     = "backend.workerGatewayPort"
+	ArgParamRoutingMode           = "backend.routingMode"
+	ArgParamCaddyAdminMode        = "caddy.adminMode"
 )
@@ -3,6 +3,7 @@ package podman
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -14,10 +15,15 @@ import (
 	catalogUtils "github.com/project-ai-services/ai-services/internal/pkg/catalog/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/proxy"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
 	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 )
 
+// acmeHTTPChallengePort is the host port published for Caddy to answer ACME HTTP-01 challenges on.
+const acmeHTTPChallengePort = "80"
+
 // DeployCatalog deploys the catalog service using the assets/catalog template for podman runtime.
 func DeployCatalog(ctx context.Context, opts catalogUtils.PodmanConfigureOptions) error {
 	// Create deployment context without argParams for status check
@@ -38,11 +44,21 @@ func DeployCatalog(ctx context.Context, opts catalogUtils.PodmanConfigureOptions
 		return err
 	}
 
+	// Apply the admin mode, enabling the remote admin API in mtls mode
+	if err := caddyCtx.ConfigureAdmin(); err != nil {
+		return err
+	}
+
 	// Load SSL certificates if provided
 	if err := caddyCtx.LoadSSLCertificates(opts.BaseDir, opts.SSLCertPath, opts.SSLKeyPath); err != nil {
 		return err
 	}
 
+	// Configure ACME certificate issuance if requested
+	if err := caddyCtx.ConfigureACME(opts.BaseDir, opts.ACME); err != nil {
+		return err
+	}
+
 	return handlePostDeployment(caddyCtx, deployCtx)
 }
 
@@ -62,6 +78,13 @@ func executeCatalogDeployment(ctx context.Context, deployCtx *deploy.DeployConte
 		return nil, err
 	}
 
+	// Move existing deployments to the admin mode being configured by recreating their pods
+	if err := migrateAdminAPI(deployCtx.Runtime, caddyCtx, &opts); err != nil {
+		s.Fail("failed to migrate caddy admin api")
+
+		return nil, err
+	}
+
 	logger.Debugln("checking for existing resources...")
 
 	// Check existing deployment status
@@ -74,7 +97,7 @@ func executeCatalogDeployment(ctx context.Context, deployCtx *deploy.DeployConte
 
 	if !isDeployed {
 		// Prepare deployment with domain suffix computation and create Caddy context
-		err = loadCatalogParamValues(deployCtx, passwordHash, opts.HttpsPort, opts.WorkerGatewayPort)
+		err = loadCatalogParamValues(deployCtx, passwordHash, opts)
 		if err != nil {
 			s.Fail("failed to load param values")
 
@@ -104,6 +127,60 @@ func executeCatalogDeployment(ctx context.Context, deployCtx *deploy.DeployConte
 	return caddyCtx, nil
 }
 
+// migrateAdminAPI moves an existing deployment to the admin mode being configured. Deployments that
+// predate the admin socket serve the admin API on a published port without authentication; their
+// Caddy pod is deleted and its saved configuration moved onto the admin socket. The catalog pod is
+// deleted whenever its admin endpoint changes. The deleted pods are then deployed again from the
+// current templates like any missing resource.
+func migrateAdminAPI(rt *podman.PodmanClient, caddyCtx *caddy.Context, opts *catalogUtils.PodmanConfigureOptions) error {
+	// Deployments redeployed from the configuration of their catalog pod may predate admin modes
+	if opts.AdminMode == "" {
+		opts.AdminMode = proxy.AdminModeUnix
+	}
+
+	legacy, err := caddyCtx.UsesLegacyAdmin(rt)
+	if err != nil {
+		return err
+	}
+
+	existingOpts, catalogPodID, err := catalogUtils.GetCatalogPodConfig(rt)
+	if err != nil && !errors.Is(err, catalogUtils.ErrCatalogPodNotFound) {
+		return fmt.Errorf("failed to get catalog pod details: %w", err)
+	}
+	catalogExists := err == nil
+	modeChanged := catalogExists && existingOpts.AdminMode != opts.AdminMode
+
+	if !legacy && !modeChanged {
+		return nil
+	}
+
+	// Validate the rest of the configuration before any pod is deleted
+	if catalogExists {
+		if err := validateReconfigureParameters(rt, opts, caddyCtx.GetDomainSuffix()); err != nil {
+			return fmt.Errorf("reconfigure validation failed: %w", err)
+		}
+	}
+
+	if legacy {
+		logger.Infoln("Migrating the Caddy admin API from an unauthenticated port to the admin socket...")
+		if err := rt.DeletePod(caddyCtx.GetPodName(), utils.BoolPtr(true)); err != nil {
+			return fmt.Errorf("failed to delete existing Caddy pod: %w", err)
+		}
+		if err := caddy.MigrateAdminConfig(opts.BaseDir); err != nil {
+			return err
+		}
+	}
+
+	if catalogExists {
+		logger.Infof("Recreating the catalog pod to use the Caddy admin API in %s mode...\n", opts.AdminMode)
+		if err := rt.DeletePod(catalogPodID, utils.BoolPtr(true)); err != nil {
+			return fmt.Errorf("failed to delete existing catalog pod: %w", err)
+		}
+	}
+
+	return nil
+}
+
 // handlePostDeployment handles route registration and next steps display after catalog deployment.
 func handlePostDeployment(caddyCtx *caddy.Context, deployCtx *deploy.DeployContext) error {
 	logger.Debugln("handling post deployment steps...")
@@ -136,11 +213,11 @@ func handlePostDeployment(caddyCtx *caddy.Context, deployCtx *deploy.DeployConte
 }
 
 // loadCatalogParamValues prepares all necessary data for deployment including domain suffix computation.
-func loadCatalogParamValues(deployCtx *deploy.DeployContext, passwordHash string, httpsPort, workerGatewayPort int) error {
+func loadCatalogParamValues(deployCtx *deploy.DeployContext, passwordHash string, opts catalogUtils.PodmanConfigureOptions) error {
 	logger.Debugln("loading catalog service param values...")
 
 	// Generate argument parameters
-	argParams, err := generateArgParams(passwordHash, httpsPort, workerGatewayPort)
+	argParams, err := generateArgParams(passwordHash, opts)
 	if err != nil {
 		return fmt.Errorf("failed to generate arg params: %w", err)
 	}
@@ -155,7 +232,7 @@ func loadCatalogParamValues(deployCtx *deploy.DeployContext, passwordHash string
 }
 
 // generateArgParams generates the argument parameters for template rendering.
-func generateArgParams(passwordHash string, httpsPort, workerGatewayPort int) (map[string]string, error) {
+func generateArgParams(passwordHash string, opts catalogUtils.PodmanConfigureOptions) (map[string]string, error) {
 	// Generate database password
 	dbPassword, err := utils.GenerateRandomPassword()
 	if err != nil {
@@ -201,8 +278,15 @@ func generateArgParams(passwordHash string, httpsPort, workerGatewayPort int) (m
 	argParams[configure.ArgParamPodmanAuthFileContent] = authFileBase64
 	argParams[configure.ArgParamPodmanURI] = podmanSocketPath
 	argParams[configure.ArgParamDBPassword] = dbPassword
-	argParams[configure.ArgParamCaddyHTTPSPort] = fmt.Sprintf("%d", httpsPort)
-	argParams[configure.ArgParamWorkerGatewayPort] = fmt.Sprintf("%d", workerGatewayPort)
+	argParams[configure.ArgParamCaddyHTTPSPort] = fmt.Sprintf("%d", opts.HttpsPort)
+	argParams[configure.ArgParamWorkerGatewayPort] = fmt.Sprintf("%d", opts.WorkerGatewayPort)
+	argParams[configure.ArgParamRoutingMode] = opts.RoutingMode
+	argParams[configure.ArgParamCaddyAdminMode] = opts.AdminMode
+
+	// The CA validates HTTP-01 challenges on the standard HTTP port
+	if opts.ACME != nil && opts.ACME.Challenge == catalogUtils.ACMEChallengeHTTP01 {
+		argParams[configure.ArgParamCaddyHTTPPort] = acmeHTTPChallengePort
+	}
 
 	return argParams, nil
 }
@@ -233,7 +317,8 @@ func setupCaddyContext(deployCtx *deploy.DeployContext, opts catalogUtils.Podman
 	logger.Debugf("Using domain suffix: %s\n", domainSuffix)
 
 	// Create Caddy context with pod name and domain suffix (NO template dependencies)
-	caddyCtx := caddy.NewContext(caddyPodName, domainSuffix)
+	caddyCtx := caddy.NewContext(caddyPodName, domainSuffix, opts.RoutingMode)
+	caddyCtx.SetAdminAccess(opts.BaseDir, opts.AdminMode)
 
 	// Generate and write Caddyfile before deploying
 	if err := caddy.GenerateCaddyfile(opts.BaseDir); err != nil {
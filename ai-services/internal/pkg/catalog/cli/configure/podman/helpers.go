@@ -10,6 +10,7 @@ import (
 	cliutils "github.com/project-ai-services/ai-services/internal/pkg/catalog/cli/configure/utils"
 	catalogUtils "github.com/project-ai-services/ai-services/internal/pkg/catalog/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/proxy"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
 )
 
@@ -74,6 +75,15 @@ func validateConfigParameters(existingOpts *catalogUtils.PodmanConfigureOptions,
 		return fmt.Errorf("HTTPS port change not allowed during reconfigure: existing=%d, new=%d. Please uninstall the catalog deployment and re-run configure to change https port", existingOpts.HttpsPort, newOpts.HttpsPort)
 	}
 
+	// Deployments that predate routing modes use host routing
+	existingRoutingMode := existingOpts.RoutingMode
+	if existingRoutingMode == "" {
+		existingRoutingMode = proxy.RoutingModeHost
+	}
+	if existingRoutingMode != newOpts.RoutingMode {
+		return fmt.Errorf("routing mode change not allowed during reconfigure: existing=%s, new=%s. Please uninstall the catalog deployment and re-run configure to change routing mode", existingRoutingMode, newOpts.RoutingMode)
+	}
+
 	if existingOpts.BaseDir != newOpts.BaseDir {
 		return fmt.Errorf("base directory change not allowed during reconfigure: existing=%s, new=%s. Please uninstall the catalog deployment and re-run configure to change base directory", existingOpts.BaseDir, newOpts.BaseDir)
 	}
@@ -95,6 +105,11 @@ func validateCertificateChanges(opts *catalogUtils.PodmanConfigureOptions) error
 	stagedCertExists := len(stagedCerts) > 0
 	stagedKeyExists := len(stagedKeys) > 0
 
+	// ACME issuance cannot take over from custom certificates, which Caddy keeps serving
+	if opts.ACME != nil && stagedCertExists && stagedKeyExists {
+		return fmt.Errorf("certificate type change not allowed: custom certificates are already configured. Cannot switch to ACME certificates during reconfigure. Please uninstall the catalog deployment and re-run configure to change certificate type")
+	}
+
 	// If no SSL paths provided in new config but staged certs exist, block cert type change
 	if (opts.SSLCertPath == "" || opts.SSLKeyPath == "") && stagedCertExists && stagedKeyExists {
 		return fmt.Errorf("certificate type change not allowed: custom certificates are already configured. Cannot switch to Caddy self-signed certificates during reconfigure. Please uninstall the catalog deployment and re-run configure to change certificate type")
@@ -106,6 +121,7 @@ func validateCertificateChanges(opts *catalogUtils.PodmanConfigureOptions) error
 	// - Reconfigure with same custom certs (content matches)
 	// - Reconfigure with updated custom certs (content differs - allow for cert renewal/expiry)
 	// - Caddy self-signed to custom certs transition
+	// - Caddy self-signed to ACME certs transition
 	return nil
 }
 
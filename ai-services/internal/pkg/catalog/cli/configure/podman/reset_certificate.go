@@ -54,7 +54,7 @@ func ResetCatalogCertificate(sslCertPath, sslKeyPath string) error {
 	}
 
 	// Create Caddy context for certificate operations
-	caddyCtx := caddy.NewContext(caddyPodName, "")
+	caddyCtx := caddy.NewContext(caddyPodName, "", "")
 
 	// Load certificates with health check
 	if err := loadCertificatesToCaddy(caddyCtx, opts.BaseDir, sslCertPath, sslKeyPath); err != nil {
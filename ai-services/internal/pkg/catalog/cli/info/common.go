@@ -6,6 +6,7 @@ import (
 
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/cli/info/openshift"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/cli/info/podman"
+	catalogTypes "github.com/project-ai-services/ai-services/internal/pkg/catalog/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 )
 
@@ -21,4 +22,16 @@ func Run(ctx context.Context, runtimeType types.RuntimeType) error {
 	}
 }
 
+// Get returns the catalog service information based on the runtime type.
+func Get(runtimeType types.RuntimeType) (*catalogTypes.CatalogInfo, error) {
+	switch runtimeType {
+	case types.RuntimeTypePodman:
+		return podman.GetCatalogInfo()
+	case types.RuntimeTypeOpenShift:
+		return openshift.GetCatalogInfo()
+	default:
+		return nil, fmt.Errorf("unsupported runtime type: %s", runtimeType)
+	}
+}
+
 // Made with Bob
@@ -6,11 +6,13 @@ import (
 
 	"github.com/project-ai-services/ai-services/assets"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/constants"
+	catalogTypes "github.com/project-ai-services/ai-services/internal/pkg/catalog/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
 	aiconst "github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	oc "github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
@@ -23,13 +25,9 @@ func DisplayCatalogInfo(ctx context.Context) error {
 	}
 
 	// Step 1: Check if catalog pods exist in the namespace
-	listFilters := map[string][]string{
-		"label": {fmt.Sprintf("%s=%s", aiconst.ApplicationAnnotationKey, constants.CatalogAppName)},
-	}
-
-	pods, err := runtime.ListPods(listFilters)
+	pods, err := listCatalogPods(runtime)
 	if err != nil {
-		return fmt.Errorf("failed to list pods: %w", err)
+		return err
 	}
 
 	if len(pods) == 0 {
@@ -58,3 +56,40 @@ func DisplayCatalogInfo(ctx context.Context) error {
 
 	return nil
 }
+
+// GetCatalogInfo returns the information about the catalog service displayed by
+// DisplayCatalogInfo in structured form.
+func GetCatalogInfo() (*catalogTypes.CatalogInfo, error) {
+	runtime, err := oc.NewOpenshiftClientWithNamespace(constants.CatalogAppName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize openshift client: %w", err)
+	}
+
+	pods, err := listCatalogPods(runtime)
+	if err != nil {
+		return nil, err
+	}
+	if len(pods) == 0 {
+		return nil, fmt.Errorf("catalog service is not configured or running")
+	}
+
+	return &catalogTypes.CatalogInfo{
+		Name:     constants.CatalogAppName,
+		Template: pods[0].Labels[string(vars.TemplateLabel)],
+		Version:  pods[0].Labels[string(vars.VersionLabel)],
+	}, nil
+}
+
+// listCatalogPods lists the pods of the catalog service in its namespace.
+func listCatalogPods(runtime *oc.OpenshiftClient) ([]types.Pod, error) {
+	listFilters := map[string][]string{
+		"label": {fmt.Sprintf("%s=%s", aiconst.ApplicationAnnotationKey, constants.CatalogAppName)},
+	}
+
+	pods, err := runtime.ListPods(listFilters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	return pods, nil
+}
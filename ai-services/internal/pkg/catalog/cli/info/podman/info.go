@@ -2,18 +2,27 @@ package podman
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/project-ai-services/ai-services/assets"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/cli/common/podman/caddy"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/cli/common/podman/deploy"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/constants"
+	catalogTypes "github.com/project-ai-services/ai-services/internal/pkg/catalog/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	rt "github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
+const (
+	// catalogUIDomainVar is the route domain variable of the catalog UI, whose certificate is reported.
+	catalogUIDomainVar = "CATALOG_UI_DOMAIN"
+	hoursPerDay        = 24
+)
+
 // DisplayCatalogInfo displays detailed information about the catalog service.
 func DisplayCatalogInfo() error {
 	// Initialize runtime
@@ -23,13 +32,9 @@ func DisplayCatalogInfo() error {
 	}
 
 	// Step 1: Check if catalog pod exists
-	listFilters := map[string][]string{
-		"label": {fmt.Sprintf("ai-services.io/application=%s", constants.CatalogAppName)},
-	}
-
-	pods, err := runtime.ListPods(listFilters)
+	pods, err := listCatalogPods(runtime)
 	if err != nil {
-		return fmt.Errorf("failed to list pods: %w", err)
+		return err
 	}
 
 	// If there exists no pod for catalog, then inform user
@@ -70,9 +75,132 @@ func DisplayCatalogInfo() error {
 		return nil
 	}
 
+	// Step 5: Print the status of the certificate served for the catalog domains
+	printCertificateStatus(routeDomains, httpsPort)
+
 	return nil
 }
 
+// GetCatalogInfo returns the information about the catalog service displayed by
+// DisplayCatalogInfo in structured form.
+func GetCatalogInfo() (*catalogTypes.CatalogInfo, error) {
+	runtime, err := rt.NewPodmanClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize podman client: %w", err)
+	}
+
+	pods, err := listCatalogPods(runtime)
+	if err != nil {
+		return nil, err
+	}
+	if len(pods) == 0 {
+		return nil, fmt.Errorf("catalog service is not configured or running")
+	}
+
+	info := &catalogTypes.CatalogInfo{
+		Name:     constants.CatalogAppName,
+		Template: pods[0].Labels[string(vars.TemplateLabel)],
+		Version:  pods[0].Labels[string(vars.VersionLabel)],
+	}
+
+	routeDomains, httpsPort, err := GetCatalogRouteInfo(runtime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get route info: %w", err)
+	}
+	info.Routes = routeDomains
+	info.HTTPSPort = httpsPort
+
+	domain := certificateDomain(routeDomains)
+	if domain == "" {
+		return info, nil
+	}
+
+	caddyCtx, err := newCaddyContext()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get certificate status: %w", err)
+	}
+
+	status, err := caddyCtx.GetCertificateStatus(domain, httpsPort)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get certificate status: %w", err)
+	}
+	info.Certificate = &catalogTypes.CertificateInfo{Mode: status.Mode, Issuer: status.Issuer, NotAfter: status.NotAfter}
+
+	return info, nil
+}
+
+// listCatalogPods lists the pods of the catalog service.
+func listCatalogPods(runtime *rt.PodmanClient) ([]types.Pod, error) {
+	listFilters := map[string][]string{
+		"label": {fmt.Sprintf("ai-services.io/application=%s", constants.CatalogAppName)},
+	}
+
+	pods, err := runtime.ListPods(listFilters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	return pods, nil
+}
+
+// certificateDomain returns the catalog domain whose certificate is reported, preferring the
+// domain of the UI.
+func certificateDomain(routeDomains map[string]string) string {
+	if domain := routeDomains[catalogUIDomainVar]; domain != "" {
+		return domain
+	}
+
+	for _, routeDomain := range routeDomains {
+		return routeDomain
+	}
+
+	return ""
+}
+
+// printCertificateStatus prints the issuer and expiry of the certificate Caddy serves for the
+// catalog domains, and warns when it is about to expire.
+func printCertificateStatus(routeDomains map[string]string, httpsPort string) {
+	domain := certificateDomain(routeDomains)
+	if domain == "" {
+		return
+	}
+
+	caddyCtx, err := newCaddyContext()
+	if err != nil {
+		logger.Errorf("failed to get certificate status: %v\n", err)
+
+		return
+	}
+
+	status, err := caddyCtx.GetCertificateStatus(domain, httpsPort)
+	if err != nil {
+		logger.Errorf("failed to get certificate status: %v\n", err)
+
+		return
+	}
+
+	now := time.Now()
+	remaining := status.NotAfter.Sub(now)
+	logger.Infof("\nTLS Certificate: %s, issued by %s, expires %s (in %d days)\n",
+		status.Mode, status.Issuer, status.NotAfter.Format(time.DateOnly), int(remaining.Hours()/hoursPerDay))
+
+	if !status.ExpiresSoon(now) {
+		return
+	}
+
+	if remaining <= 0 {
+		logger.Warningf("The TLS certificate for %s expired on %s.\n", domain, status.NotAfter.Format(time.DateOnly))
+	} else {
+		logger.Warningf("The TLS certificate for %s expires in %d days.\n", domain, int(remaining.Hours()/hoursPerDay))
+	}
+
+	if status.Mode == caddy.CertificateModeACME {
+		logger.Warningln("Caddy renews ACME certificates automatically; check the logs of the Caddy pod for renewal errors.")
+	} else {
+		logger.Warningln("Renew it with 'ai-services catalog configure --runtime podman --reset-certificate --ssl-cert <cert> --ssl-key <key>'.")
+	}
+}
+
 // GetCatalogRouteInfo retrieves route domains and HTTPS port for the catalog service.
 // This orchestrates: deployContext gets pod name and route info from templates,
 // caddy.Context queries Caddy for route domains and HTTPS port.
@@ -83,23 +211,41 @@ func GetCatalogRouteInfo(rt *rt.PodmanClient) (map[string]string, string, error)
 		return nil, "", fmt.Errorf("failed to create deployment context: %w", err)
 	}
 
-	// Get Caddy pod name from templates
-	caddyPodName, err := deployCtx.GetCaddyPodName()
-	if err != nil {
-		return nil, "", fmt.Errorf("failed to get Caddy pod name: %w", err)
-	}
-
 	// Extract route infos from deployment context
 	routeInfos, err := deployCtx.ExtractRouteInfos()
 	if err != nil {
 		return nil, "", fmt.Errorf("failed to extract route infos: %w", err)
 	}
 
-	// Create Caddy context (domain suffix not needed for querying existing routes)
-	caddyCtx := caddy.NewContext(caddyPodName, "")
+	caddyCtx, err := newCaddyContextFrom(deployCtx)
+	if err != nil {
+		return nil, "", err
+	}
 
 	// Use caddy package to get route info
 	return caddy.GetCatalogRouteInfo(caddyCtx, rt, routeInfos)
 }
 
+// newCaddyContext creates a Caddy context for querying the deployed catalog's Caddy pod.
+func newCaddyContext() (*caddy.Context, error) {
+	deployCtx, err := deploy.NewDeployContext()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create deployment context: %w", err)
+	}
+
+	return newCaddyContextFrom(deployCtx)
+}
+
+// newCaddyContextFrom creates a Caddy context for the Caddy pod of the deployment context's templates.
+func newCaddyContextFrom(deployCtx *deploy.DeployContext) (*caddy.Context, error) {
+	// Get Caddy pod name from templates
+	caddyPodName, err := deployCtx.GetCaddyPodName()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Caddy pod name: %w", err)
+	}
+
+	// Create Caddy context (domain suffix not needed for querying the deployed pod)
+	return caddy.NewContext(caddyPodName, "", ""), nil
+}
+
 // Made with Bob
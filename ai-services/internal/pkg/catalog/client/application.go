@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/apiserver/models"
+	dbmodels "github.com/project-ai-services/ai-services/internal/pkg/catalog/db/models"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 )
@@ -16,9 +17,13 @@ const (
 	applicationsRoute       = "/api/v1/applications"
 	getApplicationPSRoute   = "/api/v1/applications/%s/ps"
 	getApplicationRoute     = "/api/v1/applications/%s"
+	applicationActionRoute  = "/api/v1/applications/%s/%s"
+	importApplicationRoute  = "/api/v1/applications/import"
 	svcDeployOptionsRoute   = "/api/v1/services/%s/deploy-options"
 	archDeployOptionsRoute  = "/api/v1/architectures/%s/deploy-options"
 	compProviderParamsRoute = "/api/v1/components/%s/providers/%s/params"
+	svcParamsRoute          = "/api/v1/services/%s/params"
+	modelsRoute             = "/api/v1/models"
 )
 
 // HTTPError represents an HTTP error with status code.
@@ -247,6 +252,23 @@ func (c *ApplicationClient) GetArchitectureDeployOptions(architectureID string)
 	return &result, nil
 }
 
+// GetServiceParams retrieves the parameter schema for a specific service.
+func (c *ApplicationClient) GetServiceParams(serviceID string) (map[string]any, error) {
+	var result map[string]any
+	resp, err := c.client.HTTPClient().R().
+		SetResult(&result).
+		Get(fmt.Sprintf(svcParamsRoute, serviceID))
+	if err != nil {
+		return nil, fmt.Errorf("get service params: %w", err)
+	}
+
+	if resp.IsError() {
+		return nil, fmt.Errorf("get service params: server returned HTTP %d: %s", resp.StatusCode(), utils.ParseErrorResponse(resp))
+	}
+
+	return result, nil
+}
+
 // GetComponentProviderParams retrieves the parameter schema for a specific component provider.
 func (c *ApplicationClient) GetComponentProviderParams(componentType, providerID string) (map[string]any, error) {
 	var result map[string]any
@@ -264,4 +286,178 @@ func (c *ApplicationClient) GetComponentProviderParams(componentType, providerID
 	return result, nil
 }
 
+// ListModels retrieves the models downloaded to the models directory of the catalog host with the
+// applications using them. File checksums are the ones recorded when the files were downloaded.
+func (c *ApplicationClient) ListModels() (*models.ModelListResponse, error) {
+	var result models.ModelListResponse
+	resp, err := c.client.HTTPClient().R().
+		SetResult(&result).
+		Get(modelsRoute)
+	if err != nil {
+		return nil, fmt.Errorf("list models: %w", err)
+	}
+
+	if resp.IsError() {
+		return nil, &HTTPError{
+			StatusCode: resp.StatusCode(),
+			Message:    utils.ParseErrorResponse(resp),
+		}
+	}
+
+	return &result, nil
+}
+
+// StartApplication starts a stopped application, or a single service of it, by ID.
+func (c *ApplicationClient) StartApplication(id string, params *LifecycleParams) (*models.ApplicationLifecycleResponse, error) {
+	return c.changeApplicationState(id, "start", params)
+}
+
+// StopApplication stops an application, or a single service of it, by ID.
+// Stopped applications keep their data and routes and can be started again.
+func (c *ApplicationClient) StopApplication(id string, params *LifecycleParams) (*models.ApplicationLifecycleResponse, error) {
+	return c.changeApplicationState(id, "stop", params)
+}
+
+// RestartApplication stops and starts an application, or a single service of it, by ID.
+func (c *ApplicationClient) RestartApplication(id string, params *LifecycleParams) (*models.ApplicationLifecycleResponse, error) {
+	return c.changeApplicationState(id, "restart", params)
+}
+
+func (c *ApplicationClient) changeApplicationState(id, action string, params *LifecycleParams) (*models.ApplicationLifecycleResponse, error) {
+	var result models.ApplicationLifecycleResponse
+	req := c.client.HTTPClient().R().SetResult(&result)
+
+	if params != nil && params.ServiceID != "" {
+		req.SetQueryParam("service_id", params.ServiceID)
+	}
+
+	resp, err := req.Post(fmt.Sprintf(applicationActionRoute, id, action))
+	if err != nil {
+		return nil, fmt.Errorf("%s application: %w", action, err)
+	}
+
+	if resp.IsError() {
+		return nil, &HTTPError{
+			StatusCode: resp.StatusCode(),
+			Message:    utils.ParseErrorResponse(resp),
+		}
+	}
+
+	return &result, nil
+}
+
+// ExportApplication retrieves the YAML definition of an application.
+func (c *ApplicationClient) ExportApplication(id string) ([]byte, error) {
+	resp, err := c.client.HTTPClient().R().
+		SetHeader("Accept", "application/yaml").
+		Get(fmt.Sprintf(applicationActionRoute, id, "export"))
+	if err != nil {
+		return nil, fmt.Errorf("export application: %w", err)
+	}
+
+	if resp.IsError() {
+		return nil, &HTTPError{
+			StatusCode: resp.StatusCode(),
+			Message:    utils.ParseErrorResponse(resp),
+		}
+	}
+
+	return resp.Body(), nil
+}
+
+// ImportApplication creates an application from a YAML definition. A non-empty name
+// overrides the name in the definition.
+func (c *ApplicationClient) ImportApplication(definition []byte, name string) (*models.CreateApplicationResponse, error) {
+	var result models.CreateApplicationResponse
+	req := c.client.HTTPClient().R().
+		SetHeader("Content-Type", "application/yaml").
+		SetBody(definition).
+		SetResult(&result)
+
+	if name != "" {
+		req.SetQueryParam("name", name)
+	}
+
+	resp, err := req.Post(importApplicationRoute)
+	if err != nil {
+		return nil, fmt.Errorf("import application: %w", err)
+	}
+
+	if resp.IsError() {
+		return nil, fmt.Errorf("import application: server returned HTTP %d: %s",
+			resp.StatusCode(), utils.ParseErrorResponse(resp))
+	}
+
+	return &result, nil
+}
+
+// ListBackups retrieves the backups of an application.
+func (c *ApplicationClient) ListBackups(id string) ([]dbmodels.Backup, error) {
+	var result models.BackupListResponse
+	if err := c.getApplicationResource(id, "backups", &result); err != nil {
+		return nil, err
+	}
+
+	return result.Backups, nil
+}
+
+// ListBackupPolicies retrieves the backup policies of an application.
+func (c *ApplicationClient) ListBackupPolicies(id string) ([]dbmodels.BackupPolicy, error) {
+	var result models.BackupPolicyListResponse
+	if err := c.getApplicationResource(id, "backup-policies", &result); err != nil {
+		return nil, err
+	}
+
+	return result.Policies, nil
+}
+
+// CreateBackupPolicy schedules recurring backups of an application.
+func (c *ApplicationClient) CreateBackupPolicy(id string, req *models.CreateBackupPolicyRequest) (*dbmodels.BackupPolicy, error) {
+	var result dbmodels.BackupPolicy
+	resp, err := c.client.HTTPClient().R().
+		SetBody(req).
+		SetResult(&result).
+		Post(fmt.Sprintf(applicationActionRoute, id, "backup-policies"))
+	if err != nil {
+		return nil, fmt.Errorf("create backup policy: %w", err)
+	}
+
+	if resp.IsError() {
+		return nil, &HTTPError{
+			StatusCode: resp.StatusCode(),
+			Message:    utils.ParseErrorResponse(resp),
+		}
+	}
+
+	return &result, nil
+}
+
+// ListAPIKeys retrieves the metadata of the API keys of an application.
+func (c *ApplicationClient) ListAPIKeys(id string) ([]dbmodels.APIKey, error) {
+	var result models.APIKeyListResponse
+	if err := c.getApplicationResource(id, "api-keys", &result); err != nil {
+		return nil, err
+	}
+
+	return result.Keys, nil
+}
+
+func (c *ApplicationClient) getApplicationResource(id, resource string, result any) error {
+	resp, err := c.client.HTTPClient().R().
+		SetResult(result).
+		Get(fmt.Sprintf(applicationActionRoute, id, resource))
+	if err != nil {
+		return fmt.Errorf("list %s: %w", resource, err)
+	}
+
+	if resp.IsError() {
+		return &HTTPError{
+			StatusCode: resp.StatusCode(),
+			Message:    utils.ParseErrorResponse(resp),
+		}
+	}
+
+	return nil
+}
+
 // Made with Bob
@@ -3,7 +3,6 @@
 package client
 
 import (
-	"crypto/tls"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -42,26 +41,23 @@ type UserInfo struct {
 	Name     string `json:"name"`
 }
 
-// New creates a Client using credentials loaded from the local config file.
+// New creates a Client using the credentials of the active context loaded from the local
+// config file. The context is selected with the --context flag or the AI_SERVICES_CONTEXT
+// environment variable, falling back to the current context.
 // It refreshes the access token only when it is about to expire (within
 // tokenRefreshSkew of its expiry time); otherwise the stored token is reused.
-// The insecure flag from stored credentials determines whether TLS verification is performed.
+// The TLS settings of the context determine how the server certificate is verified.
 func New() (*Client, error) {
 	creds, err := config.Load()
 	if err != nil {
 		return nil, err
 	}
 
-	restyClient := resty.New().
-		SetBaseURL(creds.ServerURL).
-		SetAuthToken(creds.AccessToken)
-
-	// Configure TLS settings based on the insecure flag from credentials
-	if creds.Insecure {
-		restyClient.SetTLSClientConfig(&tls.Config{
-			InsecureSkipVerify: true,
-		})
+	restyClient, err := newRestyClient(creds)
+	if err != nil {
+		return nil, err
 	}
+	restyClient.SetAuthToken(creds.AccessToken)
 
 	c := &Client{
 		serverURL:  creds.ServerURL,
@@ -78,6 +74,22 @@ func New() (*Client, error) {
 	return c, nil
 }
 
+// newRestyClient creates a resty client for the server of the credentials, applying their
+// TLS settings.
+func newRestyClient(creds config.Credentials) (*resty.Client, error) {
+	restyClient := resty.New().SetBaseURL(creds.ServerURL)
+
+	tlsConfig, err := creds.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil {
+		restyClient.SetTLSClientConfig(tlsConfig)
+	}
+
+	return restyClient, nil
+}
+
 // accessTokenNeedsRefresh returns true when the stored access token is missing,
 // has an unknown expiry, or will expire within tokenRefreshSkew.
 func (c *Client) accessTokenNeedsRefresh() bool {
@@ -101,35 +113,50 @@ func (c *Client) accessTokenNeedsRefresh() bool {
 	return time.Until(exp) < tokenRefreshSkew
 }
 
-// NewWithLogin creates a Client by performing a fresh login with username/password.
-// The resulting tokens are saved to the local config file.
-// If insecure is true, TLS certificate verification will be skipped.
-func NewWithLogin(serverURL, username, password string, insecure bool) (*Client, error) {
-	restyClient := resty.New().SetBaseURL(serverURL)
+// NewWithLogin creates a Client by performing a fresh login with username/password
+// against the server of target, using its TLS settings.
+// The resulting tokens are saved to the active context, which becomes the current context unless
+// it was named for this command only.
+func NewWithLogin(target config.Credentials, username, password string) (*Client, error) {
+	c, err := newLoginClient(target)
+	if err != nil {
+		return nil, err
+	}
 
-	// Configure TLS settings based on the insecure flag
-	if insecure {
-		restyClient.SetTLSClientConfig(&tls.Config{
-			InsecureSkipVerify: true,
-		})
+	resp, err := c.Login(username, password)
+	if err != nil {
+		return nil, err
 	}
 
-	c := &Client{
-		serverURL:  serverURL,
-		httpClient: restyClient,
+	if err := c.saveLogin(resp); err != nil {
+		return nil, err
 	}
 
-	resp, err := c.Login(username, password)
+	return c, nil
+}
+
+// newLoginClient creates an unauthenticated Client for the server of target.
+func newLoginClient(target config.Credentials) (*Client, error) {
+	restyClient, err := newRestyClient(target)
 	if err != nil {
 		return nil, err
 	}
 
-	c.creds = config.Credentials{
-		ServerURL:    serverURL,
-		AccessToken:  resp.AccessToken,
-		RefreshToken: resp.RefreshToken,
-		Insecure:     insecure,
-	}
+	return &Client{
+		serverURL:  target.ServerURL,
+		httpClient: restyClient,
+		creds: config.Credentials{
+			ServerURL: target.ServerURL,
+			Insecure:  target.Insecure,
+			CAData:    target.CAData,
+		},
+	}, nil
+}
+
+// saveLogin stores the tokens of a login in the client and persists them to the active context.
+func (c *Client) saveLogin(resp LoginResponse) error {
+	c.creds.AccessToken = resp.AccessToken
+	c.creds.RefreshToken = resp.RefreshToken
 
 	// Update the auth token in the resty client
 	c.httpClient.SetAuthToken(resp.AccessToken)
@@ -139,11 +166,11 @@ func NewWithLogin(serverURL, username, password string, insecure bool) (*Client,
 		c.creds.AccessTokenExpiry = exp
 	}
 
-	if err := config.Save(c.creds); err != nil {
-		return nil, fmt.Errorf("save credentials: %w", err)
+	if err := config.SaveLogin(c.creds); err != nil {
+		return fmt.Errorf("save credentials: %w", err)
 	}
 
-	return c, nil
+	return nil
 }
 
 // Login calls POST /api/v1/auth/login and returns the token pair.
@@ -185,16 +212,11 @@ func (c *Client) LoginWithMIQToken(miqToken string) (LoginResponse, error) {
 
 // NewWithMIQToken creates a Client by exchanging a ManageIQ token for a Catalog API JWT.
 // This is Flow B: used by IBM Power Mission Control which already holds a MIQ token.
-// The resulting tokens are saved to the local config file exactly like NewWithLogin.
-func NewWithMIQToken(serverURL, miqToken string, insecure bool) (*Client, error) {
-	restyClient := resty.New().SetBaseURL(serverURL)
-	if insecure {
-		restyClient.SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true}) //nolint:gosec
-	}
-
-	c := &Client{
-		serverURL:  serverURL,
-		httpClient: restyClient,
+// The resulting tokens are saved to the active context exactly like NewWithLogin.
+func NewWithMIQToken(target config.Credentials, miqToken string) (*Client, error) {
+	c, err := newLoginClient(target)
+	if err != nil {
+		return nil, err
 	}
 
 	resp, err := c.LoginWithMIQToken(miqToken)
@@ -202,20 +224,8 @@ func NewWithMIQToken(serverURL, miqToken string, insecure bool) (*Client, error)
 		return nil, err
 	}
 
-	c.creds = config.Credentials{
-		ServerURL:    serverURL,
-		AccessToken:  resp.AccessToken,
-		RefreshToken: resp.RefreshToken,
-		Insecure:     insecure,
-	}
-	c.httpClient.SetAuthToken(resp.AccessToken)
-
-	if exp, err := jwtExpiry(resp.AccessToken); err == nil {
-		c.creds.AccessTokenExpiry = exp
-	}
-
-	if err := config.Save(c.creds); err != nil {
-		return nil, fmt.Errorf("save credentials: %w", err)
+	if err := c.saveLogin(resp); err != nil {
+		return nil, err
 	}
 
 	return c, nil
@@ -271,7 +281,7 @@ func (c *Client) Me() (UserInfo, error) {
 }
 
 // Logout calls POST /api/v1/auth/logout to invalidate the access token on the server,
-// then removes the local credentials file.
+// then removes the tokens of the active context from the local credentials file.
 func (c *Client) Logout() error {
 	// Best-effort server-side logout; ignore errors (token may already be expired).
 	_, _ = c.httpClient.R().
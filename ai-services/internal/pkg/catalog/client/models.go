@@ -18,6 +18,12 @@ type DeleteApplicationParams struct {
 	KeepData bool
 }
 
+// LifecycleParams holds optional query parameters for starting, stopping and restarting applications.
+type LifecycleParams struct {
+	// ServiceID limits the action to a single service of the application. Default: whole application
+	ServiceID string
+}
+
 // CreateApplicationRequest represents the payload for creating an application.
 type CreateApplicationRequest struct {
 	CatalogID string                     `json:"catalog_id"`
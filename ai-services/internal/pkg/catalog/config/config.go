@@ -1,14 +1,24 @@
 // Package config manages the local CLI configuration for the catalog API client,
 // including persisting and loading auth tokens from the user's config directory.
+//
+// The credentials file holds named contexts, each with the server URL, TLS settings and tokens
+// of one catalog API server, so that several servers can be used without logging in again.
+// Commands act on the context selected with the --context flag or the AI_SERVICES_CONTEXT
+// environment variable, and otherwise on the current context of the file.
 package config
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"time"
+
+	"github.com/spf13/pflag"
 )
 
 const (
@@ -16,11 +26,25 @@ const (
 	configDirName = "ai-services"
 	// configFileName is the name of the credentials file.
 	configFileName = "catalog-credentials.json"
+
+	// ContextEnvVar names the environment variable selecting the context when no --context flag is given.
+	ContextEnvVar = "AI_SERVICES_CONTEXT"
+	// ContextFlag is the name of the global flag selecting the context.
+	ContextFlag = "context"
+	// DefaultContextName is the context used when none is selected or current. Credentials
+	// stored by versions without contexts are moved to it.
+	DefaultContextName = "default"
 )
 
 // ErrNotLoggedIn is returned when no credentials are found on disk.
 var ErrNotLoggedIn = errors.New("not logged in: run 'ai-services catalog login' first")
 
+// ErrContextNotFound is returned when a named context does not exist.
+var ErrContextNotFound = errors.New("context not found")
+
+// selectedContext is the context named by the --context flag, if any.
+var selectedContext string
+
 // Credentials holds the tokens returned by the API server after a successful login.
 type Credentials struct {
 	// ServerURL is the base URL of the catalog API server (e.g. http://localhost:8080).
@@ -35,6 +59,77 @@ type Credentials struct {
 	AccessTokenExpiry time.Time `json:"access_token_expiry,omitempty"`
 	// Insecure indicates whether to skip TLS certificate verification.
 	Insecure bool `json:"insecure,omitempty"`
+	// CAData is a PEM bundle of the certificate authorities trusted for the server instead of
+	// the system roots, e.g. the root of a self-signed catalog deployment.
+	CAData string `json:"ca_data,omitempty"`
+}
+
+// LoggedIn reports whether the credentials hold tokens.
+func (c Credentials) LoggedIn() bool {
+	return c.RefreshToken != "" || c.AccessToken != ""
+}
+
+// TLSConfig returns the TLS client configuration for the server of the credentials, or nil to
+// verify the server against the system roots.
+func (c Credentials) TLSConfig() (*tls.Config, error) {
+	if c.Insecure {
+		return &tls.Config{InsecureSkipVerify: true}, nil //nolint:gosec
+	}
+
+	if c.CAData == "" {
+		return nil, nil
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM([]byte(c.CAData)) {
+		return nil, fmt.Errorf("no certificates found in the CA bundle of the context")
+	}
+
+	return &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}, nil
+}
+
+// File is the content of the credentials file.
+type File struct {
+	// CurrentContext is the context commands act on when none is selected.
+	CurrentContext string `json:"current_context,omitempty"`
+	// Contexts holds the server and credentials of every context by name.
+	Contexts map[string]Credentials `json:"contexts"`
+}
+
+// ContextNames returns the names of the contexts in alphabetical order.
+func (f *File) ContextNames() []string {
+	names := make([]string, 0, len(f.Contexts))
+	for name := range f.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// ActiveContext returns the name of the context commands act on: the context selected with
+// the --context flag, else with AI_SERVICES_CONTEXT, else the current context of the file,
+// else DefaultContextName.
+func (f *File) ActiveContext() string {
+	if selectedContext != "" {
+		return selectedContext
+	}
+
+	if name := os.Getenv(ContextEnvVar); name != "" {
+		return name
+	}
+
+	if f.CurrentContext != "" {
+		return f.CurrentContext
+	}
+
+	return DefaultContextName
+}
+
+// BindContextFlag registers the global --context flag selecting the context.
+func BindContextFlag(flags *pflag.FlagSet) {
+	flags.StringVar(&selectedContext, ContextFlag, "",
+		fmt.Sprintf("Name of the catalog context to use (overrides %s and the current context)", ContextEnvVar))
 }
 
 // configFilePath returns the absolute path to the credentials file.
@@ -47,8 +142,46 @@ func configFilePath() (string, error) {
 	return filepath.Join(base, configDirName, configFileName), nil
 }
 
-// Save persists the credentials to disk, creating the config directory if needed.
-func Save(creds Credentials) error {
+// LoadFile reads the credentials file, returning an empty file if it does not exist.
+// Credentials stored by versions without contexts are returned as DefaultContextName.
+func LoadFile() (*File, error) {
+	path, err := configFilePath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &File{Contexts: map[string]Credentials{}}, nil
+		}
+
+		return nil, fmt.Errorf("read credentials file: %w", err)
+	}
+
+	f := &File{}
+	if err := json.Unmarshal(data, f); err != nil {
+		return nil, fmt.Errorf("parse credentials file: %w", err)
+	}
+
+	if f.Contexts == nil {
+		f.Contexts = map[string]Credentials{}
+
+		var legacy Credentials
+		if err := json.Unmarshal(data, &legacy); err != nil {
+			return nil, fmt.Errorf("parse credentials file: %w", err)
+		}
+		if legacy.ServerURL != "" {
+			f.Contexts[DefaultContextName] = legacy
+			f.CurrentContext = DefaultContextName
+		}
+	}
+
+	return f, nil
+}
+
+// SaveFile persists the credentials file, creating the config directory if needed.
+func SaveFile(f *File) error {
 	const (
 		configDirPerm  = 0o700
 		configFilePerm = 0o600
@@ -63,7 +196,7 @@ func Save(creds Credentials) error {
 		return fmt.Errorf("create config directory: %w", err)
 	}
 
-	data, err := json.MarshalIndent(creds, "", "  ")
+	data, err := json.MarshalIndent(f, "", "  ")
 	if err != nil {
 		return fmt.Errorf("marshal credentials: %w", err)
 	}
@@ -76,43 +209,116 @@ func Save(creds Credentials) error {
 	return nil
 }
 
-// Load reads the credentials from disk.
-// Returns ErrNotLoggedIn if the file does not exist.
+// Load reads the credentials of the active context from disk.
+// Returns ErrNotLoggedIn if the context does not exist or holds no tokens.
 func Load() (Credentials, error) {
-	path, err := configFilePath()
+	f, err := LoadFile()
 	if err != nil {
 		return Credentials{}, err
 	}
 
-	data, err := os.ReadFile(path)
+	creds, ok := f.Contexts[f.ActiveContext()]
+	if !ok || !creds.LoggedIn() {
+		return Credentials{}, ErrNotLoggedIn
+	}
+
+	return creds, nil
+}
+
+// LoadTarget returns the name of the active context and its stored server and TLS settings,
+// which a new login reuses when no server is given. The credentials are empty for a new context.
+func LoadTarget() (string, Credentials, error) {
+	f, err := LoadFile()
 	if err != nil {
-		if os.IsNotExist(err) {
-			return Credentials{}, ErrNotLoggedIn
-		}
+		return "", Credentials{}, err
+	}
+
+	name := f.ActiveContext()
 
-		return Credentials{}, fmt.Errorf("read credentials file: %w", err)
+	return name, f.Contexts[name], nil
+}
+
+// Save persists the credentials of the active context, e.g. after a token refresh.
+func Save(creds Credentials) error {
+	return save(creds, false)
+}
+
+// SaveLogin persists the credentials of a new login to the active context. The context becomes the
+// current context unless it was named for this command only, with --context or AI_SERVICES_CONTEXT.
+func SaveLogin(creds Credentials) error {
+	return save(creds, selectedContext == "" && os.Getenv(ContextEnvVar) == "")
+}
+
+func save(creds Credentials, makeCurrent bool) error {
+	f, err := LoadFile()
+	if err != nil {
+		return err
 	}
 
-	var creds Credentials
-	if err := json.Unmarshal(data, &creds); err != nil {
-		return Credentials{}, fmt.Errorf("parse credentials file: %w", err)
+	name := f.ActiveContext()
+	f.Contexts[name] = creds
+	if makeCurrent || f.CurrentContext == "" {
+		f.CurrentContext = name
 	}
 
-	return creds, nil
+	return SaveFile(f)
 }
 
-// Delete removes the credentials file from disk (used on logout).
+// Delete removes the tokens of the active context from disk (used on logout).
+// The server and TLS settings of the context are kept for the next login.
 func Delete() error {
-	path, err := configFilePath()
+	f, err := LoadFile()
 	if err != nil {
 		return err
 	}
 
-	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
-		return fmt.Errorf("remove credentials file: %w", err)
+	name := f.ActiveContext()
+	creds, ok := f.Contexts[name]
+	if !ok {
+		return nil
 	}
 
-	return nil
+	f.Contexts[name] = Credentials{
+		ServerURL: creds.ServerURL,
+		Insecure:  creds.Insecure,
+		CAData:    creds.CAData,
+	}
+
+	return SaveFile(f)
+}
+
+// UseContext makes the named context the current context.
+func UseContext(name string) error {
+	f, err := LoadFile()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := f.Contexts[name]; !ok {
+		return fmt.Errorf("%w: %s", ErrContextNotFound, name)
+	}
+	f.CurrentContext = name
+
+	return SaveFile(f)
+}
+
+// DeleteContext removes the named context with its credentials. Deleting the current context
+// leaves no context current.
+func DeleteContext(name string) error {
+	f, err := LoadFile()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := f.Contexts[name]; !ok {
+		return fmt.Errorf("%w: %s", ErrContextNotFound, name)
+	}
+	delete(f.Contexts, name)
+	if f.CurrentContext == name {
+		f.CurrentContext = ""
+	}
+
+	return SaveFile(f)
 }
 
 // Made with Bob
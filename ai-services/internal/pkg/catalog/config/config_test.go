@@ -0,0 +1,171 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// setupConfigDir points the user config directory at a temporary directory and clears the
+// context selection.
+func setupConfigDir(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("HOME", dir)
+	t.Setenv(ContextEnvVar, "")
+	selectedContext = ""
+	t.Cleanup(func() { selectedContext = "" })
+
+	return dir
+}
+
+func TestLoadMigratesLegacyCredentials(t *testing.T) {
+	dir := setupConfigDir(t)
+
+	path := filepath.Join(dir, configDirName, configFileName)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	legacy := `{"server_url":"https://catalog.example.com","refresh_token":"refresh","access_token":"access","insecure":true}`
+	if err := os.WriteFile(path, []byte(legacy), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	creds, err := Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if creds.ServerURL != "https://catalog.example.com" || creds.RefreshToken != "refresh" || !creds.Insecure {
+		t.Errorf("unexpected credentials %+v", creds)
+	}
+
+	f, err := LoadFile()
+	if err != nil {
+		t.Fatalf("LoadFile failed: %v", err)
+	}
+	if f.CurrentContext != DefaultContextName {
+		t.Errorf("expected current context %q, got %q", DefaultContextName, f.CurrentContext)
+	}
+}
+
+func TestContextSelection(t *testing.T) {
+	setupConfigDir(t)
+
+	selectedContext = "dev"
+	if err := SaveLogin(Credentials{ServerURL: "https://dev.example.com", AccessToken: "dev"}); err != nil {
+		t.Fatal(err)
+	}
+	selectedContext = "prod"
+	if err := SaveLogin(Credentials{ServerURL: "https://prod.example.com", AccessToken: "prod"}); err != nil {
+		t.Fatal(err)
+	}
+	selectedContext = ""
+
+	tests := []struct {
+		name       string
+		env        string
+		flag       string
+		wantServer string
+	}{
+		// Logins with --context only make the first context current
+		{name: "current context", wantServer: "https://dev.example.com"},
+		{name: "environment variable", env: "prod", wantServer: "https://prod.example.com"},
+		{name: "flag overrides environment variable", env: "prod", flag: "dev", wantServer: "https://dev.example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(ContextEnvVar, tt.env)
+			selectedContext = tt.flag
+			defer func() { selectedContext = "" }()
+
+			creds, err := Load()
+			if err != nil {
+				t.Fatalf("Load failed: %v", err)
+			}
+			if creds.ServerURL != tt.wantServer {
+				t.Errorf("expected server %s, got %s", tt.wantServer, creds.ServerURL)
+			}
+		})
+	}
+
+	if err := UseContext("prod"); err != nil {
+		t.Fatalf("UseContext failed: %v", err)
+	}
+	if creds, err := Load(); err != nil || creds.ServerURL != "https://prod.example.com" {
+		t.Errorf("expected the prod context after use, got %+v (%v)", creds, err)
+	}
+
+	// A login to a context named with the environment variable keeps the current context
+	t.Setenv(ContextEnvVar, "dev")
+	if err := SaveLogin(Credentials{ServerURL: "https://dev.example.com", AccessToken: "dev2"}); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(ContextEnvVar, "")
+	if creds, err := Load(); err != nil || creds.ServerURL != "https://prod.example.com" {
+		t.Errorf("expected the prod context after login to dev, got %+v (%v)", creds, err)
+	}
+
+	// A login without a named context makes the active context current
+	if err := UseContext("dev"); err != nil {
+		t.Fatal(err)
+	}
+	if err := SaveLogin(Credentials{ServerURL: "https://dev.example.com", AccessToken: "dev3"}); err != nil {
+		t.Fatal(err)
+	}
+	if creds, err := Load(); err != nil || creds.AccessToken != "dev3" {
+		t.Errorf("expected the dev context after login, got %+v (%v)", creds, err)
+	}
+
+	if err := UseContext("missing"); !errors.Is(err, ErrContextNotFound) {
+		t.Errorf("expected ErrContextNotFound, got %v", err)
+	}
+}
+
+func TestDeleteKeepsServerSettings(t *testing.T) {
+	setupConfigDir(t)
+
+	if err := SaveLogin(Credentials{ServerURL: "https://catalog.example.com", AccessToken: "access", CAData: "ca"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := Delete(); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+
+	if _, err := Load(); !errors.Is(err, ErrNotLoggedIn) {
+		t.Errorf("expected ErrNotLoggedIn after logout, got %v", err)
+	}
+	name, target, err := LoadTarget()
+	if err != nil {
+		t.Fatalf("LoadTarget failed: %v", err)
+	}
+	if name != DefaultContextName || target.ServerURL != "https://catalog.example.com" || target.CAData != "ca" {
+		t.Errorf("expected the server settings to be kept, got %s %+v", name, target)
+	}
+
+	if err := DeleteContext(DefaultContextName); err != nil {
+		t.Fatalf("DeleteContext failed: %v", err)
+	}
+	f, err := LoadFile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(f.Contexts) != 0 || f.CurrentContext != "" {
+		t.Errorf("expected no contexts left, got %+v", f)
+	}
+}
+
+func TestTLSConfig(t *testing.T) {
+	if cfg, err := (Credentials{}).TLSConfig(); err != nil || cfg != nil {
+		t.Errorf("expected the system roots without TLS settings, got %v (%v)", cfg, err)
+	}
+	if cfg, err := (Credentials{Insecure: true}).TLSConfig(); err != nil || !cfg.InsecureSkipVerify {
+		t.Errorf("expected verification to be skipped, got %v (%v)", cfg, err)
+	}
+	if _, err := (Credentials{CAData: "not a certificate"}).TLSConfig(); err == nil {
+		t.Error("expected an error for a CA bundle without certificates")
+	}
+}
@@ -68,4 +68,50 @@ const (
 	DefaultHTTPSPort = "443"
 )
 
+// Backup constants.
+const (
+	// BackupDirEnv is the environment variable overriding the directory the API server writes
+	// backup archives to.
+	BackupDirEnv = "BACKUP_DIR"
+	// DefaultBackupDirName is the directory below the base directory that holds backup archives.
+	DefaultBackupDirName = "backups"
+)
+
+// RoutingModeEnv is the environment variable holding the routing mode of the Caddy routes of the
+// catalog and deployed services, one of the proxy routing modes.
+const RoutingModeEnv = "ROUTING_MODE"
+
+// Service authentication constants.
+const (
+	// ServiceAuthModeEnv is the environment variable selecting how the Caddy routes of deployed
+	// services authenticate requests.
+	ServiceAuthModeEnv = "SERVICE_AUTH_MODE"
+	// ServiceAuthModeNone leaves service routes open to any client.
+	ServiceAuthModeNone = "none"
+	// ServiceAuthModeForwardAuth admits access tokens of the application owner and API keys of the
+	// application, both verified by the API server.
+	ServiceAuthModeForwardAuth = "forward_auth"
+	// ServiceAuthModeAPIKey admits API keys of the application only.
+	ServiceAuthModeAPIKey = "api_key"
+	// DefaultServiceAuthMode is used when ServiceAuthModeEnv is not set.
+	DefaultServiceAuthMode = ServiceAuthModeForwardAuth
+	// ServiceAuthUpstreamEnv is the environment variable holding the address Caddy reaches the API
+	// server at to verify credentials.
+	ServiceAuthUpstreamEnv = "SERVICE_AUTH_UPSTREAM"
+	// DefaultServiceAuthUpstream is the API server container of the catalog pod.
+	DefaultServiceAuthUpstream = CatalogAppName + "--catalog:8080"
+	// ServiceAuthVerifyPath is the API server endpoint verifying credentials for Caddy.
+	ServiceAuthVerifyPath = "/api/v1/auth/verify"
+	// ServiceAuthSubjectHeader carries the verified user or API key to the service.
+	ServiceAuthSubjectHeader = "X-Auth-Subject"
+	// ServiceRouteTypeAPI is the route type of service APIs, the routes protected by authentication.
+	ServiceRouteTypeAPI = "api"
+	// ServiceRouteTypeUI is the route type of web interfaces.
+	ServiceRouteTypeUI = "ui"
+	// APIKeyPrefix starts every application API key, telling keys apart from access tokens.
+	APIKeyPrefix = "ais_"
+	// APIKeyHeader is the header carrying an API key, as an alternative to a Bearer token.
+	APIKeyHeader = "X-API-Key"
+)
+
 // Made with Bob
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Add 'Stopped' to the application, service and component status enums so that applications
+-- scaled down through the start/stop lifecycle endpoints can be represented.
+-- IF NOT EXISTS is used defensively; Postgres does not allow removing enum values,
+-- so this migration is intentionally irreversible.
+ALTER TYPE status ADD VALUE IF NOT EXISTS 'Stopped';
+ALTER TYPE service_status ADD VALUE IF NOT EXISTS 'Stopped';
+ALTER TYPE component_status ADD VALUE IF NOT EXISTS 'Stopped';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- NOTE: Postgres does not support removing values from an enum type.
+-- Rolling back this migration is a no-op; 'Stopped' will remain in status, service_status
+-- and component_status.
+-- +goose StatementEnd
@@ -0,0 +1,79 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Backup status enum
+CREATE TYPE backup_status AS ENUM (
+    'InProgress',
+    'Completed',
+    'Failed'
+);
+
+-- Where a backup archive is stored.
+CREATE TYPE backup_destination AS ENUM (
+    'local',
+    'connector'
+);
+
+-- ── backup_policies ────────────────────────────────────────────────────────────
+-- Scheduled backups of one application target.
+--
+-- schedule:     standard 5-field cron expression evaluated in the server time zone.
+-- connector_id: object storage connector receiving the archives; NULL for local disk.
+-- retention:    number of completed backups kept for the policy; older ones are removed.
+-- last_run_at:  minute the policy last fired, used to avoid running twice per minute.
+-- ──────────────────────────────────────────────────────────────────────────────
+CREATE TABLE backup_policies (
+    id             UUID               PRIMARY KEY DEFAULT gen_random_uuid(),
+    application_id UUID               NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    target         VARCHAR(64)        NOT NULL,
+    schedule       VARCHAR(255)       NOT NULL,
+    destination    backup_destination NOT NULL DEFAULT 'local',
+    connector_id   UUID               REFERENCES connectors(id) ON DELETE RESTRICT,
+    retention      INTEGER            NOT NULL CHECK (retention > 0),
+    enabled        BOOLEAN            NOT NULL DEFAULT TRUE,
+    last_run_at    TIMESTAMPTZ,
+    created_by     VARCHAR(100)       NOT NULL,
+    created_at     TIMESTAMPTZ        NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMPTZ        NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_backup_policies_application_id ON backup_policies (application_id);
+
+-- ── backups ────────────────────────────────────────────────────────────────────
+-- One row per backup archive, whether taken on demand or by a policy.
+--
+-- location:   absolute path on the API server for local backups, object key for
+--             connector backups.
+-- size_bytes: archive size once the backup has completed.
+-- ──────────────────────────────────────────────────────────────────────────────
+CREATE TABLE backups (
+    id             UUID               PRIMARY KEY DEFAULT gen_random_uuid(),
+    application_id UUID               NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    policy_id      UUID               REFERENCES backup_policies(id) ON DELETE SET NULL,
+    target         VARCHAR(64)        NOT NULL,
+    destination    backup_destination NOT NULL DEFAULT 'local',
+    connector_id   UUID               REFERENCES connectors(id) ON DELETE SET NULL,
+    location       TEXT,
+    size_bytes     BIGINT             NOT NULL DEFAULT 0,
+    status         backup_status      NOT NULL DEFAULT 'InProgress',
+    message        TEXT,
+    created_by     VARCHAR(100)       NOT NULL,
+    created_at     TIMESTAMPTZ        NOT NULL DEFAULT NOW(),
+    completed_at   TIMESTAMPTZ
+);
+
+CREATE INDEX idx_backups_application_id ON backups (application_id);
+CREATE INDEX idx_backups_policy_id      ON backups (policy_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_backups_policy_id;
+DROP INDEX IF EXISTS idx_backups_application_id;
+DROP TABLE IF EXISTS backups;
+DROP INDEX IF EXISTS idx_backup_policies_application_id;
+DROP TABLE IF EXISTS backup_policies;
+DROP TYPE IF EXISTS backup_destination;
+DROP TYPE IF EXISTS backup_status;
+-- +goose StatementEnd
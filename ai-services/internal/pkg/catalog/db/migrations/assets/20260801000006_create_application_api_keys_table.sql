@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- ── application_api_keys ───────────────────────────────────────────────────────
+-- API keys granting access to the service endpoints of one application through
+-- the Caddy proxy. Only a hash of each key is stored; the key itself is shown
+-- once, when it is created or rotated.
+--
+-- prefix:     leading characters of the key, shown to tell keys apart.
+-- key_hash:   hex-encoded SHA-256 of the full key, looked up on every request.
+-- rotated_at: when the key was last replaced; NULL if never rotated.
+-- ──────────────────────────────────────────────────────────────────────────────
+CREATE TABLE application_api_keys (
+    id             UUID         PRIMARY KEY DEFAULT gen_random_uuid(),
+    application_id UUID         NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    name           VARCHAR(100) NOT NULL,
+    prefix         VARCHAR(16)  NOT NULL,
+    key_hash       CHAR(64)     NOT NULL UNIQUE,
+    created_by     VARCHAR(100) NOT NULL,
+    created_at     TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    rotated_at     TIMESTAMPTZ,
+    UNIQUE (application_id, name)
+);
+
+CREATE INDEX idx_application_api_keys_application_id ON application_api_keys (application_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_application_api_keys_application_id;
+DROP TABLE IF EXISTS application_api_keys;
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- ── accelerator_allocations ───────────────────────────────────────────────────
+-- Ledger of the accelerator cards reserved by applications, keyed by the PCI
+-- address of the card. Cards are reserved for the whole application when its
+-- deployment is planned and stay reserved while the application is stopped;
+-- they are released when the application is deleted.
+--
+-- application_id: owning application. Not a foreign key: cards are reserved
+--                 before the application row is inserted.
+-- component_id:   component the card was assigned to during deployment; NULL
+--                 until the component pods are created.
+-- ──────────────────────────────────────────────────────────────────────────────
+CREATE TABLE accelerator_allocations (
+    pci_address    VARCHAR(32)  PRIMARY KEY,
+    resource_name  VARCHAR(100) NOT NULL,
+    application_id UUID         NOT NULL,
+    component_id   UUID         REFERENCES components(id) ON DELETE SET NULL,
+    reserved_at    TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_accelerator_allocations_application_id ON accelerator_allocations (application_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_accelerator_allocations_application_id;
+DROP TABLE IF EXISTS accelerator_allocations;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Add 'Starting' and 'Stopping' to the application status enum, so that an application being
+-- started, stopped or restarted through the lifecycle endpoints cannot be changed or deleted
+-- until the action completes.
+-- Postgres does not allow removing enum values, so this migration is intentionally irreversible.
+ALTER TYPE status ADD VALUE IF NOT EXISTS 'Starting';
+ALTER TYPE status ADD VALUE IF NOT EXISTS 'Stopping';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- NOTE: Postgres does not support removing values from an enum type.
+-- Rolling back this migration is a no-op; 'Starting' and 'Stopping' will remain in status.
+-- +goose StatementEnd
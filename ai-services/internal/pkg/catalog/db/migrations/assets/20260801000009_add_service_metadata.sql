@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Record the non-secret params and route policy overrides a service was deployed with, so that
+-- application definitions can be exported with them.
+ALTER TABLE services ADD COLUMN IF NOT EXISTS metadata JSONB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE services DROP COLUMN IF EXISTS metadata;
+-- +goose StatementEnd
@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Keep backup records when their application is deleted, so that they can be restored into an
+-- application of the same name. The application name and catalog ID are stored on the row
+-- because the application row is gone by then. Connectors holding backups cannot be deleted,
+-- since the connector is the only reference to where a remote archive is stored.
+ALTER TABLE backups ADD COLUMN IF NOT EXISTS application_name VARCHAR(100);
+ALTER TABLE backups ADD COLUMN IF NOT EXISTS catalog_id VARCHAR(100);
+
+UPDATE backups b
+SET application_name = a.name,
+    catalog_id       = a.catalog_id
+FROM applications a
+WHERE a.id = b.application_id;
+
+ALTER TABLE backups ALTER COLUMN application_name SET NOT NULL;
+ALTER TABLE backups ALTER COLUMN catalog_id SET NOT NULL;
+
+ALTER TABLE backups ALTER COLUMN application_id DROP NOT NULL;
+ALTER TABLE backups DROP CONSTRAINT IF EXISTS backups_application_id_fkey;
+ALTER TABLE backups ADD CONSTRAINT backups_application_id_fkey
+    FOREIGN KEY (application_id) REFERENCES applications(id) ON DELETE SET NULL;
+
+ALTER TABLE backups DROP CONSTRAINT IF EXISTS backups_connector_id_fkey;
+ALTER TABLE backups ADD CONSTRAINT backups_connector_id_fkey
+    FOREIGN KEY (connector_id) REFERENCES connectors(id) ON DELETE RESTRICT;
+
+CREATE INDEX IF NOT EXISTS idx_backups_application_name ON backups (application_name);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_backups_application_name;
+
+ALTER TABLE backups DROP CONSTRAINT IF EXISTS backups_connector_id_fkey;
+ALTER TABLE backups ADD CONSTRAINT backups_connector_id_fkey
+    FOREIGN KEY (connector_id) REFERENCES connectors(id) ON DELETE SET NULL;
+
+DELETE FROM backups WHERE application_id IS NULL;
+ALTER TABLE backups DROP CONSTRAINT IF EXISTS backups_application_id_fkey;
+ALTER TABLE backups ADD CONSTRAINT backups_application_id_fkey
+    FOREIGN KEY (application_id) REFERENCES applications(id) ON DELETE CASCADE;
+ALTER TABLE backups ALTER COLUMN application_id SET NOT NULL;
+
+ALTER TABLE backups DROP COLUMN IF EXISTS catalog_id;
+ALTER TABLE backups DROP COLUMN IF EXISTS application_name;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AcceleratorAllocation records the reservation of an accelerator card by an application.
+// ApplicationName, ApplicationStatus and ComponentType are filled in when listing the ledger
+// and are empty if the owning application or component no longer exists.
+type AcceleratorAllocation struct {
+	PCIAddress        string            `json:"pci_address"`
+	ResourceName      string            `json:"resource_name"`
+	ApplicationID     uuid.UUID         `json:"application_id"`
+	ApplicationName   string            `json:"application_name,omitempty"`
+	ApplicationStatus ApplicationStatus `json:"application_status,omitempty"`
+	ComponentID       *uuid.UUID        `json:"component_id,omitempty"`
+	ComponentType     string            `json:"component_type,omitempty"`
+	ReservedAt        time.Time         `json:"reserved_at"`
+}
+
+// Made with Bob
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// APIKey grants access to the service endpoints of an application. Only the hash of the key is
+// stored, so the key itself is never part of the model.
+type APIKey struct {
+	ID            uuid.UUID  `json:"id"`
+	ApplicationID uuid.UUID  `json:"application_id"`
+	Name          string     `json:"name"`
+	Prefix        string     `json:"prefix"`
+	KeyHash       string     `json:"-"`
+	CreatedBy     string     `json:"created_by"`
+	CreatedAt     time.Time  `json:"created_at"`
+	RotatedAt     *time.Time `json:"rotated_at,omitempty"`
+}
+
+// Made with Bob
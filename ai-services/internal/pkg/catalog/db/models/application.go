@@ -22,6 +22,9 @@ const (
 	ApplicationStatusDeploying   ApplicationStatus = "Deploying"
 	ApplicationStatusRunning     ApplicationStatus = "Running"
 	ApplicationStatusDeleting    ApplicationStatus = "Deleting"
+	ApplicationStatusStopped     ApplicationStatus = "Stopped"
+	ApplicationStatusStarting    ApplicationStatus = "Starting"
+	ApplicationStatusStopping    ApplicationStatus = "Stopping"
 	ApplicationStatusError       ApplicationStatus = "Error"
 )
 
@@ -31,6 +34,7 @@ type ServiceStatus string
 const (
 	ServiceStatusInitializing ServiceStatus = "Initializing"
 	ServiceStatusRunning      ServiceStatus = "Running"
+	ServiceStatusStopped      ServiceStatus = "Stopped"
 	ServiceStatusError        ServiceStatus = "Error"
 )
 
@@ -40,6 +44,7 @@ type ComponentStatus string
 const (
 	ComponentStatusInitializing ComponentStatus = "Initializing"
 	ComponentStatusRunning      ComponentStatus = "Running"
+	ComponentStatusStopped      ComponentStatus = "Stopped"
 	ComponentStatusError        ComponentStatus = "Error"
 )
 
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BackupStatus represents the state of a backup archive.
+type BackupStatus string
+
+const (
+	BackupStatusInProgress BackupStatus = "InProgress"
+	BackupStatusCompleted  BackupStatus = "Completed"
+	BackupStatusFailed     BackupStatus = "Failed"
+)
+
+// BackupDestination represents where a backup archive is stored.
+type BackupDestination string
+
+const (
+	// BackupDestinationLocal stores archives on the API server's disk.
+	BackupDestinationLocal BackupDestination = "local"
+	// BackupDestinationConnector stores archives in an object storage connector.
+	BackupDestinationConnector BackupDestination = "connector"
+)
+
+// BackupPolicy schedules recurring backups of one application target.
+type BackupPolicy struct {
+	ID            uuid.UUID         `json:"id"`
+	ApplicationID uuid.UUID         `json:"application_id"`
+	Target        string            `json:"target"`
+	Schedule      string            `json:"schedule"`
+	Destination   BackupDestination `json:"destination"`
+	ConnectorID   *uuid.UUID        `json:"connector_id,omitempty"`
+	Retention     int               `json:"retention"`
+	Enabled       bool              `json:"enabled"`
+	LastRunAt     *time.Time        `json:"last_run_at,omitempty"`
+	CreatedBy     string            `json:"created_by"`
+	CreatedAt     time.Time         `json:"created_at"`
+	UpdatedAt     time.Time         `json:"updated_at"`
+}
+
+// Backup represents one backup archive of an application target. Backups outlive their
+// application: ApplicationID is nil once the application is deleted, and the backup can then be
+// restored into a new application with the same name and catalog ID.
+type Backup struct {
+	ID              uuid.UUID         `json:"id"`
+	ApplicationID   *uuid.UUID        `json:"application_id,omitempty"`
+	ApplicationName string            `json:"application_name"`
+	CatalogID       string            `json:"catalog_id"`
+	PolicyID        *uuid.UUID        `json:"policy_id,omitempty"`
+	Target          string            `json:"target"`
+	Destination     BackupDestination `json:"destination"`
+	ConnectorID     *uuid.UUID        `json:"connector_id,omitempty"`
+	Location        string            `json:"location,omitempty"`
+	SizeBytes       int64             `json:"size_bytes"`
+	Status          BackupStatus      `json:"status"`
+	Message         string            `json:"message,omitempty"`
+	CreatedBy       string            `json:"created_by"`
+	CreatedAt       time.Time         `json:"created_at"`
+	CompletedAt     *time.Time        `json:"completed_at,omitempty"`
+}
+
+// Made with Bob
@@ -16,6 +16,7 @@ type Service struct {
 	Endpoints []map[string]any `json:"endpoints,omitempty"`
 	Component Component        `json:"component,omitempty"`
 	Version   string           `json:"version"`
+	Metadata  map[string]any   `json:"metadata,omitempty"`
 	CreatedAt time.Time        `json:"created_at"`
 	UpdatedAt time.Time        `json:"updated_at"`
 }
@@ -0,0 +1,218 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/db/models"
+)
+
+// InsufficientAcceleratorsError is returned by Reserve when fewer unreserved cards than requested
+// are available.
+type InsufficientAcceleratorsError struct {
+	Required  int
+	Available int
+}
+
+func (e *InsufficientAcceleratorsError) Error() string {
+	return fmt.Sprintf("insufficient accelerator cards: required %d, available %d", e.Required, e.Available)
+}
+
+// AcceleratorAllocationRepository defines the interface for the accelerator reservation ledger.
+type AcceleratorAllocationRepository interface {
+	// Reserve atomically reserves count of the candidate cards for an application, skipping cards
+	// already reserved by any application, and returns the reserved PCI addresses. Candidates are
+	// taken in order. Returns *InsufficientAcceleratorsError and reserves nothing if fewer than
+	// count candidates are unreserved.
+	Reserve(ctx context.Context, applicationID uuid.UUID, resourceName string, candidates []string, count int) ([]string, error)
+	// AssignComponent records the component the given cards of an application were assigned to.
+	AssignComponent(ctx context.Context, applicationID, componentID uuid.UUID, pciAddresses []string) error
+	// Release removes the reservations of the given cards of an application, e.g. when the
+	// deployment they were reserved for cannot be planned.
+	Release(ctx context.Context, applicationID uuid.UUID, pciAddresses []string) error
+	// ReleaseByApplication removes all reservations of an application and returns how many were removed.
+	ReleaseByApplication(ctx context.Context, applicationID uuid.UUID) (int64, error)
+	// List returns all reservations ordered by PCI address, with the name and status of the owning
+	// application and the type of the owning component.
+	List(ctx context.Context) ([]models.AcceleratorAllocation, error)
+}
+
+// acceleratorAllocationRepo implements AcceleratorAllocationRepository using pgx.
+type acceleratorAllocationRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewAcceleratorAllocationRepository creates a new AcceleratorAllocationRepository instance.
+func NewAcceleratorAllocationRepository(pool *pgxpool.Pool) AcceleratorAllocationRepository {
+	return &acceleratorAllocationRepo{pool: pool}
+}
+
+// deleteAbandonedReservationsQuery removes reservations whose application record was never inserted,
+// e.g. because the API server stopped between planning and persisting a deployment. Recent
+// reservations are kept as their deployment may still be persisting.
+const deleteAbandonedReservationsQuery = `
+	DELETE FROM accelerator_allocations a
+	WHERE a.reserved_at < NOW() - INTERVAL '15 minutes'
+	  AND NOT EXISTS (SELECT 1 FROM applications app WHERE app.id = a.application_id)
+`
+
+// Reserve reserves accelerator cards for an application.
+func (r *acceleratorAllocationRepo) Reserve(
+	ctx context.Context,
+	applicationID uuid.UUID,
+	resourceName string,
+	candidates []string,
+	count int,
+) ([]string, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin accelerator reservation: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	// The lock conflicts with itself, so concurrent reservations run one at a time and never pick
+	// the same card. Reads of the ledger are not blocked.
+	if _, err := tx.Exec(ctx, `LOCK TABLE accelerator_allocations IN SHARE ROW EXCLUSIVE MODE`); err != nil {
+		return nil, fmt.Errorf("failed to lock accelerator allocations: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, deleteAbandonedReservationsQuery); err != nil {
+		return nil, fmt.Errorf("failed to delete abandoned accelerator reservations: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, `SELECT pci_address FROM accelerator_allocations WHERE pci_address = ANY($1)`, candidates)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query accelerator allocations: %w", err)
+	}
+	taken, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan accelerator allocation row: %w", err)
+	}
+
+	available := UnreservedAddresses(candidates, taken)
+	if len(available) < count {
+		return nil, &InsufficientAcceleratorsError{Required: count, Available: len(available)}
+	}
+	reserved := available[:count]
+
+	query := `
+		INSERT INTO accelerator_allocations (pci_address, resource_name, application_id)
+		SELECT unnest($1::varchar[]), $2, $3
+	`
+	if _, err := tx.Exec(ctx, query, reserved, resourceName, applicationID); err != nil {
+		return nil, fmt.Errorf("failed to insert accelerator allocations: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit accelerator reservation: %w", err)
+	}
+
+	return reserved, nil
+}
+
+// UnreservedAddresses returns the candidates that are not in reserved, keeping their order.
+func UnreservedAddresses(candidates, reserved []string) []string {
+	taken := make(map[string]bool, len(reserved))
+	for _, addr := range reserved {
+		taken[addr] = true
+	}
+
+	available := make([]string, 0, len(candidates))
+	for _, addr := range candidates {
+		if !taken[addr] {
+			available = append(available, addr)
+			taken[addr] = true
+		}
+	}
+
+	return available
+}
+
+// AssignComponent records the owning component of reserved cards.
+func (r *acceleratorAllocationRepo) AssignComponent(ctx context.Context, applicationID, componentID uuid.UUID, pciAddresses []string) error {
+	query := `
+		UPDATE accelerator_allocations
+		SET component_id = $1
+		WHERE application_id = $2 AND pci_address = ANY($3)
+	`
+
+	if _, err := r.pool.Exec(ctx, query, componentID, applicationID, pciAddresses); err != nil {
+		return fmt.Errorf("failed to assign accelerator cards to component %q: %w", componentID, err)
+	}
+
+	return nil
+}
+
+// Release removes the reservations of the given cards of an application.
+func (r *acceleratorAllocationRepo) Release(ctx context.Context, applicationID uuid.UUID, pciAddresses []string) error {
+	query := `DELETE FROM accelerator_allocations WHERE application_id = $1 AND pci_address = ANY($2)`
+	if _, err := r.pool.Exec(ctx, query, applicationID, pciAddresses); err != nil {
+		return fmt.Errorf("failed to release accelerator cards of application %q: %w", applicationID, err)
+	}
+
+	return nil
+}
+
+// ReleaseByApplication removes all reservations of an application.
+func (r *acceleratorAllocationRepo) ReleaseByApplication(ctx context.Context, applicationID uuid.UUID) (int64, error) {
+	tag, err := r.pool.Exec(ctx, `DELETE FROM accelerator_allocations WHERE application_id = $1`, applicationID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to release accelerator cards of application %q: %w", applicationID, err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// List returns all reservations.
+func (r *acceleratorAllocationRepo) List(ctx context.Context) ([]models.AcceleratorAllocation, error) {
+	query := `
+		SELECT a.pci_address, a.resource_name, a.application_id, a.component_id, a.reserved_at,
+		       app.name, app.status::text, c.type
+		FROM accelerator_allocations a
+		LEFT JOIN applications app ON app.id = a.application_id
+		LEFT JOIN components c ON c.id = a.component_id
+		ORDER BY a.pci_address ASC
+	`
+
+	rows, err := r.pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query accelerator allocations: %w", err)
+	}
+	defer rows.Close()
+
+	allocations := []models.AcceleratorAllocation{}
+	for rows.Next() {
+		var (
+			a                  models.AcceleratorAllocation
+			componentID        uuid.NullUUID
+			appName, appStatus sql.NullString
+			componentType      sql.NullString
+		)
+
+		if err := rows.Scan(&a.PCIAddress, &a.ResourceName, &a.ApplicationID, &componentID, &a.ReservedAt,
+			&appName, &appStatus, &componentType); err != nil {
+			return nil, fmt.Errorf("failed to scan accelerator allocation row: %w", err)
+		}
+
+		if componentID.Valid {
+			a.ComponentID = &componentID.UUID
+		}
+		a.ApplicationName = appName.String
+		a.ApplicationStatus = models.ApplicationStatus(appStatus.String)
+		a.ComponentType = componentType.String
+
+		allocations = append(allocations, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating accelerator allocation rows: %w", err)
+	}
+
+	return allocations, nil
+}
+
+// Made with Bob
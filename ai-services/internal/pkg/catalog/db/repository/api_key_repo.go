@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/db/models"
+)
+
+// ErrAPIKeyNameExists is returned by Insert when the application already has a key with the name.
+var ErrAPIKeyNameExists = errors.New("API key name already exists")
+
+const (
+	// uniqueViolation is the PostgreSQL error code of a unique constraint violation.
+	uniqueViolation = "23505"
+	// apiKeyNameConstraint is the unique constraint on the application and name of a key.
+	apiKeyNameConstraint = "application_api_keys_application_id_name_key"
+)
+
+// APIKeyRepository defines the interface for application API key data operations.
+type APIKeyRepository interface {
+	// Insert creates an API key, populating ID and CreatedAt on success. Returns
+	// ErrAPIKeyNameExists if the application already has a key with the same name.
+	Insert(ctx context.Context, key *models.APIKey) error
+	// GetByID retrieves an API key by ID. Returns (nil, nil) if it does not exist.
+	GetByID(ctx context.Context, id uuid.UUID) (*models.APIKey, error)
+	// GetByHash retrieves an API key by the hash of the key. Returns (nil, nil) if it does not exist.
+	GetByHash(ctx context.Context, keyHash string) (*models.APIKey, error)
+	// ListByApplication returns the API keys of an application ordered by creation time.
+	ListByApplication(ctx context.Context, applicationID uuid.UUID) ([]models.APIKey, error)
+	// Rotate replaces the prefix and hash of an API key, populating RotatedAt on success.
+	Rotate(ctx context.Context, key *models.APIKey) error
+	// Delete removes an API key. Returns (false, nil) if no row matched.
+	Delete(ctx context.Context, id uuid.UUID) (bool, error)
+}
+
+// apiKeyRepo implements APIKeyRepository using pgx.
+type apiKeyRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewAPIKeyRepository creates a new APIKeyRepository instance.
+func NewAPIKeyRepository(pool *pgxpool.Pool) APIKeyRepository {
+	return &apiKeyRepo{pool: pool}
+}
+
+const apiKeyColumns = `id, application_id, name, prefix, key_hash, created_by, created_at, rotated_at`
+
+func scanAPIKey(row pgx.Row) (*models.APIKey, error) {
+	var (
+		k       models.APIKey
+		rotated sql.NullTime
+	)
+
+	if err := row.Scan(&k.ID, &k.ApplicationID, &k.Name, &k.Prefix, &k.KeyHash, &k.CreatedBy, &k.CreatedAt, &rotated); err != nil {
+		return nil, err
+	}
+
+	if rotated.Valid {
+		k.RotatedAt = &rotated.Time
+	}
+
+	return &k, nil
+}
+
+// Insert creates an API key.
+func (r *apiKeyRepo) Insert(ctx context.Context, key *models.APIKey) error {
+	query := `
+		INSERT INTO application_api_keys (application_id, name, prefix, key_hash, created_by)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at
+	`
+
+	err := r.pool.QueryRow(ctx, query,
+		key.ApplicationID,
+		key.Name,
+		key.Prefix,
+		key.KeyHash,
+		key.CreatedBy,
+	).Scan(&key.ID, &key.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && pgErr.ConstraintName == apiKeyNameConstraint {
+			return ErrAPIKeyNameExists
+		}
+
+		return fmt.Errorf("failed to insert API key: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves an API key by ID.
+func (r *apiKeyRepo) GetByID(ctx context.Context, id uuid.UUID) (*models.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM application_api_keys WHERE id = $1`
+
+	return r.getOne(ctx, query, id)
+}
+
+// GetByHash retrieves an API key by the hash of the key.
+func (r *apiKeyRepo) GetByHash(ctx context.Context, keyHash string) (*models.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM application_api_keys WHERE key_hash = $1`
+
+	return r.getOne(ctx, query, keyHash)
+}
+
+func (r *apiKeyRepo) getOne(ctx context.Context, query string, args ...any) (*models.APIKey, error) {
+	key, err := scanAPIKey(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get API key: %w", err)
+	}
+
+	return key, nil
+}
+
+// ListByApplication returns the API keys of an application.
+func (r *apiKeyRepo) ListByApplication(ctx context.Context, applicationID uuid.UUID) ([]models.APIKey, error) {
+	query := `SELECT ` + apiKeyColumns + ` FROM application_api_keys WHERE application_id = $1 ORDER BY created_at ASC`
+
+	rows, err := r.pool.Query(ctx, query, applicationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query API keys: %w", err)
+	}
+	defer rows.Close()
+
+	keys := []models.APIKey{}
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan API key row: %w", err)
+		}
+		keys = append(keys, *key)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating API key rows: %w", err)
+	}
+
+	return keys, nil
+}
+
+// Rotate replaces the prefix and hash of an API key.
+func (r *apiKeyRepo) Rotate(ctx context.Context, key *models.APIKey) error {
+	query := `
+		UPDATE application_api_keys
+		SET prefix = $1, key_hash = $2, rotated_at = NOW()
+		WHERE id = $3
+		RETURNING rotated_at
+	`
+
+	var rotated sql.NullTime
+	if err := r.pool.QueryRow(ctx, query, key.Prefix, key.KeyHash, key.ID).Scan(&rotated); err != nil {
+		return fmt.Errorf("failed to rotate API key %q: %w", key.ID, err)
+	}
+	key.RotatedAt = &rotated.Time
+
+	return nil
+}
+
+// Delete removes an API key.
+func (r *apiKeyRepo) Delete(ctx context.Context, id uuid.UUID) (bool, error) {
+	tag, err := r.pool.Exec(ctx, `DELETE FROM application_api_keys WHERE id = $1`, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete API key %q: %w", id, err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// Made with Bob
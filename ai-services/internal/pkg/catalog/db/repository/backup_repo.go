@@ -0,0 +1,352 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/db/models"
+)
+
+// BackupUpdate carries the fields written when a backup finishes.
+type BackupUpdate struct {
+	Status    models.BackupStatus
+	Message   string
+	Location  string
+	SizeBytes int64
+}
+
+// BackupRepository defines the interface for backup and backup policy data operations.
+type BackupRepository interface {
+	// InsertPolicy creates a backup policy, populating ID, CreatedAt and UpdatedAt on success.
+	InsertPolicy(ctx context.Context, policy *models.BackupPolicy) error
+	// GetPolicy retrieves a backup policy by ID. Returns (nil, nil) if it does not exist.
+	GetPolicy(ctx context.Context, id uuid.UUID) (*models.BackupPolicy, error)
+	// ListPoliciesByApplication returns the policies of an application ordered by creation time.
+	ListPoliciesByApplication(ctx context.Context, applicationID uuid.UUID) ([]models.BackupPolicy, error)
+	// ListEnabledPolicies returns every enabled policy.
+	ListEnabledPolicies(ctx context.Context) ([]models.BackupPolicy, error)
+	// UpdatePolicyLastRun records the minute a policy last fired.
+	UpdatePolicyLastRun(ctx context.Context, id uuid.UUID, lastRun time.Time) error
+	// DeletePolicy removes a policy. Returns (false, nil) if no row matched.
+	DeletePolicy(ctx context.Context, id uuid.UUID) (bool, error)
+
+	// Insert creates a backup record, populating ID and CreatedAt on success.
+	Insert(ctx context.Context, backup *models.Backup) error
+	// GetByID retrieves a backup by ID. Returns (nil, nil) if it does not exist.
+	GetByID(ctx context.Context, id uuid.UUID) (*models.Backup, error)
+	// ListByApplication returns the backups of an application, newest first, together with the
+	// backups owner took of deleted applications of the same name.
+	ListByApplication(ctx context.Context, applicationID uuid.UUID, applicationName, owner string) ([]models.Backup, error)
+	// ListCompletedByPolicy returns the completed backups taken by a policy, newest first.
+	ListCompletedByPolicy(ctx context.Context, policyID uuid.UUID) ([]models.Backup, error)
+	// Complete sets the final status, message, location and size of a backup.
+	Complete(ctx context.Context, id uuid.UUID, update BackupUpdate) error
+	// UpdateMessage replaces the message of a backup, e.g. with the outcome of a restore.
+	UpdateMessage(ctx context.Context, id uuid.UUID, message string) error
+	// FailInProgress marks every InProgress backup as Failed with the given message and returns
+	// the number of backups updated. Used at startup for backups interrupted by a restart.
+	FailInProgress(ctx context.Context, message string) (int64, error)
+	// Delete removes a backup record.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// backupRepo implements BackupRepository using pgx.
+type backupRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewBackupRepository creates a new BackupRepository instance.
+func NewBackupRepository(pool *pgxpool.Pool) BackupRepository {
+	return &backupRepo{pool: pool}
+}
+
+const policyColumns = `id, application_id, target, schedule, destination, connector_id, retention,
+	enabled, last_run_at, created_by, created_at, updated_at`
+
+const backupColumns = `id, application_id, application_name, catalog_id, policy_id, target, destination,
+	connector_id, location, size_bytes, status, message, created_by, created_at, completed_at`
+
+func scanPolicy(row pgx.Row) (*models.BackupPolicy, error) {
+	var (
+		p       models.BackupPolicy
+		lastRun sql.NullTime
+	)
+
+	if err := row.Scan(
+		&p.ID, &p.ApplicationID, &p.Target, &p.Schedule, &p.Destination, &p.ConnectorID, &p.Retention,
+		&p.Enabled, &lastRun, &p.CreatedBy, &p.CreatedAt, &p.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if lastRun.Valid {
+		p.LastRunAt = &lastRun.Time
+	}
+
+	return &p, nil
+}
+
+func scanBackup(row pgx.Row) (*models.Backup, error) {
+	var (
+		b         models.Backup
+		location  sql.NullString
+		message   sql.NullString
+		completed sql.NullTime
+	)
+
+	if err := row.Scan(
+		&b.ID, &b.ApplicationID, &b.ApplicationName, &b.CatalogID, &b.PolicyID, &b.Target, &b.Destination, &b.ConnectorID, &location,
+		&b.SizeBytes, &b.Status, &message, &b.CreatedBy, &b.CreatedAt, &completed,
+	); err != nil {
+		return nil, err
+	}
+
+	b.Location = location.String
+	b.Message = message.String
+	if completed.Valid {
+		b.CompletedAt = &completed.Time
+	}
+
+	return &b, nil
+}
+
+// InsertPolicy creates a backup policy.
+func (r *backupRepo) InsertPolicy(ctx context.Context, policy *models.BackupPolicy) error {
+	query := `
+		INSERT INTO backup_policies (application_id, target, schedule, destination, connector_id, retention, enabled, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at, updated_at
+	`
+
+	err := r.pool.QueryRow(ctx, query,
+		policy.ApplicationID,
+		policy.Target,
+		policy.Schedule,
+		policy.Destination,
+		policy.ConnectorID,
+		policy.Retention,
+		policy.Enabled,
+		policy.CreatedBy,
+	).Scan(&policy.ID, &policy.CreatedAt, &policy.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert backup policy: %w", err)
+	}
+
+	return nil
+}
+
+// GetPolicy retrieves a backup policy by ID.
+func (r *backupRepo) GetPolicy(ctx context.Context, id uuid.UUID) (*models.BackupPolicy, error) {
+	query := `SELECT ` + policyColumns + ` FROM backup_policies WHERE id = $1`
+
+	policy, err := scanPolicy(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get backup policy: %w", err)
+	}
+
+	return policy, nil
+}
+
+// ListPoliciesByApplication returns the policies of an application.
+func (r *backupRepo) ListPoliciesByApplication(ctx context.Context, applicationID uuid.UUID) ([]models.BackupPolicy, error) {
+	query := `SELECT ` + policyColumns + ` FROM backup_policies WHERE application_id = $1 ORDER BY created_at ASC`
+
+	return r.queryPolicies(ctx, query, applicationID)
+}
+
+// ListEnabledPolicies returns every enabled policy.
+func (r *backupRepo) ListEnabledPolicies(ctx context.Context) ([]models.BackupPolicy, error) {
+	query := `SELECT ` + policyColumns + ` FROM backup_policies WHERE enabled ORDER BY created_at ASC`
+
+	return r.queryPolicies(ctx, query)
+}
+
+func (r *backupRepo) queryPolicies(ctx context.Context, query string, args ...any) ([]models.BackupPolicy, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query backup policies: %w", err)
+	}
+	defer rows.Close()
+
+	policies := []models.BackupPolicy{}
+	for rows.Next() {
+		policy, err := scanPolicy(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan backup policy row: %w", err)
+		}
+		policies = append(policies, *policy)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating backup policy rows: %w", err)
+	}
+
+	return policies, nil
+}
+
+// UpdatePolicyLastRun records the minute a policy last fired.
+func (r *backupRepo) UpdatePolicyLastRun(ctx context.Context, id uuid.UUID, lastRun time.Time) error {
+	query := `UPDATE backup_policies SET last_run_at = $1, updated_at = NOW() WHERE id = $2`
+
+	if _, err := r.pool.Exec(ctx, query, lastRun, id); err != nil {
+		return fmt.Errorf("failed to update backup policy %q: %w", id, err)
+	}
+
+	return nil
+}
+
+// DeletePolicy removes a policy.
+func (r *backupRepo) DeletePolicy(ctx context.Context, id uuid.UUID) (bool, error) {
+	tag, err := r.pool.Exec(ctx, `DELETE FROM backup_policies WHERE id = $1`, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete backup policy %q: %w", id, err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// Insert creates a backup record.
+func (r *backupRepo) Insert(ctx context.Context, backup *models.Backup) error {
+	query := `
+		INSERT INTO backups (application_id, application_name, catalog_id, policy_id, target, destination, connector_id, status, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, created_at
+	`
+
+	err := r.pool.QueryRow(ctx, query,
+		backup.ApplicationID,
+		backup.ApplicationName,
+		backup.CatalogID,
+		backup.PolicyID,
+		backup.Target,
+		backup.Destination,
+		backup.ConnectorID,
+		backup.Status,
+		backup.CreatedBy,
+	).Scan(&backup.ID, &backup.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert backup: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a backup by ID.
+func (r *backupRepo) GetByID(ctx context.Context, id uuid.UUID) (*models.Backup, error) {
+	query := `SELECT ` + backupColumns + ` FROM backups WHERE id = $1`
+
+	backup, err := scanBackup(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get backup: %w", err)
+	}
+
+	return backup, nil
+}
+
+// ListByApplication returns the backups of an application and the backups owner took of deleted
+// applications of the same name, newest first.
+func (r *backupRepo) ListByApplication(ctx context.Context, applicationID uuid.UUID, applicationName, owner string) ([]models.Backup, error) {
+	query := `
+		SELECT ` + backupColumns + ` FROM backups
+		WHERE application_id = $1
+		   OR (application_id IS NULL AND application_name = $2 AND created_by = $3)
+		ORDER BY created_at DESC
+	`
+
+	return r.queryBackups(ctx, query, applicationID, applicationName, owner)
+}
+
+// ListCompletedByPolicy returns the completed backups taken by a policy, newest first.
+func (r *backupRepo) ListCompletedByPolicy(ctx context.Context, policyID uuid.UUID) ([]models.Backup, error) {
+	query := `SELECT ` + backupColumns + ` FROM backups WHERE policy_id = $1 AND status = $2 ORDER BY created_at DESC`
+
+	return r.queryBackups(ctx, query, policyID, models.BackupStatusCompleted)
+}
+
+func (r *backupRepo) queryBackups(ctx context.Context, query string, args ...any) ([]models.Backup, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query backups: %w", err)
+	}
+	defer rows.Close()
+
+	backups := []models.Backup{}
+	for rows.Next() {
+		backup, err := scanBackup(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan backup row: %w", err)
+		}
+		backups = append(backups, *backup)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating backup rows: %w", err)
+	}
+
+	return backups, nil
+}
+
+// Complete sets the final status, message, location and size of a backup.
+func (r *backupRepo) Complete(ctx context.Context, id uuid.UUID, update BackupUpdate) error {
+	query := `
+		UPDATE backups
+		SET status       = $1,
+		    message      = $2,
+		    location     = NULLIF($3, ''),
+		    size_bytes   = $4,
+		    completed_at = NOW()
+		WHERE id = $5
+	`
+
+	if _, err := r.pool.Exec(ctx, query, update.Status, update.Message, update.Location, update.SizeBytes, id); err != nil {
+		return fmt.Errorf("failed to update backup %q: %w", id, err)
+	}
+
+	return nil
+}
+
+// UpdateMessage replaces the message of a backup.
+func (r *backupRepo) UpdateMessage(ctx context.Context, id uuid.UUID, message string) error {
+	if _, err := r.pool.Exec(ctx, `UPDATE backups SET message = $1 WHERE id = $2`, message, id); err != nil {
+		return fmt.Errorf("failed to update backup %q: %w", id, err)
+	}
+
+	return nil
+}
+
+// FailInProgress marks every InProgress backup as Failed.
+func (r *backupRepo) FailInProgress(ctx context.Context, message string) (int64, error) {
+	query := `UPDATE backups SET status = $1, message = $2, completed_at = NOW() WHERE status = $3`
+
+	tag, err := r.pool.Exec(ctx, query, models.BackupStatusFailed, message, models.BackupStatusInProgress)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update interrupted backups: %w", err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// Delete removes a backup record.
+func (r *backupRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := r.pool.Exec(ctx, `DELETE FROM backups WHERE id = $1`, id); err != nil {
+		return fmt.Errorf("failed to delete backup %q: %w", id, err)
+	}
+
+	return nil
+}
+
+// Made with Bob
@@ -41,8 +41,8 @@ func NewServiceRepository(pool *pgxpool.Pool) ServiceRepository {
 // Insert creates a new service in the database.
 func (r *serviceRepo) Insert(ctx context.Context, service *models.Service) error {
 	query := `
-		INSERT INTO services (id, app_id, catalog_id, status, message, endpoints, version)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO services (id, app_id, catalog_id, status, message, endpoints, version, metadata)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 		RETURNING created_at, updated_at
 	`
 
@@ -61,6 +61,15 @@ func (r *serviceRepo) Insert(ctx context.Context, service *models.Service) error
 		}
 	}
 
+	// Marshal metadata to JSONB
+	var metadataJSON []byte
+	if service.Metadata != nil {
+		metadataJSON, err = json.Marshal(service.Metadata)
+		if err != nil {
+			return fmt.Errorf("failed to marshal metadata: %w", err)
+		}
+	}
+
 	err = r.pool.QueryRow(
 		ctx,
 		query,
@@ -71,6 +80,7 @@ func (r *serviceRepo) Insert(ctx context.Context, service *models.Service) error
 		sql.NullString{String: service.Message, Valid: service.Message != ""},
 		endpointsJSON,
 		sql.NullString{String: service.Version, Valid: service.Version != ""},
+		metadataJSON,
 	).Scan(&service.CreatedAt, &service.UpdatedAt)
 
 	if err != nil {
@@ -97,6 +107,7 @@ func scanService(rows pgx.Rows) (*models.Service, error) {
 	var (
 		service        models.Service
 		endpointsJSON  []byte
+		metadataJSON   []byte
 		serviceVersion sql.NullString
 		message        sql.NullString
 	)
@@ -109,6 +120,7 @@ func scanService(rows pgx.Rows) (*models.Service, error) {
 		&message,
 		&endpointsJSON,
 		&serviceVersion,
+		&metadataJSON,
 		&service.CreatedAt,
 		&service.UpdatedAt,
 	)
@@ -132,13 +144,19 @@ func scanService(rows pgx.Rows) (*models.Service, error) {
 		service.Endpoints = endpoints
 	}
 
+	if len(metadataJSON) > 0 {
+		if err := json.Unmarshal(metadataJSON, &service.Metadata); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal service metadata: %w", err)
+		}
+	}
+
 	return &service, nil
 }
 
 // GetByAppID retrieves all services for a specific application.
 func (r *serviceRepo) GetByAppID(ctx context.Context, appID uuid.UUID) ([]models.Service, error) {
 	query := `
-		SELECT id, app_id, catalog_id, status, message, endpoints, version, created_at, updated_at
+		SELECT id, app_id, catalog_id, status, message, endpoints, version, metadata, created_at, updated_at
 		FROM services
 		WHERE app_id = $1
 		ORDER BY created_at
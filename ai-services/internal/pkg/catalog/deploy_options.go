@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"slices"
+	"strings"
 
 	"github.com/project-ai-services/ai-services/assets"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/types"
@@ -316,6 +318,9 @@ func (p *CatalogProvider) GetComponentProviderParams(ctx context.Context, compon
 	if err := json.Unmarshal(schemaData, &schema); err != nil {
 		return nil, fmt.Errorf("failed to parse schema: %w", err)
 	}
+	if err := resolveSchemaRefs(schemaPath, schema, nil); err != nil {
+		return nil, err
+	}
 
 	return schema, nil
 }
@@ -349,6 +354,9 @@ func (p *CatalogProvider) GetConnectorProviderParams(ctx context.Context, connec
 	if err := json.Unmarshal(schemaData, &schema); err != nil {
 		return nil, fmt.Errorf("failed to parse schema: %w", err)
 	}
+	if err := resolveSchemaRefs(schemaPath, schema, nil); err != nil {
+		return nil, err
+	}
 
 	return schema, nil
 }
@@ -384,8 +392,64 @@ func (p *CatalogProvider) GetServiceParams(ctx context.Context, serviceID string
 	if err := json.Unmarshal(schemaData, &schema); err != nil {
 		return nil, fmt.Errorf("failed to parse schema: %w", err)
 	}
+	if err := resolveSchemaRefs(schemaPath, schema, nil); err != nil {
+		return nil, err
+	}
 
 	return schema, nil
 }
 
+// resolveSchemaRefs replaces the "$ref"s of a schema to other files of the catalog, relative to the
+// schema file, with the schemas they point to, so that consumers of the schema see plain properties.
+// Keywords next to a "$ref" take precedence over those of the referenced schema; references within a
+// file ("#/...") are kept. chain holds the files referencing schemaPath, to report cycles.
+func resolveSchemaRefs(schemaPath string, node any, chain []string) error {
+	switch n := node.(type) {
+	case []any:
+		for _, item := range n {
+			if err := resolveSchemaRefs(schemaPath, item, chain); err != nil {
+				return err
+			}
+		}
+	case map[string]any:
+		for key, value := range n {
+			if key != "$ref" {
+				if err := resolveSchemaRefs(schemaPath, value, chain); err != nil {
+					return err
+				}
+			}
+		}
+
+		ref, ok := n["$ref"].(string)
+		if !ok || strings.HasPrefix(ref, "#") {
+			return nil
+		}
+		refPath := filepath.Join(filepath.Dir(schemaPath), ref)
+		if slices.Contains(chain, refPath) || refPath == schemaPath {
+			return fmt.Errorf("schema %s references itself through %s", refPath, schemaPath)
+		}
+
+		data, err := assets.CatalogFS.ReadFile(refPath)
+		if err != nil {
+			return fmt.Errorf("failed to read schema %s referenced by %s: %w", refPath, schemaPath, err)
+		}
+		var target map[string]any
+		if err := json.Unmarshal(data, &target); err != nil {
+			return fmt.Errorf("failed to parse schema %s: %w", refPath, err)
+		}
+		if err := resolveSchemaRefs(refPath, target, append(chain, schemaPath)); err != nil {
+			return err
+		}
+
+		delete(n, "$ref")
+		for key, value := range target {
+			if _, set := n[key]; !set {
+				n[key] = value
+			}
+		}
+	}
+
+	return nil
+}
+
 // Made with Bob
@@ -7,6 +7,7 @@ import (
 
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/modelsource"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 )
 
@@ -17,32 +18,79 @@ import (
 func (p *CatalogProvider) GetCatalogModels(ctx context.Context, templateID string, excludeComponentProviders ...string) ([]string, error) {
 	allModels := make(map[string]bool)
 
+	err := p.walkTemplateComponents(ctx, templateID, excludeComponentProviders, func(componentType, componentID string) error {
+		return p.addComponentModels(ctx, componentType, componentID, allModels)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.ExtractMapKeys(allModels), nil
+}
+
+// GetCatalogModelSources collects the models of a service or architecture template like
+// GetCatalogModels, each with the model source of the values.yaml of its component.
+func (p *CatalogProvider) GetCatalogModelSources(ctx context.Context, templateID string, excludeComponentProviders ...string) (map[string]modelsource.Config, error) {
+	sources := make(map[string]modelsource.Config)
+
+	err := p.walkTemplateComponents(ctx, templateID, excludeComponentProviders, func(componentType, componentID string) error {
+		componentModels := make(map[string]bool)
+		if err := p.addComponentModels(ctx, componentType, componentID, componentModels); err != nil {
+			return err
+		}
+		if len(componentModels) == 0 {
+			return nil
+		}
+
+		values, err := p.LoadComponentValues(componentType, componentID, nil)
+		if err != nil {
+			return fmt.Errorf("failed to load values of component %s/%s: %w", componentType, componentID, err)
+		}
+		source, err := modelsource.ConfigFromValues(values)
+		if err != nil {
+			return fmt.Errorf("component %s/%s: %w", componentType, componentID, err)
+		}
+
+		for model := range componentModels {
+			if existing, ok := sources[model]; ok && existing != source {
+				return fmt.Errorf("model %s is configured with different sources: %s and %s", model, existing, source)
+			}
+			sources[model] = source
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sources, nil
+}
+
+// walkTemplateComponents calls fn with the type and ID of every component provider a service or
+// architecture template can deploy.
+func (p *CatalogProvider) walkTemplateComponents(ctx context.Context, templateID string, excludeComponentProviders []string, fn componentFunc) error {
 	// Try to load as architecture first
 	arch, err := p.LoadArchitecture(templateID)
 	if err == nil {
-		if err := p.collectArchitectureModels(ctx, arch.Services, allModels, excludeComponentProviders); err != nil {
-			return nil, err
-		}
-
-		return utils.ExtractMapKeys(allModels), nil
+		return p.collectArchitectureModels(ctx, arch.Services, excludeComponentProviders, fn)
 	}
 
 	// Try to load as service
 	service, err := p.LoadService(templateID)
 	if err == nil {
 		// Only collect component models (services don't have models in schemas)
-		if err := p.collectComponentsModels(ctx, service.Dependencies, allModels, excludeComponentProviders); err != nil {
-			return nil, err
-		}
-
-		return utils.ExtractMapKeys(allModels), nil
+		return p.collectComponentsModels(ctx, service.Dependencies, excludeComponentProviders, fn)
 	}
 
-	return nil, fmt.Errorf("template '%s' not found as service or architecture", templateID)
+	return fmt.Errorf("template '%s' not found as service or architecture", templateID)
 }
 
+// componentFunc is called with the type and provider ID of a component of a template.
+type componentFunc func(componentType, componentID string) error
+
 // collectArchitectureModels collects models from all component dependencies across all services in an architecture.
-func (p *CatalogProvider) collectArchitectureModels(ctx context.Context, services []types.ServiceReference, allModels map[string]bool, excludeComponentProviders []string) error {
+func (p *CatalogProvider) collectArchitectureModels(ctx context.Context, services []types.ServiceReference, excludeComponentProviders []string, fn componentFunc) error {
 	for _, svcRef := range services {
 		service, err := p.LoadService(svcRef.ID)
 		if err != nil {
@@ -50,7 +98,7 @@ func (p *CatalogProvider) collectArchitectureModels(ctx context.Context, service
 		}
 
 		// Collect component models from service dependencies
-		if err := p.collectComponentsModels(ctx, service.Dependencies, allModels, excludeComponentProviders); err != nil {
+		if err := p.collectComponentsModels(ctx, service.Dependencies, excludeComponentProviders, fn); err != nil {
 			return fmt.Errorf("failed to collect models for service %s: %w", svcRef.ID, err)
 		}
 	}
@@ -59,7 +107,7 @@ func (p *CatalogProvider) collectArchitectureModels(ctx context.Context, service
 }
 
 // collectComponentsModels collects models for components based on dependencies.
-func (p *CatalogProvider) collectComponentsModels(ctx context.Context, dependencies []types.DependencyReference, allModels map[string]bool, excludeComponentProviders []string) error {
+func (p *CatalogProvider) collectComponentsModels(ctx context.Context, dependencies []types.DependencyReference, excludeComponentProviders []string, fn componentFunc) error {
 	if len(dependencies) == 0 {
 		return nil
 	}
@@ -70,7 +118,7 @@ func (p *CatalogProvider) collectComponentsModels(ctx context.Context, dependenc
 	}
 
 	for _, dep := range dependencies {
-		if err := p.collectComponentsByTypeModels(ctx, dep.ID, components, allModels, excludeComponentProviders); err != nil {
+		if err := p.collectComponentsByTypeModels(ctx, dep.ID, components, excludeComponentProviders, fn); err != nil {
 			return err
 		}
 	}
@@ -79,7 +127,7 @@ func (p *CatalogProvider) collectComponentsModels(ctx context.Context, dependenc
 }
 
 // collectComponentsByTypeModels collects models for all components of a specific type.
-func (p *CatalogProvider) collectComponentsByTypeModels(ctx context.Context, componentType string, components []types.Component, allModels map[string]bool, excludeComponentProviders []string) error {
+func (p *CatalogProvider) collectComponentsByTypeModels(ctx context.Context, componentType string, components []types.Component, excludeComponentProviders []string, fn componentFunc) error {
 	for _, comp := range components {
 		if comp.ComponentType != componentType {
 			continue
@@ -99,7 +147,7 @@ func (p *CatalogProvider) collectComponentsByTypeModels(ctx context.Context, com
 			continue
 		}
 
-		if err := p.addComponentModels(ctx, comp.ComponentType, comp.ID, allModels); err != nil {
+		if err := fn(comp.ComponentType, comp.ID); err != nil {
 			return fmt.Errorf("failed to collect models for component %s/%s: %w", comp.ComponentType, comp.ID, err)
 		}
 	}
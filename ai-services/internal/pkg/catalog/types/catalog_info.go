@@ -0,0 +1,30 @@
+package types
+
+import "time"
+
+// CatalogInfo is the structured output of 'ai-services catalog info'.
+type CatalogInfo struct {
+	Name     string `json:"name"`
+	Template string `json:"template"`
+	Version  string `json:"version"`
+	// Routes holds the domains and path prefixes the catalog is served on, by the variable of the
+	// catalog template they fill, e.g. CATALOG_UI_DOMAIN.
+	Routes      map[string]string `json:"routes,omitempty"`
+	HTTPSPort   string            `json:"https_port,omitempty"`
+	Certificate *CertificateInfo  `json:"certificate,omitempty"`
+}
+
+// CertificateInfo describes the TLS certificate served for the catalog.
+type CertificateInfo struct {
+	// Mode is how the certificate is provisioned: internal, acme or custom.
+	Mode     string    `json:"mode"`
+	Issuer   string    `json:"issuer"`
+	NotAfter time.Time `json:"not_after"`
+}
+
+// Names returns the name of the catalog service.
+func (i *CatalogInfo) Names() []string {
+	return []string{i.Name}
+}
+
+// Made with Bob
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 
 	"go.yaml.in/yaml/v3"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/proxy"
 )
 
 const (
@@ -104,16 +106,17 @@ type Resources struct {
 
 // Service represents a deployable AI service.
 type Service struct {
-	ID                string                `yaml:"id" json:"id"`
-	Name              string                `yaml:"name" json:"name"`
-	Description       string                `yaml:"description" json:"description"`
-	Type              string                `yaml:"type" json:"type"` // "service"
-	CertifiedBy       string                `yaml:"certified_by" json:"certified_by"`
-	Architectures     []string              `yaml:"architectures" json:"architectures"`
-	Dependencies      []DependencyReference `yaml:"dependencies,omitempty" json:"dependencies,omitempty"`
-	Standalone        bool                  `yaml:"standalone,omitempty" json:"standalone,omitempty"`
-	AcceptsDatasource bool                  `yaml:"accepts_datasource,omitempty" json:"accepts_datasource,omitempty"`
-	About             *yaml.Node            `yaml:"-" json:"-"`
+	ID                string                        `yaml:"id" json:"id"`
+	Name              string                        `yaml:"name" json:"name"`
+	Description       string                        `yaml:"description" json:"description"`
+	Type              string                        `yaml:"type" json:"type"` // "service"
+	CertifiedBy       string                        `yaml:"certified_by" json:"certified_by"`
+	Architectures     []string                      `yaml:"architectures" json:"architectures"`
+	Dependencies      []DependencyReference         `yaml:"dependencies,omitempty" json:"dependencies,omitempty"`
+	Standalone        bool                          `yaml:"standalone,omitempty" json:"standalone,omitempty"`
+	AcceptsDatasource bool                          `yaml:"accepts_datasource,omitempty" json:"accepts_datasource,omitempty"`
+	RoutePolicies     map[string]*proxy.RoutePolicy `yaml:"route_policies,omitempty" json:"route_policies,omitempty"` // Default proxy policies keyed by route type (e.g., "api")
+	About             *yaml.Node                    `yaml:"-" json:"-"`
 }
 
 // UnmarshalYAML extracts the 'about' node manually, insulating it from reflection errors.
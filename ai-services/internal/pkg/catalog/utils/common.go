@@ -32,7 +32,26 @@ type PodmanConfigureOptions struct {
 	SSLCertPath       string // Path to user-provided SSL certificate
 	SSLKeyPath        string // Path to user-provided SSL private key
 	HttpsPort         int
-	WorkerGatewayPort int // gRPC worker gateway port; always active, default 9090
+	WorkerGatewayPort int          // gRPC worker gateway port; always active, default 9090
+	RoutingMode       string       // proxy.RoutingModeHost or proxy.RoutingModePath
+	AdminMode         string       // proxy.AdminModeUnix or proxy.AdminModeMTLS; empty for deployments that predate it
+	ACME              *ACMEOptions // ACME certificate issuance; nil for self-signed or user-provided certificates
+}
+
+// ACME challenge types supported for certificate issuance.
+const (
+	ACMEChallengeHTTP01 = "http-01"
+	ACMEChallengeDNS01  = "dns-01"
+)
+
+// ACMEOptions contains the configuration for obtaining certificates from an ACME certificate authority.
+type ACMEOptions struct {
+	Email       string            // Account email registered with the CA
+	CAURL       string            // ACME directory URL; empty for Let's Encrypt
+	CARootPath  string            // PEM root trusted for the ACME directory, e.g. of a private CA or Pebble
+	Challenge   string            // ACMEChallengeHTTP01 or ACMEChallengeDNS01
+	DNSProvider string            // Caddy DNS provider module solving dns-01 challenges, e.g. cloudflare
+	DNSConfig   map[string]string // Settings of the DNS provider, e.g. api_token
 }
 
 // OpenShiftConfigureOptions contains the configuration for configuring the catalog service on OpenShift runtime.
@@ -98,6 +117,12 @@ func extractConfigFromEnv(podEnv map[string]string, config *PodmanConfigureOptio
 	if value, ok := podEnv["WORKER_GATEWAY_PORT"]; ok {
 		config.WorkerGatewayPort, _ = strconv.Atoi(value)
 	}
+	if value, ok := podEnv["ROUTING_MODE"]; ok {
+		config.RoutingMode = value
+	}
+	if value, ok := podEnv["CADDY_ADMIN_MODE"]; ok {
+		config.AdminMode = value
+	}
 }
 
 // SanitizeFilePath cleans path to prevent path-traversal attacks.
@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/google/uuid"
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/proxy"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+// ServiceAuthMode returns the service authentication mode configured through
+// constants.ServiceAuthModeEnv, defaulting to constants.DefaultServiceAuthMode.
+func ServiceAuthMode() (string, error) {
+	mode := utils.GetEnv(constants.ServiceAuthModeEnv, constants.DefaultServiceAuthMode)
+	switch mode {
+	case constants.ServiceAuthModeNone, constants.ServiceAuthModeForwardAuth, constants.ServiceAuthModeAPIKey:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("invalid %s value '%s': expected '%s', '%s' or '%s'", constants.ServiceAuthModeEnv, mode,
+			constants.ServiceAuthModeNone, constants.ServiceAuthModeForwardAuth, constants.ServiceAuthModeAPIKey)
+	}
+}
+
+// ServiceAuthPolicy returns the auth policy protecting the service APIs of an application, or nil
+// when service authentication is disabled. Caddy sends every request to the API server's verify
+// endpoint, which checks the credentials against the application and the configured mode.
+func ServiceAuthPolicy(applicationID uuid.UUID) (*proxy.AuthPolicy, error) {
+	mode, err := ServiceAuthMode()
+	if err != nil {
+		return nil, err
+	}
+	if mode == constants.ServiceAuthModeNone {
+		return nil, nil
+	}
+
+	query := url.Values{
+		"application_id": {applicationID.String()},
+		"mode":           {mode},
+	}
+
+	return &proxy.AuthPolicy{
+		Upstream:    utils.GetEnv(constants.ServiceAuthUpstreamEnv, constants.DefaultServiceAuthUpstream),
+		URI:         constants.ServiceAuthVerifyPath + "?" + query.Encode(),
+		CopyHeaders: []string{constants.ServiceAuthSubjectHeader},
+	}, nil
+}
+
+// Made with Bob
@@ -2,38 +2,188 @@ package validators
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"maps"
 	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/santhosh-tekuri/jsonschema/v6/kind"
 )
 
+// locationPrefix matches the instance location the validator puts in front of its messages.
+var locationPrefix = regexp.MustCompile(`^at '[^']*': `)
+
+// Violation is a parameter that does not satisfy its schema.
+type Violation struct {
+	// Pointer is the JSON pointer of the parameter, e.g. /services/0/components/1/params/model.
+	Pointer string `json:"pointer"`
+	// Message describes the violated constraint. Parameter values are redacted.
+	Message string `json:"message"`
+}
+
 // ValidateParams validates parameters against a JSON schema.
 // This function relies entirely on the JSON Schema validator to handle all validation logic,
 // including parameter existence, types, constraints, and conditional requirements.
+// All violations are returned in a ValidationError with status 422, with pointers relative to params.
 func ValidateParams(params map[string]any, schema map[string]any, contextName string) error {
-	// If no params provided or schema is empty, skip validation
-	if len(params) == 0 || len(schema) == 0 {
+	violations, err := SchemaViolations(params, schema, "", contextName)
+	if err != nil {
+		return err
+	}
+
+	return NewParamsValidationError(contextName, violations)
+}
+
+// NewParamsValidationError returns a ValidationError with status 422 listing the violations, or
+// nil if there are none.
+func NewParamsValidationError(contextName string, violations []Violation) error {
+	if len(violations) == 0 {
 		return nil
 	}
 
-	// Compile and validate against JSON schema
-	// The JSON Schema validator handles everything:
-	// - Parameter existence (via additionalProperties: false in schema)
-	// - Required/optional fields
-	// - Conditional requirements (dependencies, oneOf, anyOf, allOf, if/then/else)
-	// - Type validation
-	// - Format validation
-	// - Constraint validation (minLength, maxLength, min, max, pattern, etc.)
+	messages := make([]string, 0, len(violations))
+	for _, v := range violations {
+		messages = append(messages, fmt.Sprintf("%s: %s", v.Pointer, v.Message))
+	}
+
+	return &ValidationError{
+		Code:       http.StatusUnprocessableEntity,
+		Message:    fmt.Sprintf("Parameter validation failed for %s: %s", contextName, strings.Join(messages, "; ")),
+		Violations: violations,
+	}
+}
+
+// SchemaViolations validates parameters against a JSON schema and returns every violation, with
+// JSON pointers below the base pointer. Missing parameters are validated as an empty object, so
+// that required parameters are reported. An error is returned only if the schema is invalid.
+//
+// The JSON Schema validator handles everything:
+// - Parameter existence (via additionalProperties: false in schema)
+// - Required/optional fields
+// - Conditional requirements (dependencies, oneOf, anyOf, allOf, if/then/else)
+// - Type validation
+// - Format validation
+// - Constraint validation (minLength, maxLength, min, max, pattern, etc.)
+func SchemaViolations(params map[string]any, schema map[string]any, base, contextName string) ([]Violation, error) {
+	// An empty schema allows any params
+	if len(schema) == 0 {
+		return nil, nil
+	}
+
+	if params == nil {
+		params = map[string]any{}
+	}
+
 	compiledSchema, err := compileJSONSchema(schema, contextName)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	err = compiledSchema.Validate(params)
+	if err == nil {
+		return nil, nil
+	}
+
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		return []Violation{{Pointer: base, Message: err.Error()}}, nil
+	}
+
+	return collectViolations(validationErr, base), nil
+}
+
+// collectViolations recursively collects the leaf errors of a validation error. Missing and
+// additional properties are reported at the pointer of each property.
+func collectViolations(err *jsonschema.ValidationError, base string) []Violation {
+	if len(err.Causes) > 0 {
+		var violations []Violation
+		for _, cause := range err.Causes {
+			violations = append(violations, collectViolations(cause, base)...)
+		}
+
+		return violations
+	}
+
+	pointer := base + JSONPointer(err.InstanceLocation...)
+
+	switch k := err.ErrorKind.(type) {
+	case *kind.Required:
+		violations := make([]Violation, 0, len(k.Missing))
+		for _, name := range k.Missing {
+			violations = append(violations, Violation{Pointer: pointer + JSONPointer(name), Message: fmt.Sprintf("missing property '%s'", name)})
+		}
+
+		return violations
+	case *kind.AdditionalProperties:
+		violations := make([]Violation, 0, len(k.Properties))
+		for _, name := range k.Properties {
+			violations = append(violations, Violation{Pointer: pointer + JSONPointer(name), Message: fmt.Sprintf("additional property '%s' not allowed", name)})
+		}
+
+		return violations
 	}
 
-	return validateAgainstSchema(compiledSchema, params, contextName)
+	message := locationPrefix.ReplaceAllString(sanitizeErrorMessage(err.Error()), "")
+
+	return []Violation{{Pointer: pointer, Message: message}}
+}
+
+// JSONPointer returns the JSON pointer of the reference tokens, escaped as defined by RFC 6901.
+func JSONPointer(tokens ...string) string {
+	var b strings.Builder
+	for _, token := range tokens {
+		b.WriteString("/")
+		b.WriteString(strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1"))
+	}
+
+	return b.String()
+}
+
+// CoerceParams converts string parameter values to the integer, number or boolean type of their
+// schema property, so that values given as strings on the command line validate. Values that do not
+// parse are left unchanged for the validator to report.
+func CoerceParams(params map[string]any, schema map[string]any) {
+	properties, ok := schema["properties"].(map[string]any)
+	if !ok {
+		return
+	}
+
+	for name, value := range params {
+		prop, ok := properties[name].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		if nested, ok := value.(map[string]any); ok {
+			CoerceParams(nested, prop)
+
+			continue
+		}
+
+		str, ok := value.(string)
+		if !ok {
+			continue
+		}
+
+		switch prop["type"] {
+		case "integer":
+			if n, err := strconv.ParseInt(str, 10, 64); err == nil {
+				params[name] = n
+			}
+		case "number":
+			if n, err := strconv.ParseFloat(str, 64); err == nil {
+				params[name] = n
+			}
+		case "boolean":
+			if b, err := strconv.ParseBool(str); err == nil {
+				params[name] = b
+			}
+		}
+	}
 }
 
 // compileJSONSchema prepares and compiles a JSON schema for validation.
@@ -74,44 +224,6 @@ func compileJSONSchema(schema map[string]any, contextName string) (*jsonschema.S
 	return compiledSchema, nil
 }
 
-// validateAgainstSchema validates parameters against a compiled JSON schema.
-func validateAgainstSchema(compiledSchema *jsonschema.Schema, params map[string]any, contextName string) error {
-	if err := compiledSchema.Validate(params); err != nil {
-		var errorMessages []string
-		if validationErr, ok := err.(*jsonschema.ValidationError); ok {
-			errorMessages = ExtractValidationErrors(validationErr)
-		} else {
-			errorMessages = []string{err.Error()}
-		}
-
-		return &ValidationError{
-			Code:    http.StatusBadRequest,
-			Message: fmt.Sprintf("Parameter validation failed for %s: %s", contextName, strings.Join(errorMessages, "; ")),
-		}
-	}
-
-	return nil
-}
-
-// ExtractValidationErrors recursively extracts all validation error messages.
-func ExtractValidationErrors(err *jsonschema.ValidationError) []string {
-	var messages []string
-
-	// If there are no causes, this is a leaf error - add its message
-	if len(err.Causes) == 0 {
-		if err.Error() != "" {
-			messages = append(messages, sanitizeErrorMessage(err.Error()))
-		}
-	} else {
-		// If there are causes, recursively collect them (don't add parent message to avoid duplication)
-		for _, cause := range err.Causes {
-			messages = append(messages, ExtractValidationErrors(cause)...)
-		}
-	}
-
-	return messages
-}
-
 // sanitizeErrorMessage removes sensitive values from error messages while keeping field names and validation details.
 // It only redacts the actual value being validated (the first quoted string after a colon in the message).
 func sanitizeErrorMessage(msg string) string {
@@ -1,6 +1,8 @@
 package validators
 
 import (
+	"errors"
+	"net/http"
 	"strings"
 	"testing"
 )
@@ -311,4 +313,91 @@ func TestValidateParams_TypeValidation(t *testing.T) {
 	}
 }
 
+func TestSchemaViolations_CollectsAllWithPointers(t *testing.T) {
+	schema := map[string]any{
+		"type":                 "object",
+		"additionalProperties": false,
+		"required":             []any{"model"},
+		"properties": map[string]any{
+			"model": map[string]any{"type": "string", "enum": []any{"granite"}},
+			"backend": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"port": map[string]any{"type": "integer", "minimum": 1},
+				},
+			},
+		},
+	}
+	params := map[string]any{
+		"backend": map[string]any{"port": 0},
+		"unknown": "x",
+	}
+
+	violations, err := SchemaViolations(params, schema, "/services/0/params", "test")
+	if err != nil {
+		t.Fatalf("SchemaViolations failed: %v", err)
+	}
+
+	pointers := map[string]bool{}
+	for _, v := range violations {
+		pointers[v.Pointer] = true
+	}
+	for _, want := range []string{"/services/0/params/model", "/services/0/params/unknown", "/services/0/params/backend/port"} {
+		if !pointers[want] {
+			t.Errorf("expected a violation at %s, got %+v", want, violations)
+		}
+	}
+
+	err = NewParamsValidationError("test", violations)
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) || validationErr.Code != http.StatusUnprocessableEntity || len(validationErr.Violations) != len(violations) {
+		t.Errorf("expected a 422 validation error with all violations, got %v", err)
+	}
+}
+
+func TestSchemaViolations_RequiredWithoutParams(t *testing.T) {
+	violations, err := SchemaViolations(nil, getWatsonxSchema(), "", "watsonx")
+	if err != nil {
+		t.Fatalf("SchemaViolations failed: %v", err)
+	}
+	if len(violations) != 3 {
+		t.Errorf("expected the 3 required params to be reported, got %+v", violations)
+	}
+}
+
+func TestCoerceParams(t *testing.T) {
+	schema := map[string]any{
+		"properties": map[string]any{
+			"port":    map[string]any{"type": "integer"},
+			"ratio":   map[string]any{"type": "number"},
+			"enabled": map[string]any{"type": "boolean"},
+			"name":    map[string]any{"type": "string"},
+			"backend": map[string]any{
+				"type":       "object",
+				"properties": map[string]any{"debug": map[string]any{"type": "boolean"}},
+			},
+		},
+	}
+	params := map[string]any{
+		"port":    "8080",
+		"ratio":   "0.5",
+		"enabled": "true",
+		"name":    "42",
+		"backend": map[string]any{"debug": "false"},
+		"other":   "1",
+	}
+
+	CoerceParams(params, schema)
+
+	if params["port"] != int64(8080) || params["ratio"] != 0.5 || params["enabled"] != true || params["name"] != "42" || params["other"] != "1" {
+		t.Errorf("unexpected params after coercion: %v", params)
+	}
+	if params["backend"].(map[string]any)["debug"] != false {
+		t.Errorf("expected nested params to be coerced, got %v", params["backend"])
+	}
+	if err := ValidateParams(params, schema, "test"); err != nil {
+		t.Errorf("expected coerced params to validate, got %v", err)
+	}
+}
+
 // Made with Bob
@@ -17,6 +17,8 @@ import (
 type ValidationError struct {
 	Code    int
 	Message string
+	// Violations lists the parameters that do not satisfy their schemas, if any.
+	Violations []Violation
 }
 
 func (e *ValidationError) Error() string {
@@ -130,37 +132,6 @@ func (v *ApplicationValidator) ValidateComponentVersion(componentType, providerI
 	})
 }
 
-// validateParamsWithSchema is a parameter validator that loads schema and validates params.
-func (v *ApplicationValidator) validateParamsWithSchema(
-	params map[string]any,
-	loadSchema func() (map[string]any, error),
-	contextName string,
-) error {
-	if len(params) == 0 {
-		return nil
-	}
-	schema, err := loadSchema()
-	if err == nil && len(schema) > 0 {
-		return ValidateParams(params, schema, contextName)
-	}
-
-	return nil
-}
-
-// ValidateServiceParams validates service-level parameters against schema.
-func (v *ApplicationValidator) ValidateServiceParams(ctx context.Context, serviceID string, params map[string]any) error {
-	return v.validateParamsWithSchema(params, func() (map[string]any, error) {
-		return v.provider.GetServiceParams(ctx, serviceID)
-	}, fmt.Sprintf("service '%s'", serviceID))
-}
-
-// ValidateComponentParams validates component parameters against schema.
-func (v *ApplicationValidator) ValidateComponentParams(ctx context.Context, componentType, providerID string, params map[string]any) error {
-	return v.validateParamsWithSchema(params, func() (map[string]any, error) {
-		return v.provider.GetComponentProviderParams(ctx, componentType, providerID)
-	}, fmt.Sprintf("component '%s/%s'", componentType, providerID))
-}
-
 // validateServiceComponents validates all components in a service.
 func (v *ApplicationValidator) validateServiceComponents(ctx context.Context, components []apimodels.Component) error {
 	// Check for duplicate components (same component_type + provider_id combination)
@@ -231,16 +202,24 @@ func (v *ApplicationValidator) validateComponentsMatchDependencies(
 	return nil
 }
 
-// validateServiceCore performs core validation for a service (version, params, components).
+// validateServiceCore performs core validation for a service (version, route policies, components).
 func (v *ApplicationValidator) validateServiceCore(ctx context.Context, service apimodels.Service, catalogService *types.Service) error {
 	// Validate service version
 	if err := v.ValidateServiceVersion(service.CatalogID, service.Version); err != nil {
 		return err
 	}
 
-	// Validate service-level parameters
-	if err := v.ValidateServiceParams(ctx, service.CatalogID, service.Params); err != nil {
-		return err
+	// Validate route policy overrides
+	for routeType, policy := range service.RoutePolicies {
+		if policy == nil {
+			continue
+		}
+		if err := policy.Validate(); err != nil {
+			return &ValidationError{
+				Code:    http.StatusBadRequest,
+				Message: fmt.Sprintf("Service '%s' route policy for '%s' routes: %v", service.CatalogID, routeType, err),
+			}
+		}
 	}
 
 	// Validate that components match service dependencies
@@ -252,7 +231,8 @@ func (v *ApplicationValidator) validateServiceCore(ctx context.Context, service
 	return v.validateServiceComponents(ctx, service.Components)
 }
 
-// ValidateSingleComponent validates a single component (existence, version, and parameters).
+// ValidateSingleComponent validates a single component (existence and version). Its parameters
+// are validated with those of the whole request by the ParamBuilder.
 func (v *ApplicationValidator) ValidateSingleComponent(ctx context.Context, component apimodels.Component) error {
 	// Verify component provider exists
 	_, err := v.provider.LoadComponent(component.ComponentType, component.ProviderID)
@@ -264,12 +244,7 @@ func (v *ApplicationValidator) ValidateSingleComponent(ctx context.Context, comp
 	}
 
 	// Validate component version
-	if err := v.ValidateComponentVersion(component.ComponentType, component.ProviderID, component.Version); err != nil {
-		return err
-	}
-
-	// Validate component parameters
-	return v.ValidateComponentParams(ctx, component.ComponentType, component.ProviderID, component.Params)
+	return v.ValidateComponentVersion(component.ComponentType, component.ProviderID, component.Version)
 }
 
 // ValidateServiceDeployment validates a single service deployment request.
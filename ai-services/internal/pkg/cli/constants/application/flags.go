@@ -8,6 +8,8 @@ type CreateFlags struct {
 	Params         string
 	Values         string
 	Legacy         string
+	Interactive    string
+	SaveValues     string
 
 	// Podman-specific flags
 	SkipImageDownload string
@@ -26,6 +28,8 @@ var Create = CreateFlags{
 	Params:         "params",
 	Values:         "values",
 	Legacy:         "legacy",
+	Interactive:    "interactive",
+	SaveValues:     "save-values",
 
 	// Podman-specific flags
 	SkipImageDownload: "skip-image-download",
@@ -76,14 +80,16 @@ var Logs = LogsFlags{
 // PsFlags contains all flag names for the 'application ps' command.
 type PsFlags struct {
 	// Common flags - valid for all runtimes
-	Output string
-	Legacy string
+	Output   string
+	JSONPath string
+	Legacy   string
 }
 
 // Ps holds the flag constants for the 'application ps' command.
 var Ps = PsFlags{
-	Output: "output",
-	Legacy: "legacy",
+	Output:   "output",
+	JSONPath: "jsonpath",
+	Legacy:   "legacy",
 }
 
 // Made with Bob
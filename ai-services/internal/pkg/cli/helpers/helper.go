@@ -6,7 +6,9 @@ import (
 	"strings"
 	"time"
 
-	"github.com/project-ai-services/ai-services/internal/pkg/accelerator/spyre"
+	"github.com/project-ai-services/ai-services/internal/pkg/accelerator"
+	// Registers the providers returned by accelerator.Default.
+	_ "github.com/project-ai-services/ai-services/internal/pkg/accelerator/providers"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
@@ -88,16 +90,34 @@ func FetchContainerStartPeriod(runtime runtime.Runtime, containerNameOrId string
 	return containerStats.HealthcheckStartPeriod, nil
 }
 
-// ListSpyreCards lists all Spyre cards attached to the system.
-// This is a wrapper around spyre.ListCards for backward compatibility.
+// DiscoverAccelerators returns the accelerator cards reported by the configured provider.
+func DiscoverAccelerators(ctx context.Context) ([]accelerator.Card, error) {
+	provider, err := accelerator.Default()
+	if err != nil {
+		return nil, err
+	}
+
+	return provider.Discover(ctx)
+}
+
+// ListSpyreCards lists the PCI addresses of all Spyre cards attached to the system.
 func ListSpyreCards(ctx context.Context) ([]string, error) {
-	return spyre.ListCards(ctx)
+	cards, err := DiscoverAccelerators(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return accelerator.Addresses(cards), nil
 }
 
-// FindFreeSpyreCards finds available (free) Spyre cards.
-// This is a wrapper around spyre.FindFreeCards for backward compatibility.
+// FindFreeSpyreCards finds the PCI addresses of available (free) Spyre cards.
 func FindFreeSpyreCards(ctx context.Context) ([]string, error) {
-	return spyre.FindFreeCards(ctx)
+	cards, err := DiscoverAccelerators(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return accelerator.FreeAddresses(cards), nil
 }
 
 func ParseSkipChecks(skipChecks []string) map[string]bool {
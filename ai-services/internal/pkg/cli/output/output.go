@@ -0,0 +1,274 @@
+// Package output renders the results of the CLI list and info commands in the format selected
+// with the --output flag, registered once on the command groups holding those commands.
+//
+// Structured formats (json, yaml, jsonpath and go-template) are encoded from the JSON field
+// names of the result types, which are a stable contract for scripts: fields may be added,
+// but are not renamed or removed. See docs/CLI-Output-Guide.md for the fields of each command.
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// FlagOutput is the name of the flag selecting the output format.
+	FlagOutput = "output"
+	// FlagJSONPath is the name of the flag selecting a JSONPath expression, a shorthand for
+	// --output jsonpath=<expression>.
+	FlagJSONPath = "jsonpath"
+)
+
+// Output formats. The empty format prints the human-readable table or text of the command.
+const (
+	FormatWide = "wide"
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+	FormatName = "name"
+
+	jsonPathPrefix   = "jsonpath="
+	goTemplatePrefix = "go-template="
+)
+
+// Namer is implemented by results that support the name format, which prints one name per line.
+type Namer interface {
+	Names() []string
+}
+
+// Options holds the output format selected on the command line.
+type Options struct {
+	Format   string
+	JSONPath string
+}
+
+// supportedAnnotation marks the commands printing their result in the selected format.
+const supportedAnnotation = "ai-services.io/output"
+
+// selected is the output format selected with the flags registered by BindFlags.
+var selected Options
+
+// BindFlags registers the --output and --jsonpath flags on the persistent flags of a command
+// group, so that all its list and info commands accept them.
+func BindFlags(flags *pflag.FlagSet) {
+	flags.StringVarP(&selected.Format, FlagOutput, "o", "",
+		"Output format of list and info commands: one of wide, json, yaml, name, jsonpath=<expression> or go-template=<template>")
+	flags.StringVar(&selected.JSONPath, FlagJSONPath, "",
+		"JSONPath expression to print, e.g. '{.items[*].name}' (shorthand for -o jsonpath=<expression>)")
+}
+
+// Selected returns the output format selected on the command line.
+func Selected() *Options {
+	return &selected
+}
+
+// Supported marks cmd as printing its result in the selected format.
+func Supported(cmd *cobra.Command) {
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[supportedAnnotation] = "true"
+}
+
+// Check returns an error if an output format is selected for a command not marked as Supported.
+func Check(cmd *cobra.Command) error {
+	if selected == (Options{}) || cmd.Annotations[supportedAnnotation] != "" {
+		return nil
+	}
+
+	flag := FlagOutput
+	if selected.JSONPath != "" {
+		flag = FlagJSONPath
+	}
+
+	return fmt.Errorf("--%s is not supported by '%s'", flag, cmd.CommandPath())
+}
+
+// Validate checks the selected output format.
+func (o Options) Validate() error {
+	if o.JSONPath != "" {
+		if o.Format != "" {
+			return fmt.Errorf("--%s cannot be used with --%s", FlagJSONPath, FlagOutput)
+		}
+
+		return validateJSONPath(o.JSONPath)
+	}
+
+	switch format := strings.ToLower(o.Format); {
+	case format == "", format == FormatWide, format == FormatJSON, format == FormatYAML, format == FormatName:
+		return nil
+	case strings.HasPrefix(o.Format, jsonPathPrefix):
+		return validateJSONPath(strings.TrimPrefix(o.Format, jsonPathPrefix))
+	case strings.HasPrefix(o.Format, goTemplatePrefix):
+		_, err := parseTemplate(strings.TrimPrefix(o.Format, goTemplatePrefix))
+
+		return err
+	default:
+		return fmt.Errorf("invalid output format %q: valid formats are wide, json, yaml, name, %s<expression> and %s<template>",
+			o.Format, jsonPathPrefix, goTemplatePrefix)
+	}
+}
+
+// Structured reports whether a machine-readable format is selected instead of the table or text
+// output of the command.
+func (o Options) Structured() bool {
+	format := strings.ToLower(o.Format)
+
+	return o.JSONPath != "" || (format != "" && format != FormatWide)
+}
+
+// Wide reports whether the wide table format is selected.
+func (o Options) Wide() bool {
+	return strings.ToLower(o.Format) == FormatWide
+}
+
+// RequireTable returns an error if a structured format is selected for a code path that only
+// prints text, such as a legacy implementation.
+func (o Options) RequireTable(codePath string) error {
+	if !o.Structured() {
+		return nil
+	}
+
+	format := o.Format
+	if o.JSONPath != "" {
+		format = FlagJSONPath
+	}
+
+	return fmt.Errorf("output format %q is not supported with %s", format, codePath)
+}
+
+// Print writes the result in the selected structured format.
+func (o Options) Print(w io.Writer, result any) error {
+	switch format := strings.ToLower(o.Format); {
+	case o.JSONPath != "":
+		return printJSONPath(w, o.JSONPath, result)
+	case format == FormatJSON:
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode output as JSON: %w", err)
+		}
+		_, err = fmt.Fprintln(w, string(data))
+
+		return err
+	case format == FormatYAML:
+		// Encoding through JSON keeps the YAML field names identical to the JSON ones
+		data, err := yaml.Marshal(result)
+		if err != nil {
+			return fmt.Errorf("failed to encode output as YAML: %w", err)
+		}
+		_, err = w.Write(data)
+
+		return err
+	case format == FormatName:
+		namer, ok := result.(Namer)
+		if !ok {
+			return fmt.Errorf("output format %q is not supported by this command", FormatName)
+		}
+		for _, name := range namer.Names() {
+			if _, err := fmt.Fprintln(w, name); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case strings.HasPrefix(o.Format, jsonPathPrefix):
+		return printJSONPath(w, strings.TrimPrefix(o.Format, jsonPathPrefix), result)
+	case strings.HasPrefix(o.Format, goTemplatePrefix):
+		return printTemplate(w, strings.TrimPrefix(o.Format, goTemplatePrefix), result)
+	default:
+		return fmt.Errorf("output format %q is not a structured format", o.Format)
+	}
+}
+
+// generic converts the result to the maps and slices of its JSON encoding, so that JSONPath
+// expressions and templates address the same field names as the json format.
+func generic(result any) (any, error) {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode output: %w", err)
+	}
+
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, fmt.Errorf("failed to decode output: %w", err)
+	}
+
+	return value, nil
+}
+
+// parseJSONPath parses the expression, accepting it with or without the surrounding braces.
+func parseJSONPath(expression string) (*jsonpath.JSONPath, error) {
+	if !strings.Contains(expression, "{") {
+		expression = "{" + expression + "}"
+	}
+
+	jp := jsonpath.New(FlagOutput)
+	if err := jp.Parse(expression); err != nil {
+		return nil, fmt.Errorf("invalid JSONPath expression %q: %w", expression, err)
+	}
+
+	return jp, nil
+}
+
+func validateJSONPath(expression string) error {
+	_, err := parseJSONPath(expression)
+
+	return err
+}
+
+func printJSONPath(w io.Writer, expression string, result any) error {
+	jp, err := parseJSONPath(expression)
+	if err != nil {
+		return err
+	}
+
+	value, err := generic(result)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := jp.Execute(&buf, value); err != nil {
+		return fmt.Errorf("failed to evaluate JSONPath expression: %w", err)
+	}
+	_, err = fmt.Fprintln(w, buf.String())
+
+	return err
+}
+
+func parseTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New(FlagOutput).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Go template: %w", err)
+	}
+
+	return tmpl, nil
+}
+
+func printTemplate(w io.Writer, text string, result any) error {
+	tmpl, err := parseTemplate(text)
+	if err != nil {
+		return err
+	}
+
+	value, err := generic(result)
+	if err != nil {
+		return err
+	}
+
+	if err := tmpl.Execute(w, value); err != nil {
+		return fmt.Errorf("failed to execute Go template: %w", err)
+	}
+
+	return nil
+}
+
+// Made with Bob
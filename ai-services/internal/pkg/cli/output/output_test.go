@@ -0,0 +1,151 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+type testResult struct {
+	Items []testItem `json:"items"`
+}
+
+type testItem struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+func (r testResult) Names() []string {
+	names := make([]string, 0, len(r.Items))
+	for _, item := range r.Items {
+		names = append(names, item.Name)
+	}
+
+	return names
+}
+
+func TestPrint(t *testing.T) {
+	result := testResult{Items: []testItem{{Name: "rag", Status: "running"}, {Name: "chat", Status: "exited"}}}
+
+	tests := []struct {
+		name string
+		opts Options
+		want string
+	}{
+		{
+			name: "json",
+			opts: Options{Format: FormatJSON},
+			want: "{\n  \"items\": [\n    {\n      \"name\": \"rag\",\n      \"status\": \"running\"\n    },\n" +
+				"    {\n      \"name\": \"chat\",\n      \"status\": \"exited\"\n    }\n  ]\n}\n",
+		},
+		{
+			name: "yaml",
+			opts: Options{Format: FormatYAML},
+			want: "items:\n- name: rag\n  status: running\n- name: chat\n  status: exited\n",
+		},
+		{name: "name", opts: Options{Format: FormatName}, want: "rag\nchat\n"},
+		{name: "jsonpath format", opts: Options{Format: "jsonpath={.items[*].name}"}, want: "rag chat\n"},
+		{name: "jsonpath flag without braces", opts: Options{JSONPath: ".items[0].status"}, want: "running\n"},
+		{
+			name: "go-template",
+			opts: Options{Format: "go-template={{range .items}}{{.name}}={{.status}};{{end}}"},
+			want: "rag=running;chat=exited;",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.opts.Validate(); err != nil {
+				t.Fatalf("Validate failed: %v", err)
+			}
+			if !tt.opts.Structured() {
+				t.Fatal("expected a structured format")
+			}
+
+			var buf bytes.Buffer
+			if err := tt.opts.Print(&buf, result); err != nil {
+				t.Fatalf("Print failed: %v", err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("expected output %q, got %q", tt.want, buf.String())
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    Options
+		wantErr bool
+	}{
+		{name: "table", opts: Options{}},
+		{name: "wide", opts: Options{Format: "WIDE"}},
+		{name: "unknown format", opts: Options{Format: "xml"}, wantErr: true},
+		{name: "invalid jsonpath", opts: Options{Format: "jsonpath={.items[}"}, wantErr: true},
+		{name: "invalid template", opts: Options{Format: "go-template={{.name"}, wantErr: true},
+		{name: "jsonpath flag with output flag", opts: Options{Format: FormatJSON, JSONPath: ".items"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.opts.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	if (Options{Format: FormatWide}).Structured() {
+		t.Error("expected the wide format to print a table")
+	}
+	if err := (Options{Format: FormatJSON}).RequireTable("--legacy"); err == nil {
+		t.Error("expected structured output to be rejected")
+	}
+}
+
+func TestPrintNameUnsupported(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (Options{Format: FormatName}).Print(&buf, map[string]string{"name": "rag"}); err == nil {
+		t.Error("expected an error for a result without names")
+	}
+}
+
+func TestBindFlagsCheck(t *testing.T) {
+	group := &cobra.Command{Use: "group"}
+	BindFlags(group.PersistentFlags())
+	list := &cobra.Command{Use: "list", RunE: func(*cobra.Command, []string) error { return nil }}
+	Supported(list)
+	create := &cobra.Command{Use: "create", RunE: func(*cobra.Command, []string) error { return nil }}
+	group.AddCommand(list, create)
+	t.Cleanup(func() { selected = Options{} })
+
+	tests := []struct {
+		args    []string
+		wantErr bool
+	}{
+		{args: []string{"create"}},
+		{args: []string{"list", "-o", "json"}},
+		{args: []string{"list", "--jsonpath", ".items"}},
+		{args: []string{"create", "-o", "json"}, wantErr: true},
+		{args: []string{"create", "--jsonpath", ".items"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		selected = Options{}
+		cmd, _, err := group.Find(tt.args)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := cmd.ParseFlags(tt.args[1:]); err != nil {
+			t.Fatal(err)
+		}
+		if err := Check(cmd); (err != nil) != tt.wantErr {
+			t.Errorf("Check(%v) error = %v, want error %v", tt.args, err, tt.wantErr)
+		}
+	}
+	if Selected().JSONPath != ".items" {
+		t.Errorf("Selected() = %+v, want the parsed flags", *Selected())
+	}
+}
@@ -9,3 +9,10 @@ const (
 	ApplicationTemplateKey   = "ai-services.io/template"
 	PrerequisiteLabelKey     = "ai-services.io/prerequisite"
 )
+
+// Podman restricts a container to CPUs and NUMA memory nodes with these annotations, suffixed with
+// "/<container name>".
+const (
+	CPUSetAnnotation      = "io.podman.annotations.cpuset"
+	MemoryNodesAnnotation = "io.podman.annotations.memory-nodes"
+)
@@ -12,13 +12,19 @@ import (
 	"helm.sh/helm/v4/pkg/chart"
 	"helm.sh/helm/v4/pkg/cli"
 	"helm.sh/helm/v4/pkg/kube"
+	"helm.sh/helm/v4/pkg/postrenderer"
 	releasev1 "helm.sh/helm/v4/pkg/release/v1"
 	"helm.sh/helm/v4/pkg/storage/driver"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/imagemirror"
 )
 
 type Helm struct {
 	namespace    string
 	actionConfig *action.Configuration
+	// postRenderer rewrites the images of the rendered manifests to their mirrors, nil when no
+	// mirror is configured.
+	postRenderer postrenderer.PostRenderer
 }
 
 func NewHelm(namespace string) (*Helm, error) {
@@ -40,10 +46,20 @@ func NewHelm(namespace string) (*Helm, error) {
 		return nil, fmt.Errorf("failed to initialize Helm action config: %w", err)
 	}
 
-	return &Helm{
+	h := &Helm{
 		namespace:    namespace,
 		actionConfig: actionConfig,
-	}, nil
+	}
+
+	mirrors, err := imagemirror.Current()
+	if err != nil {
+		return nil, err
+	}
+	if len(mirrors.Mirrors) > 0 {
+		h.postRenderer = imagemirror.PostRenderer{Config: mirrors}
+	}
+
+	return h, nil
 }
 
 type InstallOpts struct {
@@ -60,6 +76,7 @@ func (h *Helm) Install(ctx context.Context, release string, chart chart.Charter,
 	installClient.WaitStrategy = kube.StatusWatcherStrategy
 	installClient.Timeout = opts.Timeout
 	installClient.SkipSchemaValidation = true
+	installClient.PostRenderer = h.postRenderer
 
 	// Perform helm install
 	_, err := installClient.RunWithContext(ctx, chart, opts.Values)
@@ -85,6 +102,7 @@ func (h *Helm) Upgrade(ctx context.Context, release string, chart chart.Charter,
 	upgradeClient.ForceConflicts = true
 	upgradeClient.RollbackOnFailure = true
 	upgradeClient.SkipSchemaValidation = true
+	upgradeClient.PostRenderer = h.postRenderer
 
 	// Perform helm upgrade
 	_, err := upgradeClient.RunWithContext(ctx, release, chart, opts.Values)
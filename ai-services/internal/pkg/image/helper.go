@@ -2,32 +2,142 @@ package image
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/imagemirror"
+	"github.com/project-ai-services/ai-services/internal/pkg/imagepolicy"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/transfer"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
+// progressPuller is a runtime reporting the bytes of the images it pulls.
+type progressPuller interface {
+	PullImageWithProgress(ctx context.Context, image string, progress transfer.ProgressFunc) error
+}
+
 // PullImageFromRegistry pulls the required images from registry with retry logic.
 func PullImageFromRegistry(ctx context.Context, runtime runtime.Runtime, images []string) error {
-	for _, image := range images {
+	return PullImages(ctx, runtime, images, nil)
+}
+
+// PullImages pulls images from registry with retry logic, transfer.Concurrency() at a time, and
+// reports their progress to tracker, which may be nil. An image already being pulled for another
+// deployment is waited for rather than pulled again.
+func PullImages(ctx context.Context, runtime runtime.Runtime, images []string, tracker *transfer.Tracker) error {
+	puller, withProgress := runtime.(progressPuller)
+
+	err := transfer.Run(ctx, transfer.Concurrency(), images, func(ctx context.Context, image string) error {
 		logger.InfolnCtx(ctx, "Downloading image: "+image+"...")
-		if err := utils.Retry(ctx, vars.RetryCount, vars.RetryInterval, nil, func() error {
-			return runtime.PullImage(ctx, image)
-		}); err != nil {
-			return fmt.Errorf("failed to download image: %w", err)
+		err := transfer.Shared.Do(ctx, "image:"+image, tracker.Progress(transfer.KindImage, image), func(ctx context.Context, progress transfer.ProgressFunc) error {
+			return utils.Retry(ctx, vars.RetryCount, vars.RetryInterval, nil, func() error {
+				if withProgress {
+					return puller.PullImageWithProgress(ctx, image, progress)
+				}
+
+				return runtime.PullImage(ctx, image)
+			})
+		})
+		if err != nil {
+			return err
 		}
+		tracker.Done(transfer.KindImage, image)
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to download image: %w", err)
 	}
 
 	return nil
 }
 
-// FetchImagesNotFound returns list of images which are not present locally.
+// Verify checks the images, by the references of their mirrors, against the image policy before
+// they are pulled or deployed, returning the digests they were verified for. Nothing is checked
+// when no policy is configured. Pulls are by tag, so check the pulled images with CheckDigests.
+func Verify(ctx context.Context, images []string) ([]*imagepolicy.Verified, error) {
+	policy, err := imagepolicy.Current()
+	if err != nil {
+		return nil, err
+	}
+	if !policy.Enforced() {
+		return nil, nil
+	}
+
+	mirrors, err := imagemirror.Current()
+	if err != nil {
+		return nil, err
+	}
+
+	logger.InfolnCtx(ctx, "Verifying container images against the image policy...")
+	verifier := imagepolicy.NewVerifier(policy, imagepolicy.NewStore(imagepolicy.SignaturesDir()), imagepolicy.Registry{Mirrors: mirrors})
+
+	return verifier.VerifyImages(ctx, mirrors.RewriteImages(images))
+}
+
+// CheckDigests checks that the local images are the ones Verify verified: a tag may have moved
+// since it was verified, or the image may have been pulled before. Images not present locally are
+// skipped.
+func CheckDigests(runtime runtime.Runtime, verified []*imagepolicy.Verified) error {
+	if len(verified) == 0 {
+		return nil
+	}
+
+	lImages, err := runtime.ListImages()
+	if err != nil {
+		return fmt.Errorf("failed to list local images: %w", err)
+	}
+
+	var errs []error
+	for _, v := range verified {
+		if v.Digest == "" {
+			continue
+		}
+
+		ref := localReference(v.Image)
+		for _, lImage := range lImages {
+			if !slices.Contains(lImage.RepoTags, ref) && !slices.Contains(lImage.RepoDigests, ref) {
+				continue
+			}
+			if !slices.ContainsFunc(lImage.RepoDigests, func(d string) bool { return strings.HasSuffix(d, "@"+v.Digest) }) {
+				errs = append(errs, fmt.Errorf("local image %s has digests %v, not the verified %s", v.Image, lImage.RepoDigests, v.Digest))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("image verification failed: %w", errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// localReference returns the reference an image is stored under locally, fully qualified and with
+// the latest tag when it has neither tag nor digest.
+func localReference(image string) string {
+	ref := imagemirror.Qualify(image)
+	if strings.Contains(ref, "@") || strings.Contains(ref[strings.LastIndex(ref, "/")+1:], ":") {
+		return ref
+	}
+
+	return ref + ":latest"
+}
+
+// FetchImagesNotFound returns list of images which are not present locally, by the references
+// of their mirrors when mirrors are configured.
 func FetchImagesNotFound(runtime runtime.Runtime, reqImages []string) ([]string, error) {
 	notfoundImages := make([]string, 0, len(reqImages))
 
+	mirrors, err := imagemirror.Current()
+	if err != nil {
+		return nil, err
+	}
+	reqImages = mirrors.RewriteImages(reqImages)
+
 	// Verify the images existing locally
 	lImages, err := runtime.ListImages()
 	if err != nil {
@@ -48,10 +158,24 @@ func FetchImagesNotFound(runtime runtime.Runtime, reqImages []string) ([]string,
 
 	// Filter the requested images against the existingImages map to determine the non existing images
 	for _, image := range reqImages {
-		if !existingImages[image] {
+		if !existingImages[image] && !existingImages[withoutTag(image)] {
 			notfoundImages = append(notfoundImages, image)
 		}
 	}
 
 	return notfoundImages, nil
 }
+
+// withoutTag drops the tag of an image pinned to a digest, e.g. repo:tag@sha256:... becomes
+// repo@sha256:..., the form of the digests of local images.
+func withoutTag(image string) string {
+	name, digest, ok := strings.Cut(image, "@")
+	if !ok {
+		return image
+	}
+	if colon := strings.LastIndex(name, ":"); colon > strings.LastIndex(name, "/") {
+		name = name[:colon]
+	}
+
+	return name + "@" + digest
+}
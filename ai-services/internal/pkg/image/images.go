@@ -9,6 +9,7 @@ import (
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/transfer"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
@@ -32,6 +33,8 @@ type Images struct {
 	Runtime     runtime.Runtime
 	App         string
 	AppTemplate string
+	// Tracker, when set, is told the progress of the images pulled.
+	Tracker *transfer.Tracker
 }
 
 // ListImages returns the list of images required for the application template.
@@ -80,23 +83,33 @@ func (img *Images) Run(ctx context.Context, policy ImagePullPolicy) error {
 		return fmt.Errorf("failed to list container images for app %s template %s: %w", img.App, img.AppTemplate, err)
 	}
 
+	verified, err := Verify(ctx, images)
+	if err != nil {
+		return err
+	}
+
 	switch policy {
 	case PullAlways:
-		return img.always(ctx, images)
+		err = img.always(ctx, images)
 	case PullIfNotPresent:
-		return img.IfNotPresent(ctx, images)
+		err = img.IfNotPresent(ctx, images)
 	case PullNever:
-		return img.never(ctx, images)
+		err = img.never(ctx, images)
 	default:
 		return fmt.Errorf("unsupported policy: %s", policy)
 	}
+	if err != nil {
+		return err
+	}
+
+	return CheckDigests(img.Runtime, verified)
 }
 
 // always -> pulls all the images for a given app template.
 func (img *Images) always(ctx context.Context, images []string) error {
 	logger.InfolnCtx(ctx, "Downloading container images required for application template "+img.AppTemplate+":")
 
-	return PullImageFromRegistry(ctx, img.Runtime, images)
+	return PullImages(ctx, img.Runtime, images, img.Tracker)
 }
 
 // IfNotPresent pulls only the missing images for a given app template.
@@ -112,7 +125,7 @@ func (img *Images) IfNotPresent(ctx context.Context, images []string) error {
 		return nil
 	}
 
-	return PullImageFromRegistry(ctx, img.Runtime, notFoundImages)
+	return PullImages(ctx, img.Runtime, notFoundImages, img.Tracker)
 }
 
 // never -> never pulls any image.
@@ -0,0 +1,169 @@
+// Package imagemirror rewrites the container images of the catalog to internal mirrors.
+//
+// The images of the templates point at public registries. The registries file of the base
+// directory, e.g. /var/lib/ai-services/registries.yaml, maps registry or repository prefixes to
+// mirrors:
+//
+//	mirrors:
+//	  - source: icr.io
+//	    mirror: registry.local/icr
+//	    pinDigests: true
+//	auth:
+//	  registry.local:
+//	    authFile: /root/.config/containers/auth.json
+//	digests:
+//	  registry.local/icr/ai-services-cicd/chatbot-ui:v0.0.50: sha256:...
+//
+// Images are rewritten wherever they are listed, pulled or rendered into pod specs and Helm
+// manifests, for both runtimes, so that deployments only reach the mirrors. Rules pinning digests
+// reference the mirrored images by the digests recorded when they were mirrored.
+package imagemirror
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+// configFileName is the name of the registries file in the base directory.
+const configFileName = "registries.yaml"
+
+// Rule rewrites the images below Source to the same path below Mirror.
+type Rule struct {
+	// Source is a registry, e.g. icr.io, or a repository prefix, e.g. icr.io/ai-services-cicd.
+	Source string `yaml:"source"`
+	// Mirror replaces Source in the image references.
+	Mirror string `yaml:"mirror"`
+	// PinDigests references the mirrored images by the digests recorded in Config.Digests.
+	PinDigests bool `yaml:"pinDigests,omitempty"`
+}
+
+// Auth holds how to authenticate to a registry.
+type Auth struct {
+	// AuthFile is a containers auth.json with the credentials of the registry.
+	AuthFile string `yaml:"authFile,omitempty"`
+	// Insecure skips the verification of the certificate of the registry.
+	Insecure bool `yaml:"insecure,omitempty"`
+}
+
+// Config is the content of the registries file.
+type Config struct {
+	Mirrors []Rule `yaml:"mirrors,omitempty"`
+	// Auth is keyed by registry host, e.g. registry.local:5000.
+	Auth map[string]Auth `yaml:"auth,omitempty"`
+	// Digests maps mirrored images to the digests of their manifests.
+	Digests map[string]string `yaml:"digests,omitempty"`
+}
+
+// ConfigPath returns the path of the registries file.
+func ConfigPath() string {
+	return filepath.Join(utils.GetBaseDir(), configFileName)
+}
+
+// Load reads the registries file at path. A missing file configures no mirrors.
+func Load(path string) (*Config, error) {
+	cfg := &Config{}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read registries file: %w", err)
+	}
+
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("invalid registries file %s: %w", path, err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid registries file %s: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+// Current reads the registries file of the base directory.
+func Current() (*Config, error) {
+	return Load(ConfigPath())
+}
+
+// Save writes the configuration to the registries file at path.
+func (c *Config) Save(path string) error {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("failed to encode registries file: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), constants.DirPerm); err != nil {
+		return fmt.Errorf("failed to create directory of registries file: %w", err)
+	}
+	if err := os.WriteFile(path, data, constants.FilePerm); err != nil {
+		return fmt.Errorf("failed to write registries file: %w", err)
+	}
+
+	return nil
+}
+
+// Validate checks the rules of the configuration.
+func (c *Config) Validate() error {
+	sources := map[string]bool{}
+	for _, rule := range c.Mirrors {
+		if !validPrefix(rule.Source) || !validPrefix(rule.Mirror) {
+			return fmt.Errorf("mirror rule %q -> %q must map registries or repository prefixes, without tag or digest", rule.Source, rule.Mirror)
+		}
+		if sources[rule.Source] {
+			return fmt.Errorf("source %s has several mirror rules", rule.Source)
+		}
+		sources[rule.Source] = true
+	}
+
+	return nil
+}
+
+// SetRule adds the rule, replacing the one of the same source.
+func (c *Config) SetRule(rule Rule) {
+	for i := range c.Mirrors {
+		if c.Mirrors[i].Source == rule.Source {
+			c.Mirrors[i] = rule
+
+			return
+		}
+	}
+	c.Mirrors = append(c.Mirrors, rule)
+}
+
+// AuthFor returns how to authenticate to the registry of an image.
+func (c *Config) AuthFor(image string) Auth {
+	return c.Auth[Registry(image)]
+}
+
+// Registry returns the registry host of an image reference, docker.io when it has none.
+func Registry(image string) string {
+	host, _, ok := strings.Cut(image, "/")
+	if !ok || !strings.ContainsAny(host, ".:") && host != "localhost" {
+		return "docker.io"
+	}
+
+	return host
+}
+
+// validPrefix reports whether p is a registry or repository prefix, without tag or digest. A
+// colon is only allowed in the registry host, before its port.
+func validPrefix(p string) bool {
+	if p == "" || strings.ContainsAny(p, "@ ") || strings.HasSuffix(p, "/") {
+		return false
+	}
+	_, path, _ := strings.Cut(p, "/")
+
+	return !strings.Contains(path, ":")
+}
+
+// Made with Bob
@@ -0,0 +1,218 @@
+package imagemirror
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func testConfig() *Config {
+	return &Config{
+		Mirrors: []Rule{
+			{Source: "icr.io", Mirror: "registry.local/icr"},
+			{Source: "icr.io/ppc64le-oss", Mirror: "registry.local:5000/oss", PinDigests: true},
+			{Source: "docker.io", Mirror: "registry.local/hub"},
+		},
+		Digests: map[string]string{
+			"registry.local:5000/oss/vllm-ppc64le:0.19.1": "sha256:abc",
+		},
+	}
+}
+
+func TestRewrite(t *testing.T) {
+	cfg := testConfig()
+	tests := []struct {
+		image string
+		want  string
+	}{
+		{"icr.io/ai-services-cicd/chatbot-ui:v0.0.50", "registry.local/icr/ai-services-cicd/chatbot-ui:v0.0.50"},
+		// the longest source wins, and pins the digest recorded for the mirrored image
+		{"icr.io/ppc64le-oss/vllm-ppc64le:0.19.1", "registry.local:5000/oss/vllm-ppc64le:0.19.1@sha256:abc"},
+		// no digest recorded
+		{"icr.io/ppc64le-oss/opensearch-ppc64le:3.5.0", "registry.local:5000/oss/opensearch-ppc64le:3.5.0"},
+		// a source only matches up to a separator
+		{"icr.iox/app:v1", "icr.iox/app:v1"},
+		{"icr.io/ppc64le-oss-extra/app:v1", "registry.local/icr/ppc64le-oss-extra/app:v1"},
+		// images of Docker Hub without registry
+		{"nginx:1", "registry.local/hub/nginx:1"},
+		{"library/nginx", "registry.local/hub/library/nginx"},
+		// images without rule, and images already mirrored, are kept
+		{"registry.redhat.io/rhaii/vllm-spyre-rhel9:3.4.0", "registry.redhat.io/rhaii/vllm-spyre-rhel9:3.4.0"},
+		{"registry.local/icr/ai-services-cicd/chatbot-ui:v0.0.50", "registry.local/icr/ai-services-cicd/chatbot-ui:v0.0.50"},
+		{"registry.local:5000/oss/vllm-ppc64le:0.19.1@sha256:abc", "registry.local:5000/oss/vllm-ppc64le:0.19.1@sha256:abc"},
+	}
+	for _, tt := range tests {
+		if got := cfg.Rewrite(tt.image); got != tt.want {
+			t.Errorf("Rewrite(%q) = %q, want %q", tt.image, got, tt.want)
+		}
+		if got := cfg.Rewrite(cfg.Rewrite(tt.image)); got != tt.want {
+			t.Errorf("Rewrite(Rewrite(%q)) = %q, want %q", tt.image, got, tt.want)
+		}
+	}
+}
+
+func TestMirrorOf(t *testing.T) {
+	tests := []struct {
+		image  string
+		mirror string
+		want   string
+	}{
+		{"icr.io/ai-services-cicd/chatbot-ui:v0.0.50", "registry.local", "registry.local/ai-services-cicd/chatbot-ui:v0.0.50"},
+		{"icr.io/ai-services-cicd/chatbot-ui:v0.0.50", "registry.local:5000/ai/", "registry.local:5000/ai/ai-services-cicd/chatbot-ui:v0.0.50"},
+		{"nginx:1", "registry.local", "registry.local/nginx:1"},
+		{"library/nginx", "registry.local", "registry.local/library/nginx"},
+	}
+	for _, tt := range tests {
+		if got := MirrorOf(tt.image, tt.mirror); got != tt.want {
+			t.Errorf("MirrorOf(%q, %q) = %q, want %q", tt.image, tt.mirror, got, tt.want)
+		}
+	}
+
+	// the rule written for the registry of an image rewrites it to where it was mirrored
+	image := "icr.io/ai-services-cicd/chatbot-ui:v0.0.50"
+	cfg := &Config{Mirrors: []Rule{{Source: Registry(image), Mirror: "registry.local"}}}
+	if got, want := cfg.Rewrite(image), MirrorOf(image, "registry.local"); got != want {
+		t.Errorf("Rewrite(%q) = %q, want %q", image, got, want)
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	tests := map[string]string{
+		"icr.io/ai-services-cicd/chatbot-ui:v0.0.50": "icr.io",
+		"registry.local:5000/app":                    "registry.local:5000",
+		"localhost/app":                              "localhost",
+		"library/nginx":                              "docker.io",
+		"nginx:1":                                    "docker.io",
+	}
+	for image, want := range tests {
+		if got := Registry(image); got != want {
+			t.Errorf("Registry(%q) = %q, want %q", image, got, want)
+		}
+	}
+}
+
+func TestRewriteManifests(t *testing.T) {
+	manifests := `apiVersion: v1
+kind: Pod
+metadata:
+  name: chat
+  annotations:
+    image: icr.io/ai-services-cicd/chatbot-ui:v0.0.50
+spec:
+  containers:
+    - name: ui
+      image: icr.io/ai-services-cicd/chatbot-ui:v0.0.50
+      env:
+        - name: IMAGE
+          value: icr.io/ai-services-cicd/chatbot-ui:v0.0.50
+---
+apiVersion: v1
+kind: ConfigMap
+data:
+  image: icr.io/ppc64le-oss/vllm-ppc64le:0.19.1
+`
+	out, err := testConfig().RewriteManifests([]byte(manifests))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := string(out)
+	if n := strings.Count(got, "registry.local/icr/ai-services-cicd/chatbot-ui:v0.0.50"); n != 2 {
+		t.Errorf("expected the image of the container and the annotation to be rewritten, got %d rewrites:\n%s", n, got)
+	}
+	if !strings.Contains(got, "value: icr.io/ai-services-cicd/chatbot-ui:v0.0.50") {
+		t.Errorf("expected values of other keys to be kept:\n%s", got)
+	}
+	if !strings.Contains(got, "image: registry.local:5000/oss/vllm-ppc64le:0.19.1@sha256:abc") {
+		t.Errorf("expected the image of the second document to be rewritten:\n%s", got)
+	}
+	if strings.Count(got, "---") != 1 {
+		t.Errorf("expected two documents:\n%s", got)
+	}
+
+	// without mirrors the manifests are kept as they are
+	out, err = (&Config{}).RewriteManifests([]byte(manifests))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != manifests {
+		t.Errorf("expected manifests to be unchanged, got:\n%s", out)
+	}
+
+	if _, err := testConfig().RewriteManifests([]byte("image: [")); err == nil {
+		t.Error("expected invalid manifests to fail")
+	}
+}
+
+func TestPostRenderer(t *testing.T) {
+	renderer := PostRenderer{Config: testConfig()}
+	out, err := renderer.Run(bytes.NewBufferString("image: icr.io/ai-services-cicd/caddy:v2.11.4-2\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := out.String(), "image: registry.local/icr/ai-services-cicd/caddy:v2.11.4-2\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestLoadSave(t *testing.T) {
+	path := filepath.Join(t.TempDir(), configFileName)
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("expected a missing registries file to configure no mirrors: %v", err)
+	}
+	if len(cfg.Mirrors) != 0 {
+		t.Fatalf("expected no mirrors, got %v", cfg.Mirrors)
+	}
+
+	cfg = testConfig()
+	cfg.Auth = map[string]Auth{"registry.local:5000": {AuthFile: "/etc/auth.json", Insecure: true}}
+	cfg.SetRule(Rule{Source: "icr.io", Mirror: "registry.local/ibm"})
+	if err := cfg.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(loaded.Mirrors) != 3 || loaded.Mirrors[0].Mirror != "registry.local/ibm" {
+		t.Errorf("expected the rule of icr.io to be replaced, got %v", loaded.Mirrors)
+	}
+	if auth := loaded.AuthFor("registry.local:5000/oss/app:v1"); auth.AuthFile != "/etc/auth.json" || !auth.Insecure {
+		t.Errorf("unexpected auth %+v", auth)
+	}
+	if loaded.Digests["registry.local:5000/oss/vllm-ppc64le:0.19.1"] != "sha256:abc" {
+		t.Errorf("expected digests to be kept, got %v", loaded.Digests)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	invalid := map[string][]Rule{
+		"empty source":     {{Source: "", Mirror: "registry.local"}},
+		"tag":              {{Source: "icr.io/app:v1", Mirror: "registry.local"}},
+		"digest":           {{Source: "icr.io", Mirror: "registry.local/app@sha256:abc"}},
+		"trailing slash":   {{Source: "icr.io/", Mirror: "registry.local"}},
+		"duplicate source": {{Source: "icr.io", Mirror: "a.local"}, {Source: "icr.io", Mirror: "b.local"}},
+	}
+	for name, rules := range invalid {
+		if err := (&Config{Mirrors: rules}).Validate(); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+
+	if err := testConfig().Validate(); err != nil {
+		t.Errorf("expected a valid configuration: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), configFileName)
+	if err := os.WriteFile(path, []byte("mirrors:\n  - source: icr.io/\n    mirror: registry.local\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(path); err == nil {
+		t.Error("expected an invalid registries file to fail to load")
+	}
+}
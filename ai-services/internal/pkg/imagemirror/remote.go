@@ -0,0 +1,83 @@
+package imagemirror
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"go.podman.io/image/v5/pkg/docker/config"
+	"go.podman.io/image/v5/types"
+)
+
+// Remote holds how to reach the registry of an image with go-containerregistry.
+type Remote struct {
+	Ref       name.Reference
+	Auth      authn.Authenticator
+	Transport http.RoundTripper
+}
+
+// Remote parses an image reference and returns how to reach its registry, with the auth of the
+// registries file. Registries without an auth file use the credentials of REGISTRY_AUTH_FILE or
+// of the default containers auth files.
+func (c *Config) Remote(image string, opts ...name.Option) (*Remote, error) {
+	var auth Auth
+	if c != nil {
+		auth = c.AuthFor(image)
+	}
+
+	transport := http.RoundTripper(remote.DefaultTransport)
+	if auth.Insecure {
+		opts = append(opts, name.Insecure)
+		transport = InsecureTransport()
+	}
+
+	ref, err := name.ParseReference(image, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid image reference %s: %w", image, err)
+	}
+
+	authFile := auth.AuthFile
+	if authFile == "" {
+		authFile = os.Getenv("REGISTRY_AUTH_FILE")
+	}
+	// Auth files key Docker Hub as docker.io
+	registry := ref.Context().RegistryStr()
+	if registry == name.DefaultRegistry {
+		registry = "docker.io"
+	}
+	creds, err := config.GetCredentials(&types.SystemContext{AuthFilePath: authFile}, registry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read credentials of %s: %w", registry, err)
+	}
+
+	r := &Remote{Ref: ref, Auth: authn.Anonymous, Transport: transport}
+	if creds.Username != "" || creds.IdentityToken != "" {
+		r.Auth = authn.FromConfig(authn.AuthConfig{
+			Username:      creds.Username,
+			Password:      creds.Password,
+			IdentityToken: creds.IdentityToken,
+		})
+	}
+
+	return r, nil
+}
+
+// Options returns the options of the remote package to reach the registry.
+func (r *Remote) Options(ctx context.Context) []remote.Option {
+	return []remote.Option{remote.WithContext(ctx), remote.WithAuth(r.Auth), remote.WithTransport(r.Transport)}
+}
+
+// InsecureTransport returns a transport skipping the verification of registry certificates.
+func InsecureTransport() *http.Transport {
+	transport := remote.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec // requested for registries with self-signed certificates
+
+	return transport
+}
+
+// Made with Bob
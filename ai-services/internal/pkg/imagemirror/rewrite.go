@@ -0,0 +1,172 @@
+package imagemirror
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// imageKey is the key of the container images in pod specs and Helm manifests.
+const imageKey = "image"
+
+// Rewrite returns the reference of an image on its mirror, pinned to its recorded digest when the
+// rule says so. Images without rule, and images already on a mirror, are kept.
+func (c *Config) Rewrite(image string) string {
+	rule := c.mirrorRule(image)
+	if rule == nil {
+		qualified := Qualify(image)
+		if rule = c.sourceRule(qualified); rule == nil {
+			return image
+		}
+		image = rule.Mirror + strings.TrimPrefix(qualified, rule.Source)
+	}
+
+	if rule.PinDigests && !strings.Contains(image, "@") {
+		if digest := c.Digests[image]; digest != "" {
+			image += "@" + digest
+		}
+	}
+
+	return image
+}
+
+// RewriteImages rewrites a list of images.
+func (c *Config) RewriteImages(images []string) []string {
+	rewritten := make([]string, len(images))
+	for i, image := range images {
+		rewritten[i] = c.Rewrite(image)
+	}
+
+	return rewritten
+}
+
+// MirrorOf returns the reference of an image below the given mirror prefix, keeping its path
+// without the registry, e.g. registry.local/ai-services-cicd/chatbot-ui:v1 for
+// icr.io/ai-services-cicd/chatbot-ui:v1.
+func MirrorOf(image, mirror string) string {
+	path := image
+	if host, rest, ok := strings.Cut(image, "/"); ok && Registry(image) == host {
+		path = rest
+	}
+
+	return strings.TrimSuffix(mirror, "/") + "/" + path
+}
+
+// Qualify prefixes the images of Docker Hub without registry with docker.io, so that rules of
+// docker.io match them.
+func Qualify(image string) string {
+	if Registry(image) == "docker.io" && !strings.HasPrefix(image, "docker.io/") {
+		return "docker.io/" + image
+	}
+
+	return image
+}
+
+// sourceRule returns the rule of the longest source prefixing the image.
+func (c *Config) sourceRule(image string) *Rule {
+	var best *Rule
+	for i := range c.Mirrors {
+		rule := &c.Mirrors[i]
+		if HasPrefix(image, rule.Source) && (best == nil || len(rule.Source) > len(best.Source)) {
+			best = rule
+		}
+	}
+
+	return best
+}
+
+// mirrorRule returns the rule whose mirror prefixes the image.
+func (c *Config) mirrorRule(image string) *Rule {
+	for i := range c.Mirrors {
+		if HasPrefix(image, c.Mirrors[i].Mirror) {
+			return &c.Mirrors[i]
+		}
+	}
+
+	return nil
+}
+
+// HasPrefix reports whether prefix is a registry or repository prefix of the image, ending at a
+// path, tag or digest separator.
+func HasPrefix(image, prefix string) bool {
+	if !strings.HasPrefix(image, prefix) {
+		return false
+	}
+	rest := image[len(prefix):]
+
+	return rest == "" || strings.ContainsRune("/:@", rune(rest[0]))
+}
+
+// RewriteManifests rewrites the images of multi-document YAML, such as the pod specs played by
+// podman or the manifests rendered by Helm: every string under an "image" key. Manifests are
+// returned unchanged when no mirror is configured.
+func (c *Config) RewriteManifests(data []byte) ([]byte, error) {
+	if len(c.Mirrors) == 0 {
+		return data, nil
+	}
+
+	var out bytes.Buffer
+	enc := yaml.NewEncoder(&out)
+	enc.SetIndent(2)
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var doc yaml.Node
+		err := dec.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse manifests: %w", err)
+		}
+		if len(doc.Content) == 0 {
+			continue
+		}
+
+		c.rewriteNode(&doc)
+		if err := enc.Encode(&doc); err != nil {
+			return nil, fmt.Errorf("failed to encode manifests: %w", err)
+		}
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode manifests: %w", err)
+	}
+
+	return out.Bytes(), nil
+}
+
+func (c *Config) rewriteNode(node *yaml.Node) {
+	if node.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if key.Value == imageKey && value.Kind == yaml.ScalarNode && value.Tag == "!!str" {
+				value.Value = c.Rewrite(value.Value)
+			}
+		}
+	}
+
+	for _, child := range node.Content {
+		c.rewriteNode(child)
+	}
+}
+
+// PostRenderer rewrites the images of the manifests rendered by Helm.
+type PostRenderer struct {
+	Config *Config
+}
+
+// Run implements the post renderer interface of Helm.
+func (p PostRenderer) Run(rendered *bytes.Buffer) (*bytes.Buffer, error) {
+	data, err := p.Config.RewriteManifests(rendered.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.NewBuffer(data), nil
+}
+
+// Made with Bob
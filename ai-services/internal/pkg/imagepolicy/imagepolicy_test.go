@@ -0,0 +1,423 @@
+package imagepolicy
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	testRepo   = "icr.io/ai-services-cicd/chatbot-ui"
+	testDigest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	testImage  = testRepo + ":v0.0.50"
+)
+
+// fakeSource is a registry holding digests by image and signatures by digest.
+type fakeSource struct {
+	digests map[string]string
+	sigs    map[string][]Signature
+	calls   int
+}
+
+func (f *fakeSource) Digest(_ context.Context, image string) (string, error) {
+	f.calls++
+	if digest, ok := f.digests[image]; ok {
+		return digest, nil
+	}
+
+	return "", fmt.Errorf("manifest unknown")
+}
+
+func (f *fakeSource) Signatures(_ context.Context, _, digest string) ([]Signature, error) {
+	f.calls++
+
+	return f.sigs[digest], nil
+}
+
+func newKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return key
+}
+
+// writePublicKey writes the PEM public key of key and returns its path.
+func writePublicKey(t *testing.T, key *ecdsa.PrivateKey) string {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "key.pub")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func payloadOf(repository, digest string) []byte {
+	return fmt.Appendf(nil, `{"critical":{"identity":{"docker-reference":%q},"image":{"docker-manifest-digest":%q},"type":%q},"optional":null}`,
+		repository, digest, payloadType)
+}
+
+func sign(t *testing.T, key *ecdsa.PrivateKey, data []byte) []byte {
+	t.Helper()
+
+	sum := sha256.Sum256(data)
+	raw, err := ecdsa.SignASN1(rand.Reader, key, sum[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return raw
+}
+
+func signed(t *testing.T, key *ecdsa.PrivateKey, repository, digest string) Signature {
+	t.Helper()
+
+	payload := payloadOf(repository, digest)
+
+	return Signature{Payload: payload, Signature: base64.StdEncoding.EncodeToString(sign(t, key, payload))}
+}
+
+func TestVerifyWithKeys(t *testing.T) {
+	key := newKey(t)
+	policy := &Policy{Signatures: []SignatureRule{{Scope: "icr.io/ai-services-cicd", Keys: []string{writePublicKey(t, key)}}}}
+
+	tests := []struct {
+		name    string
+		sigs    []Signature
+		wantErr string
+	}{
+		{name: "signed", sigs: []Signature{signed(t, key, testRepo, testDigest)}},
+		{name: "one of the signatures is trusted", sigs: []Signature{signed(t, newKey(t), testRepo, testDigest), signed(t, key, testRepo, testDigest)}},
+		{name: "unsigned", wantErr: "is not signed"},
+		{name: "untrusted key", sigs: []Signature{signed(t, newKey(t), testRepo, testDigest)}, wantErr: "not signed by a trusted key"},
+		{name: "other digest", sigs: []Signature{signed(t, key, testRepo, "sha256:"+strings.Repeat("f", 64))}, wantErr: "signature is of digest"},
+		{name: "other repository", sigs: []Signature{signed(t, key, "icr.io/ai-services-cicd/other", testDigest)}, wantErr: "signature is of repository"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := &fakeSource{digests: map[string]string{testImage: testDigest}, sigs: map[string][]Signature{testDigest: tt.sigs}}
+			verified, err := NewVerifier(policy, NewStore(t.TempDir()), source).Verify(context.Background(), testImage)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if verified.Digest != testDigest || len(verified.Signatures) != 1 {
+				t.Errorf("unexpected verification %+v", verified)
+			}
+		})
+	}
+}
+
+func TestVerifyPolicy(t *testing.T) {
+	policy := &Policy{AllowedRegistries: []string{"icr.io/ai-services-cicd", "registry.local:5000"}, RequireDigest: true}
+	verifier := NewVerifier(policy, NewStore(t.TempDir()), &fakeSource{})
+
+	if _, err := verifier.Verify(context.Background(), testImage+"@"+testDigest); err != nil {
+		t.Errorf("expected an allowed pinned image to pass: %v", err)
+	}
+	if _, err := verifier.Verify(context.Background(), testImage); err == nil || !strings.Contains(err.Error(), "not pinned by digest") {
+		t.Errorf("expected an image not pinned to fail, got %v", err)
+	}
+	if _, err := verifier.Verify(context.Background(), "icr.io/ppc64le-oss/vllm-ppc64le:0.19.1@"+testDigest); err == nil ||
+		!strings.Contains(err.Error(), "not from an allowed registry") {
+		t.Errorf("expected an image of another repository to fail, got %v", err)
+	}
+
+	_, err := verifier.VerifyImages(context.Background(), []string{testImage, "nginx:1@" + testDigest, testRepo + "@" + testDigest})
+	if err == nil || !strings.Contains(err.Error(), testImage+" is not pinned") || !strings.Contains(err.Error(), "nginx:1@") {
+		t.Errorf("expected the errors of all failing images, got %v", err)
+	}
+}
+
+func TestVerifyOffline(t *testing.T) {
+	key := newKey(t)
+	policy := &Policy{
+		Offline:    true,
+		Signatures: []SignatureRule{{Scope: "icr.io", Keys: []string{writePublicKey(t, key)}}},
+	}
+	store := NewStore(t.TempDir())
+	source := &fakeSource{digests: map[string]string{testImage: testDigest}, sigs: map[string][]Signature{testDigest: {signed(t, key, testRepo, testDigest)}}}
+	verifier := NewVerifier(policy, store, source)
+
+	if _, err := verifier.Verify(context.Background(), testImage); err == nil || !strings.Contains(err.Error(), "pinned by digest to verify its signature offline") {
+		t.Errorf("expected an image not pinned to fail offline, got %v", err)
+	}
+	if _, err := verifier.Verify(context.Background(), testImage+"@"+testDigest); err == nil || !strings.Contains(err.Error(), "is not signed") {
+		t.Errorf("expected an image without stored signatures to fail offline, got %v", err)
+	}
+	if source.calls != 0 {
+		t.Errorf("expected the registry not to be reached offline, got %d calls", source.calls)
+	}
+
+	// signatures verified online are stored for offline verification
+	online, err := NewVerifier(&Policy{Signatures: policy.Signatures}, store, source).Verify(context.Background(), testImage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Save(online.Digest, online.Signatures); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := verifier.Verify(context.Background(), testImage+"@"+testDigest); err != nil {
+		t.Errorf("expected stored signatures to verify the image offline: %v", err)
+	}
+
+	// a stored signature that does not verify is not trusted
+	if err := store.Save(testDigest, []Signature{signed(t, newKey(t), testRepo, testDigest)}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := verifier.Verify(context.Background(), testImage+"@"+testDigest); err == nil || !strings.Contains(err.Error(), "no trusted signature") {
+		t.Errorf("expected an untrusted stored signature to fail, got %v", err)
+	}
+
+	if err := store.Save("../sha256:"+strings.Repeat("0", 64), nil); err == nil {
+		t.Error("expected an invalid digest to be rejected")
+	}
+}
+
+func TestVerifySignedPrefix(t *testing.T) {
+	key := newKey(t)
+	policy := &Policy{Signatures: []SignatureRule{{Scope: "registry.local:5000", Keys: []string{writePublicKey(t, key)}, SignedPrefix: "icr.io"}}}
+	mirrored := "registry.local:5000/ai-services-cicd/chatbot-ui@" + testDigest
+	source := &fakeSource{sigs: map[string][]Signature{testDigest: {signed(t, key, testRepo, testDigest)}}}
+
+	if _, err := NewVerifier(policy, NewStore(t.TempDir()), source).Verify(context.Background(), mirrored); err != nil {
+		t.Errorf("expected a mirrored image signed at its source to pass: %v", err)
+	}
+}
+
+// keylessFixture issues Fulcio-like certificates and Rekor-like bundles.
+type keylessFixture struct {
+	rootKey  *ecdsa.PrivateKey
+	root     *x509.Certificate
+	rekorKey *ecdsa.PrivateKey
+	keyless  *Keyless
+}
+
+func newKeylessFixture(t *testing.T) *keylessFixture {
+	t.Helper()
+
+	f := &keylessFixture{rootKey: newKey(t), rekorKey: newKey(t)}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "fulcio"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &f.rootKey.PublicKey, f.rootKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.root, err = x509.ParseCertificate(der); err != nil {
+		t.Fatal(err)
+	}
+
+	roots := filepath.Join(t.TempDir(), "fulcio.pem")
+	if err := os.WriteFile(roots, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	f.keyless = &Keyless{
+		Issuer:         "https://accounts.example.com",
+		Subject:        "release@example.com",
+		FulcioRoots:    roots,
+		RekorPublicKey: writePublicKey(t, f.rekorKey),
+	}
+
+	return f
+}
+
+// sign returns a keyless signature by a certificate of the identity issued at issued, logged at
+// logged.
+func (f *keylessFixture) sign(t *testing.T, subject string, issued, logged time.Time) Signature {
+	t.Helper()
+
+	key := newKey(t)
+	issuer, err := asn1.Marshal(f.keyless.Issuer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:    big.NewInt(2),
+		NotBefore:       issued,
+		NotAfter:        issued.Add(10 * time.Minute),
+		KeyUsage:        x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		EmailAddresses:  []string{subject},
+		ExtraExtensions: []pkix.Extension{{Id: oidIssuerV2, Value: issuer}},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, f.root, &key.PublicKey, f.rootKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sig := signed(t, key, testRepo, testDigest)
+	sig.Certificate = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+
+	sum := sha256.Sum256(sig.Payload)
+	body := fmt.Sprintf(`{"apiVersion":"0.0.1","kind":"hashedrekord","spec":{"data":{"hash":{"algorithm":"sha256","value":%q}},"signature":{"content":%q}}}`,
+		hex.EncodeToString(sum[:]), sig.Signature)
+	sig.Bundle = &RekorBundle{Payload: RekorPayload{
+		Body:           base64.StdEncoding.EncodeToString([]byte(body)),
+		IntegratedTime: logged.Unix(),
+		LogID:          "c0d23d6ad406973f9559f3ba2d1ca01f84147d8ffc5b8445c224f98b9591801d",
+		LogIndex:       42,
+	}}
+	entry, err := json.Marshal(sig.Bundle.Payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig.Bundle.SignedEntryTimestamp = sign(t, f.rekorKey, entry)
+
+	return sig
+}
+
+func TestVerifyKeyless(t *testing.T) {
+	f := newKeylessFixture(t)
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		sig     func() Signature
+		wantErr string
+	}{
+		{name: "trusted identity", sig: func() Signature { return f.sign(t, f.keyless.Subject, now, now) }},
+		{name: "other identity", sig: func() Signature { return f.sign(t, "someone@example.com", now, now) }, wantErr: "not issued to"},
+		{name: "not logged", sig: func() Signature {
+			sig := f.sign(t, f.keyless.Subject, now, now)
+			sig.Bundle = nil
+
+			return sig
+		}, wantErr: "not logged in Rekor"},
+		{name: "tampered log entry", sig: func() Signature {
+			sig := f.sign(t, f.keyless.Subject, now, now)
+			sig.Bundle.Payload.LogIndex++
+
+			return sig
+		}, wantErr: "not signed by the Rekor log"},
+		{name: "logged after the certificate expired", sig: func() Signature {
+			return f.sign(t, f.keyless.Subject, now, now.Add(time.Hour))
+		}, wantErr: "certificate is not trusted"},
+		{name: "logged entry of another signature", sig: func() Signature {
+			sig := f.sign(t, f.keyless.Subject, now, now)
+			sig.Bundle = f.sign(t, f.keyless.Subject, now, now).Bundle
+
+			return sig
+		}, wantErr: "rekor entry is of another signature"},
+		{name: "no certificate", sig: func() Signature {
+			sig := f.sign(t, f.keyless.Subject, now, now)
+			sig.Certificate = ""
+
+			return sig
+		}, wantErr: "has no certificate"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := &Policy{Signatures: []SignatureRule{{Scope: "icr.io", Keyless: f.keyless}}}
+			source := &fakeSource{sigs: map[string][]Signature{testDigest: {tt.sig()}}}
+			_, err := NewVerifier(policy, NewStore(t.TempDir()), source).Verify(context.Background(), testImage+"@"+testDigest)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestRekorPayloadCanonical(t *testing.T) {
+	entry, err := json.Marshal(RekorPayload{Body: "Ym9keQ==", IntegratedTime: 1, LogID: "id", LogIndex: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"body":"Ym9keQ==","integratedTime":1,"logID":"id","logIndex":2}`; string(entry) != want {
+		t.Errorf("got %s, want %s", entry, want)
+	}
+}
+
+func TestLoadValidate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), policyFileName)
+
+	policy, err := Load(path)
+	if err != nil || policy.Enforced() {
+		t.Fatalf("expected a missing policy to enforce nothing, got %+v, %v", policy, err)
+	}
+
+	invalid := map[string]string{
+		"no scope":         "signatures:\n  - keys: [a.pub]\n",
+		"no keys":          "signatures:\n  - scope: icr.io\n",
+		"duplicate scope":  "signatures:\n  - scope: icr.io\n    keys: [a.pub]\n  - scope: icr.io\n    keys: [b.pub]\n",
+		"keyless no rekor": "signatures:\n  - scope: icr.io\n    keyless:\n      issuer: i\n      subject: s\n      fulcioRoots: r.pem\n",
+	}
+	for name, content := range invalid {
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := Load(path); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+
+	if err := os.WriteFile(path, []byte("allowedRegistries: [icr.io]\nrequireDigest: true\nsignatures:\n  - scope: icr.io\n    keys: [a.pub]\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	policy, err = Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !policy.Enforced() || !policy.RequireDigest || policy.signatureRule(testImage) == nil {
+		t.Errorf("unexpected policy %+v", policy)
+	}
+}
+
+func TestSplitImage(t *testing.T) {
+	tests := map[string][3]string{
+		testImage:                               {testRepo, "v0.0.50", ""},
+		testImage + "@" + testDigest:            {testRepo, "v0.0.50", testDigest},
+		"registry.local:5000/app@" + testDigest: {"registry.local:5000/app", "", testDigest},
+		"registry.local:5000/app":               {"registry.local:5000/app", "", ""},
+	}
+	for image, want := range tests {
+		repository, tag, digest := splitImage(image)
+		if got := [3]string{repository, tag, digest}; got != want {
+			t.Errorf("splitImage(%q) = %v, want %v", image, got, want)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+// Package imagepolicy verifies the container images of deployments against a trust policy.
+//
+// The image policy file of the base directory, e.g. /var/lib/ai-services/image-policy.yaml,
+// restricts the registries images come from, requires images pinned by digest and requires cosign
+// signatures, made with public keys or keyless with certificates of a Fulcio root:
+//
+//	allowedRegistries:
+//	  - icr.io
+//	  - registry.local:5000
+//	requireDigest: true
+//	signatures:
+//	  - scope: icr.io/ai-services-cicd
+//	    keys:
+//	      - /var/lib/ai-services/keys/ai-services.pub
+//	  - scope: registry.redhat.io
+//	    keyless:
+//	      issuer: https://github.com/login/oauth
+//	      subject: release@example.com
+//	      fulcioRoots: /var/lib/ai-services/keys/fulcio.pem
+//	      rekorPublicKey: /var/lib/ai-services/keys/rekor.pub
+//
+// Images are verified as they are pulled, after being rewritten to their mirrors. Signatures are
+// read from the signatures directory of the base directory, where they are stored for offline
+// verification, or else from the signature images of the registries.
+package imagepolicy
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"go.yaml.in/yaml/v3"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/imagemirror"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+const (
+	// policyFileName is the name of the image policy file in the base directory.
+	policyFileName = "image-policy.yaml"
+	// signaturesDirName is the directory of the stored signatures in the base directory.
+	signaturesDirName = "signatures"
+)
+
+// Policy is the content of the image policy file.
+type Policy struct {
+	// AllowedRegistries are the registries or repository prefixes images may come from; any
+	// when empty.
+	AllowedRegistries []string `yaml:"allowedRegistries,omitempty"`
+	// RequireDigest requires images to be pinned by digest.
+	RequireDigest bool `yaml:"requireDigest,omitempty"`
+	// Signatures require the images of their scopes to be signed.
+	Signatures []SignatureRule `yaml:"signatures,omitempty"`
+	// Offline verifies signatures against the stored signatures only, never reaching registries.
+	Offline bool `yaml:"offline,omitempty"`
+}
+
+// SignatureRule requires the images below Scope to have a cosign signature made with one of Keys,
+// or keyless with an identity of Keyless.
+type SignatureRule struct {
+	// Scope is a registry or repository prefix, e.g. icr.io/ai-services-cicd. The rule of the
+	// longest scope applies.
+	Scope string `yaml:"scope"`
+	// Keys are paths to PEM public keys.
+	Keys []string `yaml:"keys,omitempty"`
+	// Keyless accepts signatures with certificates issued to an identity.
+	Keyless *Keyless `yaml:"keyless,omitempty"`
+	// SignedPrefix replaces Scope in the repository the signatures must name, for images
+	// mirrored from where they were signed, e.g. icr.io when Scope is registry.local:5000.
+	SignedPrefix string `yaml:"signedPrefix,omitempty"`
+}
+
+// Keyless accepts signatures with short-lived certificates issued by Fulcio to an identity, and
+// logged in Rekor.
+type Keyless struct {
+	// Issuer is the OIDC issuer that authenticated the identity.
+	Issuer string `yaml:"issuer"`
+	// Subject is the email or URI of the identity.
+	Subject string `yaml:"subject"`
+	// FulcioRoots is the path to the PEM certificates of the Fulcio roots.
+	FulcioRoots string `yaml:"fulcioRoots"`
+	// RekorPublicKey is the path to the PEM public key of the Rekor log.
+	RekorPublicKey string `yaml:"rekorPublicKey"`
+}
+
+// PolicyPath returns the path of the image policy file.
+func PolicyPath() string {
+	return filepath.Join(utils.GetBaseDir(), policyFileName)
+}
+
+// SignaturesDir returns the directory of the stored signatures.
+func SignaturesDir() string {
+	return filepath.Join(utils.GetBaseDir(), signaturesDirName)
+}
+
+// Load reads the image policy file at path. A missing file enforces nothing.
+func Load(path string) (*Policy, error) {
+	policy := &Policy{}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return policy, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image policy: %w", err)
+	}
+
+	if err := yaml.Unmarshal(data, policy); err != nil {
+		return nil, fmt.Errorf("invalid image policy %s: %w", path, err)
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid image policy %s: %w", path, err)
+	}
+
+	return policy, nil
+}
+
+// Current reads the image policy file of the base directory.
+func Current() (*Policy, error) {
+	return Load(PolicyPath())
+}
+
+// Enforced reports whether the policy checks anything.
+func (p *Policy) Enforced() bool {
+	return len(p.AllowedRegistries) > 0 || p.RequireDigest || len(p.Signatures) > 0
+}
+
+// Validate checks the rules of the policy.
+func (p *Policy) Validate() error {
+	scopes := map[string]bool{}
+	for _, rule := range p.Signatures {
+		if rule.Scope == "" {
+			return fmt.Errorf("signature rule without scope")
+		}
+		if scopes[rule.Scope] {
+			return fmt.Errorf("scope %s has several signature rules", rule.Scope)
+		}
+		scopes[rule.Scope] = true
+
+		if len(rule.Keys) == 0 && rule.Keyless == nil {
+			return fmt.Errorf("signature rule of %s needs keys or a keyless identity", rule.Scope)
+		}
+		if k := rule.Keyless; k != nil && (k.Issuer == "" || k.Subject == "" || k.FulcioRoots == "" || k.RekorPublicKey == "") {
+			return fmt.Errorf("keyless signature rule of %s needs an issuer, a subject, Fulcio roots and a Rekor public key", rule.Scope)
+		}
+	}
+
+	return nil
+}
+
+// allowed reports whether the image comes from an allowed registry.
+func (p *Policy) allowed(image string) bool {
+	if len(p.AllowedRegistries) == 0 {
+		return true
+	}
+	for _, prefix := range p.AllowedRegistries {
+		if imagemirror.HasPrefix(image, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// signatureRule returns the rule of the longest scope prefixing the image.
+func (p *Policy) signatureRule(image string) *SignatureRule {
+	var best *SignatureRule
+	for i := range p.Signatures {
+		rule := &p.Signatures[i]
+		if imagemirror.HasPrefix(image, rule.Scope) && (best == nil || len(rule.Scope) > len(best.Scope)) {
+			best = rule
+		}
+	}
+
+	return best
+}
+
+// Made with Bob
@@ -0,0 +1,121 @@
+package imagepolicy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/imagemirror"
+)
+
+// Annotations of the layers of cosign signature images.
+const (
+	signatureAnnotation   = "dev.cosignproject.cosign/signature"
+	certificateAnnotation = "dev.sigstore.cosign/certificate"
+	chainAnnotation       = "dev.sigstore.cosign/chain"
+	bundleAnnotation      = "dev.sigstore.cosign/bundle"
+
+	// maxPayloadSize bounds the simple signing payloads read from registries.
+	maxPayloadSize = 1 << 20
+)
+
+// Registry reads digests and cosign signatures from registries, authenticating with the auth of
+// the registries file.
+type Registry struct {
+	Mirrors *imagemirror.Config
+}
+
+// Digest implements Source.
+func (r Registry) Digest(ctx context.Context, image string) (string, error) {
+	ref, opts, err := r.reference(ctx, image)
+	if err != nil {
+		return "", err
+	}
+
+	desc, err := remote.Head(ref, opts...)
+	if err != nil {
+		return "", err
+	}
+
+	return desc.Digest.String(), nil
+}
+
+// Signatures implements Source, reading the signature image cosign tags after the digest.
+func (r Registry) Signatures(ctx context.Context, repository, digest string) ([]Signature, error) {
+	ref, opts, err := r.reference(ctx, repository+":"+strings.Replace(digest, ":", "-", 1)+".sig")
+	if err != nil {
+		return nil, err
+	}
+
+	img, err := remote.Image(ref, opts...)
+	var terr *transport.Error
+	if errors.As(err, &terr) && terr.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, err := img.Manifest()
+	if err != nil {
+		return nil, err
+	}
+
+	sigs := make([]Signature, 0, len(manifest.Layers))
+	for _, desc := range manifest.Layers {
+		layer, err := img.LayerByDigest(desc.Digest)
+		if err != nil {
+			return nil, err
+		}
+		payload, err := readPayload(layer.Compressed)
+		if err != nil {
+			return nil, err
+		}
+
+		sig := Signature{
+			Payload:     payload,
+			Signature:   desc.Annotations[signatureAnnotation],
+			Certificate: desc.Annotations[certificateAnnotation],
+			Chain:       desc.Annotations[chainAnnotation],
+		}
+		if bundle := desc.Annotations[bundleAnnotation]; bundle != "" {
+			sig.Bundle = &RekorBundle{}
+			if err := json.Unmarshal([]byte(bundle), sig.Bundle); err != nil {
+				return nil, fmt.Errorf("invalid Rekor bundle: %w", err)
+			}
+		}
+		sigs = append(sigs, sig)
+	}
+
+	return sigs, nil
+}
+
+// reference parses an image reference and returns the options to reach its registry.
+func (r Registry) reference(ctx context.Context, image string) (name.Reference, []remote.Option, error) {
+	rem, err := r.Mirrors.Remote(image)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rem.Ref, rem.Options(ctx), nil
+}
+
+func readPayload(open func() (io.ReadCloser, error)) ([]byte, error) {
+	rc, err := open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	return io.ReadAll(io.LimitReader(rc, maxPayloadSize))
+}
+
+// Made with Bob
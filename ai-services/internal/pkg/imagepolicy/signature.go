@@ -0,0 +1,297 @@
+package imagepolicy
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/imagemirror"
+)
+
+// payloadType is the type of the simple signing payloads of cosign.
+const payloadType = "cosign container image signature"
+
+var (
+	// oidIssuer and oidIssuerV2 are the extensions of Fulcio certificates holding the OIDC issuer
+	// that authenticated the identity, as a raw string and as a DER UTF8String.
+	oidIssuer   = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+	oidIssuerV2 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}
+)
+
+// Signature is a cosign signature of an image, as held by the layers of its signature image.
+type Signature struct {
+	// Payload is the signed simple signing payload.
+	Payload []byte `json:"payload"`
+	// Signature is the base64 signature of the payload.
+	Signature string `json:"signature"`
+	// Certificate and Chain are the PEM certificate and chain of keyless signatures.
+	Certificate string `json:"certificate,omitempty"`
+	Chain       string `json:"chain,omitempty"`
+	// Bundle is the proof that the signature is logged in Rekor.
+	Bundle *RekorBundle `json:"bundle,omitempty"`
+}
+
+// RekorBundle is the signed entry timestamp of a Rekor log entry.
+type RekorBundle struct {
+	SignedEntryTimestamp []byte       `json:"SignedEntryTimestamp"`
+	Payload              RekorPayload `json:"Payload"`
+}
+
+// RekorPayload is the log entry signed by Rekor. Its fields are in the order of their keys, so
+// that it marshals to the canonical JSON Rekor signs.
+type RekorPayload struct {
+	Body           string `json:"body"`
+	IntegratedTime int64  `json:"integratedTime"`
+	LogID          string `json:"logID"`
+	LogIndex       int64  `json:"logIndex"`
+}
+
+// simpleSigning is the payload cosign signs, binding a repository to a manifest digest.
+type simpleSigning struct {
+	Critical struct {
+		Identity struct {
+			DockerReference string `json:"docker-reference"`
+		} `json:"identity"`
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+}
+
+// hashedRekord is the body of the Rekor entries of cosign signatures.
+type hashedRekord struct {
+	Kind string `json:"kind"`
+	Spec struct {
+		Data struct {
+			Hash struct {
+				Algorithm string `json:"algorithm"`
+				Value     string `json:"value"`
+			} `json:"hash"`
+		} `json:"data"`
+		Signature struct {
+			Content string `json:"content"`
+		} `json:"signature"`
+	} `json:"spec"`
+}
+
+// verify checks that sig is a signature of the rule for the image of repository at digest.
+func (r *SignatureRule) verify(sig Signature, repository, digest string) error {
+	raw, err := base64.StdEncoding.DecodeString(sig.Signature)
+	if err != nil {
+		return fmt.Errorf("invalid signature encoding: %w", err)
+	}
+
+	var errs []error
+	if len(r.Keys) > 0 {
+		err := verifyWithKeys(r.Keys, sig.Payload, raw)
+		if err == nil {
+			return checkPayload(sig.Payload, r.signedRepository(repository), digest)
+		}
+		errs = append(errs, err)
+	}
+	if r.Keyless != nil {
+		err := r.Keyless.verify(sig, raw)
+		if err == nil {
+			return checkPayload(sig.Payload, r.signedRepository(repository), digest)
+		}
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// signedRepository returns the repository signatures of images of the repository must name.
+func (r *SignatureRule) signedRepository(repository string) string {
+	if r.SignedPrefix == "" || !imagemirror.HasPrefix(repository, r.Scope) {
+		return repository
+	}
+
+	return r.SignedPrefix + strings.TrimPrefix(repository, r.Scope)
+}
+
+// verifyWithKeys checks that one of the public keys at paths made the signature of payload.
+func verifyWithKeys(paths []string, payload, raw []byte) error {
+	for _, path := range paths {
+		verifier, err := signature.LoadVerifierFromPEMFile(path, crypto.SHA256)
+		if err != nil {
+			return fmt.Errorf("failed to load public key %s: %w", path, err)
+		}
+		if verifier.VerifySignature(bytes.NewReader(raw), bytes.NewReader(payload)) == nil {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("not signed by a trusted key")
+}
+
+// verify checks that a Fulcio certificate issued to the identity made the signature, while valid as
+// proven by its Rekor entry.
+func (k *Keyless) verify(sig Signature, raw []byte) error {
+	if sig.Certificate == "" {
+		return fmt.Errorf("signature has no certificate")
+	}
+	certs, err := cryptoutils.UnmarshalCertificatesFromPEM([]byte(sig.Certificate))
+	if err != nil || len(certs) == 0 {
+		return fmt.Errorf("invalid signature certificate")
+	}
+	cert := certs[0]
+
+	if sig.Bundle == nil {
+		return fmt.Errorf("signature is not logged in Rekor")
+	}
+	if err := k.verifyBundle(sig, raw); err != nil {
+		return err
+	}
+
+	roots, err := certPool(k.FulcioRoots)
+	if err != nil {
+		return err
+	}
+	intermediates := x509.NewCertPool()
+	intermediates.AppendCertsFromPEM([]byte(sig.Chain))
+	if _, err := cert.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   time.Unix(sig.Bundle.Payload.IntegratedTime, 0),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	}); err != nil {
+		return fmt.Errorf("certificate is not trusted: %w", err)
+	}
+
+	if !hasIdentity(cert, k.Subject) {
+		return fmt.Errorf("certificate is not issued to %s", k.Subject)
+	}
+	if issuer := certIssuer(cert); issuer != k.Issuer {
+		return fmt.Errorf("certificate identity is issued by %q, not %q", issuer, k.Issuer)
+	}
+
+	verifier, err := signature.LoadVerifier(cert.PublicKey, crypto.SHA256)
+	if err != nil {
+		return fmt.Errorf("invalid certificate key: %w", err)
+	}
+	if err := verifier.VerifySignature(bytes.NewReader(raw), bytes.NewReader(sig.Payload)); err != nil {
+		return fmt.Errorf("signature does not match its certificate")
+	}
+
+	return nil
+}
+
+// verifyBundle checks that Rekor signed the log entry of the signature.
+func (k *Keyless) verifyBundle(sig Signature, raw []byte) error {
+	verifier, err := signature.LoadVerifierFromPEMFile(k.RekorPublicKey, crypto.SHA256)
+	if err != nil {
+		return fmt.Errorf("failed to load Rekor public key %s: %w", k.RekorPublicKey, err)
+	}
+	entry, err := json.Marshal(sig.Bundle.Payload)
+	if err != nil {
+		return fmt.Errorf("invalid Rekor bundle: %w", err)
+	}
+	if err := verifier.VerifySignature(bytes.NewReader(sig.Bundle.SignedEntryTimestamp), bytes.NewReader(entry)); err != nil {
+		return fmt.Errorf("rekor bundle is not signed by the Rekor log")
+	}
+
+	body, err := base64.StdEncoding.DecodeString(sig.Bundle.Payload.Body)
+	if err != nil {
+		return fmt.Errorf("invalid Rekor entry: %w", err)
+	}
+	var rekord hashedRekord
+	if err := json.Unmarshal(body, &rekord); err != nil || rekord.Kind != "hashedrekord" {
+		return fmt.Errorf("rekor entry is not a signature")
+	}
+	logged, err := base64.StdEncoding.DecodeString(rekord.Spec.Signature.Content)
+	if err != nil || !bytes.Equal(logged, raw) {
+		return fmt.Errorf("rekor entry is of another signature")
+	}
+	sum := sha256.Sum256(sig.Payload)
+	if rekord.Spec.Data.Hash.Algorithm != "sha256" || rekord.Spec.Data.Hash.Value != hex.EncodeToString(sum[:]) {
+		return fmt.Errorf("rekor entry is of another payload")
+	}
+
+	return nil
+}
+
+// checkPayload checks that the signed payload names the repository and digest.
+func checkPayload(payload []byte, repository, digest string) error {
+	var p simpleSigning
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return fmt.Errorf("invalid signature payload: %w", err)
+	}
+	if p.Critical.Type != payloadType {
+		return fmt.Errorf("signature payload is of type %q", p.Critical.Type)
+	}
+	if p.Critical.Image.DockerManifestDigest != digest {
+		return fmt.Errorf("signature is of digest %s", p.Critical.Image.DockerManifestDigest)
+	}
+	if ref, _, _ := splitImage(p.Critical.Identity.DockerReference); ref != repository {
+		return fmt.Errorf("signature is of repository %s, not %s", ref, repository)
+	}
+
+	return nil
+}
+
+func certPool(path string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Fulcio roots: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no certificates in Fulcio roots %s", path)
+	}
+
+	return pool, nil
+}
+
+func hasIdentity(cert *x509.Certificate, subject string) bool {
+	if slices.Contains(cert.EmailAddresses, subject) {
+		return true
+	}
+
+	return slices.ContainsFunc(cert.URIs, func(uri *url.URL) bool { return uri.String() == subject })
+}
+
+// certIssuer returns the OIDC issuer of a Fulcio certificate.
+func certIssuer(cert *x509.Certificate) string {
+	for _, ext := range cert.Extensions {
+		switch {
+		case ext.Id.Equal(oidIssuerV2):
+			var issuer string
+			if _, err := asn1.Unmarshal(ext.Value, &issuer); err == nil {
+				return issuer
+			}
+		case ext.Id.Equal(oidIssuer):
+			return string(ext.Value)
+		}
+	}
+
+	return ""
+}
+
+// splitImage splits an image reference into its repository, tag and digest.
+func splitImage(image string) (string, string, string) {
+	name, digest, _ := strings.Cut(image, "@")
+	tag := ""
+	if colon := strings.LastIndex(name, ":"); colon > strings.LastIndex(name, "/") {
+		name, tag = name[:colon], name[colon+1:]
+	}
+
+	return name, tag, digest
+}
+
+// Made with Bob
@@ -0,0 +1,200 @@
+package imagepolicy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/imagemirror"
+)
+
+// digestPattern matches the digests of image manifests, e.g. sha256:<hex>.
+var digestPattern = regexp.MustCompile(`^[a-z0-9]+:[a-f0-9]{32,}$`)
+
+// Source provides the digests and signatures of images from their registries.
+type Source interface {
+	// Digest returns the digest of the manifest an image reference points at.
+	Digest(ctx context.Context, image string) (string, error)
+	// Signatures returns the signatures of the image of repository at digest, none when it is
+	// not signed.
+	Signatures(ctx context.Context, repository, digest string) ([]Signature, error)
+}
+
+// Store holds the signatures of images for offline verification, a JSON file of signatures per
+// manifest digest.
+type Store struct {
+	dir string
+}
+
+// NewStore returns the store of signatures in dir.
+func NewStore(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// Signatures returns the stored signatures of the manifest digest.
+func (s *Store) Signatures(digest string) ([]Signature, error) {
+	path, err := s.path(digest)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stored signatures: %w", err)
+	}
+
+	var sigs []Signature
+	if err := json.Unmarshal(data, &sigs); err != nil {
+		return nil, fmt.Errorf("invalid stored signatures %s: %w", path, err)
+	}
+
+	return sigs, nil
+}
+
+// Save stores the signatures of the manifest digest.
+func (s *Store) Save(digest string, sigs []Signature) error {
+	path, err := s.path(digest)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(sigs, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode signatures: %w", err)
+	}
+	if err := os.MkdirAll(s.dir, constants.DirPerm); err != nil {
+		return fmt.Errorf("failed to create signatures directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, constants.FilePerm); err != nil {
+		return fmt.Errorf("failed to store signatures: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) path(digest string) (string, error) {
+	if !digestPattern.MatchString(digest) {
+		return "", fmt.Errorf("invalid digest %q", digest)
+	}
+
+	return filepath.Join(s.dir, strings.Replace(digest, ":", "-", 1)+".json"), nil
+}
+
+// Verifier checks images against a policy.
+type Verifier struct {
+	policy *Policy
+	store  *Store
+	source Source
+}
+
+// NewVerifier returns a verifier of the policy reading stored signatures from store, and else from
+// source. source is not used when the policy is offline and may be nil.
+func NewVerifier(policy *Policy, store *Store, source Source) *Verifier {
+	if policy.Offline {
+		source = nil
+	}
+
+	return &Verifier{policy: policy, store: store, source: source}
+}
+
+// Verified is an image that passed the policy.
+type Verified struct {
+	Image string
+	// Digest is the manifest digest the signatures were verified for, empty when no signature
+	// is required.
+	Digest string
+	// Signatures are the signatures that verified the image.
+	Signatures []Signature
+}
+
+// Verify checks an image, as pulled, against the policy.
+func (v *Verifier) Verify(ctx context.Context, image string) (*Verified, error) {
+	qualified := imagemirror.Qualify(image)
+	if !v.policy.allowed(qualified) {
+		return nil, fmt.Errorf("image %s is not from an allowed registry", image)
+	}
+
+	repository, _, digest := splitImage(qualified)
+	if v.policy.RequireDigest && digest == "" {
+		return nil, fmt.Errorf("image %s is not pinned by digest", image)
+	}
+
+	verified := &Verified{Image: image}
+	rule := v.policy.signatureRule(qualified)
+	if rule == nil {
+		return verified, nil
+	}
+
+	if digest == "" {
+		if v.source == nil {
+			return nil, fmt.Errorf("image %s must be pinned by digest to verify its signature offline", image)
+		}
+		var err error
+		if digest, err = v.source.Digest(ctx, image); err != nil {
+			return nil, fmt.Errorf("failed to resolve digest of image %s: %w", image, err)
+		}
+	}
+	verified.Digest = digest
+
+	sigs, err := v.store.Signatures(digest)
+	if err != nil {
+		return nil, err
+	}
+	if len(sigs) == 0 && v.source != nil {
+		if sigs, err = v.source.Signatures(ctx, repository, digest); err != nil {
+			return nil, fmt.Errorf("failed to fetch signatures of image %s: %w", image, err)
+		}
+	}
+	if len(sigs) == 0 {
+		return nil, fmt.Errorf("image %s is not signed", image)
+	}
+
+	var errs []error
+	for _, sig := range sigs {
+		err := rule.verify(sig, repository, digest)
+		if err == nil {
+			verified.Signatures = append(verified.Signatures, sig)
+
+			continue
+		}
+		errs = append(errs, err)
+	}
+	if len(verified.Signatures) == 0 {
+		return nil, fmt.Errorf("image %s has no trusted signature: %w", image, errors.Join(errs...))
+	}
+
+	return verified, nil
+}
+
+// VerifyImages checks images against the policy, failing with the errors of all the images that do
+// not pass.
+func (v *Verifier) VerifyImages(ctx context.Context, images []string) ([]*Verified, error) {
+	verified := make([]*Verified, 0, len(images))
+	var errs []error
+	for _, image := range images {
+		result, err := v.Verify(ctx, image)
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+		verified = append(verified, result)
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("image verification failed: %w", errors.Join(errs...))
+	}
+
+	return verified, nil
+}
+
+// Made with Bob
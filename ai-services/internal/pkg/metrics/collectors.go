@@ -0,0 +1,138 @@
+package metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+const (
+	// collectTimeout bounds the lookups of the collectors reading state at scrape time.
+	collectTimeout = 10 * time.Second
+	// spyreCardsTTL is how long Spyre card counts are reused across scrapes, as counting them
+	// discovers the cards of the host.
+	spyreCardsTTL = 30 * time.Second
+)
+
+// dbPoolCollector reports the statistics of a database connection pool at scrape time.
+type dbPoolCollector struct {
+	pool *pgxpool.Pool
+
+	acquiredConns    *prometheus.Desc
+	idleConns        *prometheus.Desc
+	totalConns       *prometheus.Desc
+	maxConns         *prometheus.Desc
+	acquires         *prometheus.Desc
+	acquireDuration  *prometheus.Desc
+	emptyAcquires    *prometheus.Desc
+	canceledAcquires *prometheus.Desc
+}
+
+// RegisterDBPool registers the statistics of the database connection pool.
+func RegisterDBPool(pool *pgxpool.Pool) error {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "db_pool", name), help, nil, nil)
+	}
+
+	return Registry.Register(&dbPoolCollector{
+		pool:             pool,
+		acquiredConns:    desc("acquired_conns", "Connections of the database pool currently in use."),
+		idleConns:        desc("idle_conns", "Idle connections of the database pool."),
+		totalConns:       desc("total_conns", "Connections of the database pool, in use, idle or being opened."),
+		maxConns:         desc("max_conns", "Maximum size of the database pool."),
+		acquires:         desc("acquires_total", "Connections acquired from the database pool."),
+		acquireDuration:  desc("acquire_duration_seconds_total", "Time spent acquiring connections from the database pool."),
+		emptyAcquires:    desc("empty_acquires_total", "Acquisitions that waited for a connection as the database pool was empty."),
+		canceledAcquires: desc("canceled_acquires_total", "Acquisitions from the database pool cancelled by their context."),
+	})
+}
+
+// Describe implements prometheus.Collector.
+func (c *dbPoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+// Collect implements prometheus.Collector.
+func (c *dbPoolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+	ch <- prometheus.MustNewConstMetric(c.acquiredConns, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(stat.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(stat.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(c.maxConns, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquires, prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.acquireDuration, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquires, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.canceledAcquires, prometheus.CounterValue, float64(stat.CanceledAcquireCount()))
+}
+
+// SpyreCardCounter returns the number of Spyre cards per allocation status, e.g. free, reserved or
+// in_use.
+type SpyreCardCounter func(ctx context.Context) (map[string]int, error)
+
+// spyreCardCollector reports the allocation of Spyre cards, counted at most once per spyreCardsTTL.
+type spyreCardCollector struct {
+	count SpyreCardCounter
+	cards *prometheus.Desc
+
+	mu        sync.Mutex
+	counts    map[string]int
+	countedAt time.Time
+}
+
+// RegisterSpyreCards registers the gauges of the Spyre cards per allocation status, read with
+// count at scrape time and reused by the scrapes of the next spyreCardsTTL.
+func RegisterSpyreCards(count SpyreCardCounter) error {
+	return Registry.Register(&spyreCardCollector{
+		count: count,
+		cards: prometheus.NewDesc(prometheus.BuildFQName(namespace, "spyre", "cards"),
+			"Spyre cards by allocation status.", []string{"status"}, nil),
+	})
+}
+
+// Describe implements prometheus.Collector.
+func (c *spyreCardCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.cards
+}
+
+// Collect implements prometheus.Collector.
+func (c *spyreCardCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	counts, err := c.cachedCounts(ctx)
+	if err != nil {
+		logger.WarningfCtx(ctx, "Failed to count Spyre cards for metrics: %v\n", err)
+		ch <- prometheus.NewInvalidMetric(c.cards, err)
+
+		return
+	}
+	for status, n := range counts {
+		ch <- prometheus.MustNewConstMetric(c.cards, prometheus.GaugeValue, float64(n), status)
+	}
+}
+
+// cachedCounts returns the counts of the last spyreCardsTTL, counting the cards again when they
+// are older. Failed counts are not cached.
+func (c *spyreCardCollector) cachedCounts(ctx context.Context) (map[string]int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.counts != nil && time.Since(c.countedAt) < spyreCardsTTL {
+		return c.counts, nil
+	}
+
+	counts, err := c.count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.counts, c.countedAt = counts, time.Now()
+
+	return counts, nil
+}
+
+// Made with Bob
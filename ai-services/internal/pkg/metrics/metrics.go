@@ -0,0 +1,140 @@
+// Package metrics holds the Prometheus metrics of the catalog API server, served on /metrics: HTTP
+// requests, deployments and deletions, sync cycles, connected workers, Spyre card allocations and
+// the database connection pool.
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "ai_services"
+
+// Outcomes of deployments, deletions and worker commands.
+const (
+	OutcomeSuccess   = "success"
+	OutcomeFailure   = "failure"
+	OutcomeCancelled = "cancelled"
+)
+
+// Resources whose drift from the runtime a sync cycle corrects.
+const (
+	DriftApplication = "application"
+	DriftService     = "service"
+	DriftComponent   = "component"
+	DriftRoute       = "route"
+)
+
+// Registry holds the metrics of the API server, along with the ones of the Go runtime and the
+// process.
+var Registry = prometheus.NewRegistry()
+
+var factory = promauto.With(Registry)
+
+// deploymentBuckets span deployments pulling images and downloading models, up to two hours.
+var deploymentBuckets = []float64{10, 30, 60, 120, 300, 600, 1200, 1800, 3600, 7200}
+
+var (
+	// HTTPRequests counts the requests of the API by route and response status.
+	HTTPRequests = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests handled by the API server, by method, route and status code.",
+	}, []string{"method", "route", "status"})
+
+	// HTTPRequestDuration observes the latency of the requests of the API by route.
+	HTTPRequestDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Latency of the HTTP requests handled by the API server, by method and route.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route"})
+
+	deployments = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "deployments_total",
+		Help:      "Application deployments, by catalog ID and outcome.",
+	}, []string{"catalog_id", "outcome"})
+
+	deploymentDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "deployment_duration_seconds",
+		Help:      "Duration of application deployments, by catalog ID and outcome.",
+		Buckets:   deploymentBuckets,
+	}, []string{"catalog_id", "outcome"})
+
+	deletions = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "deletions_total",
+		Help:      "Application deletions, by catalog ID and outcome.",
+	}, []string{"catalog_id", "outcome"})
+
+	deletionDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "deletion_duration_seconds",
+		Help:      "Duration of application deletions, by catalog ID and outcome.",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 10),
+	}, []string{"catalog_id", "outcome"})
+
+	// SyncDuration observes the duration of the cycles synchronizing the database with the runtime.
+	SyncDuration = factory.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "sync_cycle_duration_seconds",
+		Help:      "Duration of the cycles synchronizing the database with the runtime.",
+		Buckets:   prometheus.ExponentialBuckets(0.1, 2, 10),
+	})
+
+	// SyncDrift counts the records and routes a sync cycle found out of date with the runtime.
+	SyncDrift = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "sync_drift_total",
+		Help:      "Applications, services and components whose status, and proxy routes, sync cycles corrected.",
+	}, []string{"resource"})
+
+	// WorkersConnected is the number of workers connected to the worker gateway.
+	WorkersConnected = factory.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "workers_connected",
+		Help:      "Workers connected to the worker gateway.",
+	})
+
+	// WorkerCommandDuration observes the time between sending a command to a worker and receiving
+	// its result.
+	WorkerCommandDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "worker_command_duration_seconds",
+		Help:      "Round-trip time of the commands sent to workers, by worker and outcome.",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 4, 10),
+	}, []string{"worker", "outcome"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// Handler serves the metrics of the registry in the Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// ObserveDeployment records a deployment of the catalog entry that started at start.
+func ObserveDeployment(catalogID, outcome string, start time.Time) {
+	deployments.WithLabelValues(catalogID, outcome).Inc()
+	deploymentDuration.WithLabelValues(catalogID, outcome).Observe(time.Since(start).Seconds())
+}
+
+// ObserveDeletion records a deletion of an application of the catalog entry that started at start.
+func ObserveDeletion(catalogID, outcome string, start time.Time) {
+	deletions.WithLabelValues(catalogID, outcome).Inc()
+	deletionDuration.WithLabelValues(catalogID, outcome).Observe(time.Since(start).Seconds())
+}
+
+// Made with Bob
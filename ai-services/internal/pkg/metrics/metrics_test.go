@@ -0,0 +1,60 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestObserveDeployment(t *testing.T) {
+	before := testutil.ToFloat64(deployments.WithLabelValues("rag", OutcomeFailure))
+
+	ObserveDeployment("rag", OutcomeFailure, time.Now().Add(-time.Minute))
+
+	if got := testutil.ToFloat64(deployments.WithLabelValues("rag", OutcomeFailure)); got != before+1 {
+		t.Errorf("deployments_total = %v, want %v", got, before+1)
+	}
+	if n := testutil.CollectAndCount(deploymentDuration, "ai_services_deployment_duration_seconds"); n == 0 {
+		t.Error("deployment duration not observed")
+	}
+}
+
+func TestSpyreCardCollector(t *testing.T) {
+	calls := 0
+	collector := &spyreCardCollector{
+		count: func(context.Context) (map[string]int, error) {
+			calls++
+
+			return map[string]int{"free": 2, "reserved": 4}, nil
+		},
+		cards: prometheus.NewDesc("ai_services_spyre_cards", "Spyre cards by allocation status.", []string{"status"}, nil),
+	}
+
+	want := `
+# HELP ai_services_spyre_cards Spyre cards by allocation status.
+# TYPE ai_services_spyre_cards gauge
+ai_services_spyre_cards{status="free"} 2
+ai_services_spyre_cards{status="reserved"} 4
+`
+	for range 2 {
+		if err := testutil.CollectAndCompare(collector, strings.NewReader(want)); err != nil {
+			t.Error(err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("cards counted %d times by scrapes within the TTL, want 1", calls)
+	}
+
+	collector.counts = nil
+	collector.count = func(context.Context) (map[string]int, error) {
+		return nil, errors.New("sysfs not readable")
+	}
+	if err := testutil.CollectAndCompare(collector, strings.NewReader("")); err == nil {
+		t.Error("failed count collected without error")
+	}
+}
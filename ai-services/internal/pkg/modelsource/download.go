@@ -0,0 +1,127 @@
+package modelsource
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/db/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/modelstore"
+	"github.com/project-ai-services/ai-services/internal/pkg/objectstorage"
+	"github.com/project-ai-services/ai-services/internal/pkg/transfer"
+)
+
+// ConnectorResolver returns the object_storage connector of the given ID, with its credentials.
+type ConnectorResolver func(ctx context.Context, id string) (*models.Connector, error)
+
+// New creates the source selected by cfg. resolve looks up the connector of s3 sources and may be
+// nil when none is used.
+func New(ctx context.Context, cfg Config, resolve ConnectorResolver) (Source, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	switch cfg.Type {
+	case TypeS3:
+		return newS3Source(ctx, cfg, resolve)
+	case TypeOCI:
+		return newOCISource(cfg)
+	case TypeLocal:
+		return &localSource{path: cfg.Path}, nil
+	default:
+		return &huggingFaceSource{endpoint: cfg.Endpoint, token: cfg.token(), revision: cfg.Revision}, nil
+	}
+}
+
+func newS3Source(ctx context.Context, cfg Config, resolve ConnectorResolver) (*s3Source, error) {
+	if cfg.Connector == "" {
+		client, err := objectstorage.NewClient(cfg.Endpoint, cfg.Bucket, os.Getenv(S3AccessKeyEnv), os.Getenv(S3SecretKeyEnv))
+		if err != nil {
+			return nil, err
+		}
+
+		return &s3Source{client: client, prefix: strings.Trim(cfg.Prefix, "/")}, nil
+	}
+
+	if resolve == nil {
+		return nil, fmt.Errorf("object_storage connectors can only be used for deployments of the catalog")
+	}
+	connector, err := resolve(ctx, cfg.Connector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get connector %s: %w", cfg.Connector, err)
+	}
+	client, prefix, err := objectstorage.NewClientFromConnector(connector)
+	if err != nil {
+		return nil, err
+	}
+
+	// The prefix of the model source is below the one of the connector
+	return &s3Source{client: client, prefix: strings.Trim(prefix+"/"+strings.Trim(cfg.Prefix, "/"), "/")}, nil
+}
+
+// Download downloads a model from the source selected by cfg to the models directory and verifies
+// its files against the checksums recorded by the download.
+func Download(ctx context.Context, cfg Config, resolve ConnectorResolver, model, modelsDir string) error {
+	return DownloadWithProgress(ctx, cfg, resolve, model, modelsDir, nil)
+}
+
+// DownloadWithProgress downloads a model like Download, reporting the bytes downloaded so far to
+// progress.
+func DownloadWithProgress(ctx context.Context, cfg Config, resolve ConnectorResolver, model, modelsDir string, progress transfer.ProgressFunc) error {
+	if err := validFilePath(model); err != nil {
+		return fmt.Errorf("invalid model name %q", model)
+	}
+
+	source, err := New(ctx, cfg, resolve)
+	if err != nil {
+		return err
+	}
+
+	logger.InfofCtx(ctx, "Downloading model %s from %s to %s\n", model, cfg, modelsDir)
+	if err := source.Download(ctx, model, modelsDir, progress); err != nil {
+		return err
+	}
+
+	result, err := modelstore.NewStore(modelsDir).Verify(model)
+	if err != nil {
+		return fmt.Errorf("failed to verify model %s: %w", model, err)
+	}
+	if !result.Valid {
+		bad := []string{}
+		for _, file := range result.Files {
+			if file.Status == modelstore.FileStatusMismatch || file.Status == modelstore.FileStatusMissing {
+				bad = append(bad, fmt.Sprintf("%s (%s)", file.Path, file.Status))
+			}
+		}
+
+		return fmt.Errorf("model %s failed verification: %s", model, strings.Join(bad, ", "))
+	}
+
+	logger.InfolnCtx(ctx, "Model downloaded successfully")
+
+	return nil
+}
+
+// modelDir returns the directory of a model in the models directory.
+func modelDir(modelsDir, model string) string {
+	return filepath.Join(modelsDir, filepath.FromSlash(model))
+}
+
+// isCachePath reports whether a path of a model belongs to the download metadata rather than the
+// model.
+func isCachePath(path string) bool {
+	return path == ".cache" || strings.HasPrefix(path, ".cache/")
+}
+
+func isSHA256Hex(s string) bool {
+	const sha256HexLen = 64
+	_, err := hex.DecodeString(s)
+
+	return len(s) == sha256HexLen && err == nil
+}
+
+// Made with Bob
@@ -0,0 +1,251 @@
+package modelsource
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec // S3 reports the MD5 checksum of objects, it is not used for security
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/modelstore"
+	"github.com/project-ai-services/ai-services/internal/pkg/transfer"
+)
+
+// opener opens a file of a source from byte offset on. It returns the offset the content actually
+// starts at, 0 when the source cannot resume.
+type opener func(ctx context.Context, offset int64) (io.ReadCloser, int64, error)
+
+// remoteFile is a file of a model in a source.
+type remoteFile struct {
+	// path relative to the model directory, slash separated.
+	path string
+	// size in bytes, -1 when unknown.
+	size int64
+	// sha256 and md5 are the hex encoded checksums given by the source, empty when unknown.
+	sha256 string
+	md5    string
+	open   opener
+}
+
+// counter sums the bytes of the files of a model downloaded so far and reports them, with the size
+// of the model, to a progress func, which may be nil.
+type counter struct {
+	progress transfer.ProgressFunc
+	current  int64
+	total    int64
+}
+
+func (c *counter) add(n int64) {
+	c.current += n
+	if c.progress != nil {
+		c.progress(c.current, c.total)
+	}
+}
+
+// Write counts the bytes written to a download.
+func (c *counter) Write(p []byte) (int, error) {
+	c.add(int64(len(p)))
+
+	return len(p), nil
+}
+
+// fetchFiles downloads the files of a model to modelDir, recording revision as the revision they
+// were downloaded from, and reports the bytes downloaded so far to progress, which may be nil.
+func fetchFiles(ctx context.Context, modelDir, revision string, files []remoteFile, progress transfer.ProgressFunc) error {
+	count := &counter{progress: progress}
+	for _, file := range files {
+		if file.size < 0 {
+			count.total = -1
+
+			break
+		}
+		count.total += file.size
+	}
+
+	for _, file := range files {
+		if err := validFilePath(file.path); err != nil {
+			return err
+		}
+		if err := fetchFile(ctx, modelDir, revision, file, count); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fetchFile downloads a file unless it was already downloaded. The file is written next to its
+// download metadata first, appending to what an interrupted download left there, and moved to the
+// model directory once its size and checksums match the ones of the source.
+func fetchFile(ctx context.Context, modelDir, revision string, file remoteFile, count *counter) error {
+	dst := filepath.Join(modelDir, filepath.FromSlash(file.path))
+	if downloaded(modelDir, dst, file) {
+		logger.DebugfCtx(ctx, "Skipping %s, already downloaded\n", file.path)
+		count.add(max(file.size, 0))
+
+		return nil
+	}
+
+	incomplete := modelstore.IncompletePath(modelDir, file.path)
+	if err := os.MkdirAll(filepath.Dir(incomplete), constants.DirPerm); err != nil {
+		return fmt.Errorf("failed to create directory for %s: %w", file.path, err)
+	}
+
+	sha, md := sha256.New(), md5.New() //nolint:gosec // see import
+	offset, err := hashIncomplete(incomplete, file.size, sha, md)
+	if err != nil {
+		return err
+	}
+	count.add(offset)
+
+	if file.size < 0 || offset < file.size {
+		if offset > 0 {
+			logger.InfofCtx(ctx, "Resuming download of %s at byte %d\n", file.path, offset)
+		}
+		if err := appendFrom(ctx, incomplete, offset, file, sha, md, count); err != nil {
+			return err
+		}
+	}
+
+	if err := checkDownload(incomplete, file, sha, md); err != nil {
+		_ = os.Remove(incomplete)
+
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dst), constants.DirPerm); err != nil {
+		return fmt.Errorf("failed to create directory for %s: %w", file.path, err)
+	}
+	if err := os.Rename(incomplete, dst); err != nil {
+		return fmt.Errorf("failed to move %s into the model directory: %w", file.path, err)
+	}
+
+	return modelstore.WriteDownloadMetadata(modelDir, file.path, modelstore.DownloadMetadata{
+		Commit: revision,
+		ETag:   hex.EncodeToString(sha.Sum(nil)),
+	})
+}
+
+// downloaded reports whether a file was downloaded before: it exists with the size of the source,
+// and its download recorded the checksum of the source when the source has one.
+func downloaded(modelDir, dst string, file remoteFile) bool {
+	info, err := os.Stat(dst)
+	if err != nil || (file.size >= 0 && info.Size() != file.size) {
+		return false
+	}
+
+	meta, ok := modelstore.ReadDownloadMetadata(modelDir, file.path)
+	if !ok {
+		return false
+	}
+
+	return file.sha256 == "" || strings.EqualFold(meta.ETag, file.sha256)
+}
+
+// hashIncomplete hashes what an interrupted download left and returns its size, the offset to
+// resume at. A leftover larger than the file is discarded.
+func hashIncomplete(incomplete string, size int64, hashes ...hash.Hash) (int64, error) {
+	info, err := os.Stat(incomplete)
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to read partial download %s: %w", incomplete, err)
+	}
+	if size >= 0 && info.Size() > size {
+		return 0, os.Remove(incomplete)
+	}
+
+	f, err := os.Open(incomplete)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read partial download %s: %w", incomplete, err)
+	}
+	defer f.Close()
+
+	n, err := io.Copy(multiHash(hashes), f)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read partial download %s: %w", incomplete, err)
+	}
+
+	return n, nil
+}
+
+// appendFrom appends the content of the file from offset on to the incomplete download, starting
+// over when the source cannot resume.
+func appendFrom(ctx context.Context, incomplete string, offset int64, file remoteFile, sha, md hash.Hash, count *counter) error {
+	body, start, err := file.open(ctx, offset)
+	if err != nil {
+		return fmt.Errorf("failed to download %s: %w", file.path, err)
+	}
+	defer body.Close()
+
+	flags := os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	if start != offset {
+		sha.Reset()
+		md.Reset()
+		count.add(-offset)
+		flags |= os.O_TRUNC
+	}
+
+	out, err := os.OpenFile(incomplete, flags, constants.FilePerm)
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", file.path, err)
+	}
+	if _, err := io.Copy(io.MultiWriter(out, sha, md, count), body); err != nil {
+		_ = out.Close()
+
+		return fmt.Errorf("failed to download %s: %w", file.path, err)
+	}
+
+	return out.Close()
+}
+
+// checkDownload compares a completed download with the size and checksums of the source.
+func checkDownload(incomplete string, file remoteFile, sha, md hash.Hash) error {
+	info, err := os.Stat(incomplete)
+	if err != nil {
+		return fmt.Errorf("failed to read download of %s: %w", file.path, err)
+	}
+	if file.size >= 0 && info.Size() != file.size {
+		return fmt.Errorf("download of %s is %d bytes, expected %d", file.path, info.Size(), file.size)
+	}
+
+	if actual := hex.EncodeToString(sha.Sum(nil)); file.sha256 != "" && !strings.EqualFold(actual, file.sha256) {
+		return fmt.Errorf("checksum mismatch for %s: sha256 %s, expected %s", file.path, actual, file.sha256)
+	}
+	if actual := hex.EncodeToString(md.Sum(nil)); file.md5 != "" && !strings.EqualFold(actual, file.md5) {
+		return fmt.Errorf("checksum mismatch for %s: md5 %s, expected %s", file.path, actual, file.md5)
+	}
+
+	return nil
+}
+
+// validFilePath refuses file paths of a source that would leave the model directory.
+func validFilePath(path string) error {
+	clean := filepath.Clean(filepath.FromSlash(path))
+	if path == "" || filepath.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("invalid model file path %q", path)
+	}
+
+	return nil
+}
+
+func multiHash(hashes []hash.Hash) io.Writer {
+	writers := make([]io.Writer, len(hashes))
+	for i, h := range hashes {
+		writers[i] = h
+	}
+
+	return io.MultiWriter(writers...)
+}
+
+// Made with Bob
@@ -0,0 +1,139 @@
+package modelsource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/containers/podman/v5/pkg/specgen"
+	"github.com/google/uuid"
+	spec "github.com/opencontainers/runtime-spec/specs-go"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
+	"github.com/project-ai-services/ai-services/internal/pkg/transfer"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// huggingFaceSource downloads models with "hf download" in a container of the tool image, which
+// resumes interrupted downloads and checks the files of Git LFS against their checksums.
+type huggingFaceSource struct {
+	endpoint string
+	token    string
+	revision string
+}
+
+const (
+	// progressInterval is how often the size of a model downloaded in a container is reported.
+	progressInterval = 2 * time.Second
+	// tokenSecretPath is where the token of the hf command is mounted in the tool container.
+	tokenSecretPath = "/run/secrets/hf-token"
+)
+
+func (s *huggingFaceSource) Download(ctx context.Context, model, modelsDir string, progress transfer.ProgressFunc) error {
+	runtimeClient, err := podman.NewPodmanClient()
+	if err != nil {
+		return fmt.Errorf("failed to create podman client: %w", err)
+	}
+
+	// Create container spec
+	gen := specgen.NewSpecGenerator(vars.ToolImage, false)
+	terminal := true
+	stdin := true
+	gen.Terminal = &terminal
+	gen.Stdin = &stdin
+	gen.Command = s.command(model)
+	gen.Env = s.env()
+	rm := true
+	gen.Remove = &rm
+	gen.Mounts = modelsMount(modelsDir)
+
+	// The token is mounted from a secret so that it does not show in the container configuration
+	if s.token != "" {
+		secret := "ai-services-hf-token-" + uuid.NewString()
+		if err := runtimeClient.CreateSecret(secret, []byte(s.token)); err != nil {
+			return fmt.Errorf("failed to create the token secret: %w", err)
+		}
+		defer func() {
+			if err := runtimeClient.DeleteSecret(secret); err != nil {
+				logger.WarningfCtx(ctx, "Failed to remove the token secret %s: %v\n", secret, err)
+			}
+		}()
+		gen.Secrets = []specgen.Secret{{Source: secret, Target: tokenSecretPath}}
+		gen.Env["HF_TOKEN_PATH"] = tokenSecretPath
+	}
+
+	if progress != nil {
+		stop := watchSize(modelDir(modelsDir, model), progress)
+		defer stop()
+	}
+
+	// Run container with spec, passing ctx so cancellation (e.g. mid-deployment delete)
+	// stops the download container immediately instead of blocking until it finishes.
+	exitCode, err := runtimeClient.RunContainerWithSpec(ctx, gen)
+	if err != nil {
+		return fmt.Errorf("failed to run container: %w", err)
+	}
+
+	if exitCode != 0 {
+		return fmt.Errorf("model download failed with exit code %d", exitCode)
+	}
+
+	return nil
+}
+
+// watchSize reports the size of the files of a directory, whose final size is unknown, until the
+// returned func is called.
+func watchSize(dir string, progress transfer.ProgressFunc) func() {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(progressInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				size, _ := utils.DirStats(dir)
+				progress(size, -1)
+			}
+		}
+	}()
+
+	return func() { close(done) }
+}
+
+// command returns the command downloading the model in the tool container.
+func (s *huggingFaceSource) command(model string) []string {
+	cmd := []string{"hf", "download", model, "--local-dir", fmt.Sprintf("/models/%s", model)}
+	if s.revision != "" {
+		cmd = append(cmd, "--revision", s.revision)
+	}
+
+	return cmd
+}
+
+// env returns the environment selecting the mirror of the hf command.
+func (s *huggingFaceSource) env() map[string]string {
+	env := map[string]string{}
+	if s.endpoint != "" {
+		env["HF_ENDPOINT"] = s.endpoint
+	}
+
+	return env
+}
+
+// modelsMount mounts the models directory at /models in the tool container.
+func modelsMount(modelsDir string) []spec.Mount {
+	return []spec.Mount{
+		{
+			Type:        "bind",
+			Source:      modelsDir,
+			Destination: "/models",
+			Options:     []string{"Z"},
+		},
+	}
+}
+
+// Made with Bob
@@ -0,0 +1,87 @@
+package modelsource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/modelstore"
+	"github.com/project-ai-services/ai-services/internal/pkg/transfer"
+)
+
+// localSource copies models from a directory of the host, such as an NFS mount, laid out as the
+// models directory: <path>/<model>. The checksums recorded by the download that filled it, if any,
+// are the checksums of the source.
+type localSource struct {
+	path string
+}
+
+func (s *localSource) Download(ctx context.Context, model, modelsDir string, progress transfer.ProgressFunc) error {
+	src := filepath.Join(s.path, filepath.FromSlash(model))
+
+	files := []remoteFile{}
+	revision := ""
+	err := filepath.WalkDir(src, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(src, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if isCachePath(rel) {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		file := remoteFile{path: rel, size: info.Size(), open: openLocal(p)}
+		if meta, ok := modelstore.ReadDownloadMetadata(src, rel); ok {
+			if isSHA256Hex(meta.ETag) {
+				file.sha256 = meta.ETag
+			}
+			revision = meta.Commit
+		}
+		files = append(files, file)
+
+		return nil
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("model %s not found in %s", model, s.path)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read model %s from %s: %w", model, s.path, err)
+	}
+
+	return fetchFiles(ctx, modelDir(modelsDir, model), revision, files, progress)
+}
+
+// openLocal opens a local file from an offset on.
+func openLocal(path string) opener {
+	return func(_ context.Context, offset int64) (io.ReadCloser, int64, error) {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, 0, err
+		}
+		if _, err := f.Seek(offset, io.SeekStart); err != nil {
+			_ = f.Close()
+
+			return nil, 0, err
+		}
+
+		return f, offset, nil
+	}
+}
+
+// Made with Bob
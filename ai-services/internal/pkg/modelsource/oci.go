@@ -0,0 +1,207 @@
+package modelsource
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/imagemirror"
+	"github.com/project-ai-services/ai-services/internal/pkg/transfer"
+)
+
+const (
+	// ociTitleAnnotation holds the file name of a layer, set by "oras push".
+	ociTitleAnnotation = "org.opencontainers.image.title"
+	ociDefaultTag      = "latest"
+	ociErrorBodyLimit  = 1024
+)
+
+// ociSource downloads models pushed as OCI artifacts to a registry, e.g. with
+// "oras push registry.example.com/models/org/name:v1 *", each file of the model being a layer
+// titled with its path. The registry is reached with the auth of the registries file, unless the
+// model source has a token.
+type ociSource struct {
+	registry  string
+	namespace string
+	tag       string
+	// plainHTTP reaches the registry without TLS, for http:// endpoints.
+	plainHTTP bool
+	// insecure skips the verification of the certificate of the registry.
+	insecure bool
+	// auth overrides the credentials of the registries file when the model source has a token.
+	auth    authn.Authenticator
+	mirrors *imagemirror.Config
+}
+
+func newOCISource(cfg Config) (*ociSource, error) {
+	endpoint := cfg.Endpoint
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	u, err := url.Parse(strings.TrimSuffix(endpoint, "/"))
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid registry endpoint %q", cfg.Endpoint)
+	}
+
+	mirrors, err := imagemirror.Current()
+	if err != nil {
+		return nil, err
+	}
+
+	tag := cfg.Revision
+	if tag == "" {
+		tag = ociDefaultTag
+	}
+
+	source := &ociSource{
+		registry:  u.Host,
+		namespace: strings.Trim(u.Path, "/"),
+		tag:       tag,
+		plainHTTP: u.Scheme == "http",
+		insecure:  cfg.Insecure,
+		mirrors:   mirrors,
+	}
+	switch token := cfg.token(); {
+	case token != "" && cfg.Username != "":
+		source.auth = &authn.Basic{Username: cfg.Username, Password: token}
+	case token != "":
+		source.auth = authn.FromConfig(authn.AuthConfig{RegistryToken: token})
+	}
+
+	return source, nil
+}
+
+func (s *ociSource) Download(ctx context.Context, model, modelsDir string, progress transfer.ProgressFunc) error {
+	image := s.registry + "/" + s.repository(model) + ":" + s.tag
+	rem, err := s.remote(image)
+	if err != nil {
+		return err
+	}
+
+	desc, err := remote.Get(rem.Ref, rem.Options(ctx)...)
+	if err != nil {
+		return fmt.Errorf("failed to get artifact %s: %w", image, err)
+	}
+	manifest, err := v1.ParseManifest(bytes.NewReader(desc.Manifest))
+	if err != nil {
+		return fmt.Errorf("failed to decode manifest of %s: %w", image, err)
+	}
+
+	client, err := s.blobClient(ctx, rem)
+	if err != nil {
+		return fmt.Errorf("failed to authenticate to %s: %w", s.registry, err)
+	}
+
+	files := []remoteFile{}
+	for _, layer := range manifest.Layers {
+		title := layer.Annotations[ociTitleAnnotation]
+		if title == "" || isCachePath(title) {
+			continue
+		}
+		if layer.Digest.Algorithm != "sha256" {
+			return fmt.Errorf("unsupported digest %q of %s", layer.Digest, title)
+		}
+
+		blob := rem.Ref.Context().Digest(layer.Digest.String())
+		files = append(files, remoteFile{
+			path:   title,
+			size:   layer.Size,
+			sha256: layer.Digest.Hex,
+			open: func(ctx context.Context, offset int64) (io.ReadCloser, int64, error) {
+				return openBlob(ctx, client, blob, offset)
+			},
+		})
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("artifact %s has no model files", image)
+	}
+
+	return fetchFiles(ctx, modelDir(modelsDir, model), desc.Digest.String(), files, progress)
+}
+
+// repository returns the repository of a model, whose name must be lower case.
+func (s *ociSource) repository(model string) string {
+	repo := strings.ToLower(model)
+	if s.namespace != "" {
+		repo = s.namespace + "/" + repo
+	}
+
+	return repo
+}
+
+// remote returns how to reach the registry of an image, with the settings of the model source
+// taking precedence over the registries file.
+func (s *ociSource) remote(image string) (*imagemirror.Remote, error) {
+	var opts []name.Option
+	if s.plainHTTP {
+		opts = append(opts, name.Insecure)
+	}
+
+	rem, err := s.mirrors.Remote(image, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if s.insecure {
+		rem.Transport = imagemirror.InsecureTransport()
+	}
+	if s.auth != nil {
+		rem.Auth = s.auth
+	}
+
+	return rem, nil
+}
+
+// blobClient returns a client authenticated to pull from the repository of the artifact. Blobs are
+// fetched with it rather than with the remote package, which cannot resume them from an offset.
+func (s *ociSource) blobClient(ctx context.Context, rem *imagemirror.Remote) (*http.Client, error) {
+	repo := rem.Ref.Context()
+	rt, err := transport.NewWithContext(ctx, repo.Registry, rem.Auth, rem.Transport, []string{repo.Scope(transport.PullScope)})
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Client{Transport: rt}, nil
+}
+
+// openBlob opens a layer from offset on. Registries that do not support ranges send it from the
+// start.
+func openBlob(ctx context.Context, client *http.Client, blob name.Digest, offset int64) (io.ReadCloser, int64, error) {
+	repo := blob.Context()
+	u := url.URL{Scheme: repo.Scheme(), Host: repo.RegistryStr(), Path: "/v2/" + repo.RepositoryStr() + "/blobs/" + blob.DigestStr()}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("registry request failed: %w", err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		return resp.Body, offset, nil
+	case http.StatusOK:
+		return resp.Body, 0, nil
+	default:
+		defer resp.Body.Close()
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, ociErrorBodyLimit))
+
+		return nil, 0, fmt.Errorf("registry returned %s for %s: %s", resp.Status, u.Path, strings.TrimSpace(string(msg)))
+	}
+}
+
+// Made with Bob
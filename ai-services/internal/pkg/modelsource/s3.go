@@ -0,0 +1,62 @@
+package modelsource
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/objectstorage"
+	"github.com/project-ai-services/ai-services/internal/pkg/transfer"
+)
+
+// s3Source downloads the objects below <prefix>/<model>/ of a bucket.
+type s3Source struct {
+	client *objectstorage.Client
+	prefix string
+}
+
+func (s *s3Source) Download(ctx context.Context, model, modelsDir string, progress transfer.ProgressFunc) error {
+	prefix := path.Join(s.prefix, model) + "/"
+	objects, err := s.client.List(ctx, prefix)
+	if err != nil {
+		return fmt.Errorf("failed to list the files of model %s: %w", model, err)
+	}
+
+	files := []remoteFile{}
+	for _, object := range objects {
+		rel := strings.TrimPrefix(object.Key, prefix)
+		if rel == "" || strings.HasSuffix(rel, "/") || isCachePath(rel) {
+			continue
+		}
+
+		key := object.Key
+		files = append(files, remoteFile{
+			path: rel,
+			size: object.Size,
+			md5:  singlePartMD5(object.ETag),
+			open: func(ctx context.Context, offset int64) (io.ReadCloser, int64, error) {
+				return s.client.GetFrom(ctx, key, offset)
+			},
+		})
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("model %s not found in the bucket below %s", model, prefix)
+	}
+
+	return fetchFiles(ctx, modelDir(modelsDir, model), "", files, progress)
+}
+
+// singlePartMD5 returns the ETag of an object when it is its MD5 checksum, which is not the case
+// for objects uploaded in several parts.
+func singlePartMD5(etag string) string {
+	const md5HexLen = 32
+	if len(etag) != md5HexLen || strings.Contains(etag, "-") {
+		return ""
+	}
+
+	return etag
+}
+
+// Made with Bob
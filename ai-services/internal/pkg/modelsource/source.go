@@ -0,0 +1,162 @@
+// Package modelsource downloads models to the models directory of the host from one of several
+// sources: Hugging Face or a mirror of it, the bucket of an object_storage connector, OCI artifacts
+// in a registry, or a local directory such as an NFS mount.
+//
+// Whatever the source, a model named "org/name" is downloaded to <models>/org/name, and every file
+// gets the download metadata Hugging Face downloads keep, so the model can be inspected and
+// verified the same way afterwards (see modelstore). Downloads are resumed where they stopped and
+// the files are verified against the checksums of the source.
+package modelsource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/transfer"
+)
+
+// Type is the kind of a model source.
+type Type string
+
+const (
+	// TypeHuggingFace downloads from Hugging Face, or the mirror at Config.Endpoint.
+	TypeHuggingFace Type = "huggingface"
+	// TypeS3 downloads from the bucket of an object_storage connector, the model files being the
+	// objects below <prefix>/<model>/.
+	TypeS3 Type = "s3"
+	// TypeOCI downloads the OCI artifact <endpoint>/<model>:<revision>, with a layer per model file
+	// titled with its path, as pushed by "oras push".
+	TypeOCI Type = "oci"
+	// TypeLocal copies the model from <path>/<model>, e.g. on an NFS mount.
+	TypeLocal Type = "local"
+)
+
+// ValuesKey is the key of the model source in the values of a component.
+const ValuesKey = "modelSource"
+
+// Environment variables holding the credentials of the sources, so they do not have to be written
+// in values files or command lines.
+const (
+	// TokenEnv is the Hugging Face or registry token used when Config.Token is empty.
+	TokenEnv = "AI_SERVICES_MODEL_SOURCE_TOKEN"
+	// S3AccessKeyEnv and S3SecretKeyEnv are the credentials of a bucket given without connector.
+	S3AccessKeyEnv = "AWS_ACCESS_KEY_ID"
+	S3SecretKeyEnv = "AWS_SECRET_ACCESS_KEY"
+)
+
+// Config selects and configures the source of a model. The zero value downloads from Hugging Face.
+type Config struct {
+	// Type of the source, huggingface when empty.
+	Type Type `json:"type,omitempty"`
+	// Endpoint is the Hugging Face mirror, the S3 endpoint when no connector is given, or the
+	// registry and namespace of OCI artifacts, e.g. registry.example.com/models, reached over
+	// HTTPS unless prefixed with http://.
+	Endpoint string `json:"endpoint,omitempty"`
+	// Token authenticates to Hugging Face or the registry. Without it, registries are reached with the
+	// auth of the registries file.
+	Token string `json:"token,omitempty"`
+	// Username goes with Token to log in to the registry.
+	Username string `json:"username,omitempty"`
+	// Revision is the branch, tag or commit to download from Hugging Face, or the tag of the OCI
+	// artifact (latest when empty).
+	Revision string `json:"revision,omitempty"`
+	// Connector is the ID of the object_storage connector of the bucket.
+	Connector string `json:"connector,omitempty"`
+	// Bucket is the bucket used when no connector is given.
+	Bucket string `json:"bucket,omitempty"`
+	// Prefix is the key prefix of the models in the bucket.
+	Prefix string `json:"prefix,omitempty"`
+	// Path is the directory holding the models of a local source.
+	Path string `json:"path,omitempty"`
+	// Insecure skips the verification of the certificate of the registry.
+	Insecure bool `json:"insecure,omitempty"`
+}
+
+// Source downloads models.
+type Source interface {
+	// Download downloads the model of the given name to <modelsDir>/<name>, resuming a previous
+	// download and keeping the files already downloaded. progress, which may be nil, is told the
+	// bytes of the model downloaded so far.
+	Download(ctx context.Context, model, modelsDir string, progress transfer.ProgressFunc) error
+}
+
+// ConfigFromValues reads the model source from the values of a component. Values without model
+// source select Hugging Face.
+func ConfigFromValues(values map[string]any) (Config, error) {
+	var cfg Config
+
+	raw, ok := values[ValuesKey]
+	if !ok || raw == nil {
+		return cfg, nil
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return cfg, fmt.Errorf("invalid %s: %w", ValuesKey, err)
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("invalid %s: %w", ValuesKey, err)
+	}
+
+	return cfg, cfg.Validate()
+}
+
+// Validate checks that the configuration has what its source type needs.
+func (c Config) Validate() error {
+	switch c.Type {
+	case "", TypeHuggingFace:
+		return nil
+	case TypeS3:
+		if c.Connector == "" && (c.Endpoint == "" || c.Bucket == "") {
+			return fmt.Errorf("an s3 model source needs a connector, or an endpoint and a bucket")
+		}
+	case TypeOCI:
+		if c.Endpoint == "" {
+			return fmt.Errorf("an oci model source needs the registry endpoint")
+		}
+	case TypeLocal:
+		if c.Path == "" {
+			return fmt.Errorf("a local model source needs a path")
+		}
+	default:
+		return fmt.Errorf("unknown model source type %q, expected one of %s, %s, %s or %s",
+			c.Type, TypeHuggingFace, TypeS3, TypeOCI, TypeLocal)
+	}
+
+	return nil
+}
+
+// String describes the source for logs, without its credentials.
+func (c Config) String() string {
+	switch c.Type {
+	case TypeS3:
+		if c.Connector != "" {
+			return fmt.Sprintf("s3 connector %s", c.Connector)
+		}
+
+		return fmt.Sprintf("s3 bucket %s at %s", c.Bucket, c.Endpoint)
+	case TypeOCI:
+		return "oci registry " + c.Endpoint
+	case TypeLocal:
+		return "local path " + c.Path
+	default:
+		if c.Endpoint != "" {
+			return "huggingface mirror " + c.Endpoint
+		}
+
+		return string(TypeHuggingFace)
+	}
+}
+
+// token returns the configured token, or the one of the environment.
+func (c Config) token() string {
+	if c.Token != "" {
+		return c.Token
+	}
+
+	return os.Getenv(TokenEnv)
+}
+
+// Made with Bob
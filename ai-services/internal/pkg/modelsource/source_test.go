@@ -0,0 +1,275 @@
+package modelsource
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec // checksums of S3 test objects
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/v1/types"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/modelstore"
+)
+
+const testModel = "org/llm"
+
+var testFiles = map[string]string{
+	"config.json":       `{"architectures": ["GraniteForCausalLM"]}`,
+	"model.safetensors": strings.Repeat("weights", 1000),
+}
+
+func sha256Hex(content string) string {
+	sum := sha256.Sum256([]byte(content))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// checkModel checks that the test model was downloaded to modelsDir and verifies.
+func checkModel(t *testing.T, modelsDir string) {
+	t.Helper()
+
+	for file, content := range testFiles {
+		data, err := os.ReadFile(filepath.Join(modelsDir, testModel, file))
+		if err != nil || string(data) != content {
+			t.Errorf("%s = %q, %v, want its content", file, data, err)
+		}
+	}
+
+	result, err := modelstore.NewStore(modelsDir).Verify(testModel)
+	if err != nil || !result.Valid {
+		t.Fatalf("Verify() = %+v, %v, want valid", result, err)
+	}
+	for _, file := range result.Files {
+		if file.Status != modelstore.FileStatusOK {
+			t.Errorf("status of %s = %s, want ok", file.Path, file.Status)
+		}
+	}
+}
+
+func TestConfigFromValues(t *testing.T) {
+	cfg, err := ConfigFromValues(map[string]any{"model": testModel})
+	if err != nil || cfg != (Config{}) {
+		t.Errorf("ConfigFromValues() without source = %+v, %v, want Hugging Face", cfg, err)
+	}
+
+	cfg, err = ConfigFromValues(map[string]any{
+		ValuesKey: map[string]any{"type": "oci", "endpoint": "registry.example.com/models", "insecure": true},
+	})
+	if err != nil || cfg.Type != TypeOCI || cfg.Endpoint != "registry.example.com/models" || !cfg.Insecure {
+		t.Errorf("ConfigFromValues() = %+v, %v, want the oci source", cfg, err)
+	}
+
+	for _, values := range []map[string]any{
+		{ValuesKey: map[string]any{"type": "ftp"}},
+		{ValuesKey: map[string]any{"type": "s3", "bucket": "models"}},
+		{ValuesKey: map[string]any{"type": "local"}},
+		{ValuesKey: "huggingface"},
+	} {
+		if _, err := ConfigFromValues(values); err == nil {
+			t.Errorf("ConfigFromValues(%v) succeeded, want error", values)
+		}
+	}
+}
+
+func TestLocalSource(t *testing.T) {
+	src := t.TempDir()
+	for file, content := range testFiles {
+		path := filepath.Join(src, testModel, file)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// An interrupted download left the start of the weights
+	modelsDir := t.TempDir()
+	incomplete := modelstore.IncompletePath(filepath.Join(modelsDir, testModel), "model.safetensors")
+	if err := os.MkdirAll(filepath.Dir(incomplete), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(incomplete, []byte(testFiles["model.safetensors"][:100]), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := Config{Type: TypeLocal, Path: src}
+	var current, total int64
+	progress := func(c, t int64) { current, total = c, t }
+	if err := DownloadWithProgress(context.Background(), cfg, nil, testModel, modelsDir, progress); err != nil {
+		t.Fatalf("DownloadWithProgress() error = %v", err)
+	}
+	checkModel(t, modelsDir)
+	size := int64(len(testFiles["config.json"]) + len(testFiles["model.safetensors"]))
+	if current != size || total != size {
+		t.Errorf("progress = %d/%d, want %d/%d", current, total, size, size)
+	}
+	if _, err := os.Stat(incomplete); !os.IsNotExist(err) {
+		t.Errorf("incomplete download left behind: %v", err)
+	}
+
+	if err := Download(context.Background(), cfg, nil, "org/missing", modelsDir); err == nil {
+		t.Error("Download() of a missing model succeeded, want error")
+	}
+	if err := Download(context.Background(), cfg, nil, "../outside", modelsDir); err == nil {
+		t.Error("Download() of a name outside the directory succeeded, want error")
+	}
+}
+
+func TestS3Source(t *testing.T) {
+	objects := map[string]string{}
+	for file, content := range testFiles {
+		objects["models/"+testModel+"/"+file] = content
+	}
+	objects["models/"+testModel+"/.cache/huggingface/download/config.json.metadata"] = "ignored"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.TrimSuffix(r.URL.Path, "/") == "/bucket" && r.URL.Query().Get("list-type") == "2" {
+			fmt.Fprint(w, "<ListBucketResult>")
+			for key, content := range objects {
+				if strings.HasPrefix(key, r.URL.Query().Get("prefix")) {
+					sum := md5.Sum([]byte(content)) //nolint:gosec // see import
+					fmt.Fprintf(w, "<Contents><Key>%s</Key><Size>%d</Size><ETag>&quot;%s&quot;</ETag></Contents>",
+						key, len(content), hex.EncodeToString(sum[:]))
+				}
+			}
+			fmt.Fprint(w, "<IsTruncated>false</IsTruncated></ListBucketResult>")
+
+			return
+		}
+
+		content, ok := objects[strings.TrimPrefix(r.URL.Path, "/bucket/")]
+		if !ok {
+			http.NotFound(w, r)
+
+			return
+		}
+		http.ServeContent(w, r, "", time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC), strings.NewReader(content))
+	}))
+	defer server.Close()
+
+	t.Setenv(S3AccessKeyEnv, "key")
+	t.Setenv(S3SecretKeyEnv, "secret")
+	cfg := Config{Type: TypeS3, Endpoint: server.URL, Bucket: "bucket", Prefix: "/models/"}
+
+	modelsDir := t.TempDir()
+	if err := Download(context.Background(), cfg, nil, testModel, modelsDir); err != nil {
+		t.Fatalf("Download() error = %v", err)
+	}
+	checkModel(t, modelsDir)
+
+	// A transfer corrupting the object fails the download
+	if err := os.Remove(filepath.Join(modelsDir, testModel, "model.safetensors")); err != nil {
+		t.Fatal(err)
+	}
+	server.Config.Handler = corruptingHandler(server.Config.Handler)
+	if err := Download(context.Background(), cfg, nil, testModel, modelsDir); err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Errorf("Download() of a corrupted object error = %v, want checksum mismatch", err)
+	}
+
+	if err := Download(context.Background(), Config{Type: TypeS3, Connector: "id"}, nil, testModel, modelsDir); err == nil {
+		t.Error("Download() from a connector without resolver succeeded, want error")
+	}
+}
+
+// corruptingHandler flips the content of objects while keeping the listing, as a faulty transfer.
+func corruptingHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("list-type") != "" {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+		rec := httptest.NewRecorder()
+		next.ServeHTTP(rec, r)
+		body := []byte(strings.ToUpper(rec.Body.String()))
+		maps.Copy(w.Header(), rec.Header())
+		w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+		w.WriteHeader(rec.Code)
+		_, _ = w.Write(body)
+	})
+}
+
+func TestOCISource(t *testing.T) {
+	blobs := map[string]string{}
+	layers := []map[string]any{}
+	for file, content := range testFiles {
+		digest := "sha256:" + sha256Hex(content)
+		blobs[digest] = content
+		layers = append(layers, map[string]any{
+			"mediaType":   "application/octet-stream",
+			"digest":      digest,
+			"size":        len(content),
+			"annotations": map[string]string{ociTitleAnnotation: file},
+		})
+	}
+	manifest, err := json.Marshal(map[string]any{"schemaVersion": 2, "layers": layers})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			if user, pass, ok := r.BasicAuth(); !ok || user != "robot" || pass != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+
+				return
+			}
+			fmt.Fprint(w, `{"token": "issued"}`)
+
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer issued" {
+			// The client refuses token realms at IP literals of private addresses
+			realm := strings.Replace(server.URL, "127.0.0.1", "localhost", 1) + "/token"
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s",service="registry",scope="repository:models/org/llm:pull"`, realm))
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		switch r.URL.Path {
+		case "/v2/models/org/llm/manifests/v1":
+			w.Header().Set("Content-Type", string(types.OCIManifestSchema1))
+			_, _ = w.Write(manifest)
+		default:
+			content, ok := blobs[strings.TrimPrefix(r.URL.Path, "/v2/models/org/llm/blobs/")]
+			if !ok {
+				http.NotFound(w, r)
+
+				return
+			}
+			http.ServeContent(w, r, "", time.Time{}, strings.NewReader(content))
+		}
+	}))
+	defer server.Close()
+
+	modelsDir := t.TempDir()
+	cfg := Config{Type: TypeOCI, Endpoint: server.URL + "/models", Revision: "v1", Username: "robot", Token: "secret"}
+	if err := Download(context.Background(), cfg, nil, testModel, modelsDir); err != nil {
+		t.Fatalf("Download() error = %v", err)
+	}
+	checkModel(t, modelsDir)
+
+	model, err := modelstore.NewStore(modelsDir).Get(testModel)
+	if err != nil || !strings.HasPrefix(model.Revision, "sha256:") {
+		t.Errorf("Revision = %q, %v, want the manifest digest", model.Revision, err)
+	}
+
+	cfg.Token = "wrong"
+	if err := Download(context.Background(), cfg, nil, testModel, t.TempDir()); err == nil {
+		t.Error("Download() with a wrong token succeeded, want error")
+	}
+}
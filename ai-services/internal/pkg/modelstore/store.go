@@ -0,0 +1,282 @@
+// Package modelstore inventories the models downloaded to the models directory of the host, e.g.
+// /var/lib/ai-services/models. Models are downloaded with "hf download <name> --local-dir
+// <models>/<name>", so a model named "ibm-granite/granite-3.3-8b-instruct" lives in the directory of
+// the same relative path. The download keeps a metadata file per model file under
+// .cache/huggingface/download, holding the revision it was downloaded from and its checksum.
+package modelstore
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// cacheDir is the directory of the download metadata below a model directory.
+	cacheDir = ".cache"
+	// metadataDir is the directory of the metadata files of the model files below a model directory.
+	metadataDir = ".cache/huggingface/download"
+	// metadataSuffix is the suffix of the metadata file of a model file.
+	metadataSuffix = ".metadata"
+	// incompleteSuffix is the suffix of a model file being downloaded.
+	incompleteSuffix = ".incomplete"
+	// maxModelDepth is how deep models are searched below the models directory, e.g. <org>/<name>.
+	maxModelDepth = 3
+)
+
+// Model is a model downloaded to the models directory.
+type Model struct {
+	Name       string    `json:"name"`
+	Path       string    `json:"path"`
+	SizeBytes  int64     `json:"size_bytes"`
+	Revision   string    `json:"revision,omitempty"`
+	ModifiedAt time.Time `json:"modified_at"`
+	Files      []File    `json:"files"`
+	UsedBy     []string  `json:"used_by"` // nil when the applications using the model are unknown
+}
+
+// File is a file of a model. SHA256 is the checksum recorded when the file was downloaded, or the
+// computed one after ComputeChecksums; it is empty when neither is known.
+type File struct {
+	Path      string `json:"path"`
+	SizeBytes int64  `json:"size_bytes"`
+	SHA256    string `json:"sha256,omitempty"`
+}
+
+// Store is the models directory of the host.
+type Store struct {
+	dir string
+}
+
+// NewStore creates a store for the models directory dir.
+func NewStore(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// Dir returns the models directory.
+func (s *Store) Dir() string {
+	return s.dir
+}
+
+// List returns the models of the directory ordered by name. A missing directory has no models.
+func (s *Store) List() ([]Model, error) {
+	names := []string{}
+	err := filepath.WalkDir(s.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if path == s.dir && errors.Is(err, fs.ErrNotExist) {
+				return fs.SkipAll
+			}
+
+			return err
+		}
+		if !d.IsDir() || path == s.dir {
+			return nil
+		}
+		if strings.HasPrefix(d.Name(), ".") {
+			return fs.SkipDir
+		}
+
+		rel, err := filepath.Rel(s.dir, path)
+		if err != nil {
+			return err
+		}
+		if isModelDir(path) {
+			names = append(names, filepath.ToSlash(rel))
+
+			return fs.SkipDir
+		}
+		if strings.Count(filepath.ToSlash(rel), "/")+1 >= maxModelDepth {
+			return fs.SkipDir
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read models directory %s: %w", s.dir, err)
+	}
+
+	models := make([]Model, 0, len(names))
+	for _, name := range names {
+		model, err := s.Get(name)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, *model)
+	}
+
+	return models, nil
+}
+
+// isModelDir reports whether dir holds a model: a download left its metadata in it, or it holds
+// files rather than only directories of other models.
+func isModelDir(dir string) bool {
+	if info, err := os.Stat(filepath.Join(dir, metadataDir)); err == nil && info.IsDir() {
+		return true
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return false
+	}
+	for _, entry := range entries {
+		if entry.Type().IsRegular() && !strings.HasPrefix(entry.Name(), ".") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Get returns the model of the given name with its files.
+func (s *Store) Get(name string) (*Model, error) {
+	path, err := s.modelPath(name)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("model %s not found in %s", name, s.dir)
+		}
+
+		return nil, fmt.Errorf("failed to read model %s: %w", name, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("model %s is not a directory", name)
+	}
+
+	model := &Model{Name: name, Path: path, ModifiedAt: info.ModTime(), Files: []File{}, UsedBy: []string{}}
+	revisions := map[string]int{}
+	err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !d.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		model.SizeBytes += info.Size()
+		if info.ModTime().After(model.ModifiedAt) {
+			model.ModifiedAt = info.ModTime()
+		}
+
+		rel, err := filepath.Rel(path, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if rel == cacheDir || strings.HasPrefix(rel, cacheDir+"/") {
+			return nil
+		}
+
+		file := File{Path: rel, SizeBytes: info.Size()}
+		if meta, ok := ReadDownloadMetadata(path, rel); ok {
+			if isSHA256(meta.ETag) {
+				file.SHA256 = meta.ETag
+			}
+			revisions[meta.Commit]++
+		}
+		model.Files = append(model.Files, file)
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read model %s: %w", name, err)
+	}
+
+	sort.Slice(model.Files, func(i, j int) bool { return model.Files[i].Path < model.Files[j].Path })
+	model.Revision = mostCommon(revisions)
+
+	return model, nil
+}
+
+// ComputeChecksums computes the SHA-256 checksum of every file of the model.
+func ComputeChecksums(model *Model) error {
+	for i := range model.Files {
+		sum, err := fileSHA256(filepath.Join(model.Path, filepath.FromSlash(model.Files[i].Path)))
+		if err != nil {
+			return err
+		}
+		model.Files[i].SHA256 = sum
+	}
+
+	return nil
+}
+
+// Delete removes the model of the given name, and the directory of its organization if it is left
+// empty.
+func (s *Store) Delete(name string) error {
+	model, err := s.Get(name)
+	if err != nil {
+		return err
+	}
+
+	if err := os.RemoveAll(model.Path); err != nil {
+		return fmt.Errorf("failed to delete model %s: %w", name, err)
+	}
+
+	// Remove the parents left empty, up to the models directory
+	for dir := filepath.Dir(model.Path); dir != filepath.Clean(s.dir); dir = filepath.Dir(dir) {
+		if err := os.Remove(dir); err != nil {
+			break
+		}
+	}
+
+	return nil
+}
+
+// modelPath returns the directory of a model, refusing names that leave the models directory.
+func (s *Store) modelPath(name string) (string, error) {
+	clean := filepath.Clean(filepath.FromSlash(name))
+	if name == "" || clean == "." || filepath.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid model name %q", name)
+	}
+
+	return filepath.Join(s.dir, clean), nil
+}
+
+// mostCommon returns the key with the highest count, the smallest one on ties.
+func mostCommon(counts map[string]int) string {
+	best := ""
+	for key, count := range counts {
+		if key == "" {
+			continue
+		}
+		if best == "" || count > counts[best] || (count == counts[best] && key < best) {
+			best = key
+		}
+	}
+
+	return best
+}
+
+// fileSHA256 returns the hex encoded SHA-256 checksum of a file.
+func fileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Made with Bob
@@ -0,0 +1,182 @@
+package modelstore
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testCommit = "0123456789abcdef0123456789abcdef01234567"
+
+// writeModelFile writes a model file and, unless etag is empty, the metadata its download would
+// have left.
+func writeModelFile(t *testing.T, modelDir, file, content, etag string) {
+	t.Helper()
+
+	path := filepath.Join(modelDir, filepath.FromSlash(file))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if etag == "" {
+		return
+	}
+
+	meta := filepath.Join(modelDir, metadataDir, filepath.FromSlash(file)+metadataSuffix)
+	if err := os.MkdirAll(filepath.Dir(meta), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(meta, []byte(testCommit+"\n\""+etag+"\"\n1700000000.0\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func sha256Hex(content string) string {
+	sum := sha256.Sum256([]byte(content))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// newTestStore creates a store with the model org/llm, whose weights are stored in Git LFS and
+// its config in Git, and the model copied by hand plain.
+func newTestStore(t *testing.T) *Store {
+	t.Helper()
+
+	dir := t.TempDir()
+	llm := filepath.Join(dir, "org", "llm")
+	writeModelFile(t, llm, "model.safetensors", "weights", sha256Hex("weights"))
+	writeModelFile(t, llm, "config.json", "{}", "9e26dfeeb6e641a33dae4961196235bdb965b21b") // git hash-object of "{}"
+	writeModelFile(t, filepath.Join(dir, "plain"), "weights.bin", "plain", "")
+
+	return NewStore(dir)
+}
+
+func TestList(t *testing.T) {
+	models, err := newTestStore(t).List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(models) != 2 || models[0].Name != "org/llm" || models[1].Name != "plain" {
+		t.Fatalf("List() = %+v, want org/llm and plain", models)
+	}
+
+	llm := models[0]
+	if llm.Revision != testCommit {
+		t.Errorf("Revision = %q, want %q", llm.Revision, testCommit)
+	}
+	if len(llm.Files) != 2 || llm.Files[0].Path != "config.json" || llm.Files[1].Path != "model.safetensors" {
+		t.Fatalf("Files = %+v, want config.json and model.safetensors", llm.Files)
+	}
+	if llm.Files[0].SHA256 != "" || llm.Files[1].SHA256 != sha256Hex("weights") {
+		t.Errorf("Files = %+v, want the recorded SHA-256 of model.safetensors only", llm.Files)
+	}
+	if llm.SizeBytes <= int64(len("weights")+len("{}")) {
+		t.Errorf("SizeBytes = %d, want the files and their metadata", llm.SizeBytes)
+	}
+
+	if models, err := NewStore(filepath.Join(t.TempDir(), "missing")).List(); err != nil || len(models) != 0 {
+		t.Errorf("List() of a missing directory = %v, %v, want no models", models, err)
+	}
+}
+
+func TestComputeChecksums(t *testing.T) {
+	model, err := newTestStore(t).Get("org/llm")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if err := ComputeChecksums(model); err != nil {
+		t.Fatalf("ComputeChecksums() error = %v", err)
+	}
+	if model.Files[0].SHA256 != sha256Hex("{}") {
+		t.Errorf("SHA256 of config.json = %q, want %q", model.Files[0].SHA256, sha256Hex("{}"))
+	}
+}
+
+func TestVerify(t *testing.T) {
+	store := newTestStore(t)
+
+	result, err := store.Verify("org/llm")
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if !result.Valid {
+		t.Errorf("Verify() = %+v, want valid", result)
+	}
+	for _, file := range result.Files {
+		if file.Status != FileStatusOK {
+			t.Errorf("status of %s = %s, want ok", file.Path, file.Status)
+		}
+	}
+
+	llm := filepath.Join(store.Dir(), "org", "llm")
+	if err := os.WriteFile(filepath.Join(llm, "model.safetensors"), []byte("tampered"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(filepath.Join(llm, "config.json")); err != nil {
+		t.Fatal(err)
+	}
+	result, err = store.Verify("org/llm")
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	want := map[string]FileStatus{"model.safetensors": FileStatusMismatch, "config.json": FileStatusMissing}
+	if result.Valid || len(result.Files) != len(want) {
+		t.Fatalf("Verify() = %+v, want invalid with %d files", result, len(want))
+	}
+	for _, file := range result.Files {
+		if file.Status != want[file.Path] {
+			t.Errorf("status of %s = %s, want %s", file.Path, file.Status, want[file.Path])
+		}
+	}
+
+	result, err = store.Verify("plain")
+	if err != nil || !result.Valid || result.Files[0].Status != FileStatusUnverified {
+		t.Errorf("Verify(plain) = %+v, %v, want valid and unverified", result, err)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	store := newTestStore(t)
+
+	if err := store.Delete("../outside"); err == nil {
+		t.Error("Delete() of a name outside the directory succeeded, want error")
+	}
+	if err := store.Delete("org/missing"); err == nil {
+		t.Error("Delete() of a missing model succeeded, want error")
+	}
+
+	if err := store.Delete("org/llm"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(store.Dir(), "org")); !os.IsNotExist(err) {
+		t.Errorf("empty organization directory left behind: %v", err)
+	}
+	models, err := store.List()
+	if err != nil || len(models) != 1 || models[0].Name != "plain" {
+		t.Errorf("List() after Delete() = %+v, %v, want plain only", models, err)
+	}
+}
+
+func TestUsageApply(t *testing.T) {
+	usage := Usage{}
+	usage.Add("org/llm", "rag-b", "rag-a")
+	usage.Add("org/llm", "rag-a")
+	models := []Model{{Name: "org/llm"}, {Name: "plain"}}
+	usage.Apply(models)
+	want := []string{"rag-a", "rag-b"}
+	if len(models[0].UsedBy) != len(want) {
+		t.Fatalf("UsedBy = %v, want %v", models[0].UsedBy, want)
+	}
+	for i := range want {
+		if models[0].UsedBy[i] != want[i] {
+			t.Errorf("UsedBy = %v, want %v", models[0].UsedBy, want)
+		}
+	}
+	if models[1].UsedBy == nil || len(models[1].UsedBy) != 0 {
+		t.Errorf("UsedBy of an unused model = %#v, want empty", models[1].UsedBy)
+	}
+}
@@ -0,0 +1,64 @@
+package modelstore
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+)
+
+// Usage maps model names to the applications using them.
+type Usage map[string][]string
+
+// AddLegacy records the models used by the pods of legacy applications, running or not. Legacy
+// applications are not in the catalog, so their pods are the only record of their models: they
+// declare them with annotations prefixed with ai-services.io/model. Pods of catalog components are
+// skipped; the catalog records their models.
+func (u Usage) AddLegacy(rt runtime.Runtime) error {
+	pods, err := rt.ListPods(nil)
+	if err != nil {
+		return fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	for _, pod := range pods {
+		app := pod.Labels[constants.ApplicationAnnotationKey]
+		if app == "" {
+			continue
+		}
+
+		for _, c := range pod.Containers {
+			container, err := rt.InspectContainer(c.ID)
+			if err != nil {
+				return fmt.Errorf("failed to inspect container %s of pod %s: %w", c.Name, pod.Name, err)
+			}
+			for key, value := range container.Annotations {
+				if strings.HasPrefix(key, constants.ModelAnnotationKey) && strings.TrimSpace(value) != "" {
+					u.Add(strings.TrimSpace(value), app)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Add records that users use a model.
+func (u Usage) Add(model string, users ...string) {
+	for _, user := range users {
+		if !slices.Contains(u[model], user) {
+			u[model] = append(u[model], user)
+		}
+	}
+	slices.Sort(u[model])
+}
+
+// Apply sets the applications using each model.
+func (u Usage) Apply(models []Model) {
+	for i := range models {
+		models[i].UsedBy = append([]string{}, u[models[i].Name]...)
+	}
+}
+
+// Made with Bob
@@ -0,0 +1,250 @@
+package modelstore
+
+import (
+	"bufio"
+	"crypto/sha1" //nolint:gosec // git identifies blobs by SHA-1, it is not used for security
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+)
+
+// FileStatus is the result of the verification of a model file.
+type FileStatus string
+
+const (
+	// FileStatusOK is a file whose checksum matches the one recorded when it was downloaded.
+	FileStatusOK FileStatus = "ok"
+	// FileStatusMismatch is a file whose checksum differs from the recorded one.
+	FileStatusMismatch FileStatus = "mismatch"
+	// FileStatusMissing is a file that was downloaded but no longer exists.
+	FileStatusMissing FileStatus = "missing"
+	// FileStatusUnverified is a file without recorded checksum, e.g. one copied by hand.
+	FileStatusUnverified FileStatus = "unverified"
+)
+
+// FileCheck is the verification of a model file.
+type FileCheck struct {
+	Path     string     `json:"path"`
+	Status   FileStatus `json:"status"`
+	Expected string     `json:"expected,omitempty"`
+	Actual   string     `json:"actual,omitempty"`
+}
+
+// VerifyResult is the verification of a model.
+type VerifyResult struct {
+	Name  string      `json:"name"`
+	Valid bool        `json:"valid"`
+	Files []FileCheck `json:"files"`
+}
+
+// DownloadMetadata is the metadata the download kept for a model file: the commit of the revision
+// and the etag, which is the SHA-256 checksum of files stored in Git LFS and the Git blob ID of the
+// others.
+type DownloadMetadata struct {
+	Commit string
+	ETag   string
+}
+
+// metadataPath returns the path of the download metadata of a model file, given by its path
+// relative to the model directory.
+func metadataPath(modelDir, file string) string {
+	return filepath.Join(modelDir, metadataDir, filepath.FromSlash(file)+metadataSuffix)
+}
+
+// IncompletePath returns where a model file is written while it is downloaded, next to its
+// download metadata so that it is not taken for a file of the model.
+func IncompletePath(modelDir, file string) string {
+	return filepath.Join(modelDir, metadataDir, filepath.FromSlash(file)+incompleteSuffix)
+}
+
+// ReadDownloadMetadata reads the download metadata of a model file, given by its path relative to
+// the model directory.
+func ReadDownloadMetadata(modelDir, file string) (DownloadMetadata, bool) {
+	f, err := os.Open(metadataPath(modelDir, file))
+	if err != nil {
+		return DownloadMetadata{}, false
+	}
+	defer f.Close()
+
+	lines := []string{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() && len(lines) < 2 {
+		lines = append(lines, strings.TrimSpace(scanner.Text()))
+	}
+	if len(lines) < 2 {
+		return DownloadMetadata{}, false
+	}
+
+	return DownloadMetadata{Commit: lines[0], ETag: strings.Trim(lines[1], `"`)}, true
+}
+
+// WriteDownloadMetadata records the download of a model file in the format of Hugging Face
+// downloads, so that models from any source can be verified.
+func WriteDownloadMetadata(modelDir, file string, meta DownloadMetadata) error {
+	path := metadataPath(modelDir, file)
+	if err := os.MkdirAll(filepath.Dir(path), constants.DirPerm); err != nil {
+		return fmt.Errorf("failed to create download metadata directory: %w", err)
+	}
+
+	content := fmt.Sprintf("%s\n%s\n%d\n", meta.Commit, meta.ETag, time.Now().Unix())
+	if err := os.WriteFile(path, []byte(content), constants.FilePerm); err != nil {
+		return fmt.Errorf("failed to write download metadata of %s: %w", file, err)
+	}
+
+	return nil
+}
+
+// Verify compares the checksum of every file of the model with the one recorded when it was
+// downloaded. The model is valid when no file is missing or mismatches; files without recorded
+// checksum do not make it invalid.
+func (s *Store) Verify(name string) (*VerifyResult, error) {
+	model, err := s.Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &VerifyResult{Name: name, Valid: true, Files: []FileCheck{}}
+	seen := map[string]bool{}
+	for _, file := range model.Files {
+		seen[file.Path] = true
+
+		check, err := verifyFile(model.Path, file.Path)
+		if err != nil {
+			return nil, err
+		}
+		if check.Status == FileStatusMismatch {
+			result.Valid = false
+		}
+		result.Files = append(result.Files, check)
+	}
+
+	// Files that were downloaded but deleted since
+	missing, err := downloadedFiles(model.Path)
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range missing {
+		if !seen[file] {
+			result.Valid = false
+			result.Files = append(result.Files, FileCheck{Path: file, Status: FileStatusMissing})
+		}
+	}
+
+	return result, nil
+}
+
+// verifyFile verifies a model file against its download metadata.
+func verifyFile(modelDir, file string) (FileCheck, error) {
+	check := FileCheck{Path: file, Status: FileStatusUnverified}
+
+	meta, ok := ReadDownloadMetadata(modelDir, file)
+	if !ok {
+		return check, nil
+	}
+
+	path := filepath.Join(modelDir, filepath.FromSlash(file))
+	var (
+		actual string
+		err    error
+	)
+	switch {
+	case isSHA256(meta.ETag):
+		actual, err = fileSHA256(path)
+	case isGitBlobID(meta.ETag):
+		actual, err = gitBlobID(path)
+	default:
+		return check, nil
+	}
+	if err != nil {
+		return check, err
+	}
+
+	check.Expected, check.Actual = meta.ETag, actual
+	check.Status = FileStatusOK
+	if !strings.EqualFold(actual, meta.ETag) {
+		check.Status = FileStatusMismatch
+	}
+
+	return check, nil
+}
+
+// downloadedFiles returns the model files that have download metadata, relative to the model
+// directory.
+func downloadedFiles(modelDir string) ([]string, error) {
+	root := filepath.Join(modelDir, metadataDir)
+	files := []string{}
+	err := filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			if path == root && os.IsNotExist(err) {
+				return filepath.SkipAll
+			}
+
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(d.Name(), metadataSuffix) {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, strings.TrimSuffix(filepath.ToSlash(rel), metadataSuffix))
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read download metadata of %s: %w", modelDir, err)
+	}
+
+	return files, nil
+}
+
+// gitBlobID returns the Git blob ID of a file, the SHA-1 checksum of "blob <size>\x00<content>".
+func gitBlobID(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	h := sha1.New() //nolint:gosec // see import
+	fmt.Fprintf(h, "blob %d\x00", info.Size())
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func isSHA256(s string) bool {
+	return len(s) == sha256HexLen && isHex(s)
+}
+
+func isGitBlobID(s string) bool {
+	return len(s) == sha1HexLen && isHex(s)
+}
+
+const (
+	sha256HexLen = 64
+	sha1HexLen   = 40
+)
+
+func isHex(s string) bool {
+	_, err := hex.DecodeString(s)
+
+	return err == nil
+}
+
+// Made with Bob
@@ -0,0 +1,199 @@
+// Package objectstorage is a minimal client for S3-compatible object storage, used to keep backups
+// in and download models from the bucket of an object_storage connector.
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/catalog/db/models"
+)
+
+const (
+	// ConnectorProvider is the connector provider holding the credentials of a bucket.
+	ConnectorProvider = "object_storage"
+
+	s3DefaultRegion = "us-east-1"
+
+	// s3PartSize is the size of the parts of a multipart upload. Objects up to this size are
+	// uploaded with a single PUT; larger ones in parts.
+	s3PartSize = 64 << 20
+)
+
+// Client performs object operations on a bucket of an S3-compatible endpoint using path-style
+// URLs.
+type Client struct {
+	client   *minio.Client
+	bucket   string
+	partSize uint64
+}
+
+// Object is an object listed in a bucket.
+type Object struct {
+	Key  string
+	Size int64
+	// ETag is the entity tag of the object without quotes, the MD5 checksum of objects uploaded in
+	// a single part.
+	ETag string
+}
+
+// NewClient creates a client for a bucket. The endpoint is the scheme and host of the service,
+// e.g. https://s3.eu-west-1.amazonaws.com.
+func NewClient(endpoint, bucket, accessKey, secretKey string) (*Client, error) {
+	u, err := url.Parse(strings.TrimSuffix(endpoint, "/"))
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid object storage endpoint %q", endpoint)
+	}
+	if u.Path != "" {
+		return nil, fmt.Errorf("invalid object storage endpoint %q: paths are not supported", endpoint)
+	}
+
+	client, err := minio.New(u.Host, &minio.Options{
+		Creds:        credentials.NewStaticV4(accessKey, secretKey, ""),
+		Secure:       u.Scheme == "https",
+		Region:       regionFromHost(u.Hostname()),
+		BucketLookup: minio.BucketLookupPath,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid object storage endpoint %q: %w", endpoint, err)
+	}
+
+	return &Client{client: client, bucket: bucket, partSize: s3PartSize}, nil
+}
+
+// regionFromHost extracts the region from AWS style hosts such as s3.eu-west-1.amazonaws.com.
+// Other S3-compatible services generally accept any region, so the AWS default is used for them.
+func regionFromHost(host string) string {
+	labels := strings.Split(host, ".")
+	for i := 0; i+1 < len(labels); i++ {
+		if region, ok := strings.CutPrefix(labels[i], "s3-"); ok {
+			return region
+		}
+		if labels[i] == "s3" && labels[i+1] != "amazonaws" {
+			return labels[i+1]
+		}
+	}
+
+	return s3DefaultRegion
+}
+
+// NewClientFromConnector creates a client for the bucket of an object_storage connector, read with
+// its credentials. It also returns the key prefix configured on the connector, without slashes.
+func NewClientFromConnector(connector *models.Connector) (*Client, string, error) {
+	if connector.Provider != ConnectorProvider {
+		return nil, "", fmt.Errorf("connector %q is a %s connector, not %s", connector.Name, connector.Provider, ConnectorProvider)
+	}
+
+	meta := func(key string) string {
+		v, _ := connector.Metadata[key].(string)
+
+		return v
+	}
+
+	for _, key := range []string{"endpoint_url", "bucket_name", "access_key_id", "secret_access_key"} {
+		if meta(key) == "" {
+			return nil, "", fmt.Errorf("connector %q is missing %s", connector.Name, key)
+		}
+	}
+
+	client, err := NewClient(meta("endpoint_url"), meta("bucket_name"), meta("access_key_id"), meta("secret_access_key"))
+	if err != nil {
+		return nil, "", err
+	}
+
+	return client, strings.Trim(meta("prefix"), "/"), nil
+}
+
+// Put uploads size bytes read from body to key. Objects larger than the part size are uploaded
+// in parts, and the upload is aborted if a part fails.
+func (c *Client) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+	_, err := c.client.PutObject(ctx, c.bucket, key, body, size, minio.PutObjectOptions{
+		ContentType: contentType,
+		PartSize:    c.partSize,
+	})
+
+	return err
+}
+
+// Get downloads key. The caller must close the returned reader.
+func (c *Client) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	body, _, err := c.GetFrom(ctx, key, 0)
+
+	return body, err
+}
+
+// GetFrom downloads key from byte offset on, to resume an interrupted download. It returns the
+// offset the content actually starts at: 0 when the server ignores the range and sends the whole
+// object. The caller must close the returned reader.
+func (c *Client) GetFrom(ctx context.Context, key string, offset int64) (io.ReadCloser, int64, error) {
+	opts := minio.GetObjectOptions{}
+	if offset > 0 {
+		if err := opts.SetRange(offset, 0); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	// The core client exposes the response headers, which tell whether the range was served
+	body, _, header, err := minio.Core{Client: c.client}.GetObject(ctx, c.bucket, key, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// A server ignoring the range answers 200 without a Content-Range
+	contentRange := header.Get("Content-Range")
+	if offset == 0 || contentRange == "" {
+		return body, 0, nil
+	}
+	if start, ok := contentRangeStart(contentRange); !ok || start != offset {
+		_ = body.Close()
+
+		return nil, 0, fmt.Errorf("object storage returned range %q for %s, want bytes from %d", contentRange, key, offset)
+	}
+
+	return body, offset, nil
+}
+
+// contentRangeStart returns the first byte of a Content-Range header, e.g. 10 for "bytes 10-99/100".
+func contentRangeStart(contentRange string) (int64, bool) {
+	spec, ok := strings.CutPrefix(contentRange, "bytes ")
+	if !ok {
+		return 0, false
+	}
+	first, _, ok := strings.Cut(spec, "-")
+	if !ok {
+		return 0, false
+	}
+	start, err := strconv.ParseInt(first, 10, 64)
+
+	return start, err == nil
+}
+
+// Delete removes key. Deleting a missing object is not an error.
+func (c *Client) Delete(ctx context.Context, key string) error {
+	return c.client.RemoveObject(ctx, c.bucket, key, minio.RemoveObjectOptions{})
+}
+
+// List returns the objects whose key starts with prefix, ordered by key.
+func (c *Client) List(ctx context.Context, prefix string) ([]Object, error) {
+	objects := []Object{}
+	for info := range c.client.ListObjects(ctx, c.bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if info.Err != nil {
+			return nil, fmt.Errorf("failed to list objects: %w", info.Err)
+		}
+		objects = append(objects, Object{Key: info.Key, Size: info.Size, ETag: strings.Trim(info.ETag, `"`)})
+	}
+
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Key < objects[j].Key })
+
+	return objects, nil
+}
+
+// Made with Bob
@@ -0,0 +1,261 @@
+package objectstorage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// readPayload reads the body of an upload, decoding the signed chunks sent over plain HTTP.
+func readPayload(t *testing.T, r *http.Request) []byte {
+	t.Helper()
+
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		body, _ := io.ReadAll(r.Body)
+
+		return body
+	}
+
+	var payload []byte
+	reader := bufio.NewReader(r.Body)
+	for {
+		header, err := reader.ReadString('\n')
+		if err != nil {
+			t.Errorf("reading chunk header: %v", err)
+
+			return nil
+		}
+		size, err := strconv.ParseInt(strings.SplitN(header, ";", 2)[0], 16, 64)
+		if err != nil {
+			t.Errorf("invalid chunk header %q", header)
+
+			return nil
+		}
+		if size == 0 {
+			return payload
+		}
+		chunk := make([]byte, size+2)
+		if _, err := io.ReadFull(reader, chunk); err != nil {
+			t.Errorf("reading chunk: %v", err)
+
+			return nil
+		}
+		payload = append(payload, chunk[:size]...)
+	}
+}
+
+// setObjectHeaders sets the headers S3 returns with an object.
+func setObjectHeaders(w http.ResponseWriter) {
+	w.Header().Set("Last-Modified", time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC).Format(http.TimeFormat))
+	w.Header().Set("ETag", `"etag"`)
+}
+
+func TestRegionFromHost(t *testing.T) {
+	tests := map[string]string{
+		"s3.eu-west-1.amazonaws.com":  "eu-west-1",
+		"s3-us-west-2.amazonaws.com":  "us-west-2",
+		"s3.amazonaws.com":            "us-east-1",
+		"minio.example.com":           "us-east-1",
+		"s3.us-south.cloud-object.io": "us-south",
+	}
+
+	for host, want := range tests {
+		if got := regionFromHost(host); got != want {
+			t.Errorf("regionFromHost(%q) = %q, want %q", host, got, want)
+		}
+	}
+}
+
+func TestClientRoundTrip(t *testing.T) {
+	objects := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=key/") {
+			w.WriteHeader(http.StatusForbidden)
+
+			return
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			objects[r.URL.Path] = string(readPayload(t, r))
+		case http.MethodGet:
+			body, ok := objects[r.URL.Path]
+			if !ok {
+				http.Error(w, "NoSuchKey", http.StatusNotFound)
+
+				return
+			}
+			setObjectHeaders(w)
+			_, _ = io.WriteString(w, body)
+		case http.MethodDelete:
+			delete(objects, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, "backups", "key", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	if err := client.Put(ctx, "app/1.tar.gz", strings.NewReader("data"), int64(len("data")), "application/gzip"); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if _, ok := objects["/backups/app/1.tar.gz"]; !ok {
+		t.Fatalf("object not stored under the path-style key, have %v", objects)
+	}
+
+	body, err := client.Get(ctx, "app/1.tar.gz")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	data, _ := io.ReadAll(body)
+	_ = body.Close()
+	if string(data) != "data" {
+		t.Errorf("Get() = %q, want %q", data, "data")
+	}
+
+	if err := client.Delete(ctx, "app/1.tar.gz"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := client.Get(ctx, "app/1.tar.gz"); minio.ToErrorResponse(err).Code != "NoSuchKey" {
+		t.Errorf("Get() after Delete error = %v, want NoSuchKey", err)
+	}
+}
+
+func TestClientGetFrom(t *testing.T) {
+	const object = "0123456789"
+	contentRange := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		setObjectHeaders(w)
+		if contentRange == "" {
+			_, _ = io.WriteString(w, object)
+
+			return
+		}
+		w.Header().Set("Content-Range", contentRange)
+		w.WriteHeader(http.StatusPartialContent)
+		_, _ = io.WriteString(w, object[4:])
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, "models", "key", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		contentRange string
+		wantStart    int64
+		wantBody     string
+		wantErr      bool
+	}{
+		{name: "range served", contentRange: "bytes 4-9/10", wantStart: 4, wantBody: object[4:]},
+		{name: "range ignored", wantStart: 0, wantBody: object},
+		{name: "other range served", contentRange: "bytes 2-9/10", wantErr: true},
+		{name: "malformed range", contentRange: "bytes */10", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contentRange = tt.contentRange
+			body, start, err := client.GetFrom(context.Background(), "model/file", 4)
+			if tt.wantErr {
+				if err == nil {
+					_ = body.Close()
+					t.Fatal("GetFrom() succeeded, want error")
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetFrom() error = %v", err)
+			}
+			data, _ := io.ReadAll(body)
+			_ = body.Close()
+			if start != tt.wantStart || string(data) != tt.wantBody {
+				t.Errorf("GetFrom() = %q from %d, want %q from %d", data, start, tt.wantBody, tt.wantStart)
+			}
+		})
+	}
+}
+
+func TestClientMultipartPut(t *testing.T) {
+	const partSize = 5 << 20
+	var (
+		mu      sync.Mutex
+		parts   = map[string][]byte{}
+		stored  []byte
+		aborted bool
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		query := r.URL.Query()
+		switch {
+		case r.Method == http.MethodPost && query.Has("uploads"):
+			_, _ = io.WriteString(w, `<InitiateMultipartUploadResult><UploadId>`+r.URL.Path+`</UploadId></InitiateMultipartUploadResult>`)
+		case r.Method == http.MethodPut && query.Has("uploadId"):
+			body := readPayload(t, r)
+			if strings.HasSuffix(query.Get("uploadId"), "2.tar.gz") && query.Get("partNumber") == "2" {
+				http.Error(w, "InvalidArgument", http.StatusBadRequest)
+
+				return
+			}
+			parts[query.Get("partNumber")] = body
+			w.Header().Set("ETag", `"etag-`+query.Get("partNumber")+`"`)
+		case r.Method == http.MethodPost && query.Has("uploadId"):
+			body, _ := io.ReadAll(r.Body)
+			for _, n := range []string{"1", "2", "3"} {
+				if !strings.Contains(string(body), "<PartNumber>"+n+"</PartNumber>") {
+					http.Error(w, "InvalidPart "+n, http.StatusBadRequest)
+
+					return
+				}
+				stored = append(stored, parts[n]...)
+			}
+			_, _ = io.WriteString(w, `<CompleteMultipartUploadResult><Bucket>backups</Bucket><ETag>"etag"</ETag></CompleteMultipartUploadResult>`)
+		case r.Method == http.MethodDelete && query.Has("uploadId"):
+			aborted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.URL, "backups", "key", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.partSize = partSize
+
+	object := bytes.Repeat([]byte("0123456789"), (2*partSize+partSize/2)/10)
+	ctx := context.Background()
+	if err := client.Put(ctx, "app/1.tar.gz", bytes.NewReader(object), int64(len(object)), "application/gzip"); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if !bytes.Equal(stored, object) || len(parts) != 3 {
+		t.Errorf("stored %d bytes in %d parts, want %d bytes in 3 parts", len(stored), len(parts), len(object))
+	}
+
+	if err := client.Put(ctx, "app/2.tar.gz", bytes.NewReader(object), int64(len(object)), "application/gzip"); err == nil {
+		t.Error("Put() with a failing part succeeded")
+	}
+	if !aborted {
+		t.Error("failed multipart upload was not aborted")
+	}
+}
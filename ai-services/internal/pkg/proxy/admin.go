@@ -0,0 +1,166 @@
+package proxy
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+// Modes of the Caddy admin API, chosen when the catalog is configured.
+const (
+	// AdminModeUnix serves the admin API on a unix socket only, which the catalog backend reaches
+	// through the base directory it shares with the host
+	AdminModeUnix = "unix"
+
+	// AdminModeMTLS additionally serves Caddy's remote admin API, to which the catalog backend
+	// authenticates with a client certificate pinned in Caddy's access control
+	AdminModeMTLS = "mtls"
+)
+
+// ValidateAdminMode validates that the admin mode is a supported value.
+func ValidateAdminMode(mode string) error {
+	switch mode {
+	case AdminModeUnix, AdminModeMTLS:
+		return nil
+	default:
+		return fmt.Errorf("invalid admin mode '%s', valid modes are: %s, %s", mode, AdminModeUnix, AdminModeMTLS)
+	}
+}
+
+// Environment variables locating the Caddy admin API of the catalog backend.
+const (
+	adminURLEnv        = "CADDY_ADMIN_URL"
+	adminClientCertEnv = "CADDY_ADMIN_CLIENT_CERT"
+	adminClientKeyEnv  = "CADDY_ADMIN_CLIENT_KEY"
+	adminCAEnv         = "CADDY_ADMIN_CA"
+)
+
+const (
+	// adminSocketScheme is the URL scheme of an admin API served on a unix socket.
+	adminSocketScheme = "unix"
+	// adminSocketBaseURL addresses requests sent over the admin socket. Caddy only accepts a
+	// loopback Host header on unix sockets.
+	adminSocketBaseURL = "http://127.0.0.1"
+)
+
+// AdminEndpoint locates the Caddy admin API and the credentials to authenticate to it with.
+type AdminEndpoint struct {
+	// URL is unix:///path/to/admin.sock for the admin socket, https://host:port for the remote
+	// admin API, or http://host:port for an unauthenticated listener of older deployments
+	URL string
+
+	// ClientCertFile and ClientKeyFile hold the client certificate presented to the remote admin API
+	ClientCertFile string
+	ClientKeyFile  string
+
+	// CAFile holds the root certificate that issued the identity certificate of the remote admin API
+	CAFile string
+}
+
+// AdminEndpointFromEnv reads the admin endpoint of the catalog backend from its environment.
+func AdminEndpointFromEnv() (AdminEndpoint, error) {
+	adminURL := utils.GetEnv(adminURLEnv, "")
+	if adminURL == "" {
+		return AdminEndpoint{}, fmt.Errorf("%s environment variable not set", adminURLEnv)
+	}
+
+	return AdminEndpoint{
+		URL:            adminURL,
+		ClientCertFile: utils.GetEnv(adminClientCertEnv, ""),
+		ClientKeyFile:  utils.GetEnv(adminClientKeyEnv, ""),
+		CAFile:         utils.GetEnv(adminCAEnv, ""),
+	}, nil
+}
+
+// NewAdminClient creates an HTTP client for the admin API with its base URL set, which connects
+// over the admin socket or authenticates with the client certificate as the endpoint requires.
+func NewAdminClient(endpoint AdminEndpoint) (*resty.Client, error) {
+	baseURL, transport, err := endpoint.transport()
+	if err != nil {
+		return nil, err
+	}
+
+	client := resty.New().SetBaseURL(baseURL)
+	if transport != nil {
+		client.SetTransport(transport)
+	}
+
+	return client, nil
+}
+
+// transport returns the base URL of the admin API and the transport reaching it, nil for the
+// default transport.
+func (e AdminEndpoint) transport() (string, http.RoundTripper, error) {
+	u, err := url.Parse(e.URL)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid Caddy admin URL %q: %w", e.URL, err)
+	}
+
+	switch u.Scheme {
+	case adminSocketScheme:
+		if u.Path == "" {
+			return "", nil, fmt.Errorf("invalid Caddy admin URL %q: socket path is empty", e.URL)
+		}
+		socketPath := u.Path
+		dialer := &net.Dialer{Timeout: Timeout}
+
+		return adminSocketBaseURL, &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return dialer.DialContext(ctx, "unix", socketPath)
+			},
+		}, nil
+	case "https":
+		tlsConfig, err := e.tlsConfig()
+		if err != nil {
+			return "", nil, err
+		}
+
+		return e.URL, &http.Transport{TLSClientConfig: tlsConfig}, nil
+	case "http":
+		return e.URL, nil, nil
+	default:
+		return "", nil, fmt.Errorf("unsupported Caddy admin URL scheme %q", u.Scheme)
+	}
+}
+
+// tlsConfig returns the TLS configuration authenticating to the remote admin API.
+func (e AdminEndpoint) tlsConfig() (*tls.Config, error) {
+	if e.ClientCertFile == "" || e.ClientKeyFile == "" {
+		return nil, fmt.Errorf("a client certificate is required for the remote Caddy admin API")
+	}
+
+	cert, err := tls.LoadX509KeyPair(e.ClientCertFile, e.ClientKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load Caddy admin client certificate: %w", err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	// Without a CA file the system roots verify the identity certificate of Caddy
+	if e.CAFile != "" {
+		caPEM, err := os.ReadFile(e.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read Caddy admin CA certificate: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no certificates found in Caddy admin CA file %s", e.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return tlsConfig, nil
+}
+
+// Made with Bob
@@ -0,0 +1,131 @@
+package proxy
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeClientCertificate writes a self-signed client certificate and its key to dir.
+func writeClientCertificate(t *testing.T, dir string) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "catalog-backend"},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+
+	certFile := filepath.Join(dir, "client.crt")
+	keyFile := filepath.Join(dir, "client.key")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}), 0o600); err != nil {
+		t.Fatalf("failed to write certificate: %v", err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatalf("failed to write key: %v", err)
+	}
+
+	return certFile, keyFile
+}
+
+func TestNewCaddyManagerOverAdminSocket(t *testing.T) {
+	// Keep the socket path short, unix socket paths are limited to about 100 bytes
+	dir, err := os.MkdirTemp("", "caddy")
+	if err != nil {
+		t.Fatalf("failed to create socket directory: %v", err)
+	}
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+
+	socketPath := filepath.Join(dir, "admin.sock")
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		t.Fatalf("failed to listen on admin socket: %v", err)
+	}
+
+	var host string
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host = r.Host
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.Listener = listener
+	server.Start()
+	t.Cleanup(server.Close)
+
+	manager, err := NewCaddyManager(AdminEndpoint{URL: "unix://" + socketPath}, "srv0")
+	if err != nil {
+		t.Fatalf("NewCaddyManager failed: %v", err)
+	}
+	if err := manager.HealthCheck(); err != nil {
+		t.Fatalf("HealthCheck over the admin socket failed: %v", err)
+	}
+	if host != "127.0.0.1" {
+		t.Errorf("expected requests addressed to 127.0.0.1, got host %q", host)
+	}
+}
+
+func TestNewCaddyManagerWithClientCertificate(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeClientCertificate(t, dir)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 {
+			w.WriteHeader(http.StatusForbidden)
+
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert, MinVersion: tls.VersionTLS12}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	caFile := filepath.Join(dir, "ca.crt")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caFile, caPEM, 0o600); err != nil {
+		t.Fatalf("failed to write CA certificate: %v", err)
+	}
+
+	manager, err := NewCaddyManager(AdminEndpoint{
+		URL:            server.URL,
+		ClientCertFile: certFile,
+		ClientKeyFile:  keyFile,
+		CAFile:         caFile,
+	}, "srv0")
+	if err != nil {
+		t.Fatalf("NewCaddyManager failed: %v", err)
+	}
+	if err := manager.HealthCheck(); err != nil {
+		t.Fatalf("HealthCheck with the client certificate failed: %v", err)
+	}
+
+	// The remote admin API cannot be reached without a client certificate
+	if _, err := NewCaddyManager(AdminEndpoint{URL: server.URL, CAFile: caFile}, "srv0"); err == nil {
+		t.Error("expected an error for the remote admin API without a client certificate")
+	}
+}
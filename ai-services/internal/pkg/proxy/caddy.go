@@ -2,22 +2,36 @@ package proxy
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
-	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 )
 
 // ErrRouteNotFound is returned when a route is not found in Caddy.
 var ErrRouteNotFound = errors.New("route not found")
 
+// ErrRateLimitUnsupported is returned when a route has a rate limit but the Caddy build does not
+// include the rate_limit handler module.
+var ErrRateLimitUnsupported = errors.New("the proxy does not support rate limits: build Caddy with the " +
+	rateLimitModulePackage + " module or remove requests_per_second from the route policy")
+
+const (
+	// rateLimitModule is the ID of the handler module rateLimitHandler configures, as named in
+	// the error Caddy returns when loading a configuration with a module it does not include.
+	rateLimitModule = "http.handlers.rate_limit"
+	// rateLimitModulePackage is the Go package providing rateLimitModule.
+	rateLimitModulePackage = "github.com/mholt/caddy-ratelimit"
+)
+
 // caddyManager implements ProxyManager interface for Caddy.
 type caddyManager struct {
 	httpClient *resty.Client
@@ -32,9 +46,27 @@ const (
 	RetryMaxWaitTime = 5 * time.Second
 )
 
-// NewCaddyManager creates a new Caddy proxy manager.
-func NewCaddyManager(adminURL, serverName string) ProxyManager {
-	httpClient := resty.New().
+// statusClassSuccess matches any 2xx status in a Caddy response matcher.
+const statusClassSuccess = 2
+
+// Placeholders identifying the client of a request for rate limiting. API keys are sent either as
+// a Bearer token or in the X-API-Key header, so both headers make up the key.
+const (
+	clientIPRateLimitKey = "{http.request.remote.host}"
+	apiKeyRateLimitKey   = "{http.request.header.Authorization}{http.request.header.X-API-Key}"
+)
+
+// rateLimitWindow is the window RoutePolicy.RequestsPerSecond is counted over.
+const rateLimitWindow = "1s"
+
+// NewCaddyManager creates a new Caddy proxy manager for the admin API at the endpoint, connecting over
+// the admin socket or authenticating with the client certificate as the endpoint requires.
+func NewCaddyManager(endpoint AdminEndpoint, serverName string) (ProxyManager, error) {
+	httpClient, err := NewAdminClient(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	httpClient.
 		SetTimeout(Timeout).
 		SetRetryCount(RetryCount).
 		SetRetryWaitTime(RetryWaitTime).
@@ -42,19 +74,19 @@ func NewCaddyManager(adminURL, serverName string) ProxyManager {
 
 	return &caddyManager{
 		httpClient: httpClient,
-		adminURL:   adminURL,
+		adminURL:   httpClient.BaseURL,
 		serverName: serverName,
-	}
+	}, nil
 }
 
-// GetCaddyProxyManager retrieves the Caddy admin URL from environment and creates a ProxyManager.
+// GetCaddyProxyManager retrieves the Caddy admin endpoint from environment and creates a ProxyManager.
 func GetCaddyProxyManager() (ProxyManager, error) {
-	adminURL := utils.GetEnv("CADDY_ADMIN_URL", "")
-	if adminURL == "" {
-		return nil, fmt.Errorf("CADDY_ADMIN_URL environment variable not set")
+	endpoint, err := AdminEndpointFromEnv()
+	if err != nil {
+		return nil, err
 	}
 
-	return NewCaddyManager(adminURL, constants.CaddyServerName), nil
+	return NewCaddyManager(endpoint, constants.CaddyServerName)
 }
 
 // HealthCheck verifies Caddy is running and accessible.
@@ -82,12 +114,9 @@ func (c *caddyManager) RegisterRoute(ctx context.Context, route Route) error {
 	}
 
 	routeConfig := map[string]any{
-		"@id":   route.ID,
-		"match": []map[string]any{{"host": []string{route.Domain}}},
-		"handle": []map[string]any{{
-			"handler":   "reverse_proxy",
-			"upstreams": []map[string]any{{"dial": route.Upstream}},
-		}},
+		"@id":      route.ID,
+		"match":    []map[string]any{routeMatcher(route)},
+		"handle":   routeHandlers(route),
 		"terminal": route.Terminal,
 	}
 
@@ -103,38 +132,371 @@ func (c *caddyManager) RegisterRoute(ctx context.Context, route Route) error {
 	}
 
 	if checkResp.StatusCode() == http.StatusOK {
-		// Route already exists, skip registration
-		logger.DebugfCtx(ctx, "Route %s already exists, skipping registration\n", route.ID)
+		// Route already exists, replace it so that changes such as its auth policy take effect
+		logger.DebugfCtx(ctx, "Route %s already exists, updating its configuration\n", route.ID)
 
-		return nil
+		return c.replaceRoute(ctx, idURL, routeConfig)
 	}
 
 	// Route doesn't exist, create it
-	return c.createRoute(ctx, routeConfig)
+	return c.createRoute(ctx, routeConfig, route.PathPrefix != "")
 }
 
-// Helper to append a new route to the server's route array.
-func (c *caddyManager) createRoute(ctx context.Context, routeConfig map[string]any) error {
+// Helper to add a new route to the server's route array. Routes are appended unless first is set,
+// which inserts the route ahead of the others so that it takes precedence over routes matching
+// every path of the same domain.
+func (c *caddyManager) createRoute(ctx context.Context, routeConfig map[string]any, first bool) error {
 	routeURL, err := url.JoinPath(c.adminURL, "config", "apps", "http", "servers", c.serverName, "routes")
 	if err != nil {
 		return err
 	}
 
-	resp, err := c.httpClient.R().
+	req := c.httpClient.R().
 		SetContext(ctx).
 		SetHeader("Content-Type", "application/json").
-		SetBody(routeConfig).
-		Post(routeURL)
+		SetBody(routeConfig)
+
+	var resp *resty.Response
+	if first {
+		// PUT on an array index inserts at that position
+		resp, err = req.Put(routeURL + "/0")
+	} else {
+		resp, err = req.Post(routeURL)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to create route: %w", err)
 	}
 	if resp.StatusCode() != http.StatusOK && resp.StatusCode() != http.StatusCreated {
-		return fmt.Errorf("caddy returned status %d on creation: %s", resp.StatusCode(), resp.String())
+		return configLoadError(resp, "creation")
 	}
 
 	return nil
 }
 
+// Helper to replace the configuration of an existing route in place.
+func (c *caddyManager) replaceRoute(ctx context.Context, idURL string, routeConfig map[string]any) error {
+	resp, err := c.httpClient.R().
+		SetContext(ctx).
+		SetHeader("Content-Type", "application/json").
+		SetBody(routeConfig).
+		Patch(idURL)
+	if err != nil {
+		return fmt.Errorf("failed to update route: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return configLoadError(resp, "update")
+	}
+
+	return nil
+}
+
+// configLoadError describes a rejected route configuration. Caddy loads every module of a
+// configuration change and rejects it when a module is not part of its build, which is reported
+// as ErrRateLimitUnsupported for the rate_limit handler.
+func configLoadError(resp *resty.Response, action string) error {
+	if strings.Contains(resp.String(), "unknown module: "+rateLimitModule) {
+		return ErrRateLimitUnsupported
+	}
+
+	return fmt.Errorf("caddy returned status %d on %s: %s", resp.StatusCode(), action, resp.String())
+}
+
+// routeMatcher matches the requests of a route: those for its domain and, if it has a path prefix,
+// for the prefix itself or any path below it.
+func routeMatcher(route Route) map[string]any {
+	matcher := map[string]any{"host": []string{route.Domain}}
+	if route.PathPrefix != "" {
+		matcher["path"] = []string{route.PathPrefix, route.PathPrefix + "/*"}
+	}
+
+	return matcher
+}
+
+// routeHandlers returns the handler chain of a route: the body size and rate limits of its policy,
+// the forward authentication of its auth policy, the removal of its path prefix, and the reverse
+// proxy to its upstream. Limits come first so that rejected requests never reach the
+// authentication endpoint, which validates the request as sent by the client.
+func routeHandlers(route Route) []map[string]any {
+	policy := route.Policy
+	if policy == nil {
+		policy = &RoutePolicy{}
+	}
+
+	var handlers []map[string]any
+	if policy.MaxBodySize > 0 {
+		handlers = append(handlers, map[string]any{
+			"handler":  "request_body",
+			"max_size": policy.MaxBodySize,
+		})
+	}
+	if policy.RequestsPerSecond > 0 {
+		handlers = append(handlers, rateLimitHandler(policy))
+	}
+	if route.Auth != nil {
+		handlers = append(handlers, forwardAuthHandler(route.Auth))
+	}
+
+	proxyHandler := reverseProxyHandler(route.Upstream, policy)
+	if route.PathPrefix != "" {
+		handlers = append(handlers, map[string]any{
+			"handler":           "rewrite",
+			"strip_path_prefix": route.PathPrefix,
+		})
+		// Upstreams build their links with the prefix the client sees
+		proxyHandler["headers"] = map[string]any{
+			"request": map[string]any{
+				"set": map[string][]string{"X-Forwarded-Prefix": {route.PathPrefix}},
+			},
+		}
+	}
+
+	return append(handlers, proxyHandler)
+}
+
+// rateLimitHandler builds the configuration of the rate_limit handler, which requires a Caddy build
+// with the github.com/mholt/caddy-ratelimit module; RegisterRoute returns ErrRateLimitUnsupported
+// otherwise. Requests over the limit get a 429 response.
+func rateLimitHandler(policy *RoutePolicy) map[string]any {
+	key := clientIPRateLimitKey
+	if policy.RateLimitKey == RateLimitKeyAPIKey {
+		key = apiKeyRateLimitKey
+	}
+
+	return map[string]any{
+		"handler": "rate_limit",
+		"rate_limits": map[string]any{
+			"route": map[string]any{
+				"key":        key,
+				"window":     rateLimitWindow,
+				"max_events": policy.RequestsPerSecond,
+			},
+		},
+	}
+}
+
+// reverseProxyHandler builds the reverse proxy to a route's upstream with the concurrency limit and
+// timeouts of its policy.
+func reverseProxyHandler(upstream string, policy *RoutePolicy) map[string]any {
+	dial := map[string]any{"dial": upstream}
+	if policy.MaxConcurrentRequests > 0 {
+		dial["max_requests"] = policy.MaxConcurrentRequests
+	}
+
+	handler := map[string]any{
+		"handler":   "reverse_proxy",
+		"upstreams": []map[string]any{dial},
+	}
+
+	if policy.DialTimeoutSeconds > 0 || policy.ResponseTimeoutSeconds > 0 {
+		transport := map[string]any{"protocol": "http"}
+		if policy.DialTimeoutSeconds > 0 {
+			transport["dial_timeout"] = fmt.Sprintf("%ds", policy.DialTimeoutSeconds)
+		}
+		if policy.ResponseTimeoutSeconds > 0 {
+			transport["response_header_timeout"] = fmt.Sprintf("%ds", policy.ResponseTimeoutSeconds)
+		}
+		handler["transport"] = transport
+	}
+
+	return handler
+}
+
+// forwardAuthHandler builds the JSON equivalent of Caddy's forward_auth directive. The request is
+// proxied to the authentication endpoint as a GET request. A 2xx response runs the handle_response
+// route, which copies the configured headers onto the original request and, since it writes no
+// response, lets the request continue to the next handler. Any other response is sent back to the
+// client.
+func forwardAuthHandler(policy *AuthPolicy) map[string]any {
+	copied := map[string][]string{}
+	for _, header := range policy.CopyHeaders {
+		copied[header] = []string{fmt.Sprintf("{http.reverse_proxy.header.%s}", header)}
+	}
+
+	return map[string]any{
+		"handler":   "reverse_proxy",
+		"upstreams": []map[string]any{{"dial": policy.Upstream}},
+		"rewrite":   map[string]any{"method": http.MethodGet, "uri": policy.URI},
+		"headers": map[string]any{
+			"request": map[string]any{
+				"set": map[string][]string{
+					"X-Forwarded-Method": {"{http.request.method}"},
+					"X-Forwarded-Uri":    {"{http.request.uri}"},
+				},
+			},
+		},
+		"handle_response": []map[string]any{{
+			"match": map[string]any{"status_code": []int{statusClassSuccess}},
+			"routes": []map[string]any{{
+				"handle": []map[string]any{{
+					"handler": "headers",
+					"request": map[string]any{"set": copied},
+				}},
+			}},
+		}},
+	}
+}
+
+// ListRoutes retrieves the routes of the server that carry an ID. Routes without an ID were not
+// registered through the ProxyManager and are left out.
+func (c *caddyManager) ListRoutes(ctx context.Context) ([]Route, error) {
+	routesURL, err := url.JoinPath(c.adminURL, "config", "apps", "http", "servers", c.serverName, "routes")
+	if err != nil {
+		return nil, err
+	}
+
+	var rawRoutes []map[string]any
+	resp, err := c.httpClient.R().
+		SetContext(ctx).
+		SetResult(&rawRoutes).
+		Get(routesURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list routes: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("caddy returned status %d when listing routes: %s", resp.StatusCode(), resp.String())
+	}
+
+	routes := []Route{}
+	for _, rawRoute := range rawRoutes {
+		id, _ := rawRoute["@id"].(string)
+		if id == "" {
+			continue
+		}
+
+		route, err := routeFromConfig(id, rawRoute)
+		if err != nil {
+			logger.WarningfCtx(ctx, "Skipping route %s with unexpected configuration: %v\n", id, err)
+
+			continue
+		}
+		routes = append(routes, *route)
+	}
+
+	return routes, nil
+}
+
+// routeFromConfig converts a Caddy route configuration built by RegisterRoute back into a Route.
+// The type of a route is not part of its configuration and is left empty.
+func routeFromConfig(id string, rawRoute map[string]any) (*Route, error) {
+	domain, err := extractDomainFromRoute(rawRoute)
+	if err != nil {
+		return nil, err
+	}
+
+	route := &Route{ID: id, Domain: domain, PathPrefix: extractPathPrefixFromRoute(rawRoute)}
+	route.Terminal, _ = rawRoute["terminal"].(bool)
+
+	var policy RoutePolicy
+	handlers, _ := rawRoute["handle"].([]any)
+	for _, h := range handlers {
+		handler, _ := h.(map[string]any)
+		switch handler["handler"] {
+		case "request_body":
+			policy.MaxBodySize = int64(number(handler["max_size"]))
+		case "rate_limit":
+			readRateLimit(handler, &policy)
+		case "reverse_proxy":
+			upstream := firstUpstream(handler)
+			dial, _ := upstream["dial"].(string)
+
+			if rewrite, ok := handler["rewrite"].(map[string]any); ok {
+				uri, _ := rewrite["uri"].(string)
+				route.Auth = &AuthPolicy{Upstream: dial, URI: uri, CopyHeaders: copiedHeaders(handler)}
+
+				continue
+			}
+			route.Upstream = dial
+			policy.MaxConcurrentRequests = int(number(upstream["max_requests"]))
+
+			transport, _ := handler["transport"].(map[string]any)
+			policy.DialTimeoutSeconds = durationSeconds(transport["dial_timeout"])
+			policy.ResponseTimeoutSeconds = durationSeconds(transport["response_header_timeout"])
+		}
+	}
+	route.Policy = policy.orNil()
+
+	if route.Upstream == "" {
+		return nil, errors.New("missing reverse proxy upstream in route")
+	}
+
+	return route, nil
+}
+
+// firstUpstream returns the first upstream of a reverse_proxy handler.
+func firstUpstream(handler map[string]any) map[string]any {
+	upstreams, _ := handler["upstreams"].([]any)
+	if len(upstreams) == 0 {
+		return nil
+	}
+
+	upstream, _ := upstreams[0].(map[string]any)
+
+	return upstream
+}
+
+// readRateLimit reads the limit built by rateLimitHandler into a policy.
+func readRateLimit(handler map[string]any, policy *RoutePolicy) {
+	limits, _ := handler["rate_limits"].(map[string]any)
+	limit, _ := limits["route"].(map[string]any)
+
+	policy.RequestsPerSecond = int(number(limit["max_events"]))
+	policy.RateLimitKey = RateLimitKeyClientIP
+	if limit["key"] == apiKeyRateLimitKey {
+		policy.RateLimitKey = RateLimitKeyAPIKey
+	}
+}
+
+// number returns a JSON number of a route configuration, or 0 if it is absent.
+func number(value any) float64 {
+	n, _ := value.(float64)
+
+	return n
+}
+
+// durationSeconds returns a duration of a route configuration in whole seconds. Caddy accepts
+// durations as strings, e.g. "30s", or as nanoseconds.
+func durationSeconds(value any) int {
+	switch v := value.(type) {
+	case string:
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return 0
+		}
+
+		return int(d / time.Second)
+	case float64:
+		return int(time.Duration(v) / time.Second)
+	default:
+		return 0
+	}
+}
+
+// copiedHeaders returns the sorted names of the headers a forward authentication handler, as built
+// by forwardAuthHandler, copies from a successful response onto the request.
+func copiedHeaders(handler map[string]any) []string {
+	set := map[string]any{}
+	if responses, _ := handler["handle_response"].([]any); len(responses) > 0 {
+		response, _ := responses[0].(map[string]any)
+		if routes, _ := response["routes"].([]any); len(routes) > 0 {
+			route, _ := routes[0].(map[string]any)
+			if handles, _ := route["handle"].([]any); len(handles) > 0 {
+				headers, _ := handles[0].(map[string]any)
+				request, _ := headers["request"].(map[string]any)
+				set, _ = request["set"].(map[string]any)
+			}
+		}
+	}
+
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
 // extractDomainFromRoute extracts the domain from a Caddy route configuration.
 // Returns the domain or an error if extraction fails.
 func extractDomainFromRoute(rawRoute map[string]any) (string, error) {
@@ -161,6 +523,25 @@ func extractDomainFromRoute(rawRoute map[string]any) (string, error) {
 	return domain, nil
 }
 
+// extractPathPrefixFromRoute extracts the path prefix matched by a Caddy route configuration built
+// by RegisterRoute, or an empty string if the route matches every path.
+func extractPathPrefixFromRoute(rawRoute map[string]any) string {
+	matches, _ := rawRoute["match"].([]any)
+	if len(matches) == 0 {
+		return ""
+	}
+
+	firstMatch, _ := matches[0].(map[string]any)
+	paths, _ := firstMatch["path"].([]any)
+	if len(paths) == 0 {
+		return ""
+	}
+
+	prefix, _ := paths[0].(string)
+
+	return strings.TrimSuffix(prefix, "/*")
+}
+
 // GetRouteByID retrieves a specific route by its ID from Caddy.
 func (c *caddyManager) GetRouteByID(routeID string) (*Route, error) {
 	idURL, err := url.JoinPath(c.adminURL, "id", routeID)
@@ -190,8 +571,9 @@ func (c *caddyManager) GetRouteByID(routeID string) (*Route, error) {
 	}
 
 	return &Route{
-		ID:     routeID,
-		Domain: domain,
+		ID:         routeID,
+		Domain:     domain,
+		PathPrefix: extractPathPrefixFromRoute(rawRoute),
 	}, nil
 }
 
@@ -223,42 +605,19 @@ func (c *caddyManager) UnregisterRoute(routeID string) error {
 	}
 }
 
-// RegisterRoutesForAppAndReturn registers routes for an application with Caddy proxy and returns the built routes.
-//
-// Parameters:
-//   - rt: Runtime interface for interacting with pods
-//   - appName: Name of the application (e.g., "ai-services" for catalog)
-//   - proxyManager: ProxyManager instance for route operations (reuse to avoid creating multiple instances)
-//   - routesAnnotation: Routes annotation value in format "port:subdomain,port:subdomain,..."
-//   - domainSuffix: Pre-computed domain suffix (e.g., "example.com" or "192.168.1.100.nip.io")
-//   - servicePodName: Name of the service pod for upstream configuration
-//
-// Returns:
-//   - []Route: List of successfully built and registered routes
-//   - error: nil if routes were registered successfully, error otherwise
-func RegisterRoutesForAppAndReturn(
-	ctx context.Context,
-	appName string,
-	proxyManager ProxyManager,
-	routesAnnotation string,
-	domainSuffix string,
-	servicePodName string,
-) ([]Route, error) {
+// RegisterRoutes checks that the proxy is healthy and registers each route with it, typically the
+// routes built by BuildRoutesFromAnnotation after adjusting them, e.g. to set an auth policy or
+// apply path routing.
+func RegisterRoutes(ctx context.Context, proxyManager ProxyManager, routes []Route) error {
 	// Step 1: Perform health check on Caddy
 	if err := proxyManager.HealthCheck(); err != nil {
-		return nil, fmt.Errorf(
+		return fmt.Errorf(
 			"caddy health check failed, routes not registered: %w",
 			err,
 		)
 	}
 
-	// Step 2: Build routes from the annotation string using service pod name for upstreams
-	routes, err := BuildRoutesFromAnnotation(routesAnnotation, domainSuffix, servicePodName)
-	if err != nil {
-		return nil, fmt.Errorf("failed to build routes: %w", err)
-	}
-
-	// Step 3: Register each route with Caddy
+	// Step 2: Register each route with Caddy
 	var registrationErrors []error
 	for _, route := range routes {
 		if err := proxyManager.RegisterRoute(ctx, route); err != nil {
@@ -268,10 +627,10 @@ func RegisterRoutesForAppAndReturn(
 
 	// Return error if any routes failed to register
 	if len(registrationErrors) > 0 {
-		return nil, fmt.Errorf("failed to register %d route(s): %w", len(registrationErrors), errors.Join(registrationErrors...))
+		return fmt.Errorf("failed to register %d route(s): %w", len(registrationErrors), errors.Join(registrationErrors...))
 	}
 
-	return routes, nil
+	return nil
 }
 
 // UnregisterRoutesFromEndpoints unregisters Caddy routes by reconstructing route IDs from endpoints.
@@ -296,7 +655,7 @@ func UnregisterRoutesFromEndpoints(
 		return nil
 	}
 
-	routesToUnregister := extractRouteIDsFromEndpoints(endpoints)
+	routesToUnregister := RouteIDsFromEndpoints(endpoints)
 
 	if len(routesToUnregister) == 0 {
 		logger.InfofCtx(ctx, "%s %s: no routes found to unregister", instanceType, instanceID)
@@ -309,8 +668,8 @@ func UnregisterRoutesFromEndpoints(
 	return unregisterRoutes(ctx, proxyManager, routesToUnregister, instanceType, instanceID)
 }
 
-// extractRouteIDsFromEndpoints extracts unique route IDs from endpoints.
-func extractRouteIDsFromEndpoints(endpoints []map[string]any) map[string]bool {
+// RouteIDsFromEndpoints extracts unique route IDs from endpoints.
+func RouteIDsFromEndpoints(endpoints []map[string]any) map[string]bool {
 	if len(endpoints) == 0 {
 		return nil
 	}
@@ -318,6 +677,13 @@ func extractRouteIDsFromEndpoints(endpoints []map[string]any) map[string]bool {
 	routesToUnregister := make(map[string]bool)
 
 	for _, endpoint := range endpoints {
+		// Endpoints of path routes record their route ID, the hostname is shared by all routes
+		if routeID, _ := endpoint["route_id"].(string); routeID != "" {
+			routesToUnregister[routeID] = true
+
+			continue
+		}
+
 		urlStr, ok := endpoint["url"].(string)
 		if !ok {
 			continue
@@ -347,6 +713,54 @@ func extractRouteIDsFromEndpoints(endpoints []map[string]any) map[string]bool {
 	return routesToUnregister
 }
 
+// RouteFromEndpoint rebuilds the route of a service endpoint recorded by the deployer, e.g.
+// {"type": "api", "url": "https://chat-bot-abc.example.com:443", "upstream": "chat-bot-abc:5000"},
+// along with the route policy recorded under "policy", if any. The path of the URL is the path
+// prefix of the route, whose ID is then recorded under "route_id".
+// The auth policy of the route is not part of the endpoint and is left nil.
+func RouteFromEndpoint(endpoint map[string]any) (*Route, error) {
+	urlStr, _ := endpoint["url"].(string)
+	parsedURL, err := url.Parse(urlStr)
+	if err != nil || parsedURL.Hostname() == "" {
+		return nil, fmt.Errorf("invalid endpoint URL %q", urlStr)
+	}
+
+	upstream, _ := endpoint["upstream"].(string)
+	if upstream == "" {
+		return nil, fmt.Errorf("no upstream recorded for endpoint %s", urlStr)
+	}
+
+	var policy *RoutePolicy
+	if rawPolicy, ok := endpoint["policy"]; ok && rawPolicy != nil {
+		// Endpoints read from the database hold the policy as a decoded JSON object
+		data, err := json.Marshal(rawPolicy)
+		if err == nil {
+			err = json.Unmarshal(data, &policy)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid route policy for endpoint %s: %w", urlStr, err)
+		}
+	}
+
+	hostname := parsedURL.Hostname()
+	routeType, _ := endpoint["type"].(string)
+
+	routeID, _ := endpoint["route_id"].(string)
+	if routeID == "" {
+		routeID = strings.Split(hostname, ".")[0]
+	}
+
+	return &Route{
+		ID:         routeID,
+		Domain:     hostname,
+		PathPrefix: strings.TrimSuffix(parsedURL.Path, "/"),
+		Upstream:   upstream,
+		Terminal:   true,
+		Type:       routeType,
+		Policy:     policy,
+	}, nil
+}
+
 // unregisterRoutes unregisterroutes and returns error if any fail.
 func unregisterRoutes(ctx context.Context, proxyManager ProxyManager, routeIDs map[string]bool, instanceType, instanceID string) error {
 	var failedRoutes []string
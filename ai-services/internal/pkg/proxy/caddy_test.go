@@ -0,0 +1,329 @@
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeCaddyAdmin records the route configurations written through the Caddy admin API.
+type fakeCaddyAdmin struct {
+	mu     sync.Mutex
+	routes map[string]map[string]any
+	posts  int
+	puts   int
+	patch  int
+	// noRateLimit rejects routes with the rate_limit handler, like a Caddy build without it
+	noRateLimit bool
+}
+
+func (f *fakeCaddyAdmin) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	id, isID := strings.CutPrefix(r.URL.Path, "/id/")
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/config/apps/http/servers/srv0/routes":
+		// Routes from the Caddyfile carry no ID
+		routes := []map[string]any{{"match": []any{map[string]any{"host": []any{"static.example.com"}}}}}
+		for _, route := range f.routes {
+			routes = append(routes, route)
+		}
+		_ = json.NewEncoder(w).Encode(routes)
+	case r.Method == http.MethodGet && isID:
+		route, ok := f.routes[id]
+		if !ok {
+			http.NotFound(w, r)
+
+			return
+		}
+		_ = json.NewEncoder(w).Encode(route)
+	case r.Method == http.MethodPatch:
+		f.patch++
+		f.store(w, r)
+	case r.Method == http.MethodPost:
+		f.posts++
+		f.store(w, r)
+	case r.Method == http.MethodPut && r.URL.Path == "/config/apps/http/servers/srv0/routes/0":
+		f.puts++
+		f.store(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (f *fakeCaddyAdmin) store(w http.ResponseWriter, r *http.Request) {
+	var route map[string]any
+	if err := json.NewDecoder(r.Body).Decode(&route); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+	if f.noRateLimit {
+		for _, handler := range route["handle"].([]any) {
+			if handler.(map[string]any)["handler"] == "rate_limit" {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":"loading module 'rate_limit': unknown module: http.handlers.rate_limit"}`))
+
+				return
+			}
+		}
+	}
+	f.routes[route["@id"].(string)] = route
+	w.WriteHeader(http.StatusOK)
+}
+
+func TestRegisterRouteWithAuthPolicy(t *testing.T) {
+	fake := &fakeCaddyAdmin{routes: map[string]map[string]any{}}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	manager, err := NewCaddyManager(AdminEndpoint{URL: server.URL}, "srv0")
+	if err != nil {
+		t.Fatalf("NewCaddyManager failed: %v", err)
+	}
+	route := Route{
+		ID:       "chat-bot-backend-abc",
+		Domain:   "chat-bot-backend-abc.example.com",
+		Upstream: "chat-bot-abc:5000",
+		Terminal: true,
+		Type:     "api",
+		Auth: &AuthPolicy{
+			Upstream:    "ai-services--catalog:8080",
+			URI:         "/api/v1/auth/verify?application_id=1",
+			CopyHeaders: []string{"X-Auth-Subject"},
+		},
+	}
+
+	if err := manager.RegisterRoute(context.Background(), route); err != nil {
+		t.Fatalf("RegisterRoute() error = %v", err)
+	}
+
+	handlers, _ := fake.routes[route.ID]["handle"].([]any)
+	if len(handlers) != 2 {
+		t.Fatalf("route has %d handlers, want forward auth followed by reverse proxy", len(handlers))
+	}
+
+	auth := handlers[0].(map[string]any)
+	rewrite, _ := auth["rewrite"].(map[string]any)
+	if rewrite["uri"] != route.Auth.URI || rewrite["method"] != http.MethodGet {
+		t.Errorf("forward auth rewrite = %v, want GET %s", rewrite, route.Auth.URI)
+	}
+	if upstreams, _ := auth["upstreams"].([]any); len(upstreams) != 1 || upstreams[0].(map[string]any)["dial"] != route.Auth.Upstream {
+		t.Errorf("forward auth upstreams = %v, want %s", auth["upstreams"], route.Auth.Upstream)
+	}
+
+	proxy := handlers[1].(map[string]any)
+	if upstreams, _ := proxy["upstreams"].([]any); len(upstreams) != 1 || upstreams[0].(map[string]any)["dial"] != route.Upstream {
+		t.Errorf("reverse proxy upstreams = %v, want %s", proxy["upstreams"], route.Upstream)
+	}
+
+	// Registering the route again without a policy replaces it in place.
+	route.Auth = nil
+	if err := manager.RegisterRoute(context.Background(), route); err != nil {
+		t.Fatalf("RegisterRoute() error = %v", err)
+	}
+
+	if fake.posts != 1 || fake.patch != 1 {
+		t.Errorf("got %d creations and %d updates, want 1 and 1", fake.posts, fake.patch)
+	}
+	if handlers, _ := fake.routes[route.ID]["handle"].([]any); len(handlers) != 1 {
+		t.Errorf("updated route has %d handlers, want only the reverse proxy", len(handlers))
+	}
+}
+
+func TestRegisterRouteWithoutRateLimitModule(t *testing.T) {
+	fake := &fakeCaddyAdmin{routes: map[string]map[string]any{}, noRateLimit: true}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	manager, err := NewCaddyManager(AdminEndpoint{URL: server.URL}, "srv0")
+	if err != nil {
+		t.Fatalf("NewCaddyManager failed: %v", err)
+	}
+	route := Route{ID: "chat-bot-backend-abc", Domain: "chat-bot-backend-abc.example.com", Upstream: "chat-bot-abc:5000", Terminal: true}
+
+	route.Policy = &RoutePolicy{RequestsPerSecond: 10}
+	if err := manager.RegisterRoute(context.Background(), route); !errors.Is(err, ErrRateLimitUnsupported) {
+		t.Errorf("RegisterRoute() error = %v, want %v", err, ErrRateLimitUnsupported)
+	}
+
+	route.Policy = &RoutePolicy{MaxConcurrentRequests: 8}
+	if err := manager.RegisterRoute(context.Background(), route); err != nil {
+		t.Errorf("RegisterRoute() without a rate limit error = %v", err)
+	}
+}
+
+func TestListRoutesRoundTrip(t *testing.T) {
+	fake := &fakeCaddyAdmin{routes: map[string]map[string]any{}}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	manager, err := NewCaddyManager(AdminEndpoint{URL: server.URL}, "srv0")
+	if err != nil {
+		t.Fatalf("NewCaddyManager failed: %v", err)
+	}
+	want := []Route{
+		{ID: "chat-bot-ui-abc", Domain: "chat-bot-ui-abc.example.com", Upstream: "chat-bot-abc:3000", Terminal: true, Type: "ui"},
+		{
+			ID:       "chat-bot-backend-abc",
+			Domain:   "chat-bot-backend-abc.example.com",
+			Upstream: "chat-bot-abc:5000",
+			Terminal: true,
+			Type:     "api",
+			Auth: &AuthPolicy{
+				Upstream:    "ai-services--catalog:8080",
+				URI:         "/api/v1/auth/verify?application_id=1",
+				CopyHeaders: []string{"X-Auth-Subject"},
+			},
+			Policy: &RoutePolicy{
+				RequestsPerSecond:      5,
+				RateLimitKey:           RateLimitKeyAPIKey,
+				MaxBodySize:            1024,
+				DialTimeoutSeconds:     5,
+				ResponseTimeoutSeconds: 300,
+				MaxConcurrentRequests:  8,
+			},
+		},
+	}
+	want = append(want, Route{
+		ID:         "summarize-api-abc",
+		Domain:     "ai.example.com",
+		PathPrefix: "/apps/rag/summarize-api",
+		Upstream:   "summarize-api-abc:6000",
+		Terminal:   true,
+		Type:       "api",
+	})
+	for _, route := range want {
+		if err := manager.RegisterRoute(context.Background(), route); err != nil {
+			t.Fatalf("RegisterRoute() error = %v", err)
+		}
+	}
+
+	// Path routes go ahead of the routes matching every path of their domain
+	if fake.puts != 1 {
+		t.Errorf("got %d route insertions, want 1 for the path route", fake.puts)
+	}
+
+	got, err := manager.ListRoutes(context.Background())
+	if err != nil {
+		t.Fatalf("ListRoutes() error = %v", err)
+	}
+
+	// Listed routes match the registered ones and the route without an ID is left out
+	drift := DiffRoutes(want, got)
+	if len(got) != len(want) || len(drift.Missing)+len(drift.Changed) > 0 {
+		t.Errorf("ListRoutes() = %+v, drift from registered routes = %+v", got, drift)
+	}
+}
+
+func TestRouteFromEndpointPathRouting(t *testing.T) {
+	routes, err := BuildRoutesFromAnnotation("3000:chat-bot-ui-abc:ui,5000:chat-bot-backend-abc:api", "ai.example.com", "chat-bot-abc")
+	if err != nil {
+		t.Fatalf("BuildRoutesFromAnnotation() error = %v", err)
+	}
+	ApplyPathRouting(routes, "ai.example.com", "/apps/rag", "-abc")
+
+	for _, route := range routes {
+		wantPrefix := "/apps/rag/" + strings.TrimSuffix(route.ID, "-abc")
+		if route.Domain != "ai.example.com" || route.PathPrefix != wantPrefix {
+			t.Errorf("route %s served at %s%s, want ai.example.com%s", route.ID, route.Domain, route.PathPrefix, wantPrefix)
+		}
+
+		// The deployer records the endpoint URL with the path prefix and the route ID
+		endpoint := map[string]any{
+			"type":     route.Type,
+			"url":      "https://" + route.Domain + ":8443" + route.PathPrefix,
+			"upstream": route.Upstream,
+			"route_id": route.ID,
+		}
+		rebuilt, err := RouteFromEndpoint(endpoint)
+		if err != nil {
+			t.Fatalf("RouteFromEndpoint() error = %v", err)
+		}
+		if drift := DiffRoutes([]Route{route}, []Route{*rebuilt}); len(drift.Missing)+len(drift.Changed) > 0 {
+			t.Errorf("RouteFromEndpoint() = %+v, want %+v", *rebuilt, route)
+		}
+		if ids := RouteIDsFromEndpoints([]map[string]any{endpoint}); !ids[route.ID] || len(ids) != 1 {
+			t.Errorf("RouteIDsFromEndpoints() = %v, want %s", ids, route.ID)
+		}
+	}
+}
+
+func TestDiffRoutes(t *testing.T) {
+	api := Route{ID: "api", Domain: "api.example.com", Upstream: "pod:8080", Terminal: true,
+		Auth: &AuthPolicy{Upstream: "auth:8080", URI: "/verify", CopyHeaders: []string{"A", "B"}}}
+	ui := Route{ID: "ui", Domain: "ui.example.com", Upstream: "pod:3000", Terminal: true}
+	other := Route{ID: "old", Domain: "old.example.com", Upstream: "gone:3000", Terminal: true}
+
+	reordered := api
+	reordered.Auth = &AuthPolicy{Upstream: "auth:8080", URI: "/verify", CopyHeaders: []string{"B", "A"}}
+	unprotected := api
+	unprotected.Auth = nil
+
+	tests := []struct {
+		name             string
+		actual           []Route
+		missing, changed []string
+	}{
+		{name: "in sync", actual: []Route{reordered, ui}},
+		{name: "missing route", actual: []Route{api}, missing: []string{"ui"}},
+		{name: "auth policy removed", actual: []Route{unprotected, ui}, changed: []string{"api"}},
+		{name: "undesired route ignored", actual: []Route{api, ui, other}},
+	}
+
+	ids := func(routes []Route) []string {
+		var result []string
+		for _, route := range routes {
+			result = append(result, route.ID)
+		}
+
+		return result
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			drift := DiffRoutes([]Route{api, ui}, tt.actual)
+			if !slices.Equal(ids(drift.Missing), tt.missing) || !slices.Equal(ids(drift.Changed), tt.changed) {
+				t.Errorf("DiffRoutes() = %+v, want missing %v, changed %v", drift, tt.missing, tt.changed)
+			}
+		})
+	}
+}
+
+func TestRoutePolicyMerge(t *testing.T) {
+	defaults := &RoutePolicy{MaxBodySize: 1024, MaxConcurrentRequests: 16}
+
+	tests := []struct {
+		name     string
+		base     *RoutePolicy
+		override *RoutePolicy
+		want     *RoutePolicy
+	}{
+		{name: "defaults only", base: defaults, want: defaults},
+		{name: "no policies", want: nil},
+		{
+			name:     "override replaces set limits",
+			base:     defaults,
+			override: &RoutePolicy{MaxConcurrentRequests: 4, RequestsPerSecond: 10},
+			want:     &RoutePolicy{MaxBodySize: 1024, MaxConcurrentRequests: 4, RequestsPerSecond: 10, RateLimitKey: RateLimitKeyClientIP},
+		},
+		{name: "rate limit key without rate limit", override: &RoutePolicy{RateLimitKey: RateLimitKeyAPIKey}, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.base.Merge(tt.override)
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Errorf("Merge() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package proxy
+
+import "slices"
+
+// RouteDrift describes how the routes registered with a proxy differ from the desired routes.
+type RouteDrift struct {
+	// Missing lists desired routes that are not registered
+	Missing []Route
+
+	// Changed lists desired routes whose registered configuration differs
+	Changed []Route
+}
+
+// DiffRoutes compares the desired routes with the routes registered with a proxy, as returned by
+// ProxyManager.ListRoutes. Routes are matched by ID; the Type of a route is not compared since the
+// proxy does not record it.
+func DiffRoutes(desired, actual []Route) RouteDrift {
+	registered := make(map[string]Route, len(actual))
+	for _, route := range actual {
+		registered[route.ID] = route
+	}
+
+	var drift RouteDrift
+	for _, route := range desired {
+		current, ok := registered[route.ID]
+		switch {
+		case !ok:
+			drift.Missing = append(drift.Missing, route)
+		case !sameRouteConfig(route, current):
+			drift.Changed = append(drift.Changed, route)
+		}
+	}
+
+	return drift
+}
+
+// sameRouteConfig reports whether two routes result in the same proxy configuration.
+func sameRouteConfig(a, b Route) bool {
+	if a.Domain != b.Domain || a.PathPrefix != b.PathPrefix || a.Upstream != b.Upstream || a.Terminal != b.Terminal {
+		return false
+	}
+
+	// Merging normalizes policies, e.g. a policy without limits to nil
+	pa, pb := a.Policy.Merge(nil), b.Policy.Merge(nil)
+	if (pa == nil) != (pb == nil) || (pa != nil && *pa != *pb) {
+		return false
+	}
+
+	if a.Auth == nil || b.Auth == nil {
+		return a.Auth == nil && b.Auth == nil
+	}
+
+	return a.Auth.Upstream == b.Auth.Upstream &&
+		a.Auth.URI == b.Auth.URI &&
+		slices.Equal(sortedCopy(a.Auth.CopyHeaders), sortedCopy(b.Auth.CopyHeaders))
+}
+
+func sortedCopy(values []string) []string {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+
+	return sorted
+}
+
+// Made with Bob
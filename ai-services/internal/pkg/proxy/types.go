@@ -1,6 +1,9 @@
 package proxy
 
-import "context"
+import (
+	"context"
+	"fmt"
+)
 
 // ProxyManager defines the interface for managing reverse proxy routes.
 type ProxyManager interface {
@@ -15,6 +18,9 @@ type ProxyManager interface {
 
 	// GetRouteByID retrieves a specific route by its ID from the proxy
 	GetRouteByID(routeID string) (*Route, error)
+
+	// ListRoutes retrieves all routes registered with the proxy
+	ListRoutes(ctx context.Context) ([]Route, error)
 }
 
 // Route represents a reverse proxy route configuration.
@@ -25,6 +31,10 @@ type Route struct {
 	// Domain is the hostname to match (e.g., "service.example.com")
 	Domain string
 
+	// PathPrefix restricts the route to requests under the path (e.g., "/apps/rag/chat-bot-ui"),
+	// which is stripped before the request is proxied upstream. Empty matches every path.
+	PathPrefix string
+
 	// Upstream is the backend service address (e.g., "pod-name:8080")
 	Upstream string
 
@@ -33,6 +43,146 @@ type Route struct {
 
 	// Type indicates the endpoint type
 	Type string
+
+	// Auth restricts the route to authorized clients. A nil Auth leaves the route open.
+	Auth *AuthPolicy
+
+	// Policy bounds the traffic the route lets through. A nil Policy applies no limits.
+	Policy *RoutePolicy
+}
+
+// Routing modes of the proxy, chosen when the catalog is configured.
+const (
+	// RoutingModeHost serves every route under its own hostname below the domain suffix
+	RoutingModeHost = "host"
+
+	// RoutingModePath serves every route from the domain suffix itself, under its own path
+	RoutingModePath = "path"
+)
+
+// ApplicationsPathBase is the path the routes of applications are served under in path routing
+// mode, followed by the application name.
+const ApplicationsPathBase = "/apps"
+
+// ValidateRoutingMode checks that mode is a known routing mode.
+func ValidateRoutingMode(mode string) error {
+	switch mode {
+	case RoutingModeHost, RoutingModePath:
+		return nil
+	default:
+		return fmt.Errorf("invalid routing mode '%s', valid modes are: %s, %s", mode, RoutingModeHost, RoutingModePath)
+	}
+}
+
+// AuthPolicy delegates the authentication of a route's requests to an external endpoint, the way
+// Caddy's forward_auth directive does. Every request is first sent, with its original headers, to
+// the authentication endpoint as a GET request and only reaches the route's upstream when the
+// endpoint answers with a 2xx status. Any other response is returned to the client as is.
+type AuthPolicy struct {
+	// Upstream is the address of the server validating credentials (e.g., "pod-name:8080")
+	Upstream string
+
+	// URI is the path and query of the validation endpoint (e.g., "/api/v1/auth/verify?id=1")
+	URI string
+
+	// CopyHeaders lists headers of a successful validation response that are set on the request
+	// forwarded to the route's upstream
+	CopyHeaders []string
+}
+
+// Rate limit keys of a RoutePolicy.
+const (
+	// RateLimitKeyClientIP limits the requests of each client IP address
+	RateLimitKeyClientIP = "client_ip"
+
+	// RateLimitKeyAPIKey limits the requests of each credential, i.e. each API key or access token
+	RateLimitKeyAPIKey = "api_key"
+)
+
+// RoutePolicy bounds the traffic of a route. Zero fields apply no limit. Service metadata sets the
+// defaults of its routes, keyed by route type, and applications may override them on creation.
+type RoutePolicy struct {
+	// RequestsPerSecond is the number of requests a client may send per second
+	RequestsPerSecond int `yaml:"requests_per_second,omitempty" json:"requests_per_second,omitempty"`
+
+	// RateLimitKey tells clients apart for rate limiting, RateLimitKeyClientIP if empty
+	RateLimitKey string `yaml:"rate_limit_key,omitempty" json:"rate_limit_key,omitempty" enums:"client_ip,api_key"`
+
+	// MaxBodySize is the largest request body accepted, in bytes
+	MaxBodySize int64 `yaml:"max_body_size,omitempty" json:"max_body_size,omitempty"`
+
+	// DialTimeoutSeconds bounds the time to connect to the upstream
+	DialTimeoutSeconds int `yaml:"dial_timeout_seconds,omitempty" json:"dial_timeout_seconds,omitempty"`
+
+	// ResponseTimeoutSeconds bounds the time the upstream takes to start its response
+	ResponseTimeoutSeconds int `yaml:"response_timeout_seconds,omitempty" json:"response_timeout_seconds,omitempty"`
+
+	// MaxConcurrentRequests is the number of requests the upstream handles at once; further
+	// requests are rejected with 503
+	MaxConcurrentRequests int `yaml:"max_concurrent_requests,omitempty" json:"max_concurrent_requests,omitempty"`
+}
+
+// Validate checks that the policy holds no negative limits and a known rate limit key.
+func (p *RoutePolicy) Validate() error {
+	if p.RequestsPerSecond < 0 || p.MaxBodySize < 0 || p.DialTimeoutSeconds < 0 ||
+		p.ResponseTimeoutSeconds < 0 || p.MaxConcurrentRequests < 0 {
+		return fmt.Errorf("route policy limits cannot be negative")
+	}
+
+	switch p.RateLimitKey {
+	case "", RateLimitKeyClientIP, RateLimitKeyAPIKey:
+		return nil
+	default:
+		return fmt.Errorf("invalid rate limit key '%s', valid keys are: %s, %s", p.RateLimitKey, RateLimitKeyClientIP, RateLimitKeyAPIKey)
+	}
+}
+
+// Merge returns the policy with the non-zero fields of override applied. Either policy may be nil.
+func (p *RoutePolicy) Merge(override *RoutePolicy) *RoutePolicy {
+	merged := RoutePolicy{}
+	if p != nil {
+		merged = *p
+	}
+	if override == nil {
+		return merged.orNil()
+	}
+
+	if override.RequestsPerSecond != 0 {
+		merged.RequestsPerSecond = override.RequestsPerSecond
+	}
+	if override.RateLimitKey != "" {
+		merged.RateLimitKey = override.RateLimitKey
+	}
+	if override.MaxBodySize != 0 {
+		merged.MaxBodySize = override.MaxBodySize
+	}
+	if override.DialTimeoutSeconds != 0 {
+		merged.DialTimeoutSeconds = override.DialTimeoutSeconds
+	}
+	if override.ResponseTimeoutSeconds != 0 {
+		merged.ResponseTimeoutSeconds = override.ResponseTimeoutSeconds
+	}
+	if override.MaxConcurrentRequests != 0 {
+		merged.MaxConcurrentRequests = override.MaxConcurrentRequests
+	}
+
+	return merged.orNil()
+}
+
+// orNil normalizes a policy so that policies with the same effect compare equal: the rate limit
+// key is only set along with a rate limit, and a policy without limits is nil.
+func (p RoutePolicy) orNil() *RoutePolicy {
+	switch {
+	case p.RequestsPerSecond == 0:
+		p.RateLimitKey = ""
+	case p.RateLimitKey == "":
+		p.RateLimitKey = RateLimitKeyClientIP
+	}
+	if p == (RoutePolicy{}) {
+		return nil
+	}
+
+	return &p
 }
 
 // Made with Bob
@@ -2,32 +2,12 @@ package proxy
 
 import (
 	"fmt"
+	"path"
 	"strings"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
-	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
 )
 
-// GetCaddyAdminPort retrieves the host port mapped to Caddy's admin API (container port 2019).
-func GetCaddyAdminPort(rt runtime.Runtime, podName string) (string, error) {
-	pod, err := rt.InspectPod(podName)
-	if err != nil {
-		return "", fmt.Errorf("failed to inspect Caddy pod: %w", err)
-	}
-
-	// Get port mappings from the Ports field
-	// Ports is a map[string][]string where key is "containerPort/protocol" and value is list of host ports
-	// Example: {"2019/tcp": ["37249"], "443/tcp": ["39341"]}
-	for containerPort, hostPorts := range pod.Ports {
-		// Check if this is the admin API port (2019)
-		if strings.HasPrefix(containerPort, "2019/") && len(hostPorts) > 0 {
-			return hostPorts[0], nil
-		}
-	}
-
-	return "", fmt.Errorf("admin port mapping not found in pod ports")
-}
-
 // RouteEntryParts represents the parsed components of a route entry.
 type RouteEntryParts struct {
 	Port      string
@@ -112,6 +92,17 @@ func BuildRoutesFromAnnotation(routesAnnotation, domainSuffix, podName string) (
 	return routes, nil
 }
 
+// ApplyPathRouting moves routes built by BuildRoutesFromAnnotation from their own hostnames onto the
+// domain suffix itself, each under pathBase followed by its name. The name of a route is its ID
+// without nameSuffix, e.g. "chat-bot-ui" for the route "chat-bot-ui-<slug>" of an application's pod.
+func ApplyPathRouting(routes []Route, domainSuffix, pathBase, nameSuffix string) {
+	for i := range routes {
+		name := strings.TrimSuffix(routes[i].ID, nameSuffix)
+		routes[i].Domain = domainSuffix
+		routes[i].PathPrefix = path.Join("/", pathBase, name)
+	}
+}
+
 // FindCaddyPodNameFromTemplates finds the Caddy pod name by looking for the pod with component=proxy label in templates.
 func FindCaddyPodNameFromTemplates(tp templates.Template, appTemplateName, catalogAppName string, argParams map[string]string) (string, error) {
 	// Load all templates
@@ -0,0 +1,193 @@
+package openshift
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// replicasAnnotation records the replica count a workload had before it was scaled to zero,
+	// so that a later start restores the same size.
+	replicasAnnotation = "ai-services.io/stopped-replicas"
+
+	// kserveStopAnnotation pauses an InferenceService without deleting it.
+	kserveStopAnnotation = "serving.kserve.io/stop"
+
+	// inferenceServiceKind is the owner kind of Deployments managed by KServe.
+	inferenceServiceKind = "InferenceService"
+
+	defaultStartReplicas = int32(1)
+)
+
+// ScaleWorkloads stops or starts every Deployment, StatefulSet and InferenceService in the
+// client namespace matching labelSelector. Stopping scales workloads to zero and remembers
+// the previous replica count in an annotation; starting restores it.
+func (kc *OpenshiftClient) ScaleWorkloads(labelSelector string, stop bool) error {
+	if err := kc.scaleDeployments(labelSelector, stop); err != nil {
+		return err
+	}
+
+	if err := kc.scaleStatefulSets(labelSelector, stop); err != nil {
+		return err
+	}
+
+	return kc.toggleInferenceServices(labelSelector, stop)
+}
+
+func (kc *OpenshiftClient) scaleDeployments(labelSelector string, stop bool) error {
+	list, err := kc.KubeClient.AppsV1().Deployments(kc.Namespace).List(kc.Ctx, metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return fmt.Errorf("failed to list deployments: %w", err)
+	}
+
+	for i := range list.Items {
+		d := &list.Items[i]
+		// KServe reconciles its own Deployments; those are handled via the InferenceService.
+		if isOwnedBy(d.OwnerReferences, inferenceServiceKind) {
+			continue
+		}
+
+		patch, ok := scalePatch(d.Spec.Replicas, d.Annotations, stop)
+		if !ok {
+			continue
+		}
+
+		if _, err := kc.KubeClient.AppsV1().Deployments(kc.Namespace).Patch(
+			kc.Ctx, d.Name, k8stypes.MergePatchType, patch, metav1.PatchOptions{},
+		); err != nil {
+			return fmt.Errorf("failed to scale deployment %q: %w", d.Name, err)
+		}
+		logger.InfofCtx(kc.Ctx, "Scaled deployment %s/%s (stop=%t)", kc.Namespace, d.Name, stop)
+	}
+
+	return nil
+}
+
+func (kc *OpenshiftClient) scaleStatefulSets(labelSelector string, stop bool) error {
+	list, err := kc.KubeClient.AppsV1().StatefulSets(kc.Namespace).List(kc.Ctx, metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return fmt.Errorf("failed to list statefulsets: %w", err)
+	}
+
+	for i := range list.Items {
+		sts := &list.Items[i]
+
+		patch, ok := scalePatch(sts.Spec.Replicas, sts.Annotations, stop)
+		if !ok {
+			continue
+		}
+
+		if _, err := kc.KubeClient.AppsV1().StatefulSets(kc.Namespace).Patch(
+			kc.Ctx, sts.Name, k8stypes.MergePatchType, patch, metav1.PatchOptions{},
+		); err != nil {
+			return fmt.Errorf("failed to scale statefulset %q: %w", sts.Name, err)
+		}
+		logger.InfofCtx(kc.Ctx, "Scaled statefulset %s/%s (stop=%t)", kc.Namespace, sts.Name, stop)
+	}
+
+	return nil
+}
+
+// toggleInferenceServices sets or clears the KServe stop annotation. Clusters without
+// the KServe CRDs are skipped silently.
+func (kc *OpenshiftClient) toggleInferenceServices(labelSelector string, stop bool) error {
+	selector, err := metav1.ParseToLabelSelector(labelSelector)
+	if err != nil {
+		return fmt.Errorf("invalid label selector %q: %w", labelSelector, err)
+	}
+
+	matchLabels := client.MatchingLabels(selector.MatchLabels)
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "serving.kserve.io",
+		Version: "v1beta1",
+		Kind:    "InferenceServiceList",
+	})
+
+	if err := kc.Client.List(kc.Ctx, list, client.InNamespace(kc.Namespace), matchLabels); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to list inference services: %w", err)
+	}
+
+	for i := range list.Items {
+		isvc := &list.Items[i]
+		patch, err := json.Marshal(map[string]any{
+			"metadata": map[string]any{
+				"annotations": map[string]string{kserveStopAnnotation: strconv.FormatBool(stop)},
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to marshal inference service patch: %w", err)
+		}
+
+		if err := kc.Client.Patch(kc.Ctx, isvc, client.RawPatch(k8stypes.MergePatchType, patch)); err != nil {
+			return fmt.Errorf("failed to patch inference service %q: %w", isvc.GetName(), err)
+		}
+		logger.InfofCtx(kc.Ctx, "Set %s=%t on inference service %s/%s", kserveStopAnnotation, stop, kc.Namespace, isvc.GetName())
+	}
+
+	return nil
+}
+
+// scalePatch builds the merge patch that stops or starts a workload. It returns false
+// when the workload is already in the requested state.
+func scalePatch(current *int32, annotations map[string]string, stop bool) ([]byte, bool) {
+	replicas := defaultStartReplicas
+	if current != nil {
+		replicas = *current
+	}
+
+	var patch map[string]any
+	if stop {
+		if replicas == 0 {
+			return nil, false
+		}
+		patch = map[string]any{
+			"metadata": map[string]any{"annotations": map[string]string{replicasAnnotation: strconv.Itoa(int(replicas))}},
+			"spec":     map[string]any{"replicas": 0},
+		}
+	} else {
+		if replicas > 0 {
+			return nil, false
+		}
+		restore := defaultStartReplicas
+		if v, err := strconv.ParseInt(annotations[replicasAnnotation], 10, 32); err == nil && v > 0 {
+			restore = int32(v)
+		}
+		patch = map[string]any{
+			// A nil value removes the annotation in a JSON merge patch.
+			"metadata": map[string]any{"annotations": map[string]any{replicasAnnotation: nil}},
+			"spec":     map[string]any{"replicas": restore},
+		}
+	}
+
+	data, err := json.Marshal(patch)
+	if err != nil {
+		return nil, false
+	}
+
+	return data, true
+}
+
+func isOwnedBy(refs []metav1.OwnerReference, kind string) bool {
+	for _, ref := range refs {
+		if ref.Kind == kind {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,66 @@
+package openshift
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestScalePatch(t *testing.T) {
+	three := int32(3)
+	zero := int32(0)
+
+	tests := []struct {
+		name         string
+		current      *int32
+		annotations  map[string]string
+		stop         bool
+		wantOK       bool
+		wantReplicas float64
+		wantSaved    string
+	}{
+		{name: "stop running workload", current: &three, stop: true, wantOK: true, wantReplicas: 0, wantSaved: "3"},
+		{name: "stop already stopped workload", current: &zero, stop: true, wantOK: false},
+		{name: "start restores saved replicas", current: &zero, annotations: map[string]string{replicasAnnotation: "3"}, wantOK: true, wantReplicas: 3},
+		{name: "start without annotation defaults to one", current: &zero, wantOK: true, wantReplicas: 1},
+		{name: "start ignores invalid annotation", current: &zero, annotations: map[string]string{replicasAnnotation: "x"}, wantOK: true, wantReplicas: 1},
+		{name: "start already running workload", current: &three, wantOK: false},
+		{name: "nil replicas is treated as running", current: nil, stop: true, wantOK: true, wantReplicas: 0, wantSaved: "1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, ok := scalePatch(tt.current, tt.annotations, tt.stop)
+			if ok != tt.wantOK {
+				t.Fatalf("scalePatch() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+
+			var patch struct {
+				Metadata struct {
+					Annotations map[string]*string `json:"annotations"`
+				} `json:"metadata"`
+				Spec struct {
+					Replicas float64 `json:"replicas"`
+				} `json:"spec"`
+			}
+			if err := json.Unmarshal(data, &patch); err != nil {
+				t.Fatalf("failed to unmarshal patch: %v", err)
+			}
+
+			if patch.Spec.Replicas != tt.wantReplicas {
+				t.Errorf("replicas = %v, want %v", patch.Spec.Replicas, tt.wantReplicas)
+			}
+
+			saved := patch.Metadata.Annotations[replicasAnnotation]
+			if tt.stop {
+				if saved == nil || *saved != tt.wantSaved {
+					t.Errorf("saved replicas annotation = %v, want %q", saved, tt.wantSaved)
+				}
+			} else if saved != nil {
+				t.Errorf("expected replicas annotation to be removed, got %q", *saved)
+			}
+		})
+	}
+}
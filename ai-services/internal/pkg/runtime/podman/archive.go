@@ -0,0 +1,124 @@
+package podman
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/containers/podman/v5/pkg/bindings/images"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/imagemirror"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+const ociArchiveFormat = "oci-archive"
+
+// SaveImage writes an image of the local storage to w as an OCI archive.
+func (pc *PodmanClient) SaveImage(ctx context.Context, image string, w io.Writer) error {
+	podCtx, cancel := pc.podmanCtx(ctx)
+	defer cancel()
+
+	format := ociArchiveFormat
+	if err := images.Export(podCtx, []string{image}, w, &images.ExportOptions{Format: &format}); err != nil {
+		return fmt.Errorf("failed to save image %s: %w", image, err)
+	}
+
+	return nil
+}
+
+// LoadImage loads an image archive into the local storage and makes sure the image carries the
+// given name, which archives do not always keep.
+func (pc *PodmanClient) LoadImage(ctx context.Context, r io.Reader, name string) error {
+	podCtx, cancel := pc.podmanCtx(ctx)
+	defer cancel()
+
+	report, err := images.Load(podCtx, r)
+	if err != nil {
+		return fmt.Errorf("failed to load image %s: %w", name, err)
+	}
+	if len(report.Names) == 0 {
+		return fmt.Errorf("archive of image %s holds no image", name)
+	}
+
+	if slices.Contains(report.Names, name) {
+		return nil
+	}
+
+	repo, tag, ok := splitReference(name)
+	if !ok {
+		return fmt.Errorf("loaded image %s cannot be named %s", report.Names[0], name)
+	}
+	if err := images.Tag(podCtx, report.Names[0], tag, repo, nil); err != nil {
+		return fmt.Errorf("failed to tag image %s as %s: %w", report.Names[0], name, err)
+	}
+
+	return nil
+}
+
+// ImageID returns the ID of an image of the local storage, the digest of its configuration, or an
+// empty string when the storage does not have it.
+func (pc *PodmanClient) ImageID(ctx context.Context, image string) (string, error) {
+	podCtx, cancel := pc.podmanCtx(ctx)
+	defer cancel()
+
+	exists, err := images.Exists(podCtx, image, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to look up image %s: %w", image, err)
+	}
+	if !exists {
+		return "", nil
+	}
+
+	report, err := images.GetImage(podCtx, image, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to inspect image %s: %w", image, err)
+	}
+
+	return report.ID, nil
+}
+
+// MirrorImage copies an image as referenced, without rewriting it to its mirror, to destination
+// in another registry and returns the digest of the pushed manifest. Both registries are
+// authenticated to with their auth of mirrors.
+func (pc *PodmanClient) MirrorImage(ctx context.Context, source, destination string, mirrors *imagemirror.Config) (string, error) {
+	if err := pc.pullImage(ctx, source, mirrors.AuthFor(source)); err != nil {
+		return "", err
+	}
+
+	logger.InfofCtx(ctx, "Pushing image %s...\n", destination)
+	auth := mirrors.AuthFor(destination)
+	opts := &images.PushOptions{Quiet: utils.BoolPtr(true)}
+	if authFile := authFileOf(auth); authFile != "" {
+		opts.Authfile = &authFile
+	}
+	if auth.Insecure {
+		opts.SkipTLSVerify = utils.BoolPtr(true)
+	}
+
+	podCtx, cancel := pc.podmanCtx(ctx)
+	defer cancel()
+
+	if err := images.Push(podCtx, source, destination, opts); err != nil {
+		return "", fmt.Errorf("failed to push image %s: %w", destination, err)
+	}
+
+	return opts.GetManifestDigest(), nil
+}
+
+// splitReference splits an image name into its repository and tag. Names pinned by digest cannot
+// be tagged.
+func splitReference(name string) (string, string, bool) {
+	if strings.Contains(name, "@") {
+		return "", "", false
+	}
+
+	slash := strings.LastIndex(name, "/")
+	if colon := strings.LastIndex(name, ":"); colon > slash {
+		return name[:colon], name[colon+1:], true
+	}
+
+	return name, "latest", true
+}
@@ -1,6 +1,7 @@
 package podman
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -22,8 +23,12 @@ import (
 	"github.com/containers/podman/v5/pkg/bindings/volumes"
 	"github.com/containers/podman/v5/pkg/domain/entities"
 	"github.com/containers/podman/v5/pkg/specgen"
+	"github.com/project-ai-services/ai-services/internal/pkg/accelerator"
+	// Registers the providers returned by accelerator.Default.
+	_ "github.com/project-ai-services/ai-services/internal/pkg/accelerator/providers"
 	"github.com/project-ai-services/ai-services/internal/pkg/accelerator/spyre"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/imagemirror"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/models"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
@@ -84,13 +89,27 @@ func (pc *PodmanClient) ListImages() ([]types.Image, error) {
 }
 
 func (pc *PodmanClient) PullImage(ctx context.Context, image string) error {
+	mirrors, err := imagemirror.Current()
+	if err != nil {
+		return err
+	}
+	image = mirrors.Rewrite(image)
+
+	return pc.pullImage(ctx, image, mirrors.AuthFor(image))
+}
+
+// pullImage pulls an image as referenced, authenticating with auth.
+func (pc *PodmanClient) pullImage(ctx context.Context, image string, auth imagemirror.Auth) error {
 	logger.InfofCtx(ctx, "Pulling image %s...\n", image)
 
-	// Create pull options with auth file from environment
+	// Create pull options with the auth file of the registry, or else the one from environment
 	opts := &images.PullOptions{}
-	if authFile := os.Getenv("REGISTRY_AUTH_FILE"); authFile != "" {
+	if authFile := authFileOf(auth); authFile != "" {
 		opts.Authfile = &authFile
 	}
+	if auth.Insecure {
+		opts.SkipTLSVerify = utils.BoolPtr(true)
+	}
 
 	// podmanCtx merges pc.Context (Podman connection handle) with the caller's
 	// ctx (cancellation signal). We cannot pass ctx directly — the Podman SDK
@@ -98,8 +117,7 @@ func (pc *PodmanClient) PullImage(ctx context.Context, image string) error {
 	podCtx, cancel := pc.podmanCtx(ctx)
 	defer cancel()
 
-	_, err := images.Pull(podCtx, image, opts)
-	if err != nil {
+	if _, err := images.Pull(podCtx, image, opts); err != nil {
 		return fmt.Errorf("failed to pull image %s: %w", image, err)
 	}
 	logger.InfofCtx(ctx, "Successfully pulled image %s\n", image)
@@ -107,6 +125,15 @@ func (pc *PodmanClient) PullImage(ctx context.Context, image string) error {
 	return nil
 }
 
+// authFileOf returns the auth file of a registry, or else the one from environment.
+func authFileOf(auth imagemirror.Auth) string {
+	if auth.AuthFile != "" {
+		return auth.AuthFile
+	}
+
+	return os.Getenv("REGISTRY_AUTH_FILE")
+}
+
 func (pc *PodmanClient) ListPods(filters map[string][]string) ([]types.Pod, error) {
 	var listOpts pods.ListOptions
 
@@ -175,6 +202,12 @@ func (pc *PodmanClient) CreatePod(ctx context.Context, body io.Reader, opts map[
 		}
 	}
 
+	// Pull the images of the pod specs from their mirrors
+	body, err := rewriteImages(body)
+	if err != nil {
+		return nil, err
+	}
+
 	// Use a context that carries the podman connection (from pc.Context) but is
 	// cancelled when the caller's ctx is cancelled.
 	podCtx, cancel := pc.podmanCtx(ctx)
@@ -188,6 +221,27 @@ func (pc *PodmanClient) CreatePod(ctx context.Context, body io.Reader, opts map[
 	return toPodsList(kubeReport), nil
 }
 
+// rewriteImages rewrites the images of pod specs to their mirrors.
+func rewriteImages(body io.Reader) (io.Reader, error) {
+	mirrors, err := imagemirror.Current()
+	if err != nil {
+		return nil, err
+	}
+	if len(mirrors.Mirrors) == 0 {
+		return body, nil
+	}
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pod specs: %w", err)
+	}
+	if data, err = mirrors.RewriteManifests(data); err != nil {
+		return nil, err
+	}
+
+	return bytes.NewReader(data), nil
+}
+
 func (pc *PodmanClient) DeletePod(id string, force *bool) error {
 	_, err := pods.Remove(pc.Context, id, &pods.RemoveOptions{Force: force})
 	if err != nil {
@@ -385,6 +439,12 @@ func (pc *PodmanClient) ContainerExists(nameOrID string) (bool, error) {
 // pc.Context (the Podman connection context) is still used for all Podman API calls.
 // Returns the exit code of the container.
 func (pc *PodmanClient) RunContainerWithSpec(ctx context.Context, s *specgen.SpecGenerator) (int32, error) {
+	mirrors, err := imagemirror.Current()
+	if err != nil {
+		return -1, err
+	}
+	s.Image = mirrors.Rewrite(s.Image)
+
 	// Create container
 	createResponse, err := containers.CreateWithSpec(pc.Context, s, nil)
 	if err != nil {
@@ -446,6 +506,16 @@ func (pc *PodmanClient) DeletePVCs(appLabel string) error {
 	return fmt.Errorf("unsupported method")
 }
 
+// CreateSecret creates a secret holding data, to pass it to a container as a file rather than
+// through its environment.
+func (pc *PodmanClient) CreateSecret(name string, data []byte) error {
+	if _, err := secrets.Create(pc.Context, bytes.NewReader(data), &secrets.CreateOptions{Name: &name}); err != nil {
+		return fmt.Errorf("failed to create secret: %w", err)
+	}
+
+	return nil
+}
+
 func (pc *PodmanClient) DeleteSecret(name string) error {
 	err := secrets.Remove(pc.Context, name)
 	if err != nil {
@@ -551,37 +621,29 @@ func (pc *PodmanClient) GetSystemInfo() (*models.SystemInfo, error) {
 func getAcceleratorInfo(ctx context.Context) map[string]*models.AcceleratorInfo {
 	accelerators := make(map[string]*models.AcceleratorInfo)
 
-	// Get total Spyre cards
-	totalCards, err := spyre.ListCards(ctx)
+	provider, err := accelerator.Default()
 	if err != nil {
-		logger.ErrorfCtx(ctx, "Could not list Spyre cards: %v", err)
-		// Return empty map when error occurs
-		return accelerators
-	}
+		logger.ErrorfCtx(ctx, "Could not get the accelerator provider: %v", err)
 
-	totalCount := len(totalCards)
-	if totalCount == 0 {
-		// Return empty map when no Spyre cards found
 		return accelerators
 	}
 
-	// Get available Spyre cards
-	availableCards, err := spyre.FindFreeCards(ctx)
+	// Get the cards attached to the host
+	cards, err := provider.Discover(ctx)
 	if err != nil {
-		logger.ErrorfCtx(ctx, "Could not find available Spyre cards: %v", err)
-		accelerators[constants.SpyreResourceName] = &models.AcceleratorInfo{
-			Total:     totalCount,
-			Available: 0,
-		}
-
+		logger.ErrorfCtx(ctx, "Could not list %s cards: %v", provider.Name(), err)
+		// Return empty map when error occurs
 		return accelerators
 	}
 
-	availableCount := len(availableCards)
+	if len(cards) == 0 {
+		// Return empty map when no cards found
+		return accelerators
+	}
 
-	accelerators[constants.SpyreResourceName] = &models.AcceleratorInfo{
-		Total:     totalCount,
-		Available: availableCount,
+	accelerators[provider.ResourceName()] = &models.AcceleratorInfo{
+		Total:     len(cards),
+		Available: len(accelerator.FreeAddresses(cards)),
 	}
 
 	return accelerators
@@ -704,24 +766,6 @@ func (pc *PodmanClient) ExecInContainerWithOutput(containerID string, cmd []stri
 	return string(output), nil
 }
 
-// ExecInContainerWithEnv executes a command in a container with environment variables.
-// This is used to pass sensitive data like passwords without exposing them in process lists.
-// Environment variables are set inline in the shell command to avoid exposure.
-func (pc *PodmanClient) ExecInContainerWithEnv(containerID string, env map[string]string, script string) error {
-	// Build environment variable assignments for the shell
-	envVars := make([]string, 0, len(env))
-	for key, value := range env {
-		// Use single quotes to prevent shell expansion, escape any single quotes in the value
-		escapedValue := strings.ReplaceAll(value, "'", "'\\''")
-		envVars = append(envVars, fmt.Sprintf("%s='%s'", key, escapedValue))
-	}
-
-	// Combine env vars with the script
-	fullScript := strings.Join(envVars, " ") + " " + script
-
-	return pc.ExecInContainer(containerID, []string{"sh", "-c", fullScript})
-}
-
 // CopyDirToContainer copies a directory to a container using podman cp command.
 // Note: Using exec.Command instead of SDK because the SDK's copy API requires
 // tar archive handling which is complex.
@@ -743,72 +787,6 @@ func (pc *PodmanClient) CopyDirToContainer(containerID, srcDir, destDir string)
 	return nil
 }
 
-// CreateSidecarContainer creates a sidecar container in the specified pod.
-// Returns the container ID of the created sidecar.
-func (pc *PodmanClient) CreateSidecarContainer(podID, sidecarName, image string, command []string) (string, error) {
-	s := &specgen.SpecGenerator{
-		ContainerBasicConfig: specgen.ContainerBasicConfig{
-			Name:    sidecarName,
-			Remove:  utils.BoolPtr(true), // Auto-remove container when stopped
-			Command: command,
-			Pod:     podID,
-		},
-		ContainerStorageConfig: specgen.ContainerStorageConfig{
-			Image: image,
-		},
-		ContainerHealthCheckConfig: specgen.ContainerHealthCheckConfig{
-			// Set HealthConfig to nil to disable health checks
-			HealthConfig: nil,
-			// Set HealthLogDestination to /tmp to satisfy directory requirement
-			HealthLogDestination: "/tmp",
-		},
-	}
-
-	createResponse, err := containers.CreateWithSpec(pc.Context, s, nil)
-	if err != nil {
-		return "", fmt.Errorf("failed to create sidecar container: %w", err)
-	}
-
-	containerID := createResponse.ID
-	if err := containers.Start(pc.Context, containerID, nil); err != nil {
-		return "", fmt.Errorf("failed to start sidecar container: %w", err)
-	}
-
-	return containerID, nil
-}
-
-// StopContainer stops a container by ID.
-func (pc *PodmanClient) StopContainer(containerID string) error {
-	return containers.Stop(pc.Context, containerID, nil)
-}
-
-// SidecarExecutor is a function type that performs operations using a sidecar container.
-type SidecarExecutor func(ctx context.Context, containerID string) error
-
-// ManageSidecarLifecycle manages the complete lifecycle of a sidecar container.
-// It creates the sidecar, executes the provided function, and ensures cleanup.
-func (pc *PodmanClient) ManageSidecarLifecycle(podID, sidecarName, image string, command []string, executor SidecarExecutor) error {
-	// Create and start sidecar container
-	containerID, err := pc.CreateSidecarContainer(podID, sidecarName, image, command)
-	if err != nil {
-		return fmt.Errorf("failed to create and start sidecar: %w", err)
-	}
-
-	// Ensure cleanup happens
-	defer func() {
-		logger.Infoln("Cleaning up sidecar container...")
-		stopErr := pc.StopContainer(containerID)
-		if stopErr != nil {
-			logger.Warningf("Failed to stop sidecar container %s: %v\n", containerID, stopErr)
-		}
-		// Note: Container has Remove=true, so it will be auto-removed when stopped
-		logger.Infoln("Sidecar container cleanup completed")
-	}()
-
-	// Execute the provided function with the sidecar
-	return executor(pc.Context, containerID)
-}
-
 // ExecInContainerWithCmd is not implemented for the Podman runtime.
 func (pc *PodmanClient) ExecInContainerWithCmd(_, _ string, _ []string) (string, error) {
 	logger.Errorf("unsupported method called!")
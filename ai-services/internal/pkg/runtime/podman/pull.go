@@ -0,0 +1,138 @@
+package podman
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/containers/podman/v5/pkg/auth"
+	"github.com/containers/podman/v5/pkg/bindings"
+	imagetypes "go.podman.io/image/v5/types"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/imagemirror"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/transfer"
+)
+
+// compatPullURL is the Docker compatible pull endpoint of the Podman service, the one streaming
+// the bytes pulled per layer. The host is ignored by the connection.
+const compatPullURL = "http://d/v1.41/images/create"
+
+// pullMessage is a message of the stream of the compatible pull endpoint.
+type pullMessage struct {
+	ID             string `json:"id"`
+	Status         string `json:"status"`
+	ProgressDetail struct {
+		Current int64 `json:"current"`
+		Total   int64 `json:"total"`
+	} `json:"progressDetail"`
+	Error string `json:"error"`
+	// Message is the error of requests failing before the pull starts.
+	Message string `json:"message"`
+}
+
+// PullImageWithProgress pulls an image like PullImage, reporting the bytes of its layers pulled so far
+// to progress.
+func (pc *PodmanClient) PullImageWithProgress(ctx context.Context, image string, progress transfer.ProgressFunc) error {
+	mirrors, err := imagemirror.Current()
+	if err != nil {
+		return err
+	}
+	image = mirrors.Rewrite(image)
+	auth := mirrors.AuthFor(image)
+
+	// The compatible endpoint cannot skip TLS verification
+	if progress == nil || auth.Insecure {
+		return pc.pullImage(ctx, image, auth)
+	}
+
+	logger.InfofCtx(ctx, "Pulling image %s...\n", image)
+	if err := pc.compatPull(ctx, image, authFileOf(auth), progress); err != nil {
+		return fmt.Errorf("failed to pull image %s: %w", image, err)
+	}
+	logger.InfofCtx(ctx, "Successfully pulled image %s\n", image)
+
+	return nil
+}
+
+func (pc *PodmanClient) compatPull(ctx context.Context, image, authFile string, progress transfer.ProgressFunc) error {
+	conn, err := bindings.GetClient(pc.Context)
+	if err != nil {
+		return err
+	}
+
+	header, err := auth.MakeXRegistryAuthHeader(&imagetypes.SystemContext{AuthFilePath: authFile}, "", "")
+	if err != nil {
+		return fmt.Errorf("failed to read registry credentials: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, compatPullURL+"?fromImage="+url.QueryEscape(image), nil)
+	if err != nil {
+		return err
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+
+	resp, err := conn.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return readPullStream(resp.Body, resp.StatusCode, progress)
+}
+
+// readPullStream reads the messages of a pull, reporting the sum of the bytes pulled over the
+// layers seen so far, and returns the error the stream ends with, if any.
+func readPullStream(body io.Reader, status int, progress transfer.ProgressFunc) error {
+	type layer struct{ current, total int64 }
+	layers := map[string]*layer{}
+
+	decoder := json.NewDecoder(body)
+	for {
+		var msg pullMessage
+		err := decoder.Decode(&msg)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read pull progress: %w", err)
+		}
+		if msg.Error != "" {
+			return errors.New(msg.Error)
+		}
+		if status != http.StatusOK && msg.Message != "" {
+			return errors.New(msg.Message)
+		}
+		if msg.ID == "" || msg.ProgressDetail.Total <= 0 {
+			continue
+		}
+
+		l, ok := layers[msg.ID]
+		if !ok {
+			l = &layer{}
+			layers[msg.ID] = l
+		}
+		l.current, l.total = msg.ProgressDetail.Current, msg.ProgressDetail.Total
+
+		var current, total int64
+		for _, l := range layers {
+			current += l.current
+			total += l.total
+		}
+		progress(current, total)
+	}
+
+	if status != http.StatusOK {
+		return fmt.Errorf("unexpected status %d", status)
+	}
+
+	return nil
+}
+
+// Made with Bob
@@ -0,0 +1,150 @@
+package transfer
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+// Kind is the kind of artifact transferred.
+type Kind string
+
+const (
+	KindImage Kind = "image"
+	KindModel Kind = "model"
+)
+
+// Progress is the progress of the transfer of an artifact.
+type Progress struct {
+	Kind Kind
+	Name string
+	// Current is the number of bytes transferred so far.
+	Current int64
+	// Total is the size of the artifact in bytes, -1 while unknown.
+	Total int64
+	Done  bool
+}
+
+// Tracker tracks the transfers of the artifacts of a deployment. A nil tracker tracks nothing.
+type Tracker struct {
+	mu        sync.Mutex
+	transfers []*Progress
+	index     map[string]*Progress
+}
+
+// NewTracker returns an empty tracker.
+func NewTracker() *Tracker {
+	return &Tracker{index: map[string]*Progress{}}
+}
+
+// Progress registers the transfer of an artifact and returns the func reporting its progress.
+func (t *Tracker) Progress(kind Kind, name string) ProgressFunc {
+	if t == nil {
+		return nil
+	}
+
+	p := t.get(kind, name)
+
+	return func(current, total int64) {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		p.Current, p.Total = current, total
+	}
+}
+
+// Done marks the transfer of an artifact as complete.
+func (t *Tracker) Done(kind Kind, name string) {
+	if t == nil {
+		return
+	}
+
+	p := t.get(kind, name)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	p.Done = true
+}
+
+// Snapshot returns the progress of the tracked transfers, in the order they were registered.
+func (t *Tracker) Snapshot() []Progress {
+	if t == nil {
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	snapshot := make([]Progress, 0, len(t.transfers))
+	for _, p := range t.transfers {
+		snapshot = append(snapshot, *p)
+	}
+
+	return snapshot
+}
+
+// Summary renders the tracked transfers for the status of a deployment, e.g.
+// "images 1/3 done, pulling vllm 1.2 GiB/4.0 GiB; models 0/1 done, downloading granite 300.0 MiB".
+func (t *Tracker) Summary() string {
+	snapshot := t.Snapshot()
+	if len(snapshot) == 0 {
+		return ""
+	}
+
+	var parts []string
+	for _, kind := range []Kind{KindImage, KindModel} {
+		var total, done int
+		var active []string
+		for _, p := range snapshot {
+			if p.Kind != kind {
+				continue
+			}
+			total++
+			if p.Done {
+				done++
+
+				continue
+			}
+			active = append(active, p.Name+" "+formatProgress(p.Current, p.Total))
+		}
+		if total == 0 {
+			continue
+		}
+
+		part := fmt.Sprintf("%ss %d/%d done", kind, done, total)
+		if len(active) > 0 {
+			verb := "pulling"
+			if kind == KindModel {
+				verb = "downloading"
+			}
+			part += ", " + verb + " " + strings.Join(active, ", ")
+		}
+		parts = append(parts, part)
+	}
+
+	return strings.Join(parts, "; ")
+}
+
+func (t *Tracker) get(kind Kind, name string) *Progress {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := string(kind) + ":" + name
+	p, ok := t.index[key]
+	if !ok {
+		p = &Progress{Kind: kind, Name: name, Total: -1}
+		t.index[key] = p
+		t.transfers = append(t.transfers, p)
+	}
+
+	return p
+}
+
+func formatProgress(current, total int64) string {
+	if total <= 0 {
+		return utils.FormatBytes(current)
+	}
+
+	return utils.FormatBytes(current) + "/" + utils.FormatBytes(total)
+}
+
+// Made with Bob
@@ -0,0 +1,134 @@
+// Package transfer runs the image pulls and model downloads of deployments concurrently, shares the
+// transfer of an artifact between the deployments needing it at the same time, and tracks the bytes
+// transferred per artifact so that deployments can report their progress.
+package transfer
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+const (
+	// ConcurrencyEnv is the environment variable holding how many images or models are
+	// transferred at a time.
+	ConcurrencyEnv = "AI_SERVICES_DOWNLOAD_CONCURRENCY"
+	// DefaultConcurrency is the number of artifacts transferred at a time by default.
+	DefaultConcurrency = 3
+)
+
+// ProgressFunc reports the bytes of an artifact transferred so far, total being -1 while unknown.
+type ProgressFunc func(current, total int64)
+
+// Concurrency returns how many images or models are transferred at a time.
+func Concurrency() int {
+	value := strings.TrimSpace(os.Getenv(ConcurrencyEnv))
+	if value == "" {
+		return DefaultConcurrency
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		logger.Warningf("%s must be a positive number, got %q; transferring %d artifacts at a time\n", ConcurrencyEnv, value, DefaultConcurrency)
+
+		return DefaultConcurrency
+	}
+
+	return n
+}
+
+// Run calls fn for every item, at most limit at a time. The first error cancels the context of the
+// other calls and is returned once they all returned.
+func Run[T any](ctx context.Context, limit int, items []T, fn func(ctx context.Context, item T) error) error {
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(max(limit, 1))
+	for _, item := range items {
+		g.Go(func() error {
+			return fn(gctx, item)
+		})
+	}
+
+	return g.Wait()
+}
+
+// Group shares the transfer of an artifact between the callers asking for it at the same time.
+type Group struct {
+	mu    sync.Mutex
+	calls map[string]*call
+}
+
+// call is a transfer in flight.
+type call struct {
+	done      chan struct{}
+	err       error
+	current   int64
+	total     int64
+	listeners []ProgressFunc
+}
+
+// Shared is the group of the transfers of the process, shared by all deployments.
+var Shared = &Group{}
+
+// Do calls fn to transfer the artifact of key, unless a transfer of the same key is in flight, in
+// which case it waits for that one and returns its result. progress, which may be nil, is told the
+// progress of whichever transfer is made. When the caller that started a transfer goes away, the
+// transfer is taken over by a caller still waiting for it.
+func (g *Group) Do(ctx context.Context, key string, progress ProgressFunc, fn func(ctx context.Context, progress ProgressFunc) error) error {
+	for {
+		g.mu.Lock()
+		if g.calls == nil {
+			g.calls = map[string]*call{}
+		}
+		c, inFlight := g.calls[key]
+		if !inFlight {
+			c = &call{done: make(chan struct{}), total: -1}
+			g.calls[key] = c
+		}
+		if progress != nil {
+			c.listeners = append(c.listeners, progress)
+			progress(c.current, c.total)
+		}
+		g.mu.Unlock()
+
+		if !inFlight {
+			c.err = fn(ctx, func(current, total int64) { g.report(c, current, total) })
+
+			g.mu.Lock()
+			delete(g.calls, key)
+			g.mu.Unlock()
+			close(c.done)
+
+			return c.err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-c.done:
+		}
+
+		cancelled := errors.Is(c.err, context.Canceled) || errors.Is(c.err, context.DeadlineExceeded)
+		if !cancelled || ctx.Err() != nil {
+			return c.err
+		}
+	}
+}
+
+func (g *Group) report(c *call, current, total int64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	c.current, c.total = current, total
+	for _, listener := range c.listeners {
+		listener(current, total)
+	}
+}
+
+// Made with Bob
@@ -0,0 +1,193 @@
+package transfer
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGroupSharesTransfers(t *testing.T) {
+	g := &Group{}
+	release := make(chan struct{})
+	var calls atomic.Int32
+
+	fn := func(_ context.Context, progress ProgressFunc) error {
+		calls.Add(1)
+		progress(50, 100)
+		<-release
+
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	seen := map[int]int64{}
+	for i := range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			progress := func(current, _ int64) {
+				mu.Lock()
+				defer mu.Unlock()
+				seen[i] = current
+			}
+			if err := g.Do(context.Background(), "image:vllm", progress, fn); err != nil {
+				t.Errorf("Do() error = %v", err)
+			}
+		}()
+	}
+
+	// Wait for the three callers to be listening before the transfer completes
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		mu.Lock()
+		n := 0
+		for _, current := range seen {
+			if current == 50 {
+				n++
+			}
+		}
+		mu.Unlock()
+		if n == 3 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("callers were not told the progress of the shared transfer")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+
+	if n := calls.Load(); n != 1 {
+		t.Errorf("transfer made %d times, want once", n)
+	}
+}
+
+func TestGroupTakesOverCancelledTransfer(t *testing.T) {
+	g := &Group{}
+	started := make(chan struct{})
+	ctx, cancel := context.WithCancel(context.Background())
+
+	firstErr := make(chan error, 1)
+	go func() {
+		firstErr <- g.Do(ctx, "model:granite", nil, func(ctx context.Context, _ ProgressFunc) error {
+			close(started)
+			<-ctx.Done()
+
+			return ctx.Err()
+		})
+	}()
+	<-started
+
+	secondErr := make(chan error, 1)
+	var calls atomic.Int32
+	go func() {
+		secondErr <- g.Do(context.Background(), "model:granite", nil, func(context.Context, ProgressFunc) error {
+			calls.Add(1)
+
+			return nil
+		})
+	}()
+
+	// Let the second caller wait for the first transfer before cancelling it
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	if err := <-firstErr; !errors.Is(err, context.Canceled) {
+		t.Errorf("Do() of the cancelled caller error = %v, want context.Canceled", err)
+	}
+	if err := <-secondErr; err != nil {
+		t.Errorf("Do() of the waiting caller error = %v, want nil", err)
+	}
+	if n := calls.Load(); n != 1 {
+		t.Errorf("waiting caller made the transfer %d times, want once", n)
+	}
+}
+
+func TestGroupReturnsErrors(t *testing.T) {
+	g := &Group{}
+	want := errors.New("manifest unknown")
+	err := g.Do(context.Background(), "image:missing", nil, func(context.Context, ProgressFunc) error { return want })
+	if !errors.Is(err, want) {
+		t.Errorf("Do() error = %v, want %v", err, want)
+	}
+
+	// A failed transfer is not remembered
+	if err := g.Do(context.Background(), "image:missing", nil, func(context.Context, ProgressFunc) error { return nil }); err != nil {
+		t.Errorf("Do() after a failure error = %v, want nil", err)
+	}
+}
+
+func TestRunLimitsConcurrency(t *testing.T) {
+	var running, peak atomic.Int32
+	items := []int{1, 2, 3, 4, 5, 6}
+	err := Run(context.Background(), 2, items, func(context.Context, int) error {
+		n := running.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		running.Add(-1)
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if p := peak.Load(); p > 2 {
+		t.Errorf("%d transfers ran at a time, want at most 2", p)
+	}
+
+	want := errors.New("failed")
+	err = Run(context.Background(), 2, items, func(_ context.Context, item int) error {
+		if item == 3 {
+			return want
+		}
+
+		return nil
+	})
+	if !errors.Is(err, want) {
+		t.Errorf("Run() error = %v, want %v", err, want)
+	}
+}
+
+func TestConcurrency(t *testing.T) {
+	for value, want := range map[string]int{"": DefaultConcurrency, "5": 5, "0": DefaultConcurrency, "many": DefaultConcurrency} {
+		t.Setenv(ConcurrencyEnv, value)
+		if got := Concurrency(); got != want {
+			t.Errorf("Concurrency() with %q = %d, want %d", value, got, want)
+		}
+	}
+}
+
+func TestTrackerSummary(t *testing.T) {
+	var nilTracker *Tracker
+	if nilTracker.Progress(KindImage, "vllm") != nil || nilTracker.Summary() != "" {
+		t.Error("nil tracker tracks transfers")
+	}
+	nilTracker.Done(KindImage, "vllm")
+
+	tracker := NewTracker()
+	tracker.Progress(KindImage, "vllm")(1<<30, 4<<30)
+	tracker.Progress(KindImage, "tool")
+	tracker.Done(KindImage, "tool")
+	tracker.Progress(KindModel, "granite")(300<<20, -1)
+
+	summary := tracker.Summary()
+	for _, want := range []string{
+		"images 1/2 done, pulling vllm 1.0 GiB/4.0 GiB",
+		"models 0/1 done, downloading granite 300.0 MiB",
+	} {
+		if !strings.Contains(summary, want) {
+			t.Errorf("Summary() = %q, want it to contain %q", summary, want)
+		}
+	}
+}
@@ -152,7 +152,8 @@ func ExtractDomainFromCertificate(certPath string) (string, error) {
 }
 
 // LoadUserCertificates validates staged certificate files on the host and updates Caddy to load them from container-visible paths.
-func LoadUserCertificates(hostCertPath, hostKeyPath, caddyCertPath, caddyKeyPath, adminURL string) error {
+// The admin client addresses the Caddy admin API, see proxy.NewAdminClient.
+func LoadUserCertificates(hostCertPath, hostKeyPath, caddyCertPath, caddyKeyPath string, adminClient *resty.Client) error {
 	// Read and parse staged host-side certificate files
 	_, keyBytes, cert, err := readAndParseCertificates(hostCertPath, hostKeyPath)
 	if err != nil {
@@ -165,7 +166,7 @@ func LoadUserCertificates(hostCertPath, hostKeyPath, caddyCertPath, caddyKeyPath
 	}
 
 	// Load into Caddy using container-visible mounted file paths
-	if err := loadCertificatesIntoCaddy(caddyCertPath, caddyKeyPath, adminURL); err != nil {
+	if err := loadCertificatesIntoCaddy(caddyCertPath, caddyKeyPath, adminClient); err != nil {
 		return err
 	}
 
@@ -307,7 +308,7 @@ func matchPublicPrivateKeys(publicKey, privateKey interface{}) error {
 }
 
 // loadCertificatesIntoCaddy updates the live Caddy config to load mounted certificate files.
-func loadCertificatesIntoCaddy(certPath, keyPath, adminURL string) error {
+func loadCertificatesIntoCaddy(certPath, keyPath string, adminClient *resty.Client) error {
 	payload := map[string]any{
 		"certificates": map[string]any{
 			"load_files": []map[string]string{
@@ -319,11 +320,10 @@ func loadCertificatesIntoCaddy(certPath, keyPath, adminURL string) error {
 		},
 	}
 
-	client := resty.New().SetTimeout(caddyAPITimeout)
-	resp, err := client.R().
+	resp, err := adminClient.SetTimeout(caddyAPITimeout).R().
 		SetHeader("Content-Type", "application/json").
 		SetBody(payload).
-		Patch(adminURL + "/config/apps/tls")
+		Patch("/config/apps/tls")
 
 	if err != nil {
 		return fmt.Errorf("failed to load certificates: %w", err)
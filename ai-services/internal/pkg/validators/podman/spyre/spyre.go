@@ -1,10 +1,13 @@
 package spyre
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
-	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap/spyreconfig/spyre"
+	"github.com/project-ai-services/ai-services/internal/pkg/accelerator"
+	// Registers the providers returned by accelerator.Default.
+	_ "github.com/project-ai-services/ai-services/internal/pkg/accelerator/providers"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 )
@@ -27,16 +30,25 @@ func (r *SpyreRule) Description() string {
 func (r *SpyreRule) Verify() error {
 	logger.Debugln("Running comprehensive Spyre validation...")
 
+	provider, err := accelerator.Default()
+	if err != nil {
+		return err
+	}
+
 	// Check if Spyre cards are present
-	if !spyre.IsApplicable() {
+	ctx := context.Background()
+	cards, err := provider.Discover(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to discover Spyre cards: %w", err)
+	}
+	if len(cards) == 0 {
 		return fmt.Errorf("IBM Spyre Accelerator is not attached to the LPAR")
 	}
 
-	numCards := spyre.GetNumberOfSpyreCards()
-	logger.Infof("Detected %d Spyre card(s)", numCards)
+	logger.Infof("Detected %d Spyre card(s)", len(cards))
 
 	// Run all validation checks
-	checks := spyre.RunChecks()
+	checks := provider.Health(ctx)
 
 	// Collect validation errors
 	var validationErrors []string
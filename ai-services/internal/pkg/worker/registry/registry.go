@@ -14,6 +14,7 @@ import (
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/db/models"
 	"github.com/project-ai-services/ai-services/internal/pkg/catalog/db/repository"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/metrics"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	workerpb "github.com/project-ai-services/ai-services/internal/pkg/worker/proto"
 )
@@ -42,14 +43,20 @@ type WorkerEntry struct {
 	CommandCh chan *workerpb.Command
 
 	resultsMu sync.Mutex
-	results   map[string]chan *workerpb.CommandResult
+	results   map[string]pendingResult
+}
+
+// pendingResult is the channel awaiting the result of a command, and the time the command was sent.
+type pendingResult struct {
+	ch   chan *workerpb.CommandResult
+	sent time.Time
 }
 
 // waitForResult registers a result channel for commandID and returns it.
 func (w *WorkerEntry) waitForResult(commandID string) chan *workerpb.CommandResult {
 	ch := make(chan *workerpb.CommandResult, 1)
 	w.resultsMu.Lock()
-	w.results[commandID] = ch
+	w.results[commandID] = pendingResult{ch: ch, sent: time.Now()}
 	w.resultsMu.Unlock()
 
 	return ch
@@ -59,14 +66,20 @@ func (w *WorkerEntry) waitForResult(commandID string) chan *workerpb.CommandResu
 func (w *WorkerEntry) deliverResult(res *workerpb.CommandResult) {
 	id := res.GetCommandId()
 	w.resultsMu.Lock()
-	ch, ok := w.results[id]
+	pending, ok := w.results[id]
 	if ok {
 		delete(w.results, id)
 	}
 	w.resultsMu.Unlock()
 	if ok {
+		outcome := metrics.OutcomeSuccess
+		if !res.GetSuccess() {
+			outcome = metrics.OutcomeFailure
+		}
+		metrics.WorkerCommandDuration.WithLabelValues(w.WorkerName, outcome).Observe(time.Since(pending.sent).Seconds())
+
 		select {
-		case ch <- res:
+		case pending.ch <- res:
 		default:
 		}
 	}
@@ -108,10 +121,11 @@ func (r *Registry) Register(ctx context.Context, workerName, runtimeType string,
 		entry = &WorkerEntry{
 			WorkerName: workerName,
 			CommandCh:  make(chan *workerpb.Command, commandChannelSize),
-			results:    make(map[string]chan *workerpb.CommandResult),
+			results:    make(map[string]pendingResult),
 		}
 		r.workers[workerName] = entry
 	}
+	metrics.WorkersConnected.Set(float64(len(r.workers)))
 	r.mu.Unlock()
 
 	if r.repo != nil {
@@ -181,6 +195,7 @@ func (r *Registry) Disconnect(ctx context.Context, workerName string) {
 	if ok {
 		delete(r.workers, workerName)
 	}
+	metrics.WorkersConnected.Set(float64(len(r.workers)))
 	r.mu.Unlock()
 
 	if ok && r.repo != nil && entry.DBID != uuid.Nil {
@@ -252,6 +267,7 @@ func (r *Registry) Deregister(ctx context.Context, id uuid.UUID) (bool, error) {
 			break
 		}
 	}
+	metrics.WorkersConnected.Set(float64(len(r.workers)))
 	r.mu.Unlock()
 
 	if r.repo == nil {